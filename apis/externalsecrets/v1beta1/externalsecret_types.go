@@ -356,6 +356,12 @@ type ExternalSecretSpec struct {
 	// If multiple entries are specified, the Secret keys are merged in the specified order
 	// +optional
 	DataFrom []ExternalSecretDataFromRemoteRef `json:"dataFrom,omitempty"`
+
+	// SyncMetadata copies the metadata of the secrets referenced by data and dataFrom.extract,
+	// e.g. their tags and description, to annotations prefixed with external-secrets.io/meta.
+	// on the target Secret. Stores which do not support metadata are skipped.
+	// +optional
+	SyncMetadata bool `json:"syncMetadata,omitempty"`
 }
 
 // StoreSourceRef allows you to override the SecretStore source
@@ -402,6 +408,7 @@ type ExternalSecretConditionType string
 const (
 	ExternalSecretReady   ExternalSecretConditionType = "Ready"
 	ExternalSecretDeleted ExternalSecretConditionType = "Deleted"
+	ExternalSecretPaused  ExternalSecretConditionType = "Paused"
 )
 
 type ExternalSecretStatusCondition struct {
@@ -425,12 +432,16 @@ const (
 	ConditionReasonSecretSyncedError = "SecretSyncedError"
 	// ConditionReasonSecretDeleted indicates that the secret has been deleted.
 	ConditionReasonSecretDeleted = "SecretDeleted"
+	// ConditionReasonSyncPaused indicates that syncing is paused by the paused annotation.
+	ConditionReasonSyncPaused = "SyncPaused"
 
 	ReasonUpdateFailed = "UpdateFailed"
 	ReasonDeprecated   = "ParameterDeprecated"
 	ReasonCreated      = "Created"
 	ReasonUpdated      = "Updated"
 	ReasonDeleted      = "Deleted"
+	ReasonFetchFailed  = "FetchFailed"
+	ReasonRevokeFailed = "RevokeFailed"
 )
 
 type ExternalSecretStatus struct {
@@ -447,6 +458,20 @@ type ExternalSecretStatus struct {
 
 	// Binding represents a servicebinding.io Provisioned Service reference to the secret
 	Binding corev1.LocalObjectReference `json:"binding,omitempty"`
+
+	// Leases of the dynamic credentials synced to the target secret.
+	// They are revoked once superseded or when the ExternalSecret is deleted.
+	// +optional
+	Leases []ExternalSecretLease `json:"leases,omitempty"`
+}
+
+// ExternalSecretLease is a lease of dynamic credentials issued by a store.
+type ExternalSecretLease struct {
+	// StoreRef is the store that issued the lease.
+	StoreRef SecretStoreRef `json:"storeRef"`
+
+	// ID of the lease.
+	ID string `json:"id"`
 }
 
 // +kubebuilder:object:root=true
@@ -470,6 +495,17 @@ type ExternalSecret struct {
 const (
 	// AnnotationDataHash is used to ensure consistency.
 	AnnotationDataHash = "reconcile.external-secrets.io/data-hash"
+	// AnnotationPaused pauses syncing of an ExternalSecret while it is set to "true".
+	AnnotationPaused = "external-secrets.io/paused"
+	// AnnotationMetadataPrefix prefixes the annotations of the target Secret
+	// which hold the metadata of the provider secrets when syncMetadata is set.
+	AnnotationMetadataPrefix = "external-secrets.io/meta."
+	// AnnotationExpiresAt holds the expiry of generated values of the target Secret
+	// in RFC 3339 format, the Secret is refreshed before it expires.
+	AnnotationExpiresAt = "external-secrets.io/expires-at"
+	// AnnotationLeases holds the comma separated IDs of the leases of the dynamic
+	// credentials in the target Secret.
+	AnnotationLeases = "external-secrets.io/leases"
 	// LabelOwner points to the owning ExternalSecret resource
 	//  and is used to manage the lifecycle of a Secret
 	LabelOwner = "reconcile.external-secrets.io/created-by"
@@ -20,23 +20,96 @@ import (
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
-type ExternalSecretValidator struct{}
+// +kubebuilder:object:generate=false
+type ExternalSecretValidator struct {
+	// StoreReader reads the stores referenced by an ExternalSecret.
+	// If set, remote refs are validated by the provider of their store.
+	StoreReader client.Reader
+}
 
-func (esv *ExternalSecretValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
-	return validateExternalSecret(obj)
+func (esv *ExternalSecretValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return esv.validate(ctx, obj)
 }
 
-func (esv *ExternalSecretValidator) ValidateUpdate(_ context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
-	return validateExternalSecret(newObj)
+func (esv *ExternalSecretValidator) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	return esv.validate(ctx, newObj)
 }
 
 func (esv *ExternalSecretValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
 	return nil, nil
 }
 
+func (esv *ExternalSecretValidator) validate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	warnings, err := validateExternalSecret(obj)
+	es, ok := obj.(*ExternalSecret)
+	if esv.StoreReader == nil || !ok {
+		return warnings, err
+	}
+	return warnings, errors.Join(err, esv.validateRemoteRefs(ctx, es))
+}
+
+// validateRemoteRefs checks the remote keys of data and dataFrom.extract
+// with the providers of their stores. Stores which can not be read are skipped,
+// they may be created after the ExternalSecret.
+func (esv *ExternalSecretValidator) validateRemoteRefs(ctx context.Context, es *ExternalSecret) error {
+	var errs error
+	for i, data := range es.Spec.Data {
+		storeRef := es.Spec.SecretStoreRef
+		if data.SourceRef != nil {
+			if data.SourceRef.GeneratorRef != nil {
+				continue
+			}
+			if data.SourceRef.SecretStoreRef.Name != "" {
+				storeRef = data.SourceRef.SecretStoreRef
+			}
+		}
+		if err := esv.validateRemoteRef(ctx, es.Namespace, storeRef, data.RemoteRef); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("invalid data[%d].remoteRef: %w", i, err))
+		}
+	}
+	for i, ref := range es.Spec.DataFrom {
+		if ref.Extract == nil {
+			continue
+		}
+		storeRef := es.Spec.SecretStoreRef
+		if ref.SourceRef != nil && ref.SourceRef.SecretStoreRef != nil {
+			storeRef = *ref.SourceRef.SecretStoreRef
+		}
+		if err := esv.validateRemoteRef(ctx, es.Namespace, storeRef, *ref.Extract); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("invalid dataFrom[%d].extract: %w", i, err))
+		}
+	}
+	return errs
+}
+
+func (esv *ExternalSecretValidator) validateRemoteRef(ctx context.Context, namespace string, storeRef SecretStoreRef, ref ExternalSecretDataRemoteRef) error {
+	var store GenericStore
+	key := types.NamespacedName{Name: storeRef.Name}
+	if storeRef.Kind == ClusterSecretStoreKind {
+		store = &ClusterSecretStore{}
+	} else {
+		store = &SecretStore{}
+		key.Namespace = namespace
+	}
+	if storeRef.Name == "" || esv.StoreReader.Get(ctx, key, store) != nil {
+		return nil
+	}
+	provider, err := GetProvider(store)
+	if err != nil {
+		return nil
+	}
+	validator, ok := provider.(RemoteRefValidator)
+	if !ok {
+		return nil
+	}
+	return validator.ValidateRemoteRef(store, ref)
+}
+
 func validateExternalSecret(obj runtime.Object) (admission.Warnings, error) {
 	es, ok := obj.(*ExternalSecret)
 	if !ok {
@@ -15,11 +15,27 @@ limitations under the License.
 package v1beta1
 
 import (
+	"context"
+	"errors"
 	"testing"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
+// RemoteRefValidationProvider rejects remote keys named "invalid".
+type RemoteRefValidationProvider struct {
+	Provider
+}
+
+func (v *RemoteRefValidationProvider) ValidateRemoteRef(_ GenericStore, ref ExternalSecretDataRemoteRef) error {
+	if ref.Key == "invalid" {
+		return errors.New("invalid key")
+	}
+	return nil
+}
+
 func TestValidateExternalSecret(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -218,3 +234,94 @@ either data or dataFrom should be specified`,
 		})
 	}
 }
+
+func TestValidateExternalSecretRemoteRefs(t *testing.T) {
+	ForceRegister(&RemoteRefValidationProvider{}, &SecretStoreProvider{
+		Fake: &FakeProvider{},
+	})
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	kube := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&SecretStore{
+			ObjectMeta: metav1.ObjectMeta{Name: "store", Namespace: "default"},
+			Spec:       SecretStoreSpec{Provider: &SecretStoreProvider{Fake: &FakeProvider{}}},
+		},
+		&ClusterSecretStore{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster-store"},
+			Spec:       SecretStoreSpec{Provider: &SecretStoreProvider{Fake: &FakeProvider{}}},
+		},
+	).Build()
+
+	tests := []struct {
+		name        string
+		disabled    bool
+		spec        ExternalSecretSpec
+		expectedErr string
+	}{
+		{
+			name: "valid keys",
+			spec: ExternalSecretSpec{
+				SecretStoreRef: SecretStoreRef{Name: "store"},
+				Data:           []ExternalSecretData{{SecretKey: "a", RemoteRef: ExternalSecretDataRemoteRef{Key: "valid"}}},
+			},
+		},
+		{
+			name: "invalid data key",
+			spec: ExternalSecretSpec{
+				SecretStoreRef: SecretStoreRef{Name: "store"},
+				Data:           []ExternalSecretData{{SecretKey: "a", RemoteRef: ExternalSecretDataRemoteRef{Key: "invalid"}}},
+			},
+			expectedErr: "invalid data[0].remoteRef: invalid key",
+		},
+		{
+			name: "invalid extract key of a cluster store",
+			spec: ExternalSecretSpec{
+				SecretStoreRef: SecretStoreRef{Name: "missing"},
+				DataFrom: []ExternalSecretDataFromRemoteRef{{
+					Extract:   &ExternalSecretDataRemoteRef{Key: "invalid"},
+					SourceRef: &StoreGeneratorSourceRef{SecretStoreRef: &SecretStoreRef{Name: "cluster-store", Kind: ClusterSecretStoreKind}},
+				}},
+			},
+			expectedErr: "invalid dataFrom[0].extract: invalid key",
+		},
+		{
+			name: "missing store",
+			spec: ExternalSecretSpec{
+				SecretStoreRef: SecretStoreRef{Name: "missing"},
+				Data:           []ExternalSecretData{{SecretKey: "a", RemoteRef: ExternalSecretDataRemoteRef{Key: "invalid"}}},
+			},
+		},
+		{
+			name:     "validation disabled",
+			disabled: true,
+			spec: ExternalSecretSpec{
+				SecretStoreRef: SecretStoreRef{Name: "store"},
+				Data:           []ExternalSecretData{{SecretKey: "a", RemoteRef: ExternalSecretDataRemoteRef{Key: "invalid"}}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			esv := &ExternalSecretValidator{}
+			if !tt.disabled {
+				esv.StoreReader = kube
+			}
+			es := &ExternalSecret{
+				ObjectMeta: metav1.ObjectMeta{Name: "es", Namespace: "default"},
+				Spec:       tt.spec,
+			}
+			_, err := esv.ValidateCreate(context.Background(), es)
+			if tt.expectedErr == "" {
+				if err != nil {
+					t.Fatalf("ValidateCreate() returned an unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.expectedErr {
+				t.Fatalf("ValidateCreate() returned an unexpected error: got: %v, expected: %v", err, tt.expectedErr)
+			}
+		})
+	}
+}
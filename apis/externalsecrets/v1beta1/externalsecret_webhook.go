@@ -19,8 +19,12 @@ import (
 )
 
 func (r *ExternalSecret) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return r.SetupWebhookWithValidator(mgr, &ExternalSecretValidator{})
+}
+
+func (r *ExternalSecret) SetupWebhookWithValidator(mgr ctrl.Manager, validator *ExternalSecretValidator) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
-		WithValidator(&ExternalSecretValidator{}).
+		WithValidator(validator).
 		Complete()
 }
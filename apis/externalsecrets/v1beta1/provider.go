@@ -16,6 +16,7 @@ package v1beta1
 
 import (
 	"context"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -66,6 +67,18 @@ type Provider interface {
 // +k8s:deepcopy-gen:interfaces=nil
 // +k8s:deepcopy-gen=nil
 
+// RemoteRefValidator is implemented by Providers that can check the syntax of remote keys
+// without connecting to the secret backend. Providers which don't implement it accept any key.
+type RemoteRefValidator interface {
+	// ValidateRemoteRef checks if the remote key of ref is valid for the store.
+	ValidateRemoteRef(store GenericStore, ref ExternalSecretDataRemoteRef) error
+}
+
+// +kubebuilder:object:root=false
+// +kubebuilder:object:generate:false
+// +k8s:deepcopy-gen:interfaces=nil
+// +k8s:deepcopy-gen=nil
+
 // SecretsClient provides access to secrets.
 type SecretsClient interface {
 	// GetSecret returns a single secret from the provider
@@ -96,6 +109,36 @@ type SecretsClient interface {
 	Close(ctx context.Context) error
 }
 
+// +kubebuilder:object:root=false
+// +kubebuilder:object:generate:false
+// +k8s:deepcopy-gen:interfaces=nil
+// +k8s:deepcopy-gen=nil
+
+// LeaseClient is implemented by SecretsClients that issue leased dynamic credentials.
+type LeaseClient interface {
+	// IssuedLeases returns the IDs of the leases issued since the last call.
+	IssuedLeases() []string
+
+	// RenewLease extends a lease issued by the store by increment and returns its
+	// remaining TTL, which may be shorter when the lease reaches its maximum TTL.
+	RenewLease(ctx context.Context, leaseID string, increment time.Duration) (time.Duration, error)
+
+	// RevokeLease revokes a lease issued by the store.
+	RevokeLease(ctx context.Context, leaseID string) error
+}
+
+// +kubebuilder:object:root=false
+// +kubebuilder:object:generate:false
+// +k8s:deepcopy-gen:interfaces=nil
+// +k8s:deepcopy-gen=nil
+
+// MetadataClient is implemented by SecretsClients that can read the metadata of a secret,
+// e.g. its tags or description, for ExternalSecrets with syncMetadata.
+type MetadataClient interface {
+	// GetSecretMetadata returns the metadata of the secret referenced by ref.
+	GetSecretMetadata(ctx context.Context, ref ExternalSecretDataRemoteRef) (map[string]string, error)
+}
+
 var NoSecretErr = NoSecretError{}
 
 // NoSecretError shall be returned when a GetSecret can not find the
@@ -29,4 +29,9 @@ type PassboltProvider struct {
 	Auth *PassboltAuth `json:"auth"`
 	// Host defines the Passbolt Server to connect to
 	Host string `json:"host"`
+	// ServerFingerprint is the fingerprint of the Passbolt server's OpenPGP key.
+	// When set, the server has to prove that it holds the matching private key
+	// before the operator logs in.
+	// +optional
+	ServerFingerprint string `json:"serverFingerprint,omitempty"`
 }
@@ -0,0 +1,41 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// AnsibleVaultProvider configures a store to sync values encrypted with Ansible Vault
+// and stored in a ConfigMap.
+type AnsibleVaultProvider struct {
+	// ConfigMap holding the encrypted values, every key of the ConfigMap is either a
+	// vault encrypted file or a YAML file with variables encrypted with "ansible-vault encrypt_string".
+	ConfigMap AnsibleVaultConfigMapRef `json:"configMap"`
+
+	// PasswordSecretRef is a reference to a key in a Secret containing the vault password.
+	PasswordSecretRef esmeta.SecretKeySelector `json:"passwordSecretRef"`
+}
+
+// AnsibleVaultConfigMapRef references the ConfigMap holding the encrypted values.
+type AnsibleVaultConfigMapRef struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap, can only be defined when used in a ClusterSecretStore.
+	// Defaults to the namespace of the ExternalSecret.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+}
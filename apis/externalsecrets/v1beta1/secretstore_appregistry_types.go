@@ -0,0 +1,35 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// AppRegistryProvider configures a store to sync the attribute groups of an
+// application registered in AWS Service Catalog AppRegistry.
+type AppRegistryProvider struct {
+	// Region of the application.
+	Region string `json:"region"`
+
+	// Application is the name, ID or ARN of the application, only attribute
+	// groups associated with it can be read.
+	Application string `json:"application"`
+
+	// Auth defines the information necessary to authenticate against AWS,
+	// if not set the default credential chain of the operator is used.
+	// +optional
+	Auth AWSAuth `json:"auth,omitempty"`
+
+	// Role is a Role ARN which is assumed to call AppRegistry.
+	// +optional
+	Role string `json:"role,omitempty"`
+}
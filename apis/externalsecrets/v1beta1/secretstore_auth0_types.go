@@ -0,0 +1,46 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// Auth0Provider configures a store to sync the credentials of applications of an Auth0 tenant.
+type Auth0Provider struct {
+	// Domain of the Auth0 tenant, e.g: "acme.eu.auth0.com".
+	// The Management API is requested on this domain, whichever region the tenant is in.
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9.-]+$`
+	Domain string `json:"domain"`
+
+	// CustomDomain of the tenant, e.g: "login.acme.com". If set, it is returned
+	// as the domain of the applications instead of the tenant domain.
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9.-]+$`
+	// +optional
+	CustomDomain string `json:"customDomain,omitempty"`
+
+	// Auth configures how the operator authenticates with the Auth0 Management API.
+	Auth Auth0Auth `json:"auth"`
+}
+
+// Auth0Auth contains the client credentials of a machine to machine application
+// authorized to request the Management API.
+type Auth0Auth struct {
+	// ClientID of an application with the read:clients and read:client_keys scopes.
+	ClientID esmeta.SecretKeySelector `json:"clientID"`
+
+	// ClientSecret of the application.
+	ClientSecret esmeta.SecretKeySelector `json:"clientSecret"`
+}
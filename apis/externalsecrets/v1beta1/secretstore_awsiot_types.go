@@ -0,0 +1,36 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// AWSIoTProvider configures a store to provision X.509 certificates for
+// AWS IoT Core things. A certificate and its keys are created once and kept
+// until the certificate becomes inactive, is revoked or the ExternalSecret is deleted.
+type AWSIoTProvider struct {
+	// Region of the things.
+	Region string `json:"region"`
+
+	// PolicyNames are the names of the IoT policies attached to new certificates.
+	// +optional
+	PolicyNames []string `json:"policyNames,omitempty"`
+
+	// Auth defines the information necessary to authenticate against AWS,
+	// if not set the default credential chain of the operator is used.
+	// +optional
+	Auth AWSAuth `json:"auth,omitempty"`
+
+	// Role is a Role ARN which is assumed to call AWS IoT.
+	// +optional
+	Role string `json:"role,omitempty"`
+}
@@ -0,0 +1,52 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// AzureDevOpsProvider configures a store to sync variables of Azure DevOps variable groups.
+type AzureDevOpsProvider struct {
+	// OrganizationURL is the URL of the Azure DevOps organization, e.g: "https://dev.azure.com/my-org".
+	OrganizationURL string `json:"organizationURL"`
+
+	// Auth configures how the operator authenticates with Azure DevOps.
+	// Exactly one of personalAccessToken and servicePrincipal must be set.
+	Auth AzureDevOpsAuth `json:"auth"`
+}
+
+// AzureDevOpsAuth contains the credentials used to authenticate with Azure DevOps.
+type AzureDevOpsAuth struct {
+	// PersonalAccessToken is a reference to a personal access token with the Variable Groups (Read) scope.
+	// +optional
+	PersonalAccessToken *esmeta.SecretKeySelector `json:"personalAccessToken,omitempty"`
+
+	// ServicePrincipal authenticates with an Azure AD service principal added to the organization.
+	// +optional
+	ServicePrincipal *AzureDevOpsServicePrincipal `json:"servicePrincipal,omitempty"`
+}
+
+// AzureDevOpsServicePrincipal contains the client credentials of an Azure AD service principal.
+type AzureDevOpsServicePrincipal struct {
+	// TenantID of the Azure AD tenant of the service principal.
+	TenantID string `json:"tenantID"`
+
+	// ClientID of the service principal.
+	ClientID esmeta.SecretKeySelector `json:"clientID"`
+
+	// ClientSecret of the service principal.
+	ClientSecret esmeta.SecretKeySelector `json:"clientSecret"`
+}
@@ -0,0 +1,60 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// BitbucketProvider configures a store to sync Bitbucket Cloud workspace and repository variables.
+type BitbucketProvider struct {
+	// URL of the Bitbucket Cloud API.
+	// +kubebuilder:default="https://api.bitbucket.org/2.0"
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Auth configures how the operator authenticates with Bitbucket.
+	// Exactly one of appPassword and oauth must be set.
+	Auth BitbucketAuth `json:"auth"`
+}
+
+// BitbucketAuth contains the credentials used to authenticate with Bitbucket.
+type BitbucketAuth struct {
+	// AppPassword authenticates as a user with an app password.
+	// +optional
+	AppPassword *BitbucketAppPassword `json:"appPassword,omitempty"`
+
+	// OAuth authenticates with the client credentials of an OAuth consumer.
+	// +optional
+	OAuth *BitbucketOAuth `json:"oauth,omitempty"`
+}
+
+// BitbucketAppPassword contains a username and an app password.
+type BitbucketAppPassword struct {
+	// Username of the Bitbucket user owning the app password.
+	Username string `json:"username"`
+
+	// Password is a reference to an app password with the Pipelines permissions.
+	Password esmeta.SecretKeySelector `json:"password"`
+}
+
+// BitbucketOAuth contains the client credentials of an OAuth consumer.
+type BitbucketOAuth struct {
+	// ClientID is the key of the OAuth consumer.
+	ClientID esmeta.SecretKeySelector `json:"clientID"`
+
+	// ClientSecret is the secret of the OAuth consumer.
+	ClientSecret esmeta.SecretKeySelector `json:"clientSecret"`
+}
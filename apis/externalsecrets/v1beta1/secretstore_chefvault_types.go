@@ -0,0 +1,26 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// ChefVaultProvider configures a store to sync items of chef-vault, data bag items encrypted for Chef clients.
+type ChefVaultProvider struct {
+	// Auth defines the information necessary to authenticate against chef Server.
+	// The private key is also used to decrypt the vault items, they must be shared with the client.
+	Auth *ChefAuth `json:"auth"`
+	// UserName is the name of the client or user on the chef server
+	UserName string `json:"username"`
+	// ServerURL is the chef server URL used to connect to. If using orgs you should include your org in the url and terminate the url with a "/"
+	ServerURL string `json:"serverUrl"`
+}
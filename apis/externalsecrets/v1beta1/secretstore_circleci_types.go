@@ -0,0 +1,40 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// CircleCIProvider configures a store to push environment variables of CircleCI contexts.
+type CircleCIProvider struct {
+	// URL of the CircleCI v2 API.
+	// +kubebuilder:default="https://circleci.com/api/v2"
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// OwnerSlug of the organization owning the contexts, e.g: "gh/my-org".
+	OwnerSlug string `json:"ownerSlug"`
+
+	// Auth configures how the operator authenticates with CircleCI.
+	Auth CircleCIAuth `json:"auth"`
+}
+
+// CircleCIAuth contains the API token used to authenticate with CircleCI.
+type CircleCIAuth struct {
+	// APIToken is a reference to a personal API token of a member of the organization
+	// who may manage the contexts.
+	APIToken esmeta.SecretKeySelector `json:"apiToken"`
+}
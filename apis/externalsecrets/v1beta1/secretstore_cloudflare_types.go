@@ -0,0 +1,37 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// CloudflareProvider configures a store to push secrets of Cloudflare Workers.
+type CloudflareProvider struct {
+	// URL of the Cloudflare v4 API.
+	// +kubebuilder:default="https://api.cloudflare.com/client/v4"
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Auth configures how the operator authenticates with Cloudflare.
+	Auth CloudflareAuth `json:"auth"`
+}
+
+// CloudflareAuth contains the API token used to authenticate with Cloudflare.
+type CloudflareAuth struct {
+	// APIToken is a reference to an API token with the Workers Scripts Edit permission
+	// on the accounts of the workers.
+	APIToken esmeta.SecretKeySelector `json:"apiToken"`
+}
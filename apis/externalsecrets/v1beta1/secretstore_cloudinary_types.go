@@ -0,0 +1,43 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// CloudinaryProvider configures a store to sync the API credentials of the product environments
+// of a Cloudinary account with the Provisioning API.
+type CloudinaryProvider struct {
+	// URL of the Cloudinary API.
+	// +kubebuilder:default="https://api.cloudinary.com"
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// AccountID is the ID of the Cloudinary account.
+	AccountID string `json:"accountId"`
+
+	// Auth configures how the operator authenticates with Cloudinary.
+	Auth CloudinaryAuth `json:"auth"`
+}
+
+// CloudinaryAuth contains the provisioning API key of the account.
+type CloudinaryAuth struct {
+	// APIKey is a reference to the provisioning API key.
+	APIKey esmeta.SecretKeySelector `json:"apiKey"`
+
+	// APISecret is a reference to the provisioning API secret.
+	APISecret esmeta.SecretKeySelector `json:"apiSecret"`
+}
@@ -0,0 +1,43 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// ConfluentProvider configures a store to sync API keys of Confluent Cloud.
+type ConfluentProvider struct {
+	// URL of the Confluent Cloud API.
+	// +kubebuilder:default="https://api.confluent.cloud"
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// OwnerID is the ID of the service account or user owning the API keys created by a PushSecret.
+	// +optional
+	OwnerID string `json:"ownerID,omitempty"`
+
+	// Auth configures how the operator authenticates with Confluent Cloud.
+	Auth ConfluentAuth `json:"auth"`
+}
+
+// ConfluentAuth contains the Cloud API key used to authenticate with Confluent Cloud.
+type ConfluentAuth struct {
+	// APIKey is a reference to the ID of a Cloud API key.
+	APIKey esmeta.SecretKeySelector `json:"apiKey"`
+
+	// APISecret is a reference to the secret of the Cloud API key.
+	APISecret esmeta.SecretKeySelector `json:"apiSecret"`
+}
@@ -59,3 +59,51 @@ type ConjurJWT struct {
 	// +optional
 	ServiceAccountRef *esmeta.ServiceAccountSelector `json:"serviceAccountRef,omitempty"`
 }
+
+// ConjurCloudProvider configures a store to sync secrets from Conjur Cloud,
+// the SaaS edition of CyberArk Conjur.
+type ConjurCloudProvider struct {
+	// Subdomain of the CyberArk tenant, e.g: "acme" for https://acme.secretsmgr.cyberark.cloud.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`
+	Subdomain string `json:"subdomain"`
+
+	Auth ConjurCloudAuth `json:"auth"`
+}
+
+type ConjurCloudAuth struct {
+	// +optional
+	Jwt *ConjurCloudJWT `json:"jwt,omitempty"`
+	// +optional
+	Oidc *ConjurCloudOIDC `json:"oidc,omitempty"`
+}
+
+type ConjurCloudJWT struct {
+	// The conjur authn jwt webservice id
+	ServiceID string `json:"serviceID"`
+
+	// Optional HostID for JWT authentication. This may be used depending
+	// on how the Conjur JWT authenticator policy is configured.
+	// +optional
+	HostID string `json:"hostId,omitempty"`
+
+	// Optional SecretRef that refers to a key in a Secret resource containing JWT token to
+	// authenticate with Conjur using the JWT authentication method.
+	// +optional
+	SecretRef *esmeta.SecretKeySelector `json:"secretRef,omitempty"`
+
+	// Optional ServiceAccountRef specifies the Kubernetes service account for which to request
+	// a token for with the `TokenRequest` API.
+	// +optional
+	ServiceAccountRef *esmeta.ServiceAccountSelector `json:"serviceAccountRef,omitempty"`
+}
+
+type ConjurCloudOIDC struct {
+	// The conjur authn oidc webservice id.
+	// Defaults to "cyberark", the authenticator trusting CyberArk Identity.
+	// +optional
+	ServiceID string `json:"serviceID,omitempty"`
+
+	// IDTokenRef refers to a key in a Secret resource containing an ID token
+	// issued by the identity provider of the authenticator.
+	IDTokenRef esmeta.SecretKeySelector `json:"idTokenRef"`
+}
@@ -0,0 +1,48 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// ConsulProvider configures a store to sync secrets using the HashiCorp Consul KV store.
+type ConsulProvider struct {
+	// Server is the address of the Consul HTTP API, e.g: "https://consul.example.com:8501".
+	Server string `json:"server"`
+
+	// Datacenter to query. If not set, the datacenter of the agent
+	// serving the request is used.
+	// +optional
+	Datacenter string `json:"datacenter,omitempty"`
+
+	// Prefix is prepended to every remote key, e.g: "secrets/team-a".
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// PEM encoded CA bundle used to validate the Consul server certificate.
+	// If not set the system root certificates are used.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// Auth configures how the operator authenticates with Consul.
+	Auth ConsulAuth `json:"auth"`
+}
+
+// ConsulAuth contains the ACL token used to authenticate with Consul.
+type ConsulAuth struct {
+	// TokenSecretRef is a reference to a key in a Secret containing the Consul ACL token.
+	TokenSecretRef esmeta.SecretKeySelector `json:"tokenSecretRef"`
+}
@@ -0,0 +1,40 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// DatadogProvider configures a store to sync API keys and application keys of a Datadog organization.
+type DatadogProvider struct {
+	// URL of the Datadog API of the site, e.g: "https://api.datadoghq.eu".
+	// +kubebuilder:default="https://api.datadoghq.com"
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Auth configures how the operator authenticates with Datadog.
+	Auth DatadogAuth `json:"auth"`
+}
+
+// DatadogAuth contains the keys used to authenticate with the Datadog API.
+type DatadogAuth struct {
+	// APIKey is a reference to an API key of the organization.
+	APIKey esmeta.SecretKeySelector `json:"apiKey"`
+
+	// AppKey is a reference to an application key allowed to read the API keys,
+	// and the application keys of the organization to sync application keys.
+	AppKey esmeta.SecretKeySelector `json:"appKey"`
+}
@@ -0,0 +1,67 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// EtcdProvider configures a store to sync secrets stored as keys in an etcd v3 cluster.
+type EtcdProvider struct {
+	// Endpoints of the etcd cluster, e.g: "https://etcd-0.example.com:2379".
+	// The client fails over to the next endpoint when one can not be reached.
+	// +kubebuilder:validation:MinItems=1
+	Endpoints []string `json:"endpoints"`
+
+	// Prefix is prepended to every remote key, e.g: "/secrets/team-a".
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// PEM encoded CA bundle used to validate the etcd server certificate.
+	// If not set the system root certificates are used.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// Auth configures how the operator authenticates with etcd.
+	// +optional
+	Auth EtcdAuth `json:"auth,omitempty"`
+}
+
+// EtcdAuth configures username/password and/or client certificate authentication.
+type EtcdAuth struct {
+	// SecretRef holds the username and password of an etcd user.
+	// +optional
+	SecretRef *EtcdAuthSecretRef `json:"secretRef,omitempty"`
+
+	// ClientTLS configures the client certificate presented to etcd.
+	// +optional
+	ClientTLS *EtcdClientTLS `json:"tls,omitempty"`
+}
+
+type EtcdAuthSecretRef struct {
+	// Username of the etcd user.
+	Username esmeta.SecretKeySelector `json:"username"`
+
+	// Password of the etcd user.
+	Password esmeta.SecretKeySelector `json:"password"`
+}
+
+type EtcdClientTLS struct {
+	// CertSecretRef is a reference to a PEM encoded client certificate.
+	CertSecretRef esmeta.SecretKeySelector `json:"certSecretRef"`
+
+	// KeySecretRef is a reference to the PEM encoded private key of the client certificate.
+	KeySecretRef esmeta.SecretKeySelector `json:"keySecretRef"`
+}
@@ -49,4 +49,10 @@ type GCPSMProvider struct {
 
 	// Location optionally defines a location for a secret
 	Location string `json:"location,omitempty"`
+
+	// VersionsToKeep is the number of enabled versions a secret keeps when it is
+	// written by a PushSecret, older versions are disabled. All versions are kept if not set.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	VersionsToKeep int32 `json:"versionsToKeep,omitempty"`
 }
@@ -0,0 +1,49 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// GitHubSecretsProvider configures a store to push GitHub Actions secrets of organizations and repositories.
+type GitHubSecretsProvider struct {
+	// URL of the GitHub API, e.g. "https://github.example.com/api/v3" for GitHub Enterprise Server.
+	// +kubebuilder:default="https://api.github.com"
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// AppID of the GitHub App.
+	AppID string `json:"appID"`
+
+	// InstallationID of the GitHub App in the organization or user account owning the secrets.
+	InstallationID string `json:"installationID"`
+
+	// Visibility of the organization secrets created by the store.
+	// +kubebuilder:validation:Enum=all;private
+	// +kubebuilder:default=private
+	// +optional
+	Visibility string `json:"visibility,omitempty"`
+
+	// Auth configures how the operator authenticates with GitHub.
+	Auth GitHubSecretsAuth `json:"auth"`
+}
+
+// GitHubSecretsAuth contains the private key of the GitHub App.
+type GitHubSecretsAuth struct {
+	// PrivateKey is a reference to a key in a Secret containing the PEM encoded private key of the GitHub App.
+	// The App needs write access to the secrets of the organization or repositories.
+	PrivateKey esmeta.SecretKeySelector `json:"privateKey"`
+}
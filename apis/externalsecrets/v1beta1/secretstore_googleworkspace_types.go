@@ -0,0 +1,38 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GoogleWorkspaceProvider configures a store to issue keys of Google Cloud service accounts,
+// e.g. of the service accounts granted domain-wide delegation in Google Workspace.
+type GoogleWorkspaceProvider struct {
+	// Auth defines how to authenticate with the IAM API of Google Cloud.
+	// The identity needs the Service Account Key Admin role on the service accounts.
+	// +optional
+	Auth GCPSMAuth `json:"auth,omitempty"`
+
+	// ProjectID of the cluster, used to authenticate with workload identity.
+	// +optional
+	ProjectID string `json:"projectID,omitempty"`
+
+	// KeyRotationPeriod is the age after which a key is replaced by a new one,
+	// determined by the time the key was created.
+	// +kubebuilder:default="2160h"
+	// +optional
+	KeyRotationPeriod *metav1.Duration `json:"keyRotationPeriod,omitempty"`
+}
@@ -0,0 +1,42 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// HieraProvider configures a store to look up secrets in Hiera data files stored in a ConfigMap.
+type HieraProvider struct {
+	// ConfigMap holding the Hiera data files, every key of the ConfigMap is a YAML data file.
+	ConfigMap HieraConfigMapRef `json:"configMap"`
+
+	// Hierarchy lists the data files a key is looked up in, the first file defining the key wins.
+	// The entries are keys of the ConfigMap and may interpolate variables, e.g. "%{environment}.yaml".
+	// +kubebuilder:default={"common.yaml"}
+	// +optional
+	Hierarchy []string `json:"hierarchy,omitempty"`
+
+	// Variables available to the interpolations of the hierarchy and the data files, e.g. facts like environment.
+	// +optional
+	Variables map[string]string `json:"variables,omitempty"`
+}
+
+// HieraConfigMapRef references the ConfigMap holding the Hiera data files.
+type HieraConfigMapRef struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap, can only be defined when used in a ClusterSecretStore.
+	// Defaults to the namespace of the ExternalSecret.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+}
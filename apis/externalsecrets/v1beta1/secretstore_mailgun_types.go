@@ -0,0 +1,36 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// MailgunProvider configures a store to sync the credentials of Mailgun sending domains.
+type MailgunProvider struct {
+	// URL of the Mailgun API, https://api.eu.mailgun.net for domains in the EU region.
+	// +kubebuilder:default="https://api.mailgun.net"
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Auth configures how the operator authenticates with Mailgun.
+	Auth MailgunAuth `json:"auth"`
+}
+
+// MailgunAuth contains the credentials used to authenticate with Mailgun.
+type MailgunAuth struct {
+	// APIKey is a reference to the private API key of the account.
+	APIKey esmeta.SecretKeySelector `json:"apiKey"`
+}
@@ -0,0 +1,42 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// MongoDBAtlasProvider configures a store to sync programmatic API keys of a MongoDB Atlas organization.
+type MongoDBAtlasProvider struct {
+	// URL of the Atlas Administration API.
+	// +kubebuilder:default="https://cloud.mongodb.com"
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// OrganizationID is the ID of the organization owning the API keys.
+	OrganizationID string `json:"organizationID"`
+
+	// Auth configures how the operator authenticates with MongoDB Atlas.
+	Auth MongoDBAtlasAuth `json:"auth"`
+}
+
+// MongoDBAtlasAuth contains the programmatic API key used to authenticate with digest authentication.
+type MongoDBAtlasAuth struct {
+	// PublicKey is a reference to the public key of the API key.
+	PublicKey esmeta.SecretKeySelector `json:"publicKey"`
+
+	// PrivateKey is a reference to the private key of the API key.
+	PrivateKey esmeta.SecretKeySelector `json:"privateKey"`
+}
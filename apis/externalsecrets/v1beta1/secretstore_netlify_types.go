@@ -0,0 +1,54 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// NetlifyProvider configures a store to sync environment variables of Netlify accounts and sites.
+type NetlifyProvider struct {
+	// URL of the Netlify API.
+	// +kubebuilder:default="https://api.netlify.com"
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// AccountID is the ID or slug of the team owning the variables.
+	AccountID string `json:"accountID"`
+
+	// Scopes in which pushed variables are available, all scopes if empty.
+	// +optional
+	Scopes []NetlifyScope `json:"scopes,omitempty"`
+
+	// Auth configures how the operator authenticates with Netlify.
+	Auth NetlifyAuth `json:"auth"`
+}
+
+// NetlifyScope is a part of Netlify in which an environment variable is available.
+// +kubebuilder:validation:Enum=builds;functions;runtime;post-processing
+type NetlifyScope string
+
+const (
+	NetlifyScopeBuilds         NetlifyScope = "builds"
+	NetlifyScopeFunctions      NetlifyScope = "functions"
+	NetlifyScopeRuntime        NetlifyScope = "runtime"
+	NetlifyScopePostProcessing NetlifyScope = "post-processing"
+)
+
+// NetlifyAuth contains the credentials used to authenticate with Netlify.
+type NetlifyAuth struct {
+	// AccessToken is a reference to a personal access token of a member of the team.
+	AccessToken esmeta.SecretKeySelector `json:"accessToken"`
+}
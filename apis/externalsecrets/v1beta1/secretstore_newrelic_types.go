@@ -0,0 +1,36 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// NewRelicProvider configures a store to sync license keys, browser keys and user keys of New Relic accounts.
+type NewRelicProvider struct {
+	// URL of the NerdGraph API, e.g: "https://api.eu.newrelic.com/graphql" for accounts in the EU region.
+	// +kubebuilder:default="https://api.newrelic.com/graphql"
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Auth configures how the operator authenticates with New Relic.
+	Auth NewRelicAuth `json:"auth"`
+}
+
+// NewRelicAuth contains the user key used to authenticate with NerdGraph.
+type NewRelicAuth struct {
+	// APIKey is a reference to a user key (NRAK-...) of a user allowed to view the keys of the accounts.
+	APIKey esmeta.SecretKeySelector `json:"apiKey"`
+}
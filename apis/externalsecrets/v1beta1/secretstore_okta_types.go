@@ -0,0 +1,35 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// OktaProvider configures a store to sync the credentials of OAuth 2.0 client applications of an Okta org.
+type OktaProvider struct {
+	// OrgURL is the URL of the Okta org, e.g: "https://acme.okta.com".
+	OrgURL string `json:"orgURL"`
+
+	// Auth configures how the operator authenticates with Okta.
+	Auth OktaAuth `json:"auth"`
+}
+
+// OktaAuth contains the API token used to authenticate with Okta.
+type OktaAuth struct {
+	// APIToken is a reference to an Okta API token of an admin who may read
+	// the applications and their client secrets.
+	APIToken esmeta.SecretKeySelector `json:"apiToken"`
+}
@@ -0,0 +1,36 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// PagerDutyProvider configures a store to sync integration keys of PagerDuty services.
+type PagerDutyProvider struct {
+	// URL of the PagerDuty REST API, e.g: "https://api.eu.pagerduty.com" for accounts in the EU service region.
+	// +kubebuilder:default="https://api.pagerduty.com"
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Auth configures how the operator authenticates with PagerDuty.
+	Auth PagerDutyAuth `json:"auth"`
+}
+
+// PagerDutyAuth contains the API token used to authenticate with PagerDuty.
+type PagerDutyAuth struct {
+	// APIToken is a reference to a read-only account or user API token.
+	APIToken esmeta.SecretKeySelector `json:"apiToken"`
+}
@@ -0,0 +1,34 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// PluginProvider configures a store to delegate every call to an out-of-process
+// provider plugin implementing the ProviderPlugin gRPC service.
+type PluginProvider struct {
+	// Endpoint is the gRPC target of the plugin,
+	// e.g: "unix:///var/run/plugin/plugin.sock" or "dns:///plugin.plugins.svc:9090".
+	Endpoint string `json:"endpoint"`
+
+	// PEM encoded CA bundle used to validate the plugin server certificate.
+	// If not set the system CAs are used. Plugins listening on a unix socket
+	// or a loopback address are connected to without TLS.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// Insecure connects to the plugin without TLS even if it is not listening
+	// on a unix socket or a loopback address. Secrets are sent in plaintext.
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+}
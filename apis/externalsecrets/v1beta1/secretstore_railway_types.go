@@ -0,0 +1,42 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// RailwayProvider configures a store to sync variables of a Railway project environment.
+type RailwayProvider struct {
+	// URL of the Railway GraphQL API.
+	// +kubebuilder:default="https://backboard.railway.app/graphql/v2"
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// ProjectID is the ID of the project owning the variables.
+	ProjectID string `json:"projectID"`
+
+	// EnvironmentID is the ID of the environment of the project, e.g. production.
+	EnvironmentID string `json:"environmentID"`
+
+	// Auth configures how the operator authenticates with Railway.
+	Auth RailwayAuth `json:"auth"`
+}
+
+// RailwayAuth contains the credentials used to authenticate with Railway.
+type RailwayAuth struct {
+	// Token is a reference to an account or team token with access to the project.
+	Token esmeta.SecretKeySelector `json:"token"`
+}
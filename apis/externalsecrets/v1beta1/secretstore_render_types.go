@@ -0,0 +1,36 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// RenderProvider configures a store to sync environment variables of Render services.
+type RenderProvider struct {
+	// URL of the Render API.
+	// +kubebuilder:default="https://api.render.com/v1"
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Auth configures how the operator authenticates with Render.
+	Auth RenderAuth `json:"auth"`
+}
+
+// RenderAuth contains the credentials used to authenticate with Render.
+type RenderAuth struct {
+	// APIKey is a reference to an API key of a member of the workspace owning the services.
+	APIKey esmeta.SecretKeySelector `json:"apiKey"`
+}
@@ -0,0 +1,37 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// SDSProvider configures a store to subscribe to secrets of an Envoy
+// Secret Discovery Service (SDS) server.
+type SDSProvider struct {
+	// Endpoint is the gRPC target of the SDS server,
+	// e.g: "unix:///var/run/sds/sds.sock" or "dns:///sds.istio-system.svc:15012".
+	Endpoint string `json:"endpoint"`
+
+	// PEM encoded CA bundle used to validate the SDS server certificate.
+	// If not set the connection is not encrypted, which is only meant
+	// for SDS servers listening on a unix socket.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// NodeID identifies the operator to the SDS server, defaults to "external-secrets".
+	// +optional
+	NodeID string `json:"nodeID,omitempty"`
+
+	// Cluster is the local cluster name sent to the SDS server.
+	// +optional
+	Cluster string `json:"cluster,omitempty"`
+}
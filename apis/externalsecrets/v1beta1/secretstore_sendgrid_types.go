@@ -0,0 +1,41 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// SendGridProvider configures a store to read the API keys of a SendGrid account.
+type SendGridProvider struct {
+	// URL of the SendGrid v3 API, e.g: "https://api.eu.sendgrid.com" for EU regional subusers.
+	// +kubebuilder:default="https://api.sendgrid.com"
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Subuser is the username of a subuser whose API keys are read
+	// on behalf of the account of the API key.
+	// +optional
+	Subuser string `json:"subuser,omitempty"`
+
+	// Auth configures how the operator authenticates with SendGrid.
+	Auth SendGridAuth `json:"auth"`
+}
+
+// SendGridAuth contains the API key used to authenticate with SendGrid.
+type SendGridAuth struct {
+	// APIKey is a reference to an API key with the api_keys scopes.
+	APIKey esmeta.SecretKeySelector `json:"apiKey"`
+}
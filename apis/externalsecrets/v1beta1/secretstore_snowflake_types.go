@@ -0,0 +1,49 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// SnowflakeProvider configures a store to manage the key pairs of Snowflake users.
+type SnowflakeProvider struct {
+	// Account is the account identifier, e.g. myorg-myaccount.
+	Account string `json:"account"`
+
+	// URL of the Snowflake SQL API, defaults to https://<account>.snowflakecomputing.com.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Role used to run the statements, defaults to the default role of the user.
+	// +optional
+	Role string `json:"role,omitempty"`
+
+	// Auth configures how the operator authenticates with Snowflake.
+	Auth SnowflakeAuth `json:"auth"`
+}
+
+// SnowflakeAuth contains the key pair used to authenticate with Snowflake.
+type SnowflakeAuth struct {
+	// Username of the user the operator authenticates as.
+	Username string `json:"username"`
+
+	// PrivateKey is a reference to the PEM encoded private key of the user.
+	PrivateKey esmeta.SecretKeySelector `json:"privateKey"`
+
+	// Passphrase is a reference to the passphrase of an encrypted private key.
+	// +optional
+	Passphrase *esmeta.SecretKeySelector `json:"passphrase,omitempty"`
+}
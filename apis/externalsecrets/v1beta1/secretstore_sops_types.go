@@ -0,0 +1,61 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// SOPSProvider configures a store to sync secrets from files encrypted with
+// Mozilla SOPS and stored in an S3 bucket.
+type SOPSProvider struct {
+	// S3 configures the bucket the encrypted files are downloaded from.
+	S3 SOPSS3 `json:"s3"`
+
+	// KMS configures the AWS KMS keys used to decrypt the data key of the files.
+	// +optional
+	KMS SOPSKMS `json:"kms,omitempty"`
+}
+
+// SOPSS3 configures the S3 bucket holding the encrypted files.
+type SOPSS3 struct {
+	// Bucket is the name of the S3 bucket.
+	Bucket string `json:"bucket"`
+
+	// Region of the bucket.
+	Region string `json:"region"`
+
+	// Prefix is prepended to the key of every ExternalSecret, e.g. "clusters/prod/".
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// Auth defines the information necessary to authenticate against AWS,
+	// if not set the default credential chain of the operator is used.
+	// +optional
+	Auth AWSAuth `json:"auth,omitempty"`
+
+	// Role is a Role ARN which is assumed to read the bucket and call KMS.
+	// +optional
+	Role string `json:"role,omitempty"`
+}
+
+// SOPSKMS configures how the KMS master keys of a file are resolved.
+type SOPSKMS struct {
+	// KeyARNs restricts decryption to the listed KMS keys, by default
+	// every KMS master key of the file is tried in order.
+	// +optional
+	KeyARNs []string `json:"keyARNs,omitempty"`
+
+	// Role is a Role ARN which is assumed to call KMS instead of the one used to
+	// read the bucket. Roles configured in the metadata of the files are ignored.
+	// +optional
+	Role string `json:"role,omitempty"`
+}
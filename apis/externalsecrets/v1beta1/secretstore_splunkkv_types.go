@@ -0,0 +1,52 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// SplunkKVProvider configures a store to sync records of the Splunk KV Store.
+type SplunkKVProvider struct {
+	// URL of the Splunk management port, e.g: "https://splunk.example.com:8089".
+	URL string `json:"url"`
+
+	// App the collections belong to.
+	// +kubebuilder:default=search
+	// +optional
+	App string `json:"app,omitempty"`
+
+	// Owner of the collections, "nobody" selects the collections shared within the app.
+	// +kubebuilder:default=nobody
+	// +optional
+	Owner string `json:"owner,omitempty"`
+
+	// PEM encoded CA bundle used to validate the certificate of the management port.
+	// If not set the system root certificates are used.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// Auth configures how the operator authenticates with Splunk.
+	Auth SplunkKVAuth `json:"auth"`
+}
+
+// SplunkKVAuth contains the credentials used to open a Splunk session.
+type SplunkKVAuth struct {
+	// Username of a Splunk user with read access to the collections.
+	Username esmeta.SecretKeySelector `json:"username"`
+
+	// Password of the Splunk user.
+	Password esmeta.SecretKeySelector `json:"password"`
+}
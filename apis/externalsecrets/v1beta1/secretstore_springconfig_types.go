@@ -0,0 +1,84 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// SpringConfigProvider configures a store to sync properties served by a Spring Cloud Config Server.
+type SpringConfigProvider struct {
+	// URL of the Config Server, e.g: "https://config.example.com".
+	URL string `json:"url"`
+
+	// Application name the properties are fetched for.
+	// +kubebuilder:default=application
+	// +optional
+	Application string `json:"application,omitempty"`
+
+	// Profile the properties are fetched for, several profiles are separated by a comma.
+	// +kubebuilder:default=default
+	// +optional
+	Profile string `json:"profile,omitempty"`
+
+	// Label of the configuration repository, e.g. a git branch.
+	// If not set the default label of the Config Server is used.
+	// +optional
+	Label string `json:"label,omitempty"`
+
+	// PEM encoded CA bundle used to validate the Config Server certificate.
+	// If not set the system root certificates are used.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// Auth configures how the operator authenticates with the Config Server.
+	// +optional
+	Auth SpringConfigAuth `json:"auth,omitempty"`
+}
+
+// SpringConfigAuth configures basic or OAuth2 client credentials authentication,
+// only one of them may be set.
+type SpringConfigAuth struct {
+	// Basic holds the username and password of a Config Server user.
+	// +optional
+	Basic *SpringConfigBasicAuth `json:"basic,omitempty"`
+
+	// OAuth2 configures the client credentials grant used to request an access token.
+	// +optional
+	OAuth2 *SpringConfigOAuth2 `json:"oauth2,omitempty"`
+}
+
+type SpringConfigBasicAuth struct {
+	// Username of the Config Server user.
+	Username esmeta.SecretKeySelector `json:"username"`
+
+	// Password of the Config Server user.
+	Password esmeta.SecretKeySelector `json:"password"`
+}
+
+type SpringConfigOAuth2 struct {
+	// TokenURL is the token endpoint of the authorization server.
+	TokenURL string `json:"tokenURL"`
+
+	// ClientID of the OAuth2 client.
+	ClientID esmeta.SecretKeySelector `json:"clientID"`
+
+	// ClientSecret of the OAuth2 client.
+	ClientSecret esmeta.SecretKeySelector `json:"clientSecret"`
+
+	// Scopes requested with the access token.
+	// +optional
+	Scopes []string `json:"scopes,omitempty"`
+}
@@ -0,0 +1,37 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// SupabaseProvider configures a store to sync the API keys of Supabase projects
+// with the Supabase Management API.
+type SupabaseProvider struct {
+	// URL of the Supabase Management API.
+	// +kubebuilder:default="https://api.supabase.com"
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Auth configures how the operator authenticates with Supabase.
+	Auth SupabaseAuth `json:"auth"`
+}
+
+// SupabaseAuth contains the credentials used to authenticate with Supabase.
+type SupabaseAuth struct {
+	// AccessToken is a reference to a personal access token with access to the projects.
+	AccessToken esmeta.SecretKeySelector `json:"accessToken"`
+}
@@ -0,0 +1,25 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// TeleportProvider configures a store to sync credentials written by tbot,
+// the Teleport Machine ID agent, to a directory of the operator pod.
+// It can only be used with a ClusterSecretStore, as it gives access to the filesystem of the operator.
+type TeleportProvider struct {
+	// Path of the tbot destination directory, e.g: "/opt/machine-id".
+	// The directory must be mounted into the operator pod, usually from a tbot sidecar.
+	// +kubebuilder:validation:MinLength=1
+	Path string `json:"path"`
+}
@@ -0,0 +1,40 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// TenableProvider configures a store to sync managed credentials of Tenable.io.
+type TenableProvider struct {
+	// URL of the Tenable.io API.
+	// +kubebuilder:default="https://cloud.tenable.com"
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Auth configures how the operator authenticates with Tenable.io.
+	Auth TenableAuth `json:"auth"`
+}
+
+// TenableAuth contains the API keys used to authenticate with Tenable.io.
+type TenableAuth struct {
+	// AccessKeySecretRef is a reference to a key in a Secret containing the access key
+	// of a user with permission to use the managed credentials.
+	AccessKeySecretRef esmeta.SecretKeySelector `json:"accessKeySecretRef"`
+
+	// SecretKeySecretRef is a reference to a key in a Secret containing the secret key of the user.
+	SecretKeySecretRef esmeta.SecretKeySelector `json:"secretKeySecretRef"`
+}
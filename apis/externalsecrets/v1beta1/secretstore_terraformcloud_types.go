@@ -0,0 +1,41 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// TerraformCloudProvider configures a store to sync the variables of
+// Terraform Cloud or Terraform Enterprise workspaces.
+type TerraformCloudProvider struct {
+	// URL of Terraform Cloud or of a Terraform Enterprise instance.
+	// +kubebuilder:default="https://app.terraform.io"
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Organization the workspaces belong to.
+	Organization string `json:"organization"`
+
+	// Auth configures how the operator authenticates with Terraform Cloud.
+	Auth TerraformCloudAuth `json:"auth"`
+}
+
+// TerraformCloudAuth contains the API token used to authenticate with Terraform Cloud.
+type TerraformCloudAuth struct {
+	// TokenSecretRef is a reference to a key in a Secret containing a team API token
+	// with read access to the variables of the workspaces.
+	TokenSecretRef esmeta.SecretKeySelector `json:"tokenSecretRef"`
+}
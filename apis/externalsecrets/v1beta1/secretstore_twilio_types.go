@@ -0,0 +1,56 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// TwilioProvider configures a store to sync the credentials of a Twilio account.
+type TwilioProvider struct {
+	// URL of the Twilio REST API.
+	// +kubebuilder:default="https://api.twilio.com"
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// AccountSID is the SID of the Twilio account, e.g. ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX.
+	// +kubebuilder:validation:Pattern=`^AC[0-9a-fA-F]{32}$`
+	AccountSID string `json:"accountSid"`
+
+	// Auth configures how the operator authenticates with Twilio.
+	Auth TwilioAuth `json:"auth"`
+}
+
+// TwilioAuth contains the credentials used to authenticate with Twilio,
+// exactly one of AuthToken or APIKey must be set.
+type TwilioAuth struct {
+	// AuthToken is a reference to the auth token of the account.
+	// +optional
+	AuthToken *esmeta.SecretKeySelector `json:"authToken,omitempty"`
+
+	// APIKey is a main API key of the account.
+	// Standard API keys can not read the account and its API keys.
+	// +optional
+	APIKey *TwilioAPIKey `json:"apiKey,omitempty"`
+}
+
+// TwilioAPIKey is an API key of a Twilio account.
+type TwilioAPIKey struct {
+	// SID of the API key, e.g. SKXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX.
+	SID string `json:"sid"`
+
+	// Secret is a reference to the secret of the API key.
+	Secret esmeta.SecretKeySelector `json:"secret"`
+}
@@ -37,6 +37,11 @@ type SecretStoreSpec struct {
 	// +optional
 	RefreshInterval int `json:"refreshInterval,omitempty"`
 
+	// Used to limit how long a single call to the provider can run, e.g. 10s.
+	// Empty will default to 30s.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
 	// Used to constraint a ClusterSecretStore to specific namespaces. Relevant only to ClusterSecretStore
 	// +optional
 	Conditions []ClusterSecretStoreCondition `json:"conditions,omitempty"`
@@ -180,6 +185,178 @@ type SecretStoreProvider struct {
 	// Infisical configures this store to sync secrets using the Infisical provider
 	// +optional
 	Infisical *InfisicalProvider `json:"infisical,omitempty"`
+
+	// Consul configures this store to sync secrets using the HashiCorp Consul KV store
+	// +optional
+	Consul *ConsulProvider `json:"consul,omitempty"`
+
+	// Etcd configures this store to sync secrets using an etcd v3 cluster
+	// +optional
+	Etcd *EtcdProvider `json:"etcd,omitempty"`
+
+	// Plugin configures this store to sync secrets using an out-of-process provider plugin
+	// +optional
+	Plugin *PluginProvider `json:"plugin,omitempty"`
+
+	// Teleport configures this store to sync credentials issued by Teleport Machine ID
+	// +optional
+	Teleport *TeleportProvider `json:"teleport,omitempty"`
+
+	// ConjurCloud configures this store to sync secrets using Conjur Cloud
+	// +optional
+	ConjurCloud *ConjurCloudProvider `json:"conjurCloud,omitempty"`
+
+	// Venafi configures this store to sync certificates using Venafi TLS Protect
+	// +optional
+	Venafi *VenafiProvider `json:"venafi,omitempty"`
+
+	// SDS configures this store to sync TLS certificates from an Envoy Secret Discovery Service
+	// +optional
+	SDS *SDSProvider `json:"sds,omitempty"`
+
+	// SOPS configures this store to sync secrets from SOPS encrypted files stored in S3
+	// +optional
+	SOPS *SOPSProvider `json:"sops,omitempty"`
+
+	// SpringConfig configures this store to sync properties from a Spring Cloud Config Server
+	// +optional
+	SpringConfig *SpringConfigProvider `json:"springConfig,omitempty"`
+
+	// Hiera configures this store to look up secrets in Hiera data files stored in a ConfigMap
+	// +optional
+	Hiera *HieraProvider `json:"hiera,omitempty"`
+
+	// ChefVault configures this store to sync items of chef-vault
+	// +optional
+	ChefVault *ChefVaultProvider `json:"chefVault,omitempty"`
+
+	// AppRegistry configures this store to sync attribute groups of an AWS Service Catalog AppRegistry application
+	// +optional
+	AppRegistry *AppRegistryProvider `json:"appRegistry,omitempty"`
+
+	// TerraformCloud configures this store to sync variables of Terraform Cloud workspaces
+	// +optional
+	TerraformCloud *TerraformCloudProvider `json:"terraformCloud,omitempty"`
+
+	// AnsibleVault configures this store to decrypt Ansible Vault encrypted values stored in a ConfigMap
+	// +optional
+	AnsibleVault *AnsibleVaultProvider `json:"ansibleVault,omitempty"`
+
+	// OpenBao configures this store to sync secrets using an OpenBao server
+	// +optional
+	OpenBao *OpenBaoProvider `json:"openbao,omitempty"`
+
+	// Tenable configures this store to sync managed credentials of Tenable.io
+	// +optional
+	Tenable *TenableProvider `json:"tenable,omitempty"`
+
+	// Wiz configures this store to sync connection parameters of Wiz connectors
+	// +optional
+	Wiz *WizProvider `json:"wiz,omitempty"`
+
+	// SplunkKV configures this store to sync records of the Splunk KV Store
+	// +optional
+	SplunkKV *SplunkKVProvider `json:"splunkKV,omitempty"`
+
+	// GitHubSecrets configures this store to push GitHub Actions secrets
+	// +optional
+	GitHubSecrets *GitHubSecretsProvider `json:"githubSecrets,omitempty"`
+
+	// AzureDevOps configures this store to sync variables of Azure DevOps variable groups
+	// +optional
+	AzureDevOps *AzureDevOpsProvider `json:"azureDevOps,omitempty"`
+
+	// CircleCI configures this store to push environment variables of CircleCI contexts
+	// +optional
+	CircleCI *CircleCIProvider `json:"circleci,omitempty"`
+
+	// Bitbucket configures this store to sync Bitbucket Cloud workspace and repository variables
+	// +optional
+	Bitbucket *BitbucketProvider `json:"bitbucket,omitempty"`
+
+	// AWSIoT configures this store to provision certificates for AWS IoT Core things
+	// +optional
+	AWSIoT *AWSIoTProvider `json:"awsIoT,omitempty"`
+
+	// Okta configures this store to sync the credentials of Okta OAuth 2.0 client applications
+	// +optional
+	Okta *OktaProvider `json:"okta,omitempty"`
+
+	// Auth0 configures this store to sync the credentials of Auth0 applications
+	// +optional
+	Auth0 *Auth0Provider `json:"auth0,omitempty"`
+
+	// GoogleWorkspace configures this store to issue keys of service accounts used with Google Workspace
+	// +optional
+	GoogleWorkspace *GoogleWorkspaceProvider `json:"googleWorkspace,omitempty"`
+
+	// Cloudflare configures this store to push secrets of Cloudflare Workers
+	// +optional
+	Cloudflare *CloudflareProvider `json:"cloudflare,omitempty"`
+
+	// Vercel configures this store to sync environment variables of Vercel projects
+	// +optional
+	Vercel *VercelProvider `json:"vercel,omitempty"`
+
+	// Netlify configures this store to sync environment variables of Netlify accounts and sites
+	// +optional
+	Netlify *NetlifyProvider `json:"netlify,omitempty"`
+
+	// Render configures this store to sync environment variables of Render services
+	// +optional
+	Render *RenderProvider `json:"render,omitempty"`
+
+	// Railway configures this store to sync variables of a Railway project environment
+	// +optional
+	Railway *RailwayProvider `json:"railway,omitempty"`
+
+	// Confluent configures this store to sync API keys of Confluent Cloud
+	// +optional
+	Confluent *ConfluentProvider `json:"confluent,omitempty"`
+
+	// Snowflake configures this store to manage the key pairs of Snowflake users
+	// +optional
+	Snowflake *SnowflakeProvider `json:"snowflake,omitempty"`
+
+	// MongoDBAtlas configures this store to sync programmatic API keys of MongoDB Atlas
+	// +optional
+	MongoDBAtlas *MongoDBAtlasProvider `json:"mongodbatlas,omitempty"`
+
+	// Datadog configures this store to sync API keys and application keys of Datadog
+	// +optional
+	Datadog *DatadogProvider `json:"datadog,omitempty"`
+
+	// NewRelic configures this store to sync keys of New Relic accounts
+	// +optional
+	NewRelic *NewRelicProvider `json:"newrelic,omitempty"`
+
+	// PagerDuty configures this store to sync integration keys of PagerDuty services
+	// +optional
+	PagerDuty *PagerDutyProvider `json:"pagerduty,omitempty"`
+
+	// Twilio configures this store to sync credentials of a Twilio account
+	// +optional
+	Twilio *TwilioProvider `json:"twilio,omitempty"`
+
+	// SendGrid configures this store to manage API keys of a SendGrid account
+	// +optional
+	SendGrid *SendGridProvider `json:"sendgrid,omitempty"`
+
+	// Mailgun configures this store to sync credentials of Mailgun sending domains
+	// +optional
+	Mailgun *MailgunProvider `json:"mailgun,omitempty"`
+
+	// Cloudinary configures this store to sync API credentials of Cloudinary product environments
+	// +optional
+	Cloudinary *CloudinaryProvider `json:"cloudinary,omitempty"`
+
+	// Firebase configures this store to issue keys of the service accounts of a Firebase project
+	// +optional
+	Firebase *FirebaseProvider `json:"firebase,omitempty"`
+
+	// Supabase configures this store to sync API keys of Supabase projects
+	// +optional
+	Supabase *SupabaseProvider `json:"supabase,omitempty"`
 }
 
 type CAProviderType string
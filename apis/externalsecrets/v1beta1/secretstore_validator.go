@@ -28,6 +28,8 @@ var _ admission.CustomValidator = &GenericStoreValidator{}
 
 const (
 	errInvalidStore = "invalid store"
+
+	warnConditionsIgnored = "spec.conditions only applies to ClusterSecretStore and is ignored for SecretStore"
 )
 
 type GenericStoreValidator struct{}
@@ -65,7 +67,11 @@ func validateStore(store GenericStore) (admission.Warnings, error) {
 		return nil, err
 	}
 
-	return provider.ValidateStore(store)
+	warnings, err := provider.ValidateStore(store)
+	if store.GetKind() == SecretStoreKind && len(store.GetSpec().Conditions) > 0 {
+		warnings = append(warnings, warnConditionsIgnored)
+	}
+	return warnings, err
 }
 
 func validateConditions(store GenericStore) error {
@@ -148,3 +148,27 @@ func TestValidateSecretStore(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateStoreConditionsWarning(t *testing.T) {
+	ForceRegister(&ValidationProvider{}, &SecretStoreProvider{
+		AWS: &AWSProvider{},
+	})
+	spec := SecretStoreSpec{
+		Conditions: []ClusterSecretStoreCondition{
+			{
+				Namespaces: []string{"default"},
+			},
+		},
+		Provider: &SecretStoreProvider{
+			AWS: &AWSProvider{},
+		},
+	}
+
+	warnings, err := validateStore(&SecretStore{Spec: spec})
+	require.NoError(t, err)
+	assert.Equal(t, admission.Warnings{warnConditionsIgnored}, warnings)
+
+	warnings, err = validateStore(&ClusterSecretStore{Spec: spec})
+	require.NoError(t, err)
+	assert.Empty(t, warnings)
+}
@@ -25,6 +25,9 @@ const (
 	VaultKVStoreV2 VaultKVStoreVersion = "v2"
 )
 
+// AnnotationVaultActiveCluster is set on stores with clusters to the address of the Vault cluster in use.
+const AnnotationVaultActiveCluster = "external-secrets.io/vault-active-cluster"
+
 // Configures an store to sync secrets using a HashiCorp Vault
 // KV backend.
 type VaultProvider struct {
@@ -34,6 +37,13 @@ type VaultProvider struct {
 	// Server is the connection address for the Vault server, e.g: "https://vault.example.com:8200".
 	Server string `json:"server"`
 
+	// Clusters lists further Vault clusters serving the same secrets, e.g. the members of an
+	// active-active deployment. When the server can not be reached or is not healthy, the clusters are tried in order.
+	// The address in use is recorded in the "external-secrets.io/vault-active-cluster" annotation
+	// of the store when it is validated.
+	// +optional
+	Clusters []VaultClusterConfig `json:"clusters,omitempty"`
+
 	// Path is the mount path of the Vault KV backend endpoint, e.g:
 	// "secret". The v2 KV secret engine version specific "/data" path suffix
 	// for fetching secrets from Vault is optional and will be appended
@@ -48,6 +58,21 @@ type VaultProvider struct {
 	// +kubebuilder:default:="v2"
 	Version VaultKVStoreVersion `json:"version"`
 
+	// LDAPSecretsEngine makes the store serve dynamic credentials of an LDAP
+	// secrets engine instead of secrets of the KV backend. remoteRef.key is the
+	// name of the dynamic role, the credentials are returned as "username" and
+	// "password". Their leases are revoked once they are superseded or the
+	// ExternalSecret is deleted.
+	// +optional
+	LDAPSecretsEngine *VaultLDAPSecretsEngine `json:"ldapSecretsEngine,omitempty"`
+
+	// DatabaseSecretsEngine makes the store serve dynamic credentials of a
+	// database secrets engine instead of secrets of the KV backend, like
+	// LDAPSecretsEngine. remoteRef.key is the name of the dynamic role, the
+	// credentials are returned as "username" and "password".
+	// +optional
+	DatabaseSecretsEngine *VaultDatabaseSecretsEngine `json:"databaseSecretsEngine,omitempty"`
+
 	// Name of the vault namespace. Namespaces is a set of features within Vault Enterprise that allows
 	// Vault environments to support Secure Multi-tenancy. e.g: "ns1".
 	// More about namespaces can be found here https://www.vaultproject.io/docs/enterprise/namespaces
@@ -88,6 +113,28 @@ type VaultProvider struct {
 	ForwardInconsistent bool `json:"forwardInconsistent,omitempty"`
 }
 
+// VaultClusterConfig is a Vault cluster the provider fails over to.
+type VaultClusterConfig struct {
+	// Server is the connection address for the Vault cluster, e.g: "https://vault-dr.example.com:8200".
+	Server string `json:"server"`
+}
+
+// VaultLDAPSecretsEngine configures the LDAP secrets engine dynamic credentials are read from.
+type VaultLDAPSecretsEngine struct {
+	// Path is the mount path of the LDAP secrets engine.
+	// +kubebuilder:default:="ldap"
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+// VaultDatabaseSecretsEngine configures the database secrets engine dynamic credentials are read from.
+type VaultDatabaseSecretsEngine struct {
+	// Path is the mount path of the database secrets engine.
+	// +kubebuilder:default:="database"
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
 // VaultClientTLS is the configuration used for client side related TLS communication,
 // when the Vault server requires mutual authentication.
 type VaultClientTLS struct {
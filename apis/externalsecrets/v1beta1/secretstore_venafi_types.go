@@ -0,0 +1,57 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// VenafiProvider configures a store to sync certificates managed by Venafi TLS Protect Datacenter.
+type VenafiProvider struct {
+	// URL of the Venafi TLS Protect Datacenter server, e.g: "https://tpp.example.com".
+	URL string `json:"url"`
+
+	// PolicyDN is the policy folder holding the certificates, e.g: "\VED\Policy\Certificates\Team A".
+	// The object name of the certificate is given by the remote key.
+	PolicyDN string `json:"policyDN"`
+
+	// PEM encoded CA bundle used to validate the server certificate.
+	// If not set the system root certificates are used.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// Auth configures how the operator authenticates with the WebSDK.
+	Auth VenafiAuth `json:"auth"`
+}
+
+// VenafiAuth configures API key or username/password authentication.
+// Only one of them can be set.
+type VenafiAuth struct {
+	// APIKeyRef is a reference to an API key of the WebSDK.
+	// +optional
+	APIKeyRef *esmeta.SecretKeySelector `json:"apiKeyRef,omitempty"`
+
+	// SecretRef holds the username and password used to request an API key.
+	// +optional
+	SecretRef *VenafiAuthSecretRef `json:"secretRef,omitempty"`
+}
+
+type VenafiAuthSecretRef struct {
+	// Username of the Venafi user.
+	Username esmeta.SecretKeySelector `json:"username"`
+
+	// Password of the Venafi user.
+	Password esmeta.SecretKeySelector `json:"password"`
+}
@@ -0,0 +1,40 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// VercelProvider configures a store to sync environment variables of Vercel projects.
+type VercelProvider struct {
+	// URL of the Vercel API.
+	// +kubebuilder:default="https://api.vercel.com"
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// TeamID of the team owning the projects, projects of the personal account are used if empty.
+	// +optional
+	TeamID string `json:"teamID,omitempty"`
+
+	// Auth configures how the operator authenticates with Vercel.
+	Auth VercelAuth `json:"auth"`
+}
+
+// VercelAuth contains the credentials used to authenticate with Vercel.
+type VercelAuth struct {
+	// AccessToken is a reference to an access token with access to the team.
+	AccessToken esmeta.SecretKeySelector `json:"accessToken"`
+}
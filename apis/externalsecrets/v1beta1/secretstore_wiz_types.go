@@ -0,0 +1,42 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// WizProvider configures a store to sync connection parameters of Wiz connectors.
+type WizProvider struct {
+	// URL of the GraphQL API of the Wiz tenant, e.g: "https://api.us17.app.wiz.io/graphql".
+	URL string `json:"url"`
+
+	// TokenURL is the token endpoint of the Wiz authentication service.
+	// +kubebuilder:default="https://auth.app.wiz.io/oauth/token"
+	// +optional
+	TokenURL string `json:"tokenURL,omitempty"`
+
+	// Auth configures how the operator authenticates with Wiz.
+	Auth WizAuth `json:"auth"`
+}
+
+// WizAuth contains the client credentials of a Wiz service account.
+type WizAuth struct {
+	// ClientID of a service account with the read:connectors scope.
+	ClientID esmeta.SecretKeySelector `json:"clientID"`
+
+	// ClientSecret of the service account.
+	ClientSecret esmeta.SecretKeySelector `json:"clientSecret"`
+}
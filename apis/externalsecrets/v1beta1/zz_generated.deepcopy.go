@@ -71,6 +71,27 @@ func (in *AWSAuthSecretRef) DeepCopy() *AWSAuthSecretRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSIoTProvider) DeepCopyInto(out *AWSIoTProvider) {
+	*out = *in
+	if in.PolicyNames != nil {
+		in, out := &in.PolicyNames, &out.PolicyNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSIoTProvider.
+func (in *AWSIoTProvider) DeepCopy() *AWSIoTProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSIoTProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSJWTAuth) DeepCopyInto(out *AWSJWTAuth) {
 	*out = *in
@@ -311,6 +332,150 @@ func (in *AlibabaRRSAAuth) DeepCopy() *AlibabaRRSAAuth {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnsibleVaultConfigMapRef) DeepCopyInto(out *AnsibleVaultConfigMapRef) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnsibleVaultConfigMapRef.
+func (in *AnsibleVaultConfigMapRef) DeepCopy() *AnsibleVaultConfigMapRef {
+	if in == nil {
+		return nil
+	}
+	out := new(AnsibleVaultConfigMapRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AnsibleVaultProvider) DeepCopyInto(out *AnsibleVaultProvider) {
+	*out = *in
+	in.ConfigMap.DeepCopyInto(&out.ConfigMap)
+	in.PasswordSecretRef.DeepCopyInto(&out.PasswordSecretRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AnsibleVaultProvider.
+func (in *AnsibleVaultProvider) DeepCopy() *AnsibleVaultProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(AnsibleVaultProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AppRegistryProvider) DeepCopyInto(out *AppRegistryProvider) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AppRegistryProvider.
+func (in *AppRegistryProvider) DeepCopy() *AppRegistryProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(AppRegistryProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Auth0Auth) DeepCopyInto(out *Auth0Auth) {
+	*out = *in
+	in.ClientID.DeepCopyInto(&out.ClientID)
+	in.ClientSecret.DeepCopyInto(&out.ClientSecret)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Auth0Auth.
+func (in *Auth0Auth) DeepCopy() *Auth0Auth {
+	if in == nil {
+		return nil
+	}
+	out := new(Auth0Auth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Auth0Provider) DeepCopyInto(out *Auth0Provider) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Auth0Provider.
+func (in *Auth0Provider) DeepCopy() *Auth0Provider {
+	if in == nil {
+		return nil
+	}
+	out := new(Auth0Provider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureDevOpsAuth) DeepCopyInto(out *AzureDevOpsAuth) {
+	*out = *in
+	if in.PersonalAccessToken != nil {
+		in, out := &in.PersonalAccessToken, &out.PersonalAccessToken
+		*out = new(metav1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServicePrincipal != nil {
+		in, out := &in.ServicePrincipal, &out.ServicePrincipal
+		*out = new(AzureDevOpsServicePrincipal)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureDevOpsAuth.
+func (in *AzureDevOpsAuth) DeepCopy() *AzureDevOpsAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureDevOpsAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureDevOpsProvider) DeepCopyInto(out *AzureDevOpsProvider) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureDevOpsProvider.
+func (in *AzureDevOpsProvider) DeepCopy() *AzureDevOpsProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureDevOpsProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureDevOpsServicePrincipal) DeepCopyInto(out *AzureDevOpsServicePrincipal) {
+	*out = *in
+	in.ClientID.DeepCopyInto(&out.ClientID)
+	in.ClientSecret.DeepCopyInto(&out.ClientSecret)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureDevOpsServicePrincipal.
+func (in *AzureDevOpsServicePrincipal) DeepCopy() *AzureDevOpsServicePrincipal {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureDevOpsServicePrincipal)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AzureKVAuth) DeepCopyInto(out *AzureKVAuth) {
 	*out = *in
@@ -391,6 +556,80 @@ func (in *AzureKVProvider) DeepCopy() *AzureKVProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BitbucketAppPassword) DeepCopyInto(out *BitbucketAppPassword) {
+	*out = *in
+	in.Password.DeepCopyInto(&out.Password)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BitbucketAppPassword.
+func (in *BitbucketAppPassword) DeepCopy() *BitbucketAppPassword {
+	if in == nil {
+		return nil
+	}
+	out := new(BitbucketAppPassword)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BitbucketAuth) DeepCopyInto(out *BitbucketAuth) {
+	*out = *in
+	if in.AppPassword != nil {
+		in, out := &in.AppPassword, &out.AppPassword
+		*out = new(BitbucketAppPassword)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OAuth != nil {
+		in, out := &in.OAuth, &out.OAuth
+		*out = new(BitbucketOAuth)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BitbucketAuth.
+func (in *BitbucketAuth) DeepCopy() *BitbucketAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(BitbucketAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BitbucketOAuth) DeepCopyInto(out *BitbucketOAuth) {
+	*out = *in
+	in.ClientID.DeepCopyInto(&out.ClientID)
+	in.ClientSecret.DeepCopyInto(&out.ClientSecret)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BitbucketOAuth.
+func (in *BitbucketOAuth) DeepCopy() *BitbucketOAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(BitbucketOAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BitbucketProvider) DeepCopyInto(out *BitbucketProvider) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BitbucketProvider.
+func (in *BitbucketProvider) DeepCopy() *BitbucketProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(BitbucketProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BitwardenSecretsManagerAuth) DeepCopyInto(out *BitwardenSecretsManagerAuth) {
 	*out = *in
@@ -528,6 +767,123 @@ func (in *ChefProvider) DeepCopy() *ChefProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChefVaultProvider) DeepCopyInto(out *ChefVaultProvider) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(ChefAuth)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChefVaultProvider.
+func (in *ChefVaultProvider) DeepCopy() *ChefVaultProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(ChefVaultProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CircleCIAuth) DeepCopyInto(out *CircleCIAuth) {
+	*out = *in
+	in.APIToken.DeepCopyInto(&out.APIToken)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircleCIAuth.
+func (in *CircleCIAuth) DeepCopy() *CircleCIAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(CircleCIAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CircleCIProvider) DeepCopyInto(out *CircleCIProvider) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CircleCIProvider.
+func (in *CircleCIProvider) DeepCopy() *CircleCIProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(CircleCIProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudflareAuth) DeepCopyInto(out *CloudflareAuth) {
+	*out = *in
+	in.APIToken.DeepCopyInto(&out.APIToken)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudflareAuth.
+func (in *CloudflareAuth) DeepCopy() *CloudflareAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudflareAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudflareProvider) DeepCopyInto(out *CloudflareProvider) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudflareProvider.
+func (in *CloudflareProvider) DeepCopy() *CloudflareProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudflareProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudinaryAuth) DeepCopyInto(out *CloudinaryAuth) {
+	*out = *in
+	in.APIKey.DeepCopyInto(&out.APIKey)
+	in.APISecret.DeepCopyInto(&out.APISecret)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudinaryAuth.
+func (in *CloudinaryAuth) DeepCopy() *CloudinaryAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudinaryAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudinaryProvider) DeepCopyInto(out *CloudinaryProvider) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudinaryProvider.
+func (in *CloudinaryProvider) DeepCopy() *CloudinaryProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudinaryProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterExternalSecret) DeepCopyInto(out *ClusterExternalSecret) {
 	*out = *in
@@ -779,6 +1135,39 @@ func (in *ClusterSecretStoreList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfluentAuth) DeepCopyInto(out *ConfluentAuth) {
+	*out = *in
+	in.APIKey.DeepCopyInto(&out.APIKey)
+	in.APISecret.DeepCopyInto(&out.APISecret)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfluentAuth.
+func (in *ConfluentAuth) DeepCopy() *ConfluentAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfluentAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfluentProvider) DeepCopyInto(out *ConfluentProvider) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfluentProvider.
+func (in *ConfluentProvider) DeepCopy() *ConfluentProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfluentProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConjurAPIKey) DeepCopyInto(out *ConjurAPIKey) {
 	*out = *in
@@ -829,6 +1218,88 @@ func (in *ConjurAuth) DeepCopy() *ConjurAuth {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConjurCloudAuth) DeepCopyInto(out *ConjurCloudAuth) {
+	*out = *in
+	if in.Jwt != nil {
+		in, out := &in.Jwt, &out.Jwt
+		*out = new(ConjurCloudJWT)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Oidc != nil {
+		in, out := &in.Oidc, &out.Oidc
+		*out = new(ConjurCloudOIDC)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConjurCloudAuth.
+func (in *ConjurCloudAuth) DeepCopy() *ConjurCloudAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(ConjurCloudAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConjurCloudJWT) DeepCopyInto(out *ConjurCloudJWT) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(metav1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceAccountRef != nil {
+		in, out := &in.ServiceAccountRef, &out.ServiceAccountRef
+		*out = new(metav1.ServiceAccountSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConjurCloudJWT.
+func (in *ConjurCloudJWT) DeepCopy() *ConjurCloudJWT {
+	if in == nil {
+		return nil
+	}
+	out := new(ConjurCloudJWT)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConjurCloudOIDC) DeepCopyInto(out *ConjurCloudOIDC) {
+	*out = *in
+	in.IDTokenRef.DeepCopyInto(&out.IDTokenRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConjurCloudOIDC.
+func (in *ConjurCloudOIDC) DeepCopy() *ConjurCloudOIDC {
+	if in == nil {
+		return nil
+	}
+	out := new(ConjurCloudOIDC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConjurCloudProvider) DeepCopyInto(out *ConjurCloudProvider) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConjurCloudProvider.
+func (in *ConjurCloudProvider) DeepCopy() *ConjurCloudProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(ConjurCloudProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConjurJWT) DeepCopyInto(out *ConjurJWT) {
 	*out = *in
@@ -875,6 +1346,76 @@ func (in *ConjurProvider) DeepCopy() *ConjurProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsulAuth) DeepCopyInto(out *ConsulAuth) {
+	*out = *in
+	in.TokenSecretRef.DeepCopyInto(&out.TokenSecretRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsulAuth.
+func (in *ConsulAuth) DeepCopy() *ConsulAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsulAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsulProvider) DeepCopyInto(out *ConsulProvider) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsulProvider.
+func (in *ConsulProvider) DeepCopy() *ConsulProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsulProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogAuth) DeepCopyInto(out *DatadogAuth) {
+	*out = *in
+	in.APIKey.DeepCopyInto(&out.APIKey)
+	in.AppKey.DeepCopyInto(&out.AppKey)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogAuth.
+func (in *DatadogAuth) DeepCopy() *DatadogAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogProvider) DeepCopyInto(out *DatadogProvider) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogProvider.
+func (in *DatadogProvider) DeepCopy() *DatadogProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DelineaProvider) DeepCopyInto(out *DelineaProvider) {
 	*out = *in
@@ -1020,6 +1561,91 @@ func (in *DopplerProvider) DeepCopy() *DopplerProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdAuth) DeepCopyInto(out *EtcdAuth) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(EtcdAuthSecretRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientTLS != nil {
+		in, out := &in.ClientTLS, &out.ClientTLS
+		*out = new(EtcdClientTLS)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdAuth.
+func (in *EtcdAuth) DeepCopy() *EtcdAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdAuthSecretRef) DeepCopyInto(out *EtcdAuthSecretRef) {
+	*out = *in
+	in.Username.DeepCopyInto(&out.Username)
+	in.Password.DeepCopyInto(&out.Password)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdAuthSecretRef.
+func (in *EtcdAuthSecretRef) DeepCopy() *EtcdAuthSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdAuthSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdClientTLS) DeepCopyInto(out *EtcdClientTLS) {
+	*out = *in
+	in.CertSecretRef.DeepCopyInto(&out.CertSecretRef)
+	in.KeySecretRef.DeepCopyInto(&out.KeySecretRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdClientTLS.
+func (in *EtcdClientTLS) DeepCopy() *EtcdClientTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdClientTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdProvider) DeepCopyInto(out *EtcdProvider) {
+	*out = *in
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdProvider.
+func (in *EtcdProvider) DeepCopy() *EtcdProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalSecret) DeepCopyInto(out *ExternalSecret) {
 	*out = *in
@@ -1152,6 +1778,22 @@ func (in *ExternalSecretFind) DeepCopy() *ExternalSecretFind {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalSecretLease) DeepCopyInto(out *ExternalSecretLease) {
+	*out = *in
+	out.StoreRef = in.StoreRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalSecretLease.
+func (in *ExternalSecretLease) DeepCopy() *ExternalSecretLease {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalSecretLease)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExternalSecretList) DeepCopyInto(out *ExternalSecretList) {
 	*out = *in
@@ -1316,6 +1958,11 @@ func (in *ExternalSecretStatus) DeepCopyInto(out *ExternalSecretStatus) {
 		}
 	}
 	out.Binding = in.Binding
+	if in.Leases != nil {
+		in, out := &in.Leases, &out.Leases
+		*out = make([]ExternalSecretLease, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalSecretStatus.
@@ -1423,21 +2070,6 @@ func (in *ExternalSecretTemplateMetadata) DeepCopy() *ExternalSecretTemplateMeta
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExternalSecretValidator) DeepCopyInto(out *ExternalSecretValidator) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalSecretValidator.
-func (in *ExternalSecretValidator) DeepCopy() *ExternalSecretValidator {
-	if in == nil {
-		return nil
-	}
-	out := new(ExternalSecretValidator)
-	in.DeepCopyInto(out)
-	return out
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FakeProvider) DeepCopyInto(out *FakeProvider) {
 	*out = *in
@@ -1497,6 +2129,27 @@ func (in *FindName) DeepCopy() *FindName {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirebaseProvider) DeepCopyInto(out *FirebaseProvider) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.KeyRotationPeriod != nil {
+		in, out := &in.KeyRotationPeriod, &out.KeyRotationPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirebaseProvider.
+func (in *FirebaseProvider) DeepCopy() *FirebaseProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(FirebaseProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FortanixProvider) DeepCopyInto(out *FortanixProvider) {
 	*out = *in
@@ -1641,50 +2294,82 @@ func (in *GenericStoreValidator) DeepCopy() *GenericStoreValidator {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitlabAuth) DeepCopyInto(out *GitlabAuth) {
+func (in *GitHubSecretsAuth) DeepCopyInto(out *GitHubSecretsAuth) {
 	*out = *in
-	in.SecretRef.DeepCopyInto(&out.SecretRef)
+	in.PrivateKey.DeepCopyInto(&out.PrivateKey)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitlabAuth.
-func (in *GitlabAuth) DeepCopy() *GitlabAuth {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubSecretsAuth.
+func (in *GitHubSecretsAuth) DeepCopy() *GitHubSecretsAuth {
 	if in == nil {
 		return nil
 	}
-	out := new(GitlabAuth)
+	out := new(GitHubSecretsAuth)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitlabProvider) DeepCopyInto(out *GitlabProvider) {
+func (in *GitHubSecretsProvider) DeepCopyInto(out *GitHubSecretsProvider) {
 	*out = *in
 	in.Auth.DeepCopyInto(&out.Auth)
-	if in.GroupIDs != nil {
-		in, out := &in.GroupIDs, &out.GroupIDs
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitlabProvider.
-func (in *GitlabProvider) DeepCopy() *GitlabProvider {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitHubSecretsProvider.
+func (in *GitHubSecretsProvider) DeepCopy() *GitHubSecretsProvider {
 	if in == nil {
 		return nil
 	}
-	out := new(GitlabProvider)
+	out := new(GitHubSecretsProvider)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitlabSecretRef) DeepCopyInto(out *GitlabSecretRef) {
+func (in *GitlabAuth) DeepCopyInto(out *GitlabAuth) {
 	*out = *in
-	in.AccessToken.DeepCopyInto(&out.AccessToken)
+	in.SecretRef.DeepCopyInto(&out.SecretRef)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitlabSecretRef.
-func (in *GitlabSecretRef) DeepCopy() *GitlabSecretRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitlabAuth.
+func (in *GitlabAuth) DeepCopy() *GitlabAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(GitlabAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitlabProvider) DeepCopyInto(out *GitlabProvider) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.GroupIDs != nil {
+		in, out := &in.GroupIDs, &out.GroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitlabProvider.
+func (in *GitlabProvider) DeepCopy() *GitlabProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(GitlabProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitlabSecretRef) DeepCopyInto(out *GitlabSecretRef) {
+	*out = *in
+	in.AccessToken.DeepCopyInto(&out.AccessToken)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitlabSecretRef.
+func (in *GitlabSecretRef) DeepCopy() *GitlabSecretRef {
 	if in == nil {
 		return nil
 	}
@@ -1693,6 +2378,75 @@ func (in *GitlabSecretRef) DeepCopy() *GitlabSecretRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleWorkspaceProvider) DeepCopyInto(out *GoogleWorkspaceProvider) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.KeyRotationPeriod != nil {
+		in, out := &in.KeyRotationPeriod, &out.KeyRotationPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleWorkspaceProvider.
+func (in *GoogleWorkspaceProvider) DeepCopy() *GoogleWorkspaceProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleWorkspaceProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HieraConfigMapRef) DeepCopyInto(out *HieraConfigMapRef) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HieraConfigMapRef.
+func (in *HieraConfigMapRef) DeepCopy() *HieraConfigMapRef {
+	if in == nil {
+		return nil
+	}
+	out := new(HieraConfigMapRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HieraProvider) DeepCopyInto(out *HieraProvider) {
+	*out = *in
+	in.ConfigMap.DeepCopyInto(&out.ConfigMap)
+	if in.Hierarchy != nil {
+		in, out := &in.Hierarchy, &out.Hierarchy
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HieraProvider.
+func (in *HieraProvider) DeepCopy() *HieraProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(HieraProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMAuth) DeepCopyInto(out *IBMAuth) {
 	*out = *in
@@ -1915,6 +2669,140 @@ func (in *MachineIdentityScopeInWorkspace) DeepCopy() *MachineIdentityScopeInWor
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MailgunAuth) DeepCopyInto(out *MailgunAuth) {
+	*out = *in
+	in.APIKey.DeepCopyInto(&out.APIKey)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MailgunAuth.
+func (in *MailgunAuth) DeepCopy() *MailgunAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(MailgunAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MailgunProvider) DeepCopyInto(out *MailgunProvider) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MailgunProvider.
+func (in *MailgunProvider) DeepCopy() *MailgunProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(MailgunProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MongoDBAtlasAuth) DeepCopyInto(out *MongoDBAtlasAuth) {
+	*out = *in
+	in.PublicKey.DeepCopyInto(&out.PublicKey)
+	in.PrivateKey.DeepCopyInto(&out.PrivateKey)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBAtlasAuth.
+func (in *MongoDBAtlasAuth) DeepCopy() *MongoDBAtlasAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(MongoDBAtlasAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MongoDBAtlasProvider) DeepCopyInto(out *MongoDBAtlasProvider) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBAtlasProvider.
+func (in *MongoDBAtlasProvider) DeepCopy() *MongoDBAtlasProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(MongoDBAtlasProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetlifyAuth) DeepCopyInto(out *NetlifyAuth) {
+	*out = *in
+	in.AccessToken.DeepCopyInto(&out.AccessToken)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetlifyAuth.
+func (in *NetlifyAuth) DeepCopy() *NetlifyAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(NetlifyAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetlifyProvider) DeepCopyInto(out *NetlifyProvider) {
+	*out = *in
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]NetlifyScope, len(*in))
+		copy(*out, *in)
+	}
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetlifyProvider.
+func (in *NetlifyProvider) DeepCopy() *NetlifyProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(NetlifyProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NewRelicAuth) DeepCopyInto(out *NewRelicAuth) {
+	*out = *in
+	in.APIKey.DeepCopyInto(&out.APIKey)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewRelicAuth.
+func (in *NewRelicAuth) DeepCopy() *NewRelicAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(NewRelicAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NewRelicProvider) DeepCopyInto(out *NewRelicProvider) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NewRelicProvider.
+func (in *NewRelicProvider) DeepCopy() *NewRelicProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(NewRelicProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NoSecretError) DeepCopyInto(out *NoSecretError) {
 	*out = *in
@@ -1930,6 +2818,38 @@ func (in *NoSecretError) DeepCopy() *NoSecretError {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OktaAuth) DeepCopyInto(out *OktaAuth) {
+	*out = *in
+	in.APIToken.DeepCopyInto(&out.APIToken)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OktaAuth.
+func (in *OktaAuth) DeepCopy() *OktaAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(OktaAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OktaProvider) DeepCopyInto(out *OktaProvider) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OktaProvider.
+func (in *OktaProvider) DeepCopy() *OktaProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(OktaProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OnboardbaseAuthSecretRef) DeepCopyInto(out *OnboardbaseAuthSecretRef) {
 	*out = *in
@@ -2030,6 +2950,22 @@ func (in *OnePasswordProvider) DeepCopy() *OnePasswordProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenBaoProvider) DeepCopyInto(out *OpenBaoProvider) {
+	*out = *in
+	in.VaultProvider.DeepCopyInto(&out.VaultProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenBaoProvider.
+func (in *OpenBaoProvider) DeepCopy() *OpenBaoProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenBaoProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OracleAuth) DeepCopyInto(out *OracleAuth) {
 	*out = *in
@@ -2088,6 +3024,38 @@ func (in *OracleSecretRef) DeepCopy() *OracleSecretRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PagerDutyAuth) DeepCopyInto(out *PagerDutyAuth) {
+	*out = *in
+	in.APIToken.DeepCopyInto(&out.APIToken)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PagerDutyAuth.
+func (in *PagerDutyAuth) DeepCopy() *PagerDutyAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(PagerDutyAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PagerDutyProvider) DeepCopyInto(out *PagerDutyProvider) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PagerDutyProvider.
+func (in *PagerDutyProvider) DeepCopy() *PagerDutyProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(PagerDutyProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PassboltAuth) DeepCopyInto(out *PassboltAuth) {
 	*out = *in
@@ -2181,6 +3149,26 @@ func (in *PasswordDepotSecretRef) DeepCopy() *PasswordDepotSecretRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginProvider) DeepCopyInto(out *PluginProvider) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginProvider.
+func (in *PluginProvider) DeepCopy() *PluginProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PulumiProvider) DeepCopyInto(out *PulumiProvider) {
 	*out = *in
@@ -2221,6 +3209,143 @@ func (in *PulumiProviderSecretRef) DeepCopy() *PulumiProviderSecretRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RailwayAuth) DeepCopyInto(out *RailwayAuth) {
+	*out = *in
+	in.Token.DeepCopyInto(&out.Token)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RailwayAuth.
+func (in *RailwayAuth) DeepCopy() *RailwayAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(RailwayAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RailwayProvider) DeepCopyInto(out *RailwayProvider) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RailwayProvider.
+func (in *RailwayProvider) DeepCopy() *RailwayProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(RailwayProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RenderAuth) DeepCopyInto(out *RenderAuth) {
+	*out = *in
+	in.APIKey.DeepCopyInto(&out.APIKey)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RenderAuth.
+func (in *RenderAuth) DeepCopy() *RenderAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(RenderAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RenderProvider) DeepCopyInto(out *RenderProvider) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RenderProvider.
+func (in *RenderProvider) DeepCopy() *RenderProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(RenderProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SDSProvider) DeepCopyInto(out *SDSProvider) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SDSProvider.
+func (in *SDSProvider) DeepCopy() *SDSProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(SDSProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SOPSKMS) DeepCopyInto(out *SOPSKMS) {
+	*out = *in
+	if in.KeyARNs != nil {
+		in, out := &in.KeyARNs, &out.KeyARNs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SOPSKMS.
+func (in *SOPSKMS) DeepCopy() *SOPSKMS {
+	if in == nil {
+		return nil
+	}
+	out := new(SOPSKMS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SOPSProvider) DeepCopyInto(out *SOPSProvider) {
+	*out = *in
+	in.S3.DeepCopyInto(&out.S3)
+	in.KMS.DeepCopyInto(&out.KMS)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SOPSProvider.
+func (in *SOPSProvider) DeepCopy() *SOPSProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(SOPSProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SOPSS3) DeepCopyInto(out *SOPSS3) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SOPSS3.
+func (in *SOPSS3) DeepCopy() *SOPSS3 {
+	if in == nil {
+		return nil
+	}
+	out := new(SOPSS3)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScalewayProvider) DeepCopyInto(out *ScalewayProvider) {
 	*out = *in
@@ -2478,6 +3603,221 @@ func (in *SecretStoreProvider) DeepCopyInto(out *SecretStoreProvider) {
 		*out = new(InfisicalProvider)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Consul != nil {
+		in, out := &in.Consul, &out.Consul
+		*out = new(ConsulProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Etcd != nil {
+		in, out := &in.Etcd, &out.Etcd
+		*out = new(EtcdProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Plugin != nil {
+		in, out := &in.Plugin, &out.Plugin
+		*out = new(PluginProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Teleport != nil {
+		in, out := &in.Teleport, &out.Teleport
+		*out = new(TeleportProvider)
+		**out = **in
+	}
+	if in.ConjurCloud != nil {
+		in, out := &in.ConjurCloud, &out.ConjurCloud
+		*out = new(ConjurCloudProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Venafi != nil {
+		in, out := &in.Venafi, &out.Venafi
+		*out = new(VenafiProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SDS != nil {
+		in, out := &in.SDS, &out.SDS
+		*out = new(SDSProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SOPS != nil {
+		in, out := &in.SOPS, &out.SOPS
+		*out = new(SOPSProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SpringConfig != nil {
+		in, out := &in.SpringConfig, &out.SpringConfig
+		*out = new(SpringConfigProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hiera != nil {
+		in, out := &in.Hiera, &out.Hiera
+		*out = new(HieraProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ChefVault != nil {
+		in, out := &in.ChefVault, &out.ChefVault
+		*out = new(ChefVaultProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AppRegistry != nil {
+		in, out := &in.AppRegistry, &out.AppRegistry
+		*out = new(AppRegistryProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TerraformCloud != nil {
+		in, out := &in.TerraformCloud, &out.TerraformCloud
+		*out = new(TerraformCloudProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AnsibleVault != nil {
+		in, out := &in.AnsibleVault, &out.AnsibleVault
+		*out = new(AnsibleVaultProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OpenBao != nil {
+		in, out := &in.OpenBao, &out.OpenBao
+		*out = new(OpenBaoProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tenable != nil {
+		in, out := &in.Tenable, &out.Tenable
+		*out = new(TenableProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Wiz != nil {
+		in, out := &in.Wiz, &out.Wiz
+		*out = new(WizProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SplunkKV != nil {
+		in, out := &in.SplunkKV, &out.SplunkKV
+		*out = new(SplunkKVProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GitHubSecrets != nil {
+		in, out := &in.GitHubSecrets, &out.GitHubSecrets
+		*out = new(GitHubSecretsProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AzureDevOps != nil {
+		in, out := &in.AzureDevOps, &out.AzureDevOps
+		*out = new(AzureDevOpsProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CircleCI != nil {
+		in, out := &in.CircleCI, &out.CircleCI
+		*out = new(CircleCIProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Bitbucket != nil {
+		in, out := &in.Bitbucket, &out.Bitbucket
+		*out = new(BitbucketProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AWSIoT != nil {
+		in, out := &in.AWSIoT, &out.AWSIoT
+		*out = new(AWSIoTProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Okta != nil {
+		in, out := &in.Okta, &out.Okta
+		*out = new(OktaProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Auth0 != nil {
+		in, out := &in.Auth0, &out.Auth0
+		*out = new(Auth0Provider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GoogleWorkspace != nil {
+		in, out := &in.GoogleWorkspace, &out.GoogleWorkspace
+		*out = new(GoogleWorkspaceProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cloudflare != nil {
+		in, out := &in.Cloudflare, &out.Cloudflare
+		*out = new(CloudflareProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Vercel != nil {
+		in, out := &in.Vercel, &out.Vercel
+		*out = new(VercelProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Netlify != nil {
+		in, out := &in.Netlify, &out.Netlify
+		*out = new(NetlifyProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Render != nil {
+		in, out := &in.Render, &out.Render
+		*out = new(RenderProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Railway != nil {
+		in, out := &in.Railway, &out.Railway
+		*out = new(RailwayProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Confluent != nil {
+		in, out := &in.Confluent, &out.Confluent
+		*out = new(ConfluentProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Snowflake != nil {
+		in, out := &in.Snowflake, &out.Snowflake
+		*out = new(SnowflakeProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MongoDBAtlas != nil {
+		in, out := &in.MongoDBAtlas, &out.MongoDBAtlas
+		*out = new(MongoDBAtlasProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Datadog != nil {
+		in, out := &in.Datadog, &out.Datadog
+		*out = new(DatadogProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NewRelic != nil {
+		in, out := &in.NewRelic, &out.NewRelic
+		*out = new(NewRelicProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PagerDuty != nil {
+		in, out := &in.PagerDuty, &out.PagerDuty
+		*out = new(PagerDutyProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Twilio != nil {
+		in, out := &in.Twilio, &out.Twilio
+		*out = new(TwilioProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SendGrid != nil {
+		in, out := &in.SendGrid, &out.SendGrid
+		*out = new(SendGridProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mailgun != nil {
+		in, out := &in.Mailgun, &out.Mailgun
+		*out = new(MailgunProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cloudinary != nil {
+		in, out := &in.Cloudinary, &out.Cloudinary
+		*out = new(CloudinaryProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Firebase != nil {
+		in, out := &in.Firebase, &out.Firebase
+		*out = new(FirebaseProvider)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Supabase != nil {
+		in, out := &in.Supabase, &out.Supabase
+		*out = new(SupabaseProvider)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretStoreProvider.
@@ -2543,6 +3883,11 @@ func (in *SecretStoreSpec) DeepCopyInto(out *SecretStoreSpec) {
 		*out = new(SecretStoreRetrySettings)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]ClusterSecretStoreCondition, len(*in))
@@ -2579,212 +3924,573 @@ func (in *SecretStoreStatus) DeepCopy() *SecretStoreStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretStoreStatus)
+	out := new(SecretStoreStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretStoreStatusCondition) DeepCopyInto(out *SecretStoreStatusCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretStoreStatusCondition.
+func (in *SecretStoreStatusCondition) DeepCopy() *SecretStoreStatusCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretStoreStatusCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretsManager) DeepCopyInto(out *SecretsManager) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsManager.
+func (in *SecretsManager) DeepCopy() *SecretsManager {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretsManager)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SendGridAuth) DeepCopyInto(out *SendGridAuth) {
+	*out = *in
+	in.APIKey.DeepCopyInto(&out.APIKey)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SendGridAuth.
+func (in *SendGridAuth) DeepCopy() *SendGridAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(SendGridAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SendGridProvider) DeepCopyInto(out *SendGridProvider) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SendGridProvider.
+func (in *SendGridProvider) DeepCopy() *SendGridProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(SendGridProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SenhaseguraAuth) DeepCopyInto(out *SenhaseguraAuth) {
+	*out = *in
+	in.ClientSecret.DeepCopyInto(&out.ClientSecret)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SenhaseguraAuth.
+func (in *SenhaseguraAuth) DeepCopy() *SenhaseguraAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(SenhaseguraAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SenhaseguraProvider) DeepCopyInto(out *SenhaseguraProvider) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SenhaseguraProvider.
+func (in *SenhaseguraProvider) DeepCopy() *SenhaseguraProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(SenhaseguraProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnowflakeAuth) DeepCopyInto(out *SnowflakeAuth) {
+	*out = *in
+	in.PrivateKey.DeepCopyInto(&out.PrivateKey)
+	if in.Passphrase != nil {
+		in, out := &in.Passphrase, &out.Passphrase
+		*out = new(metav1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnowflakeAuth.
+func (in *SnowflakeAuth) DeepCopy() *SnowflakeAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(SnowflakeAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnowflakeProvider) DeepCopyInto(out *SnowflakeProvider) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnowflakeProvider.
+func (in *SnowflakeProvider) DeepCopy() *SnowflakeProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(SnowflakeProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SplunkKVAuth) DeepCopyInto(out *SplunkKVAuth) {
+	*out = *in
+	in.Username.DeepCopyInto(&out.Username)
+	in.Password.DeepCopyInto(&out.Password)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SplunkKVAuth.
+func (in *SplunkKVAuth) DeepCopy() *SplunkKVAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(SplunkKVAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SplunkKVProvider) DeepCopyInto(out *SplunkKVProvider) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SplunkKVProvider.
+func (in *SplunkKVProvider) DeepCopy() *SplunkKVProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(SplunkKVProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpringConfigAuth) DeepCopyInto(out *SpringConfigAuth) {
+	*out = *in
+	if in.Basic != nil {
+		in, out := &in.Basic, &out.Basic
+		*out = new(SpringConfigBasicAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OAuth2 != nil {
+		in, out := &in.OAuth2, &out.OAuth2
+		*out = new(SpringConfigOAuth2)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpringConfigAuth.
+func (in *SpringConfigAuth) DeepCopy() *SpringConfigAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(SpringConfigAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpringConfigBasicAuth) DeepCopyInto(out *SpringConfigBasicAuth) {
+	*out = *in
+	in.Username.DeepCopyInto(&out.Username)
+	in.Password.DeepCopyInto(&out.Password)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpringConfigBasicAuth.
+func (in *SpringConfigBasicAuth) DeepCopy() *SpringConfigBasicAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(SpringConfigBasicAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpringConfigOAuth2) DeepCopyInto(out *SpringConfigOAuth2) {
+	*out = *in
+	in.ClientID.DeepCopyInto(&out.ClientID)
+	in.ClientSecret.DeepCopyInto(&out.ClientSecret)
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpringConfigOAuth2.
+func (in *SpringConfigOAuth2) DeepCopy() *SpringConfigOAuth2 {
+	if in == nil {
+		return nil
+	}
+	out := new(SpringConfigOAuth2)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpringConfigProvider) DeepCopyInto(out *SpringConfigProvider) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpringConfigProvider.
+func (in *SpringConfigProvider) DeepCopy() *SpringConfigProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(SpringConfigProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StoreGeneratorSourceRef) DeepCopyInto(out *StoreGeneratorSourceRef) {
+	*out = *in
+	if in.SecretStoreRef != nil {
+		in, out := &in.SecretStoreRef, &out.SecretStoreRef
+		*out = new(SecretStoreRef)
+		**out = **in
+	}
+	if in.GeneratorRef != nil {
+		in, out := &in.GeneratorRef, &out.GeneratorRef
+		*out = new(GeneratorRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StoreGeneratorSourceRef.
+func (in *StoreGeneratorSourceRef) DeepCopy() *StoreGeneratorSourceRef {
+	if in == nil {
+		return nil
+	}
+	out := new(StoreGeneratorSourceRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StoreSourceRef) DeepCopyInto(out *StoreSourceRef) {
+	*out = *in
+	out.SecretStoreRef = in.SecretStoreRef
+	if in.GeneratorRef != nil {
+		in, out := &in.GeneratorRef, &out.GeneratorRef
+		*out = new(GeneratorRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StoreSourceRef.
+func (in *StoreSourceRef) DeepCopy() *StoreSourceRef {
+	if in == nil {
+		return nil
+	}
+	out := new(StoreSourceRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SupabaseAuth) DeepCopyInto(out *SupabaseAuth) {
+	*out = *in
+	in.AccessToken.DeepCopyInto(&out.AccessToken)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupabaseAuth.
+func (in *SupabaseAuth) DeepCopy() *SupabaseAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(SupabaseAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SupabaseProvider) DeepCopyInto(out *SupabaseProvider) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SupabaseProvider.
+func (in *SupabaseProvider) DeepCopy() *SupabaseProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(SupabaseProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeleportProvider) DeepCopyInto(out *TeleportProvider) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeleportProvider.
+func (in *TeleportProvider) DeepCopy() *TeleportProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(TeleportProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateFrom) DeepCopyInto(out *TemplateFrom) {
+	*out = *in
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(TemplateRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(TemplateRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Literal != nil {
+		in, out := &in.Literal, &out.Literal
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateFrom.
+func (in *TemplateFrom) DeepCopy() *TemplateFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateFrom)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretStoreStatusCondition) DeepCopyInto(out *SecretStoreStatusCondition) {
+func (in *TemplateRef) DeepCopyInto(out *TemplateRef) {
 	*out = *in
-	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TemplateRefItem, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretStoreStatusCondition.
-func (in *SecretStoreStatusCondition) DeepCopy() *SecretStoreStatusCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateRef.
+func (in *TemplateRef) DeepCopy() *TemplateRef {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretStoreStatusCondition)
+	out := new(TemplateRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretsManager) DeepCopyInto(out *SecretsManager) {
+func (in *TemplateRefItem) DeepCopyInto(out *TemplateRefItem) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsManager.
-func (in *SecretsManager) DeepCopy() *SecretsManager {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateRefItem.
+func (in *TemplateRefItem) DeepCopy() *TemplateRefItem {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretsManager)
+	out := new(TemplateRefItem)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SenhaseguraAuth) DeepCopyInto(out *SenhaseguraAuth) {
+func (in *TenableAuth) DeepCopyInto(out *TenableAuth) {
 	*out = *in
-	in.ClientSecret.DeepCopyInto(&out.ClientSecret)
+	in.AccessKeySecretRef.DeepCopyInto(&out.AccessKeySecretRef)
+	in.SecretKeySecretRef.DeepCopyInto(&out.SecretKeySecretRef)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SenhaseguraAuth.
-func (in *SenhaseguraAuth) DeepCopy() *SenhaseguraAuth {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenableAuth.
+func (in *TenableAuth) DeepCopy() *TenableAuth {
 	if in == nil {
 		return nil
 	}
-	out := new(SenhaseguraAuth)
+	out := new(TenableAuth)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SenhaseguraProvider) DeepCopyInto(out *SenhaseguraProvider) {
+func (in *TenableProvider) DeepCopyInto(out *TenableProvider) {
 	*out = *in
 	in.Auth.DeepCopyInto(&out.Auth)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SenhaseguraProvider.
-func (in *SenhaseguraProvider) DeepCopy() *SenhaseguraProvider {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TenableProvider.
+func (in *TenableProvider) DeepCopy() *TenableProvider {
 	if in == nil {
 		return nil
 	}
-	out := new(SenhaseguraProvider)
+	out := new(TenableProvider)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *StoreGeneratorSourceRef) DeepCopyInto(out *StoreGeneratorSourceRef) {
+func (in *TerraformCloudAuth) DeepCopyInto(out *TerraformCloudAuth) {
 	*out = *in
-	if in.SecretStoreRef != nil {
-		in, out := &in.SecretStoreRef, &out.SecretStoreRef
-		*out = new(SecretStoreRef)
-		**out = **in
-	}
-	if in.GeneratorRef != nil {
-		in, out := &in.GeneratorRef, &out.GeneratorRef
-		*out = new(GeneratorRef)
-		**out = **in
-	}
+	in.TokenSecretRef.DeepCopyInto(&out.TokenSecretRef)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StoreGeneratorSourceRef.
-func (in *StoreGeneratorSourceRef) DeepCopy() *StoreGeneratorSourceRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformCloudAuth.
+func (in *TerraformCloudAuth) DeepCopy() *TerraformCloudAuth {
 	if in == nil {
 		return nil
 	}
-	out := new(StoreGeneratorSourceRef)
+	out := new(TerraformCloudAuth)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *StoreSourceRef) DeepCopyInto(out *StoreSourceRef) {
+func (in *TerraformCloudProvider) DeepCopyInto(out *TerraformCloudProvider) {
 	*out = *in
-	out.SecretStoreRef = in.SecretStoreRef
-	if in.GeneratorRef != nil {
-		in, out := &in.GeneratorRef, &out.GeneratorRef
-		*out = new(GeneratorRef)
-		**out = **in
-	}
+	in.Auth.DeepCopyInto(&out.Auth)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StoreSourceRef.
-func (in *StoreSourceRef) DeepCopy() *StoreSourceRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TerraformCloudProvider.
+func (in *TerraformCloudProvider) DeepCopy() *TerraformCloudProvider {
 	if in == nil {
 		return nil
 	}
-	out := new(StoreSourceRef)
+	out := new(TerraformCloudProvider)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Tag) DeepCopyInto(out *Tag) {
+func (in *TokenAuth) DeepCopyInto(out *TokenAuth) {
 	*out = *in
+	in.BearerToken.DeepCopyInto(&out.BearerToken)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
-func (in *Tag) DeepCopy() *Tag {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenAuth.
+func (in *TokenAuth) DeepCopy() *TokenAuth {
 	if in == nil {
 		return nil
 	}
-	out := new(Tag)
+	out := new(TokenAuth)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TemplateFrom) DeepCopyInto(out *TemplateFrom) {
+func (in *TwilioAPIKey) DeepCopyInto(out *TwilioAPIKey) {
 	*out = *in
-	if in.ConfigMap != nil {
-		in, out := &in.ConfigMap, &out.ConfigMap
-		*out = new(TemplateRef)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Secret != nil {
-		in, out := &in.Secret, &out.Secret
-		*out = new(TemplateRef)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Literal != nil {
-		in, out := &in.Literal, &out.Literal
-		*out = new(string)
-		**out = **in
-	}
+	in.Secret.DeepCopyInto(&out.Secret)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateFrom.
-func (in *TemplateFrom) DeepCopy() *TemplateFrom {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TwilioAPIKey.
+func (in *TwilioAPIKey) DeepCopy() *TwilioAPIKey {
 	if in == nil {
 		return nil
 	}
-	out := new(TemplateFrom)
+	out := new(TwilioAPIKey)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TemplateRef) DeepCopyInto(out *TemplateRef) {
+func (in *TwilioAuth) DeepCopyInto(out *TwilioAuth) {
 	*out = *in
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]TemplateRefItem, len(*in))
-		copy(*out, *in)
+	if in.AuthToken != nil {
+		in, out := &in.AuthToken, &out.AuthToken
+		*out = new(metav1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateRef.
-func (in *TemplateRef) DeepCopy() *TemplateRef {
-	if in == nil {
-		return nil
+	if in.APIKey != nil {
+		in, out := &in.APIKey, &out.APIKey
+		*out = new(TwilioAPIKey)
+		(*in).DeepCopyInto(*out)
 	}
-	out := new(TemplateRef)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TemplateRefItem) DeepCopyInto(out *TemplateRefItem) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateRefItem.
-func (in *TemplateRefItem) DeepCopy() *TemplateRefItem {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TwilioAuth.
+func (in *TwilioAuth) DeepCopy() *TwilioAuth {
 	if in == nil {
 		return nil
 	}
-	out := new(TemplateRefItem)
+	out := new(TwilioAuth)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *TokenAuth) DeepCopyInto(out *TokenAuth) {
+func (in *TwilioProvider) DeepCopyInto(out *TwilioProvider) {
 	*out = *in
-	in.BearerToken.DeepCopyInto(&out.BearerToken)
+	in.Auth.DeepCopyInto(&out.Auth)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenAuth.
-func (in *TokenAuth) DeepCopy() *TokenAuth {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TwilioProvider.
+func (in *TwilioProvider) DeepCopy() *TwilioProvider {
 	if in == nil {
 		return nil
 	}
-	out := new(TokenAuth)
+	out := new(TwilioProvider)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2996,6 +4702,36 @@ func (in *VaultClientTLS) DeepCopy() *VaultClientTLS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultClusterConfig) DeepCopyInto(out *VaultClusterConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultClusterConfig.
+func (in *VaultClusterConfig) DeepCopy() *VaultClusterConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultClusterConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultDatabaseSecretsEngine) DeepCopyInto(out *VaultDatabaseSecretsEngine) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultDatabaseSecretsEngine.
+func (in *VaultDatabaseSecretsEngine) DeepCopy() *VaultDatabaseSecretsEngine {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultDatabaseSecretsEngine)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VaultIamAuth) DeepCopyInto(out *VaultIamAuth) {
 	*out = *in
@@ -3101,6 +4837,21 @@ func (in *VaultKubernetesServiceAccountTokenAuth) DeepCopy() *VaultKubernetesSer
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultLDAPSecretsEngine) DeepCopyInto(out *VaultLDAPSecretsEngine) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultLDAPSecretsEngine.
+func (in *VaultLDAPSecretsEngine) DeepCopy() *VaultLDAPSecretsEngine {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultLDAPSecretsEngine)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VaultLdapAuth) DeepCopyInto(out *VaultLdapAuth) {
 	*out = *in
@@ -3121,11 +4872,26 @@ func (in *VaultLdapAuth) DeepCopy() *VaultLdapAuth {
 func (in *VaultProvider) DeepCopyInto(out *VaultProvider) {
 	*out = *in
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]VaultClusterConfig, len(*in))
+		copy(*out, *in)
+	}
 	if in.Path != nil {
 		in, out := &in.Path, &out.Path
 		*out = new(string)
 		**out = **in
 	}
+	if in.LDAPSecretsEngine != nil {
+		in, out := &in.LDAPSecretsEngine, &out.LDAPSecretsEngine
+		*out = new(VaultLDAPSecretsEngine)
+		**out = **in
+	}
+	if in.DatabaseSecretsEngine != nil {
+		in, out := &in.DatabaseSecretsEngine, &out.DatabaseSecretsEngine
+		*out = new(VaultDatabaseSecretsEngine)
+		**out = **in
+	}
 	if in.Namespace != nil {
 		in, out := &in.Namespace, &out.Namespace
 		*out = new(string)
@@ -3170,6 +4936,101 @@ func (in *VaultUserPassAuth) DeepCopy() *VaultUserPassAuth {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VenafiAuth) DeepCopyInto(out *VenafiAuth) {
+	*out = *in
+	if in.APIKeyRef != nil {
+		in, out := &in.APIKeyRef, &out.APIKeyRef
+		*out = new(metav1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(VenafiAuthSecretRef)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VenafiAuth.
+func (in *VenafiAuth) DeepCopy() *VenafiAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(VenafiAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VenafiAuthSecretRef) DeepCopyInto(out *VenafiAuthSecretRef) {
+	*out = *in
+	in.Username.DeepCopyInto(&out.Username)
+	in.Password.DeepCopyInto(&out.Password)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VenafiAuthSecretRef.
+func (in *VenafiAuthSecretRef) DeepCopy() *VenafiAuthSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(VenafiAuthSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VenafiProvider) DeepCopyInto(out *VenafiProvider) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VenafiProvider.
+func (in *VenafiProvider) DeepCopy() *VenafiProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(VenafiProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VercelAuth) DeepCopyInto(out *VercelAuth) {
+	*out = *in
+	in.AccessToken.DeepCopyInto(&out.AccessToken)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VercelAuth.
+func (in *VercelAuth) DeepCopy() *VercelAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(VercelAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VercelProvider) DeepCopyInto(out *VercelProvider) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VercelProvider.
+func (in *VercelProvider) DeepCopy() *VercelProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(VercelProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WebhookCAProvider) DeepCopyInto(out *WebhookCAProvider) {
 	*out = *in
@@ -3266,6 +5127,39 @@ func (in *WebhookSecret) DeepCopy() *WebhookSecret {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WizAuth) DeepCopyInto(out *WizAuth) {
+	*out = *in
+	in.ClientID.DeepCopyInto(&out.ClientID)
+	in.ClientSecret.DeepCopyInto(&out.ClientSecret)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WizAuth.
+func (in *WizAuth) DeepCopy() *WizAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(WizAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WizProvider) DeepCopyInto(out *WizProvider) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WizProvider.
+func (in *WizProvider) DeepCopy() *WizProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(WizProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *YandexCertificateManagerAuth) DeepCopyInto(out *YandexCertificateManagerAuth) {
 	*out = *in
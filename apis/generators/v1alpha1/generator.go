@@ -16,6 +16,7 @@ package v1alpha1
 
 import (
 	"context"
+	"time"
 
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -33,3 +34,15 @@ type Generator interface {
 		namespace string,
 	) (map[string][]byte, error)
 }
+
+// ExpiringGenerator is implemented by generators whose values expire,
+// e.g. short-lived tokens, so that they are generated again in time.
+// +kubebuilder:object:root=false
+// +kubebuilder:object:generate:false
+// +k8s:deepcopy-gen:interfaces=nil
+// +k8s:deepcopy-gen=nil
+type ExpiringGenerator interface {
+	// ExpiresAt returns the expiry of the values returned by Generate,
+	// false if they do not expire.
+	ExpiresAt(data map[string][]byte) (time.Time, bool)
+}
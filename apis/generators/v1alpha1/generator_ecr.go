@@ -24,6 +24,12 @@ type ECRAuthorizationTokenSpec struct {
 	// Region specifies the region to operate in.
 	Region string `json:"region"`
 
+	// RegistryIDs are the AWS account IDs of the registries to get a token for,
+	// the registry of the account of the credentials by default.
+	// The token is valid for all registries which the credentials can access.
+	// +optional
+	RegistryIDs []string `json:"registryIDs,omitempty"`
+
 	// Auth defines how to authenticate with AWS
 	// +optional
 	Auth AWSAuth `json:"auth,omitempty"`
@@ -0,0 +1,52 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type GCPAccessTokenSpec struct {
+	// Auth defines the means for authenticating with GCP,
+	// the metadata server of the node is used if it is empty.
+	// +optional
+	Auth GCPSMAuth `json:"auth,omitempty"`
+	// ProjectID defines which project to use to authenticate with,
+	// it is required for workload identity.
+	// +optional
+	ProjectID string `json:"projectID,omitempty"`
+}
+
+// GCPAccessToken generates a short-lived GCP OAuth2 access token.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:labels="external-secrets.io/component=controller"
+// +kubebuilder:resource:scope=Namespaced,categories={gcpaccesstoken},shortName=gcpaccesstoken
+type GCPAccessToken struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec GCPAccessTokenSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GCPAccessTokenList contains a list of GCPAccessToken resources.
+type GCPAccessTokenList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GCPAccessToken `json:"items"`
+}
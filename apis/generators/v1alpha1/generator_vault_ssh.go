@@ -0,0 +1,89 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+type VaultSSHCertificateSpec struct {
+	// Used to select the correct ESO controller (think: ingress.ingressClassName)
+	// The ESO controller is instantiated with a specific controller name and filters VDS based on this property
+	// +optional
+	Controller string `json:"controller,omitempty"`
+
+	// Vault provider common spec
+	Provider *esv1beta1.VaultProvider `json:"provider"`
+
+	// Path where the SSH secrets engine is mounted.
+	// +kubebuilder:default=ssh
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Role of the SSH secrets engine used to sign the key.
+	Role string `json:"role"`
+
+	// PrivateKeySecretRef references the unencrypted private key to sign, e.g. the
+	// ssh-privatekey of a Secret of type kubernetes.io/ssh-auth. The public key is derived from it.
+	// The namespace is ignored, the Secret must be in the namespace of the generator.
+	PrivateKeySecretRef esmeta.SecretKeySelector `json:"privateKeySecretRef"`
+
+	// CertType is the type of certificate to sign.
+	// +kubebuilder:validation:Enum=user;host
+	// +kubebuilder:default=user
+	// +optional
+	CertType string `json:"certType,omitempty"`
+
+	// ValidPrincipals of the certificate, i.e. the user or host names. Defaults to the default principals of the role.
+	// +optional
+	ValidPrincipals []string `json:"validPrincipals,omitempty"`
+
+	// TTL of the certificate, e.g. "1h". Defaults to the TTL of the role.
+	// The ExternalSecret using the generator must refresh before the certificate expires.
+	// +optional
+	TTL string `json:"ttl,omitempty"`
+
+	// KeyID of the certificate, as written to the logs of the SSH servers. Defaults to a key ID generated by Vault.
+	// +optional
+	KeyID string `json:"keyID,omitempty"`
+
+	// Extensions of the certificate, e.g. permit-pty. Defaults to the default extensions of the role.
+	// +optional
+	Extensions map[string]string `json:"extensions,omitempty"`
+}
+
+// VaultSSHCertificate signs an SSH key with the SSH secrets engine of Vault.
+// The generated data contains the signed certificate alongside the key pair.
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:metadata:labels="external-secrets.io/component=controller"
+// +kubebuilder:resource:scope=Namespaced,categories={vaultsshcertificate},shortName=vaultsshcertificate
+type VaultSSHCertificate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VaultSSHCertificateSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+type VaultSSHCertificateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VaultSSHCertificate `json:"items"`
+}
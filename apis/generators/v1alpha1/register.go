@@ -52,6 +52,14 @@ var (
 	GCRAccessTokenGroupVersionKind = SchemeGroupVersion.WithKind(GCRAccessTokenKind)
 )
 
+// GCPAccessToken type metadata.
+var (
+	GCPAccessTokenKind             = reflect.TypeOf(GCPAccessToken{}).Name()
+	GCPAccessTokenGroupKind        = schema.GroupKind{Group: Group, Kind: GCPAccessTokenKind}.String()
+	GCPAccessTokenKindAPIVersion   = GCPAccessTokenKind + "." + SchemeGroupVersion.String()
+	GCPAccessTokenGroupVersionKind = SchemeGroupVersion.WithKind(GCPAccessTokenKind)
+)
+
 // ACRAccessToken type metadata.
 var (
 	ACRAccessTokenKind             = reflect.TypeOf(ACRAccessToken{}).Name()
@@ -68,6 +76,14 @@ var (
 	PasswordGroupVersionKind = SchemeGroupVersion.WithKind(PasswordKind)
 )
 
+// UUID type metadata.
+var (
+	UUIDKind             = reflect.TypeOf(UUID{}).Name()
+	UUIDGroupKind        = schema.GroupKind{Group: Group, Kind: UUIDKind}.String()
+	UUIDKindAPIVersion   = UUIDKind + "." + SchemeGroupVersion.String()
+	UUIDGroupVersionKind = SchemeGroupVersion.WithKind(UUIDKind)
+)
+
 // Webhook type metadata.
 var (
 	WebhookKind             = reflect.TypeOf(Webhook{}).Name()
@@ -92,6 +108,14 @@ var (
 	VaultDynamicSecretGroupVersionKind = SchemeGroupVersion.WithKind(VaultDynamicSecretKind)
 )
 
+// VaultSSHCertificate type metadata.
+var (
+	VaultSSHCertificateKind             = reflect.TypeOf(VaultSSHCertificate{}).Name()
+	VaultSSHCertificateGroupKind        = schema.GroupKind{Group: Group, Kind: VaultSSHCertificateKind}.String()
+	VaultSSHCertificateKindAPIVersion   = VaultSSHCertificateKind + "." + SchemeGroupVersion.String()
+	VaultSSHCertificateGroupVersionKind = SchemeGroupVersion.WithKind(VaultSSHCertificateKind)
+)
+
 // GithubAccessToken type metadata.
 var (
 	GithubAccessTokenKind             = reflect.TypeOf(GithubAccessToken{}).Name()
@@ -103,10 +127,13 @@ var (
 func init() {
 	SchemeBuilder.Register(&ECRAuthorizationToken{}, &ECRAuthorizationToken{})
 	SchemeBuilder.Register(&GCRAccessToken{}, &GCRAccessTokenList{})
+	SchemeBuilder.Register(&GCPAccessToken{}, &GCPAccessTokenList{})
 	SchemeBuilder.Register(&GithubAccessToken{}, &GithubAccessTokenList{})
 	SchemeBuilder.Register(&ACRAccessToken{}, &ACRAccessTokenList{})
 	SchemeBuilder.Register(&Fake{}, &FakeList{})
 	SchemeBuilder.Register(&VaultDynamicSecret{}, &VaultDynamicSecretList{})
+	SchemeBuilder.Register(&VaultSSHCertificate{}, &VaultSSHCertificateList{})
 	SchemeBuilder.Register(&Password{}, &PasswordList{})
+	SchemeBuilder.Register(&UUID{}, &UUIDList{})
 	SchemeBuilder.Register(&Webhook{}, &WebhookList{})
 }
@@ -17,20 +17,29 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
+	"fmt"
+	"net"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 	"go.uber.org/zap/zapcore"
 	admissionregistration "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
@@ -41,8 +50,8 @@ import (
 
 var certcontrollerCmd = &cobra.Command{
 	Use:   "certcontroller",
-	Short: "Controller to manage certificates for external secrets CRDs and ValidatingWebhookConfigs",
-	Long: `Controller to manage certificates for external secrets CRDs and ValidatingWebhookConfigs.
+	Short: "Controller to manage certificates for external secrets CRDs and WebhookConfigs",
+	Long: `Controller to manage certificates for external secrets CRDs and Validating/MutatingWebhookConfigs.
 	For more information visit https://external-secrets.io`,
 	Run: func(cmd *cobra.Command, args []string) {
 		var lvl zapcore.Level
@@ -64,6 +73,31 @@ var certcontrollerCmd = &cobra.Command{
 		logger := zap.New(zap.UseFlagOptions(&opts))
 		ctrl.SetLogger(logger)
 
+		deployments, err := parseNamespacedNames(dependentDeployments)
+		if err != nil {
+			setupLog.Error(err, "invalid dependent deployments")
+			os.Exit(1)
+		}
+		keyAlg, err := crds.ParseKeyAlgorithm(keyAlgorithm)
+		if err != nil {
+			setupLog.Error(err, "invalid key algorithm")
+			os.Exit(1)
+		}
+		keyEnc, err := crds.ParseKeyEncoding(keyEncoding)
+		if err != nil {
+			setupLog.Error(err, "invalid key encoding")
+			os.Exit(1)
+		}
+		var externalCA *types.NamespacedName
+		if externalCASecret != "" {
+			refs, err := parseNamespacedNames([]string{externalCASecret})
+			if err != nil {
+				setupLog.Error(err, "invalid external CA secret")
+				os.Exit(1)
+			}
+			externalCA = &refs[0]
+		}
+
 		cacheOptions := cache.Options{}
 		if enablePartialCache {
 			cacheOptions.ByObject = map[client.Object]cache.ByObject{
@@ -72,6 +106,11 @@ var certcontrollerCmd = &cobra.Command{
 						constants.WellKnownLabelKey: constants.WellKnownLabelValueWebhook,
 					}),
 				},
+				&admissionregistration.MutatingWebhookConfiguration{}: {
+					Label: labels.SelectorFromSet(map[string]string{
+						constants.WellKnownLabelKey: constants.WellKnownLabelValueWebhook,
+					}),
+				},
 				&apiextensions.CustomResourceDefinition{}: {
 					Label: labels.SelectorFromSet(map[string]string{
 						constants.WellKnownLabelKey: constants.WellKnownLabelValueController,
@@ -79,6 +118,29 @@ var certcontrollerCmd = &cobra.Command{
 				},
 			}
 		}
+		if enablePartialCache && injectAPIServices {
+			apiService := &unstructured.Unstructured{}
+			apiService.SetGroupVersionKind(webhookconfig.APIServiceGVK)
+			cacheOptions.ByObject[apiService] = cache.ByObject{
+				Label: labels.SelectorFromSet(map[string]string{
+					constants.WellKnownLabelKey: constants.WellKnownLabelValueWebhook,
+				}),
+			}
+		}
+		if caConfigMapName != "" {
+			if cacheOptions.ByObject == nil {
+				cacheOptions.ByObject = map[client.Object]cache.ByObject{}
+			}
+			// only the CA ConfigMap is watched, to restore it when it is deleted
+			namespace := caConfigMapNamespace
+			if namespace == "" {
+				namespace = secretNamespace
+			}
+			cacheOptions.ByObject[&v1.ConfigMap{}] = cache.ByObject{
+				Namespaces: map[string]cache.Config{namespace: {}},
+				Field:      fields.OneTermEqualSelector("metadata.name", caConfigMapName),
+			}
+		}
 
 		mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 			Scheme: scheme,
@@ -102,6 +164,8 @@ var certcontrollerCmd = &cobra.Command{
 						// that he owns.
 						// see #721
 						&v1.Secret{},
+						// dependent deployments are only read after a certificate rotation.
+						&appsv1.Deployment{},
 					},
 				},
 			},
@@ -114,17 +178,46 @@ var certcontrollerCmd = &cobra.Command{
 		crdctrl := crds.New(mgr.GetClient(), mgr.GetScheme(), mgr.Elected(),
 			ctrl.Log.WithName("controllers").WithName("webhook-certs-updater"),
 			crdRequeueInterval, serviceName, serviceNamespace, secretName, secretNamespace, crdNames)
+		crdctrl.DependentDeployments = deployments
+		crdctrl.KeyAlgorithm = keyAlg
+		crdctrl.KeyEncoding = keyEnc
+		crdctrl.ExtraIPSANs = extraIPSANs
+		crdctrl.ExternalCASecret = externalCA
+		crdctrl.RotationJitterFraction = rotationJitterFraction
+		crdctrl.CAConfigMapName = caConfigMapName
+		crdctrl.CAConfigMapNamespace = caConfigMapNamespace
+		crdctrl.ExternalCertMode = externalCertMode
+		crdctrl.CertDir = certDir
+		// the hostname of a pod is its name unless the downward API provides it
+		crdctrl.PodName = os.Getenv("POD_NAME")
+		if crdctrl.PodName == "" {
+			crdctrl.PodName, _ = os.Hostname()
+		}
 		if err := crdctrl.SetupWithManager(mgr, controller.Options{
 			MaxConcurrentReconciles: concurrent,
 		}); err != nil {
 			setupLog.Error(err, errCreateController, "controller", "CustomResourceDefinition")
 			os.Exit(1)
 		}
+		// the expiry of the existing certificates is known before the first reconcile
+		if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+			if err := crdctrl.ObserveCertExpiry(ctx); err != nil {
+				setupLog.Error(err, "unable to observe certificate expiry")
+			}
+			return nil
+		})); err != nil {
+			setupLog.Error(err, "unable to add certificate expiry observer")
+			os.Exit(1)
+		}
 
 		whc := webhookconfig.New(mgr.GetClient(), mgr.GetScheme(), mgr.Elected(),
 			ctrl.Log.WithName("controllers").WithName("webhook-certs-updater"),
 			serviceName, serviceNamespace,
 			secretName, secretNamespace, crdRequeueInterval)
+		if externalCertMode {
+			whc.CertDir = certDir
+		}
+		whc.InjectAPIServices = injectAPIServices
 		if err := whc.SetupWithManager(mgr, controller.Options{
 			MaxConcurrentReconciles: concurrent,
 		}); err != nil {
@@ -161,6 +254,26 @@ func init() {
 	certcontrollerCmd.Flags().StringVar(&secretName, "secret-name", "external-secrets-webhook", "Secret to store certs for webhook")
 	certcontrollerCmd.Flags().StringVar(&secretNamespace, "secret-namespace", "default", "namespace of the secret to store certs")
 	certcontrollerCmd.Flags().StringSliceVar(&crdNames, "crd-names", []string{"externalsecrets.external-secrets.io", "clustersecretstores.external-secrets.io", "secretstores.external-secrets.io"}, "CRD names reconciled by the controller")
+	certcontrollerCmd.Flags().StringSliceVar(&dependentDeployments, "dependent-deployments", []string{},
+		"Deployments (namespace/name) to restart after the CA has been rotated. Requires get and patch permissions on deployments")
+	certcontrollerCmd.Flags().StringVar(&keyAlgorithm, "key-algorithm", string(crds.KeyAlgorithmRSA2048),
+		"Algorithm of the keys of the webhook certificates, one of: RSA-2048, RSA-4096, ECDSA-P256, ECDSA-P384. Certificates with keys of another algorithm are rotated")
+	certcontrollerCmd.Flags().StringVar(&keyEncoding, "key-encoding", string(crds.KeyEncodingPKCS1),
+		"PEM encoding of the keys of the webhook certificates, one of: PKCS1, PKCS8. PKCS1 stores ECDSA keys in SEC 1 format. Keys in another encoding are rotated")
+	certcontrollerCmd.Flags().IPSliceVar(&extraIPSANs, "extra-ip-sans", []net.IP{},
+		"IP addresses added to the webhook certificate, for clients which reach the webhook by the IP address of its service")
+	certcontrollerCmd.Flags().StringVar(&externalCASecret, "external-ca-secret", "",
+		"Secret (namespace/name) of type kubernetes.io/tls with a root CA which signs an intermediate CA for the webhook certificates, instead of a self-signed CA")
+	certcontrollerCmd.Flags().Float64Var(&rotationJitterFraction, "rotation-jitter-fraction", crds.DefaultRotationJitterFraction,
+		"Fraction of the lookahead window of the certificate rotation, up to 0.5, by which replicas extend it depending on their pod name so that they do not rotate at the same time")
+	certcontrollerCmd.Flags().StringVar(&caConfigMapName, "ca-configmap-name", "",
+		"ConfigMap to publish the CA bundle of the webhook to under ca.crt, for clients which are not injected by the controller. Requires get, list, watch, create and update permissions on configmaps")
+	certcontrollerCmd.Flags().StringVar(&caConfigMapNamespace, "ca-configmap-namespace", "", "namespace of the CA ConfigMap, defaults to the namespace of the secret")
+	certcontrollerCmd.Flags().BoolVar(&externalCertMode, "external-cert-mode", false,
+		"Inject the CA bundle of webhook certificates which are managed externally, e.g. by cert-manager, and mounted in --cert-dir instead of creating them in the secret. The directory is watched for changes")
+	certcontrollerCmd.Flags().StringVar(&certDir, "cert-dir", "/tmp/k8s-webhook-server/serving-certs", "path to watch for externally managed certs")
+	certcontrollerCmd.Flags().BoolVar(&injectAPIServices, "inject-apiservices", false,
+		"Inject the CA bundle into APIServices with the label external-secrets.io/component=webhook")
 	certcontrollerCmd.Flags().BoolVar(&enablePartialCache, "enable-partial-cache", false,
 		"Enable caching of only the relevant CRDs and Webhook configurations in the Informer to improve memory efficiency")
 	certcontrollerCmd.Flags().BoolVar(&enableLeaderElection, "enable-leader-election", false,
@@ -170,3 +283,16 @@ func init() {
 	certcontrollerCmd.Flags().StringVar(&zapTimeEncoding, "zap-time-encoding", "epoch", "Zap time encoding (one of 'epoch', 'millis', 'nano', 'iso8601', 'rfc3339' or 'rfc3339nano')")
 	certcontrollerCmd.Flags().DurationVar(&crdRequeueInterval, "crd-requeue-interval", time.Minute*5, "Time duration between reconciling CRDs for new certs")
 }
+
+// parseNamespacedNames parses a list of namespace/name references.
+func parseNamespacedNames(refs []string) ([]types.NamespacedName, error) {
+	deployments := make([]types.NamespacedName, 0, len(refs))
+	for _, ref := range refs {
+		namespace, name, ok := strings.Cut(ref, "/")
+		if !ok || namespace == "" || name == "" {
+			return nil, fmt.Errorf("expected namespace/name, got %q", ref)
+		}
+		deployments = append(deployments, types.NamespacedName{Namespace: namespace, Name: name})
+	}
+	return deployments, nil
+}
@@ -20,7 +20,6 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
-	"net/http"
 	"os"
 	"os/signal"
 	"strings"
@@ -138,7 +137,11 @@ var webhookCmd = &cobra.Command{
 			setupLog.Error(err, "unable to start manager")
 			os.Exit(1)
 		}
-		if err = (&esv1beta1.ExternalSecret{}).SetupWebhookWithManager(mgr); err != nil {
+		esValidator := &esv1beta1.ExternalSecretValidator{}
+		if enableRemoteRefValidation {
+			esValidator.StoreReader = mgr.GetAPIReader()
+		}
+		if err = (&esv1beta1.ExternalSecret{}).SetupWebhookWithValidator(mgr, esValidator); err != nil {
 			setupLog.Error(err, errCreateWebhook, "webhook", "ExternalSecret-v1beta1")
 			os.Exit(1)
 		}
@@ -163,9 +166,11 @@ var webhookCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
-		err = mgr.AddReadyzCheck("certs", func(_ *http.Request) error {
-			return crds.CheckCerts(c, dnsName, time.Now().Add(time.Hour))
-		})
+		err = (&crds.CertBundleValidator{
+			Certs:     c,
+			DNSName:   dnsName,
+			Lookahead: time.Hour,
+		}).SetupWithManager(mgr)
 		if err != nil {
 			setupLog.Error(err, "unable to add certs readyz check")
 			os.Exit(1)
@@ -259,4 +264,5 @@ func init() {
 		" Full lists of available ciphers can be found at https://pkg.go.dev/crypto/tls#pkg-constants."+
 		" E.g. 'TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256,TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256'")
 	webhookCmd.Flags().StringVar(&tlsMinVersion, "tls-min-version", "1.2", "minimum version of TLS supported.")
+	webhookCmd.Flags().BoolVar(&enableRemoteRefValidation, "enable-remote-ref-validation", false, "Validate the remote keys of ExternalSecrets with the provider of the referenced store. Requires get permission on SecretStores and ClusterSecretStores.")
 }
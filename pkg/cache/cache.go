@@ -93,6 +93,11 @@ func (c *Cache[T]) Add(version string, key Key, client T) {
 	c.lru.Add(key, value[T]{Version: version, Client: client})
 }
 
+// Remove evicts the value of the given key, the cleanup func is called if it exists.
+func (c *Cache[T]) Remove(key Key) {
+	c.lru.Remove(key)
+}
+
 // Contains returns true if a value with the given key exists.
 func (c *Cache[T]) Contains(key Key) bool {
 	return c.lru.Contains(key)
@@ -98,3 +98,19 @@ func TestCacheEvict(t *testing.T) {
 	c.Add("", Key{Name: "bar"}, client{})
 	assert.True(t, cleanupCalled)
 }
+
+func TestCacheRemove(t *testing.T) {
+	var cleanupCalled bool
+	c, err := New(1, func(client client) {
+		cleanupCalled = true
+	})
+	if err != nil {
+		t.Fail()
+	}
+
+	c.Add("", cacheKey, client{})
+	c.Remove(cacheKey)
+
+	assert.False(t, c.Contains(cacheKey))
+	assert.True(t, cleanupCalled)
+}
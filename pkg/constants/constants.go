@@ -42,26 +42,31 @@ const (
 	CallAzureKVDeleteCertificate = "DeleteCertificate"
 	CallAzureKVImportCertificate = "ImportCertificate"
 
-	ProviderGCPSM                = "GCP/SecretManager"
-	CallGCPSMGetSecret           = "GetSecret"
-	CallGCPSMDeleteSecret        = "DeleteSecret"
-	CallGCPSMCreateSecret        = "CreateSecret"
-	CallGCPSMUpdateSecret        = "UpdateSecret"
-	CallGCPSMAccessSecretVersion = "AccessSecretVersion"
-	CallGCPSMAddSecretVersion    = "AddSecretVersion"
-	CallGCPSMListSecrets         = "ListSecrets"
-	CallGCPSMGenerateSAToken     = "GenerateServiceAccountToken"
-	CallGCPSMGenerateIDBindToken = "GenerateIDBindToken"
-	CallGCPSMGenerateAccessToken = "GenerateAccessToken"
+	ProviderGCPSM                 = "GCP/SecretManager"
+	CallGCPSMGetSecret            = "GetSecret"
+	CallGCPSMDeleteSecret         = "DeleteSecret"
+	CallGCPSMCreateSecret         = "CreateSecret"
+	CallGCPSMUpdateSecret         = "UpdateSecret"
+	CallGCPSMAccessSecretVersion  = "AccessSecretVersion"
+	CallGCPSMAddSecretVersion     = "AddSecretVersion"
+	CallGCPSMListSecrets          = "ListSecrets"
+	CallGCPSMListSecretVersions   = "ListSecretVersions"
+	CallGCPSMDisableSecretVersion = "DisableSecretVersion"
+	CallGCPSMGenerateSAToken      = "GenerateServiceAccountToken"
+	CallGCPSMGenerateIDBindToken  = "GenerateIDBindToken"
+	CallGCPSMGenerateAccessToken  = "GenerateAccessToken"
 
 	ProviderHCVault            = "HashiCorp/Vault"
 	CallHCVaultLogin           = "Login"
 	CallHCVaultRevokeSelf      = "RevokeSelf"
 	CallHCVaultLookupSelf      = "LookupSelf"
+	CallHCVaultRenewSelf       = "RenewSelf"
 	CallHCVaultReadSecretData  = "ReadSecretData"
 	CallHCVaultWriteSecretData = "WriteSecretData"
 	CallHCVaultDeleteSecret    = "DeleteSecret"
 	CallHCVaultListSecrets     = "ListSecrets"
+	CallHCVaultRevokeLease     = "RevokeLease"
+	CallHCVaultRenewLease      = "RenewLease"
 
 	ProviderKubernetes                         = "Kubernetes"
 	CallKubernetesGetSecret                    = "GetSecret"
@@ -0,0 +1,287 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterpushsecret
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	esv1alpha1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1alpha1"
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/controllers/clusterpushsecret/cpsmetrics"
+	ctest "github.com/external-secrets/external-secrets/pkg/controllers/commontest"
+	ctrlmetrics "github.com/external-secrets/external-secrets/pkg/controllers/metrics"
+	"github.com/external-secrets/external-secrets/pkg/controllers/pushsecret/psmetrics"
+	"github.com/external-secrets/external-secrets/pkg/provider/testing/fake"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var (
+	fakeProvider *fake.Client
+	timeout      = time.Second * 10
+	interval     = time.Millisecond * 250
+)
+
+func init() {
+	ctrlmetrics.SetUpLabelNames(false)
+	cpsmetrics.SetUpMetrics()
+	psmetrics.SetUpMetrics()
+	fakeProvider = fake.New()
+	esv1beta1.ForceRegister(fakeProvider, &esv1beta1.SecretStoreProvider{
+		Fake: &esv1beta1.FakeProvider{},
+	})
+}
+
+var _ = Describe("ClusterPushSecret controller", func() {
+	const (
+		secretName = "test-secret"
+		secretKey  = "key"
+		remoteKey  = "path/to/key"
+	)
+
+	var (
+		storeName string
+		cpsName   string
+		nsLabels  map[string]string
+	)
+
+	BeforeEach(func() {
+		fakeProvider.Reset()
+		storeName = fmt.Sprintf("test-store-%s", randString(10))
+		cpsName = fmt.Sprintf("test-cps-%s", randString(10))
+		nsLabels = map[string]string{"cps": cpsName}
+
+		Expect(k8sClient.Create(context.Background(), &esv1beta1.ClusterSecretStore{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: storeName,
+			},
+			Spec: esv1beta1.SecretStoreSpec{
+				Provider: &esv1beta1.SecretStoreProvider{
+					Fake: &esv1beta1.FakeProvider{
+						Data: []esv1beta1.FakeProviderData{},
+					},
+				},
+			},
+		})).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(k8sClient.Delete(context.Background(), &esv1alpha1.ClusterPushSecret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: cpsName,
+			},
+		})).To(Succeed())
+		// give the push secret reconciler time to remove its finalizers before removing the store
+		time.Sleep(2 * time.Second)
+		Expect(k8sClient.Delete(context.Background(), &esv1beta1.ClusterSecretStore{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: storeName,
+			},
+		})).To(Succeed())
+	})
+
+	createNamespace := func(withSecret bool) string {
+		ns, err := ctest.CreateNamespaceWithLabels("cps", k8sClient, nsLabels)
+		Expect(err).ToNot(HaveOccurred())
+		if withSecret {
+			Expect(k8sClient.Create(context.Background(), &v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      secretName,
+					Namespace: ns,
+				},
+				Data: map[string][]byte{
+					secretKey: []byte("value-" + ns),
+				},
+			})).To(Succeed())
+		}
+		return ns
+	}
+
+	createClusterPushSecret := func() {
+		Expect(k8sClient.Create(context.Background(), &esv1alpha1.ClusterPushSecret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: cpsName,
+			},
+			Spec: esv1alpha1.ClusterPushSecretSpec{
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: nsLabels,
+				},
+				RefreshInterval: &metav1.Duration{Duration: time.Second},
+				PushSecretSpec: esv1alpha1.PushSecretSpec{
+					RefreshInterval: &metav1.Duration{Duration: time.Second},
+					SecretStoreRefs: []esv1alpha1.PushSecretStoreRef{
+						{
+							Name: storeName,
+							Kind: "ClusterSecretStore",
+						},
+					},
+					Selector: esv1alpha1.PushSecretSelector{
+						Secret: esv1alpha1.PushSecretSecret{
+							Name: secretName,
+						},
+					},
+					Data: []esv1alpha1.PushSecretData{
+						{
+							Match: esv1alpha1.PushSecretMatch{
+								SecretKey: secretKey,
+								RemoteRef: esv1alpha1.PushSecretRemoteRef{
+									RemoteKey: remoteKey,
+								},
+							},
+						},
+					},
+				},
+			},
+		})).To(Succeed())
+	}
+
+	getClusterPushSecret := func(g Gomega) esv1alpha1.ClusterPushSecret {
+		var cps esv1alpha1.ClusterPushSecret
+		g.Expect(k8sClient.Get(context.Background(), types.NamespacedName{Name: cpsName}, &cps)).To(Succeed())
+		return cps
+	}
+
+	readyCondition := func(status v1.ConditionStatus, message string) []esv1alpha1.ClusterPushSecretStatusCondition {
+		return []esv1alpha1.ClusterPushSecretStatusCondition{
+			{
+				Type:    esv1alpha1.ClusterPushSecretReady,
+				Status:  status,
+				Message: message,
+			},
+		}
+	}
+
+	It("should push the secret of every selected namespace", func() {
+		ns1 := createNamespace(true)
+		ns2 := createNamespace(true)
+		createClusterPushSecret()
+
+		Eventually(func(g Gomega) {
+			cps := getClusterPushSecret(g)
+			g.Expect(cps.Status.PushSecretName).To(Equal(cpsName))
+			g.Expect(cps.Status.ProvisionedNamespaces).To(ConsistOf(ns1, ns2))
+			g.Expect(cps.Status.FailedNamespaces).To(BeEmpty())
+			g.Expect(cps.Status.Conditions).To(Equal(readyCondition(v1.ConditionTrue, "")))
+		}).WithTimeout(timeout).WithPolling(interval).Should(Succeed())
+
+		for _, ns := range []string{ns1, ns2} {
+			Eventually(func(g Gomega) {
+				var ps esv1alpha1.PushSecret
+				g.Expect(k8sClient.Get(context.Background(), types.NamespacedName{Name: cpsName, Namespace: ns}, &ps)).To(Succeed())
+				g.Expect(ctest.HasOwnerRef(ps.ObjectMeta, esv1alpha1.ClusterPushSecretKind, cpsName)).To(BeTrue())
+				g.Expect(ps.Status.SyncedPushSecrets).To(HaveKey(fmt.Sprintf("ClusterSecretStore/%s", storeName)))
+				g.Expect(pushSecretError(&ps)).ToNot(HaveOccurred())
+			}).WithTimeout(timeout).WithPolling(interval).Should(Succeed())
+		}
+
+		Expect(fakeProvider.SetSecretArgs).To(HaveKey(remoteKey))
+		Expect(string(fakeProvider.SetSecretArgs[remoteKey].Value)).To(BeElementOf("value-"+ns1, "value-"+ns2))
+	})
+
+	It("should report namespaces where the push failed", func() {
+		ns1 := createNamespace(true)
+		ns2 := createNamespace(false)
+		createClusterPushSecret()
+
+		Eventually(func(g Gomega) {
+			cps := getClusterPushSecret(g)
+			g.Expect(cps.Status.ProvisionedNamespaces).To(ConsistOf(ns1, ns2))
+			g.Expect(cps.Status.FailedNamespaces).To(HaveLen(1))
+			g.Expect(cps.Status.FailedNamespaces[0].Namespace).To(Equal(ns2))
+			g.Expect(cps.Status.FailedNamespaces[0].Reason).ToNot(BeEmpty())
+			g.Expect(cps.Status.Conditions).To(Equal(readyCondition(v1.ConditionFalse, errNamespacesFailed)))
+		}).WithTimeout(timeout).WithPolling(interval).Should(Succeed())
+	})
+
+	It("should remove push secrets from namespaces that are no longer selected", func() {
+		ns1 := createNamespace(true)
+		ns2 := createNamespace(true)
+		createClusterPushSecret()
+
+		Eventually(func(g Gomega) {
+			cps := getClusterPushSecret(g)
+			g.Expect(cps.Status.ProvisionedNamespaces).To(ConsistOf(ns1, ns2))
+		}).WithTimeout(timeout).WithPolling(interval).Should(Succeed())
+
+		var namespace v1.Namespace
+		Expect(k8sClient.Get(context.Background(), types.NamespacedName{Name: ns2}, &namespace)).To(Succeed())
+		namespace.Labels = map[string]string{}
+		Expect(k8sClient.Update(context.Background(), &namespace)).To(Succeed())
+
+		Eventually(func(g Gomega) {
+			cps := getClusterPushSecret(g)
+			g.Expect(cps.Status.ProvisionedNamespaces).To(ConsistOf(ns1))
+
+			var pushSecrets esv1alpha1.PushSecretList
+			g.Expect(k8sClient.List(context.Background(), &pushSecrets)).To(Succeed())
+			for _, ps := range pushSecrets.Items {
+				g.Expect(ps.Namespace == ns2 && ps.Name == cpsName && ps.DeletionTimestamp == nil).To(BeFalse())
+			}
+		}).WithTimeout(timeout).WithPolling(interval).Should(Succeed())
+	})
+
+	It("should not adopt push secrets it does not own", func() {
+		ns := createNamespace(true)
+		Expect(k8sClient.Create(context.Background(), &esv1alpha1.PushSecret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      cpsName,
+				Namespace: ns,
+			},
+			Spec: esv1alpha1.PushSecretSpec{
+				SecretStoreRefs: []esv1alpha1.PushSecretStoreRef{
+					{
+						Name: storeName,
+						Kind: "ClusterSecretStore",
+					},
+				},
+				Selector: esv1alpha1.PushSecretSelector{
+					Secret: esv1alpha1.PushSecretSecret{
+						Name: secretName,
+					},
+				},
+			},
+		})).To(Succeed())
+		createClusterPushSecret()
+
+		Eventually(func(g Gomega) {
+			cps := getClusterPushSecret(g)
+			g.Expect(cps.Status.ProvisionedNamespaces).To(BeEmpty())
+			g.Expect(cps.Status.FailedNamespaces).To(Equal([]esv1alpha1.ClusterPushSecretNamespaceFailure{
+				{
+					Namespace: ns,
+					Reason:    "push secret already exists in namespace",
+				},
+			}))
+		}).WithTimeout(timeout).WithPolling(interval).Should(Succeed())
+	})
+})
+
+var letterRunes = []rune("abcdefghijklmnopqrstuvwxyz")
+
+func randString(n int) string {
+	b := make([]rune, n)
+	for i := range b {
+		b[i] = letterRunes[rand.Intn(len(letterRunes))]
+	}
+	return string(b)
+}
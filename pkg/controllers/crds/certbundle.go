@@ -0,0 +1,68 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crds
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	// CertBundleCheckName is the name of the readiness check of the CertBundleValidator
+	// on the health probe server.
+	CertBundleCheckName = "certs"
+	// CertBundlePath is the path of the CertBundleValidator on the webhook server.
+	CertBundlePath = "/readyz/cert"
+)
+
+// CertBundleValidator validates the certificates mounted in the CertDir of the webhook
+// for debugging certificate injection. The DNS name can be overridden with the dnsName
+// query parameter.
+type CertBundleValidator struct {
+	Certs   CertInfo
+	DNSName string
+	// Lookahead is the time from now at which the certificates must still be valid.
+	Lookahead time.Duration
+}
+
+// SetupWithManager registers the validator as readiness check and on the webhook server.
+func (v *CertBundleValidator) SetupWithManager(mgr ctrl.Manager) error {
+	mgr.GetWebhookServer().Register(CertBundlePath, v)
+	return mgr.AddReadyzCheck(CertBundleCheckName, v.Check)
+}
+
+// Check returns an error if the certificates are not valid, it is a healthz.Checker.
+func (v *CertBundleValidator) Check(req *http.Request) error {
+	dnsName := v.DNSName
+	if name := req.URL.Query().Get("dnsName"); name != "" {
+		dnsName = name
+	}
+	return CheckCerts(v.Certs, dnsName, time.Now().Add(v.Lookahead))
+}
+
+// ServeHTTP responds with 200 if the certificates are valid,
+// and with 503 and the error as JSON otherwise.
+func (v *CertBundleValidator) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := v.Check(req); err != nil {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+		return
+	}
+	_ = json.NewEncoder(w).Encode(map[string]string{"status": "ok"})
+}
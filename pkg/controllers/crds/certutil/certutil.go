@@ -0,0 +1,175 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package certutil validates and parses the certificates of the webhook.
+// It only depends on the standard library so it can be used in tests of any package.
+package certutil
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+)
+
+// KeyPairArtifacts is a parsed certificate and its private key, together with their PEM encoding.
+type KeyPairArtifacts struct {
+	Cert    *x509.Certificate
+	Key     crypto.Signer
+	CertPEM []byte
+	KeyPEM  []byte
+}
+
+// ParseKeyPair parses a PEM encoded certificate and private key,
+// the key can be an RSA or ECDSA key in PKCS#1, SEC 1 or PKCS#8 format.
+func ParseKeyPair(certPEM, keyPEM []byte) (*KeyPairArtifacts, error) {
+	certDer, _ := pem.Decode(certPEM)
+	if certDer == nil {
+		return nil, errors.New("bad CA cert")
+	}
+	cert, err := x509.ParseCertificate(certDer.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	key, err := ParsePrivateKey(keyPEM)
+	if err != nil {
+		return nil, err
+	}
+	return &KeyPairArtifacts{
+		Cert:    cert,
+		Key:     key,
+		CertPEM: certPEM,
+		KeyPEM:  keyPEM,
+	}, nil
+}
+
+// ParsePrivateKey parses a PEM encoded RSA or ECDSA private key.
+func ParsePrivateKey(keyPEM []byte) (crypto.Signer, error) {
+	keyDer, _ := pem.Decode(keyPEM)
+	if keyDer == nil {
+		return nil, errors.New("bad private key")
+	}
+	switch keyDer.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(keyDer.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(keyDer.Bytes)
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(keyDer.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key type %T", key)
+		}
+		return signer, nil
+	}
+	return nil, fmt.Errorf("unsupported private key block %q", keyDer.Type)
+}
+
+// ValidCert returns true if cert and key form a valid key pair and cert is valid
+// for dnsName at the given time. caChain is a PEM bundle of the root CA, optionally
+// preceded by intermediate CAs, and cert may be followed by intermediate certificates.
+func ValidCert(caChain, cert, key []byte, dnsName string, at time.Time) (bool, error) {
+	return validCert(caChain, cert, key, dnsName, at)
+}
+
+// ValidCertForIP is like ValidCert, but checks that cert is valid for the IP address ip.
+func ValidCertForIP(caChain, cert, key []byte, ip net.IP, at time.Time) (bool, error) {
+	if ip == nil {
+		return false, errors.New("empty IP address")
+	}
+	return validCert(caChain, cert, key, ip.String(), at)
+}
+
+// validCert verifies cert for host, which is matched against the IP SANs
+// of cert if it is an IP address and against the DNS SANs otherwise.
+func validCert(caChain, cert, key []byte, host string, at time.Time) (bool, error) {
+	if len(caChain) == 0 || len(cert) == 0 || len(key) == 0 {
+		return false, errors.New("empty cert")
+	}
+
+	roots := x509.NewCertPool()
+	intermediates := x509.NewCertPool()
+	cas, err := parseCertificates(caChain)
+	if err != nil || len(cas) == 0 {
+		return false, errors.New("bad CA cert")
+	}
+	hasRoot := false
+	for _, ca := range cas {
+		if isSelfSigned(ca) {
+			roots.AddCert(ca)
+			hasRoot = true
+		} else {
+			intermediates.AddCert(ca)
+		}
+	}
+	// a bundle without a self-signed root is trusted as is
+	if !hasRoot {
+		for _, ca := range cas {
+			roots.AddCert(ca)
+		}
+	}
+
+	_, err = tls.X509KeyPair(cert, key)
+	if err != nil {
+		return false, err
+	}
+
+	certs, err := parseCertificates(cert)
+	if err != nil || len(certs) == 0 {
+		return false, errors.New("bad cert")
+	}
+	for _, inter := range certs[1:] {
+		intermediates.AddCert(inter)
+	}
+
+	_, err = certs[0].Verify(x509.VerifyOptions{
+		DNSName:       host,
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   at,
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// parseCertificates parses all certificates of a PEM bundle.
+func parseCertificates(bundle []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, bundle = pem.Decode(bundle)
+		if block == nil {
+			return certs, nil
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+}
+
+func isSelfSigned(cert *x509.Certificate) bool {
+	return bytes.Equal(cert.RawIssuer, cert.RawSubject) && cert.CheckSignatureFrom(cert) == nil
+}
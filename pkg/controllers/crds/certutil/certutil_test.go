@@ -0,0 +1,232 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certutil
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+)
+
+const dnsName = "foobar"
+
+func newKey(t *testing.T, alg string) crypto.Signer {
+	t.Helper()
+	var key crypto.Signer
+	var err error
+	switch alg {
+	case "rsa":
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+	case "ecdsa":
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	}
+	if err != nil {
+		t.Fatalf("could not generate key: %v", err)
+	}
+	return key
+}
+
+func encodeKey(t *testing.T, key crypto.Signer, format string) []byte {
+	t.Helper()
+	var block *pem.Block
+	switch format {
+	case "pkcs1":
+		block = &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key.(*rsa.PrivateKey))}
+	case "sec1":
+		der, err := x509.MarshalECPrivateKey(key.(*ecdsa.PrivateKey))
+		if err != nil {
+			t.Fatal(err)
+		}
+		block = &pem.Block{Type: "EC PRIVATE KEY", Bytes: der}
+	case "pkcs8":
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		block = &pem.Block{Type: "PRIVATE KEY", Bytes: der}
+	}
+	return pem.EncodeToMemory(block)
+}
+
+func newCert(t *testing.T, templ *x509.Certificate, key crypto.Signer, parent *KeyPairArtifacts) []byte {
+	t.Helper()
+	parentCert, parentKey := templ, key
+	if parent != nil {
+		parentCert, parentKey = parent.Cert, parent.Key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, templ, parentCert, key.Public(), parentKey)
+	if err != nil {
+		t.Fatalf("could not create certificate: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func newCA(t *testing.T, alg, format string) *KeyPairArtifacts {
+	t.Helper()
+	key := newKey(t, alg)
+	certPEM := newCert(t, &x509.Certificate{
+		SerialNumber:          big.NewInt(0),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().AddDate(1, 0, 0),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}, key, nil)
+	ca, err := ParseKeyPair(certPEM, encodeKey(t, key, format))
+	if err != nil {
+		t.Fatalf("could not parse ca: %v", err)
+	}
+	return ca
+}
+
+func TestValidCert(t *testing.T) {
+	tests := map[string]struct {
+		alg    string
+		format string
+	}{
+		"rsa pkcs1":   {alg: "rsa", format: "pkcs1"},
+		"rsa pkcs8":   {alg: "rsa", format: "pkcs8"},
+		"ecdsa sec1":  {alg: "ecdsa", format: "sec1"},
+		"ecdsa pkcs8": {alg: "ecdsa", format: "pkcs8"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ca := newCA(t, tc.alg, tc.format)
+			key := newKey(t, tc.alg)
+			certPEM := newCert(t, &x509.Certificate{
+				SerialNumber: big.NewInt(1),
+				Subject:      pkix.Name{CommonName: dnsName},
+				DNSNames:     []string{dnsName},
+				NotBefore:    time.Now().Add(-time.Hour),
+				NotAfter:     time.Now().AddDate(0, 1, 0),
+				KeyUsage:     x509.KeyUsageDigitalSignature,
+				ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+			}, key, ca)
+			keyPEM := encodeKey(t, key, tc.format)
+
+			ok, err := ValidCert(ca.CertPEM, certPEM, keyPEM, dnsName, time.Now())
+			if err != nil || !ok {
+				t.Errorf("expected valid certificate, got %v", err)
+			}
+			if ok, _ := ValidCert(ca.CertPEM, certPEM, keyPEM, "wrong", time.Now()); ok {
+				t.Error("expected failure due to dns name, got success")
+			}
+			if ok, _ := ValidCert(ca.CertPEM, certPEM, keyPEM, dnsName, time.Now().AddDate(0, 2, 0)); ok {
+				t.Error("expected failure due to expired certificate, got success")
+			}
+			if ok, _ := ValidCert(ca.CertPEM, certPEM, ca.KeyPEM, dnsName, time.Now()); ok {
+				t.Error("expected failure due to mismatched key, got success")
+			}
+		})
+	}
+}
+
+func TestValidCertForIP(t *testing.T) {
+	ca := newCA(t, "ecdsa", "sec1")
+	key := newKey(t, "ecdsa")
+	certPEM := newCert(t, &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: dnsName},
+		DNSNames:     []string{dnsName},
+		IPAddresses:  []net.IP{net.ParseIP("10.96.0.10"), net.ParseIP("fd00::10")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().AddDate(0, 1, 0),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, key, ca)
+	keyPEM := encodeKey(t, key, "sec1")
+
+	for _, ip := range []string{"10.96.0.10", "fd00::10"} {
+		ok, err := ValidCertForIP(ca.CertPEM, certPEM, keyPEM, net.ParseIP(ip), time.Now())
+		if err != nil || !ok {
+			t.Errorf("expected valid certificate for %s, got %v", ip, err)
+		}
+	}
+	if ok, _ := ValidCertForIP(ca.CertPEM, certPEM, keyPEM, net.ParseIP("10.96.0.11"), time.Now()); ok {
+		t.Error("expected failure due to IP address, got success")
+	}
+	if ok, _ := ValidCertForIP(ca.CertPEM, certPEM, keyPEM, nil, time.Now()); ok {
+		t.Error("expected failure due to empty IP address, got success")
+	}
+}
+
+func TestValidCertChain(t *testing.T) {
+	root := newCA(t, "ecdsa", "sec1")
+	interKey := newKey(t, "ecdsa")
+	interPEM := newCert(t, &x509.Certificate{
+		SerialNumber:          big.NewInt(2),
+		Subject:               pkix.Name{CommonName: "intermediate"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().AddDate(1, 0, 0),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}, interKey, root)
+	inter, err := ParseKeyPair(interPEM, encodeKey(t, interKey, "sec1"))
+	if err != nil {
+		t.Fatalf("could not parse intermediate: %v", err)
+	}
+	key := newKey(t, "ecdsa")
+	certPEM := newCert(t, &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: dnsName},
+		DNSNames:     []string{dnsName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().AddDate(0, 1, 0),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, key, inter)
+	keyPEM := encodeKey(t, key, "sec1")
+	chain := append(append([]byte{}, interPEM...), root.CertPEM...)
+
+	ok, err := ValidCert(chain, certPEM, keyPEM, dnsName, time.Now())
+	if err != nil || !ok {
+		t.Errorf("expected valid certificate with the chain, got %v", err)
+	}
+	ok, err = ValidCert(root.CertPEM, append(append([]byte{}, certPEM...), interPEM...), keyPEM, dnsName, time.Now())
+	if err != nil || !ok {
+		t.Errorf("expected valid certificate followed by the intermediate, got %v", err)
+	}
+	if ok, _ := ValidCert(root.CertPEM, certPEM, keyPEM, dnsName, time.Now()); ok {
+		t.Error("expected failure due to missing intermediate, got success")
+	}
+	if ok, _ := ValidCert(chain, certPEM, keyPEM, dnsName, time.Now().AddDate(0, 2, 0)); ok {
+		t.Error("expected failure due to expired certificate, got success")
+	}
+	other := newCA(t, "ecdsa", "sec1")
+	if ok, _ := ValidCert(append(append([]byte{}, interPEM...), other.CertPEM...), certPEM, keyPEM, dnsName, time.Now()); ok {
+		t.Error("expected failure due to wrong root, got success")
+	}
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	if _, err := ParsePrivateKey([]byte("foo")); err == nil {
+		t.Error("expected failure for invalid PEM, got success")
+	}
+	block := pem.EncodeToMemory(&pem.Block{Type: "DSA PRIVATE KEY", Bytes: []byte("foo")})
+	if _, err := ParsePrivateKey(block); err == nil {
+		t.Error("expected failure for unsupported key, got success")
+	}
+}
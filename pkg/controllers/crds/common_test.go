@@ -95,6 +95,38 @@ var _ = Describe("CRD reconcile", func() {
 		}
 	}
 
+	// the CA bundle is published to the CA ConfigMap
+	// and the ConfigMap is restored after it has been deleted
+	publishesCAConfigMap := func(tc *testCase) {
+		tc.assert = func() {
+			caConfigMap := func() string {
+				var secret corev1.Secret
+				if err := k8sClient.Get(context.Background(), types.NamespacedName{Name: "foo", Namespace: "default"}, &secret); err != nil {
+					return ""
+				}
+				var cm corev1.ConfigMap
+				if err := k8sClient.Get(context.Background(), types.NamespacedName{Name: "foo-ca", Namespace: "default"}, &cm); err != nil {
+					return ""
+				}
+				if cm.Data["ca.crt"] != string(secret.Data["ca.crt"]) {
+					return ""
+				}
+				return cm.Data["ca.crt"]
+			}
+			Eventually(caConfigMap).
+				WithTimeout(time.Second * 10).
+				WithPolling(time.Second).
+				ShouldNot(BeEmpty())
+			Expect(k8sClient.Delete(context.Background(), &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo-ca", Namespace: "default"},
+			})).To(Succeed())
+			Eventually(caConfigMap).
+				WithTimeout(time.Second * 10).
+				WithPolling(time.Second).
+				ShouldNot(BeEmpty())
+		}
+	}
+
 	DescribeTable("Controller Reconcile logic", func(muts ...func(tc *testCase)) {
 		for _, mut := range muts {
 			mut(test)
@@ -112,6 +144,7 @@ var _ = Describe("CRD reconcile", func() {
 	},
 		Entry("[namespace] Ignore non Target CRDs", ignoreNonTargetCRDs),
 		Entry("[namespace] Patch target CRDs", PatchesCRD),
+		Entry("[namespace] Publish the CA bundle to a ConfigMap", publishesCAConfigMap),
 	)
 
 })
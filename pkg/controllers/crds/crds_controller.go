@@ -17,30 +17,48 @@ package crds
 import (
 	"bytes"
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
-	"crypto/tls"
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"hash/fnv"
+	"math"
 	"math/big"
+	"net"
 	"net/http"
 	"os"
 	"path/filepath"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"github.com/external-secrets/external-secrets/pkg/controllers/crds/certutil"
 )
 
 const (
@@ -48,14 +66,76 @@ const (
 	keyName              = "tls.key"
 	caCertName           = "ca.crt"
 	caKeyName            = "ca.key"
+	pkcs8BlockType       = "PRIVATE KEY"
 	certValidityDuration = 10 * 365 * 24 * time.Hour
 	LookaheadInterval    = 90 * 24 * time.Hour
 
+	// DefaultRotationJitterFraction is the default of Reconciler.RotationJitterFraction.
+	DefaultRotationJitterFraction = 0.1
+	// MaxRotationJitterFraction is the largest supported Reconciler.RotationJitterFraction.
+	MaxRotationJitterFraction = 0.5
+
+	// RestartedAtAnnotation is set on the pod template of dependent deployments
+	// to trigger a rolling restart after the certificates have been rotated.
+	RestartedAtAnnotation = "external-secrets.io/restartedAt"
+	// LastSerialAnnotation is set on the secret to the last serial number issued by the Reconciler,
+	// so that serial numbers keep increasing across restarts.
+	LastSerialAnnotation = "external-secrets.io/last-serial"
+
 	errResNotReady       = "resource not ready: %s"
 	errSubsetsNotReady   = "subsets not ready"
 	errAddressesNotReady = "addresses not ready"
 )
 
+// KeyAlgorithm is the algorithm of the private keys of the generated certificates.
+type KeyAlgorithm string
+
+const (
+	KeyAlgorithmRSA2048   KeyAlgorithm = "RSA-2048"
+	KeyAlgorithmRSA4096   KeyAlgorithm = "RSA-4096"
+	KeyAlgorithmECDSAP256 KeyAlgorithm = "ECDSA-P256"
+	KeyAlgorithmECDSAP384 KeyAlgorithm = "ECDSA-P384"
+)
+
+// KeyAlgorithms are the supported key algorithms.
+var KeyAlgorithms = []KeyAlgorithm{KeyAlgorithmRSA2048, KeyAlgorithmRSA4096, KeyAlgorithmECDSAP256, KeyAlgorithmECDSAP384}
+
+// ParseKeyAlgorithm returns the key algorithm with the given name.
+func ParseKeyAlgorithm(name string) (KeyAlgorithm, error) {
+	for _, alg := range KeyAlgorithms {
+		if string(alg) == name {
+			return alg, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported key algorithm %q, must be one of %v", name, KeyAlgorithms)
+}
+
+// KeyEncoding is the PEM encoding of the private keys of the generated certificates.
+type KeyEncoding string
+
+const (
+	// KeyEncodingPKCS1 encodes RSA keys in PKCS#1 and ECDSA keys in SEC 1 format.
+	KeyEncodingPKCS1 KeyEncoding = "PKCS1"
+	// KeyEncodingPKCS8 encodes keys in PKCS#8 format, e.g. for Java tooling.
+	KeyEncodingPKCS8 KeyEncoding = "PKCS8"
+)
+
+// KeyEncodings are the supported key encodings.
+var KeyEncodings = []KeyEncoding{KeyEncodingPKCS1, KeyEncodingPKCS8}
+
+// ParseKeyEncoding returns the key encoding with the given name.
+func ParseKeyEncoding(name string) (KeyEncoding, error) {
+	for _, enc := range KeyEncodings {
+		if string(enc) == name {
+			return enc, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported key encoding %q, must be one of %v", name, KeyEncodings)
+}
+
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;patch
+
 type Reconciler struct {
 	client.Client
 	Log             logr.Logger
@@ -71,6 +151,49 @@ type Reconciler struct {
 	CAChainName     string
 	CAOrganization  string
 	RequeueInterval time.Duration
+	// KeyAlgorithm of the generated certificates, defaults to RSA-2048.
+	// Certificates with keys of another algorithm are rotated.
+	KeyAlgorithm KeyAlgorithm
+	// KeyEncoding of the generated private keys, defaults to PKCS1.
+	// Keys in another encoding are rotated.
+	KeyEncoding KeyEncoding
+	// ExtraIPSANs are added to the server certificate, for clients which
+	// reach the webhook by the IP address of its service.
+	ExtraIPSANs []net.IP
+	// ExternalCASecret, if set, references a kubernetes.io/tls Secret with a root CA
+	// which signs an intermediate CA named CAChainName. The intermediate signs the
+	// server certificates and the CA bundle contains the intermediate and the root.
+	ExternalCASecret *types.NamespacedName
+	// RotationJitterFraction extends the lookahead window of the certificate rotation by up to
+	// this fraction of LookaheadInterval, so that replicas do not all rotate in the same reconcile.
+	// The extension of a replica is derived from PodName, the fraction must be in [0, 0.5].
+	RotationJitterFraction float64
+	// PodName of this replica, which seeds its rotation jitter.
+	PodName string
+	// CAConfigMapName, if set, is a ConfigMap to which the CA bundle is published under ca.crt,
+	// for clients which are not injected by the controller. It is recreated if it is deleted.
+	CAConfigMapName string
+	// CAConfigMapNamespace of the CA ConfigMap, defaults to SecretNamespace.
+	CAConfigMapNamespace string
+	// ExternalCertMode injects the CA bundle of certificates which are managed externally,
+	// e.g. by cert-manager, and mounted in CertDir instead of creating them in the secret.
+	// CertDir is watched and the CRDs are injected again whenever the certificates change.
+	ExternalCertMode bool
+	// CertDir contains the tls.crt, tls.key and ca.crt of the webhook in ExternalCertMode.
+	CertDir string
+
+	// DependentDeployments are restarted after the CA has been rotated
+	// so that they pick up the new CA bundle.
+	DependentDeployments []types.NamespacedName
+	// restartPending is set from a CA rotation until all dependent deployments have been restarted
+	restartPending atomic.Bool
+	// externalCABundle is the CA bundle last loaded from CertDir in ExternalCertMode
+	externalCABundle atomic.Pointer[[]byte]
+	// certDirEvents triggers a reconcile of the CRDs when the certificates in CertDir change
+	certDirEvents chan event.GenericEvent
+	// lastSerial is the last serial number issued for a certificate
+	serialMu   sync.Mutex
+	lastSerial *big.Int
 
 	// the controller is ready when all crds are injected
 	// and the controller is elected as leader
@@ -83,20 +206,22 @@ type Reconciler struct {
 func New(k8sClient client.Client, scheme *runtime.Scheme, leaderChan <-chan struct{}, logger logr.Logger,
 	interval time.Duration, svcName, svcNamespace, secretName, secretNamespace string, resources []string) *Reconciler {
 	return &Reconciler{
-		Client:           k8sClient,
-		Log:              logger,
-		Scheme:           scheme,
-		SvcName:          svcName,
-		SvcNamespace:     svcNamespace,
-		SecretName:       secretName,
-		SecretNamespace:  secretNamespace,
-		RequeueInterval:  interval,
-		CrdResources:     resources,
-		CAName:           "external-secrets",
-		CAOrganization:   "external-secrets",
-		leaderChan:       leaderChan,
-		readyStatusMapMu: &sync.Mutex{},
-		readyStatusMap:   map[string]bool{},
+		Client:                 k8sClient,
+		Log:                    logger,
+		Scheme:                 scheme,
+		SvcName:                svcName,
+		SvcNamespace:           svcNamespace,
+		SecretName:             secretName,
+		SecretNamespace:        secretNamespace,
+		RequeueInterval:        interval,
+		CrdResources:           resources,
+		RotationJitterFraction: DefaultRotationJitterFraction,
+		CAName:                 "external-secrets",
+		CAChainName:            "external-secrets-intermediate",
+		CAOrganization:         "external-secrets",
+		leaderChan:             leaderChan,
+		readyStatusMapMu:       &sync.Mutex{},
+		readyStatusMap:         map[string]bool{},
 	}
 }
 
@@ -184,14 +309,101 @@ func (r *Reconciler) checkEndpoints() error {
 }
 
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, opts controller.Options) error {
+	if _, err := ParseKeyAlgorithm(string(r.keyAlgorithm())); err != nil {
+		return err
+	}
+	if _, err := ParseKeyEncoding(string(r.keyEncoding())); err != nil {
+		return err
+	}
+	if r.RotationJitterFraction < 0 || r.RotationJitterFraction > MaxRotationJitterFraction {
+		return fmt.Errorf("rotation jitter fraction %v must be between 0 and %v", r.RotationJitterFraction, MaxRotationJitterFraction)
+	}
+	if r.ExternalCertMode && r.CertDir == "" {
+		return errors.New("external cert mode requires a cert dir")
+	}
+	if r.ExternalCertMode && r.ExternalCASecret != nil {
+		return errors.New("external cert mode can not be combined with an external CA secret")
+	}
+	registerMetrics()
 	r.recorder = mgr.GetEventRecorderFor("custom-resource-definition")
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		WithOptions(opts).
-		For(&apiext.CustomResourceDefinition{}).
-		Complete(r)
+		For(&apiext.CustomResourceDefinition{})
+	if r.CAConfigMapName != "" {
+		b = b.Watches(
+			&corev1.ConfigMap{},
+			handler.EnqueueRequestsFromMapFunc(r.findCRDsForConfigMap),
+			builder.WithPredicates(predicate.NewPredicateFuncs(r.isCAConfigMap)),
+		)
+	}
+	if r.ExternalCertMode {
+		r.certDirEvents = make(chan event.GenericEvent)
+		b = b.WatchesRawSource(source.Channel(r.certDirEvents, &handler.EnqueueRequestForObject{}))
+		if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+			return r.watchCertDir(ctx, ExternalCertTimeout)
+		})); err != nil {
+			return err
+		}
+	}
+	return b.Complete(r)
+}
+
+// findCRDsForConfigMap reconciles all CRDs when the CA ConfigMap changes,
+// any of them publishes the CA bundle again.
+func (r *Reconciler) findCRDsForConfigMap(_ context.Context, _ client.Object) []reconcile.Request {
+	requests := make([]reconcile.Request, 0, len(r.CrdResources))
+	for _, name := range r.CrdResources {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: name}})
+	}
+	return requests
+}
+
+func (r *Reconciler) isCAConfigMap(obj client.Object) bool {
+	name := r.caConfigMapName()
+	return obj.GetName() == name.Name && obj.GetNamespace() == name.Namespace
+}
+
+func (r *Reconciler) caConfigMapName() types.NamespacedName {
+	namespace := r.CAConfigMapNamespace
+	if namespace == "" {
+		namespace = r.SecretNamespace
+	}
+	return types.NamespacedName{Name: r.CAConfigMapName, Namespace: namespace}
+}
+
+// publishCA writes the CA bundle to the CA ConfigMap if one is configured,
+// the ConfigMap is created if it does not exist.
+func (r *Reconciler) publishCA(ctx context.Context, caPEM []byte) error {
+	if r.CAConfigMapName == "" {
+		return nil
+	}
+	name := r.caConfigMapName()
+	var cm corev1.ConfigMap
+	err := r.Get(ctx, name, &cm)
+	if apierrors.IsNotFound(err) {
+		cm = corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: name.Name, Namespace: name.Namespace},
+			Data:       map[string]string{caCertName: string(caPEM)},
+		}
+		return r.Create(ctx, &cm)
+	}
+	if err != nil {
+		return err
+	}
+	if cm.Data[caCertName] == string(caPEM) {
+		return nil
+	}
+	if cm.Data == nil {
+		cm.Data = make(map[string]string)
+	}
+	cm.Data[caCertName] = string(caPEM)
+	return r.Update(ctx, &cm)
 }
 
 func (r *Reconciler) updateCRD(ctx context.Context, req ctrl.Request) error {
+	if r.ExternalCertMode {
+		return r.injectExternalCA(ctx, req)
+	}
 	secret := corev1.Secret{}
 	secretName := types.NamespacedName{
 		Name:      r.SecretName,
@@ -213,10 +425,15 @@ func (r *Reconciler) updateCRD(ctx context.Context, req ctrl.Request) error {
 		return err
 	}
 	r.dnsName = fmt.Sprintf("%v.%v.svc", r.SvcName, r.SvcNamespace)
+	oldCA := secret.Data[caCertName]
 	need, err := r.refreshCertIfNeeded(&secret)
 	if err != nil {
 		return err
 	}
+	// nothing can use the certificates yet when they are created for the first time
+	if len(oldCA) > 0 && !bytes.Equal(oldCA, secret.Data[caCertName]) {
+		r.restartPending.Store(true)
+	}
 	if need {
 		artifacts, err := buildArtifactsFromSecret(&secret)
 		if err != nil {
@@ -225,8 +442,53 @@ func (r *Reconciler) updateCRD(ctx context.Context, req ctrl.Request) error {
 		if err := injectCert(&updatedResource, artifacts.CertPEM); err != nil {
 			return err
 		}
+		if err := r.publishCA(ctx, artifacts.CertPEM); err != nil {
+			return fmt.Errorf("failed to publish the CA bundle to ConfigMap %s: %w", r.caConfigMapName(), err)
+		}
+	}
+	if err := r.Update(ctx, &updatedResource); err != nil {
+		return err
+	}
+	if r.restartPending.Load() {
+		if err := r.restartDependentDeployments(ctx); err != nil {
+			return err
+		}
+		r.restartPending.Store(false)
 	}
-	return r.Update(ctx, &updatedResource)
+	return nil
+}
+
+// restartDependentDeployments triggers a rolling restart of the dependent deployments
+// by updating an annotation on their pod template, the same way `kubectl rollout restart` does.
+// Deployments that do not exist are skipped.
+func (r *Reconciler) restartDependentDeployments(ctx context.Context) error {
+	restartedAt := time.Now().Format(time.RFC3339)
+	var errs []error
+	for _, name := range r.DependentDeployments {
+		err := r.restartDeployment(ctx, name, restartedAt)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to restart dependent deployment %s: %w", name, err))
+			continue
+		}
+		r.Log.Info("restarted dependent deployment after CA rotation", "deployment", name)
+	}
+	return errors.Join(errs...)
+}
+
+func (r *Reconciler) restartDeployment(ctx context.Context, name types.NamespacedName, restartedAt string) error {
+	var deployment appsv1.Deployment
+	if err := r.Get(ctx, name, &deployment); err != nil {
+		return err
+	}
+	patch := client.MergeFrom(deployment.DeepCopy())
+	if deployment.Spec.Template.Annotations == nil {
+		deployment.Spec.Template.Annotations = map[string]string{}
+	}
+	deployment.Spec.Template.Annotations[RestartedAtAnnotation] = restartedAt
+	return r.Patch(ctx, &deployment, patch)
 }
 
 func injectService(crd *apiext.CustomResourceDefinition, svc types.NamespacedName) error {
@@ -251,14 +513,7 @@ func injectCert(crd *apiext.CustomResourceDefinition, certPem []byte) error {
 	return nil
 }
 
-type KeyPairArtifacts struct {
-	Cert    *x509.Certificate
-	Key     *rsa.PrivateKey
-	CertPEM []byte
-	KeyPEM  []byte
-}
-
-func populateSecret(cert, key []byte, caArtifacts *KeyPairArtifacts, secret *corev1.Secret) {
+func populateSecret(cert, key []byte, caArtifacts *certutil.KeyPairArtifacts, secret *corev1.Secret) {
 	if secret.Data == nil {
 		secret.Data = make(map[string][]byte)
 	}
@@ -268,97 +523,130 @@ func populateSecret(cert, key []byte, caArtifacts *KeyPairArtifacts, secret *cor
 	secret.Data[keyName] = key
 }
 
-func ValidCert(caCert, cert, key []byte, dnsName string, at time.Time) (bool, error) {
-	if len(caCert) == 0 || len(cert) == 0 || len(key) == 0 {
-		return false, errors.New("empty cert")
-	}
+// lookaheadTime returns the time until which the certificates must be valid, the
+// lookahead window is extended by the rotation jitter of this replica.
+func (r *Reconciler) lookaheadTime() time.Time {
+	return time.Now().Add(r.lookaheadInterval())
+}
 
-	pool := x509.NewCertPool()
-	caDer, _ := pem.Decode(caCert)
-	if caDer == nil {
-		return false, errors.New("bad CA cert")
-	}
-	cac, err := x509.ParseCertificate(caDer.Bytes)
-	if err != nil {
-		return false, err
-	}
-	pool.AddCert(cac)
+// lookaheadInterval returns LookaheadInterval extended by a fraction of up to
+// RotationJitterFraction, which is derived from a hash of the pod name.
+func (r *Reconciler) lookaheadInterval() time.Duration {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(r.PodName))
+	jitter := r.RotationJitterFraction * float64(h.Sum64()) / float64(math.MaxUint64)
+	return LookaheadInterval + time.Duration(jitter*float64(LookaheadInterval))
+}
 
-	_, err = tls.X509KeyPair(cert, key)
-	if err != nil {
-		return false, err
+func (r *Reconciler) validServerCert(caCert, cert, key []byte) bool {
+	if !r.matchesKeyAlgorithm(key) {
+		return false
 	}
-
-	b, rest := pem.Decode(cert)
-	if b == nil {
-		return false, err
+	valid, err := certutil.ValidCert(caCert, cert, key, r.dnsName, r.lookaheadTime())
+	if err != nil || !valid {
+		return false
 	}
-	if len(rest) > 0 {
-		intermediate, _ := pem.Decode(rest)
-		inter, err := x509.ParseCertificate(intermediate.Bytes)
-		if err != nil {
-			return false, err
+	for _, ip := range r.ExtraIPSANs {
+		valid, err := certutil.ValidCertForIP(caCert, cert, key, ip, r.lookaheadTime())
+		if err != nil || !valid {
+			return false
 		}
-		pool.AddCert(inter)
 	}
+	return true
+}
 
-	crt, err := x509.ParseCertificate(b.Bytes)
-	if err != nil {
-		return false, err
+func (r *Reconciler) validCACert(cert, key []byte) bool {
+	if !r.matchesKeyAlgorithm(key) {
+		return false
 	}
-	_, err = crt.Verify(x509.VerifyOptions{
-		DNSName:     dnsName,
-		Roots:       pool,
-		CurrentTime: at,
-	})
+	valid, err := certutil.ValidCert(cert, cert, key, r.CAName, r.lookaheadTime())
 	if err != nil {
-		return false, err
+		return false
 	}
-	return true, nil
-}
-
-func lookaheadTime() time.Time {
-	return time.Now().Add(LookaheadInterval)
+	return valid
 }
 
-func (r *Reconciler) validServerCert(caCert, cert, key []byte) bool {
-	valid, err := ValidCert(caCert, cert, key, r.dnsName, lookaheadTime())
+// validIntermediateCACert returns true if cert is a bundle of a valid intermediate CA
+// issued by rootCA, followed by rootCA.
+func (r *Reconciler) validIntermediateCACert(rootCA *certutil.KeyPairArtifacts, cert, key []byte) bool {
+	if !r.matchesKeyAlgorithm(key) {
+		return false
+	}
+	// the bundle must end with the current root, so that a new root reaches the clients
+	if !bytes.HasSuffix(cert, rootCA.CertPEM) {
+		return false
+	}
+	valid, err := certutil.ValidCert(rootCA.CertPEM, cert, key, r.CAChainName, r.lookaheadTime())
 	if err != nil {
 		return false
 	}
 	return valid
 }
 
-func (r *Reconciler) validCACert(cert, key []byte) bool {
-	valid, err := ValidCert(cert, cert, key, r.CAName, lookaheadTime())
+// externalCA reads the root CA from the ExternalCASecret, it returns nil if none is set.
+func (r *Reconciler) externalCA(ctx context.Context) (*certutil.KeyPairArtifacts, error) {
+	if r.ExternalCASecret == nil {
+		return nil, nil
+	}
+	var secret corev1.Secret
+	if err := r.Get(ctx, *r.ExternalCASecret, &secret); err != nil {
+		return nil, fmt.Errorf("could not get external CA secret %s: %w", r.ExternalCASecret, err)
+	}
+	ca, err := certutil.ParseKeyPair(secret.Data[certName], secret.Data[keyName])
 	if err != nil {
-		return false
+		return nil, fmt.Errorf("could not parse external CA secret %s: %w", r.ExternalCASecret, err)
 	}
-	return valid
+	if !ca.Cert.IsCA {
+		return nil, fmt.Errorf("external CA secret %s does not contain a CA certificate", r.ExternalCASecret)
+	}
+	return ca, nil
 }
 
 func (r *Reconciler) refreshCertIfNeeded(secret *corev1.Secret) (bool, error) {
-	if secret.Data == nil || !r.validCACert(secret.Data[caCertName], secret.Data[caKeyName]) {
-		if err := r.refreshCerts(true, secret); err != nil {
+	rootCA, err := r.externalCA(context.Background())
+	if err != nil {
+		return false, err
+	}
+	var validCA bool
+	if rootCA != nil {
+		validCA = r.validIntermediateCACert(rootCA, secret.Data[caCertName], secret.Data[caKeyName])
+	} else {
+		validCA = r.validCACert(secret.Data[caCertName], secret.Data[caKeyName])
+	}
+	if !validCA {
+		if err := r.refreshCerts(rootCA, true, secret); err != nil {
 			return false, err
 		}
-		return true, nil
-	}
-	if !r.validServerCert(secret.Data[caCertName], secret.Data[certName], secret.Data[keyName]) {
-		if err := r.refreshCerts(false, secret); err != nil {
+	} else if !r.validServerCert(secret.Data[caCertName], secret.Data[certName], secret.Data[keyName]) {
+		if err := r.refreshCerts(rootCA, false, secret); err != nil {
 			return false, err
 		}
-		return true, nil
+	}
+	if err := observeCertExpiry(secret); err != nil {
+		r.Log.Error(err, "failed to observe certificate expiry")
 	}
 	return true, nil
 }
 
-func (r *Reconciler) refreshCerts(refreshCA bool, secret *corev1.Secret) error {
-	var caArtifacts *KeyPairArtifacts
+// refreshCerts creates a new server certificate, and a new CA if refreshCA is set.
+// The CA is self-signed, or an intermediate CA issued by rootCA if it is not nil.
+func (r *Reconciler) refreshCerts(rootCA *certutil.KeyPairArtifacts, refreshCA bool, secret *corev1.Secret) error {
+	r.loadLastSerial(secret)
+	var caArtifacts *certutil.KeyPairArtifacts
 	now := time.Now()
 	begin := now.Add(-1 * time.Hour)
 	end := now.Add(certValidityDuration)
-	if refreshCA {
+	if refreshCA && rootCA != nil {
+		if rootCA.Cert.NotAfter.Before(end) {
+			end = rootCA.Cert.NotAfter
+		}
+		var err error
+		caArtifacts, err = r.CreateCAChain(rootCA, begin, end)
+		if err != nil {
+			return err
+		}
+		caArtifacts.CertPEM = append(caArtifacts.CertPEM, rootCA.CertPEM...)
+	} else if refreshCA {
 		var err error
 		caArtifacts, err = r.CreateCACert(begin, end)
 		if err != nil {
@@ -378,7 +666,7 @@ func (r *Reconciler) refreshCerts(refreshCA bool, secret *corev1.Secret) error {
 	return r.writeSecret(cert, key, caArtifacts, secret)
 }
 
-func buildArtifactsFromSecret(secret *corev1.Secret) (*KeyPairArtifacts, error) {
+func buildArtifactsFromSecret(secret *corev1.Secret) (*certutil.KeyPairArtifacts, error) {
 	caPem, ok := secret.Data[caCertName]
 	if !ok {
 		return nil, fmt.Errorf("cert secret is not well-formed, missing %s", caCertName)
@@ -387,33 +675,51 @@ func buildArtifactsFromSecret(secret *corev1.Secret) (*KeyPairArtifacts, error)
 	if !ok {
 		return nil, fmt.Errorf("cert secret is not well-formed, missing %s", caKeyName)
 	}
-	caDer, _ := pem.Decode(caPem)
-	if caDer == nil {
-		return nil, errors.New("bad CA cert")
-	}
-	caCert, err := x509.ParseCertificate(caDer.Bytes)
+	return certutil.ParseKeyPair(caPem, keyPem)
+}
+
+// serialNumberLimit is the upper bound of the random part of the serial numbers.
+var serialNumberLimit = new(big.Int).Lsh(big.NewInt(1), 128)
+
+// nextSerialNumber returns a random 128-bit serial number, which is added to the last
+// issued serial number so that serial numbers are never reused and keep increasing.
+func (r *Reconciler) nextSerialNumber() (*big.Int, error) {
+	serial, err := rand.Int(rand.Reader, serialNumberLimit)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("could not generate serial number: %w", err)
 	}
-	keyDer, _ := pem.Decode(keyPem)
-	if keyDer == nil {
-		return nil, err
+	r.serialMu.Lock()
+	defer r.serialMu.Unlock()
+	if r.lastSerial != nil {
+		serial.Add(serial, r.lastSerial)
 	}
-	key, err := x509.ParsePKCS1PrivateKey(keyDer.Bytes)
-	if err != nil {
-		return nil, err
+	// serial numbers must be positive
+	serial.Add(serial, big.NewInt(1))
+	r.lastSerial = serial
+	return new(big.Int).Set(serial), nil
+}
+
+// loadLastSerial continues the serial numbers from the LastSerialAnnotation of the secret,
+// which may have been written by a previous instance of the Reconciler.
+func (r *Reconciler) loadLastSerial(secret *corev1.Secret) {
+	last, ok := new(big.Int).SetString(secret.Annotations[LastSerialAnnotation], 10)
+	if !ok {
+		return
+	}
+	r.serialMu.Lock()
+	defer r.serialMu.Unlock()
+	if r.lastSerial == nil || last.Cmp(r.lastSerial) > 0 {
+		r.lastSerial = last
 	}
-	return &KeyPairArtifacts{
-		Cert:    caCert,
-		CertPEM: caPem,
-		KeyPEM:  keyPem,
-		Key:     key,
-	}, nil
 }
 
-func (r *Reconciler) CreateCACert(begin, end time.Time) (*KeyPairArtifacts, error) {
+func (r *Reconciler) CreateCACert(begin, end time.Time) (*certutil.KeyPairArtifacts, error) {
+	serial, err := r.nextSerialNumber()
+	if err != nil {
+		return nil, err
+	}
 	templ := &x509.Certificate{
-		SerialNumber: big.NewInt(0),
+		SerialNumber: serial,
 		Subject: pkix.Name{
 			CommonName:   r.CAName,
 			Organization: []string{r.CAOrganization},
@@ -423,19 +729,19 @@ func (r *Reconciler) CreateCACert(begin, end time.Time) (*KeyPairArtifacts, erro
 		},
 		NotBefore:             begin,
 		NotAfter:              end,
-		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment | x509.KeyUsageCertSign,
 		BasicConstraintsValid: true,
 		IsCA:                  true,
 	}
-	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	key, err := r.generateKey()
 	if err != nil {
 		return nil, err
 	}
+	templ.KeyUsage = keyUsage(key) | x509.KeyUsageCertSign
 	der, err := x509.CreateCertificate(rand.Reader, templ, templ, key.Public(), key)
 	if err != nil {
 		return nil, err
 	}
-	certPEM, keyPEM, err := pemEncode(der, key)
+	certPEM, keyPEM, err := r.pemEncode(der, key)
 	if err != nil {
 		return nil, err
 	}
@@ -444,12 +750,16 @@ func (r *Reconciler) CreateCACert(begin, end time.Time) (*KeyPairArtifacts, erro
 		return nil, err
 	}
 
-	return &KeyPairArtifacts{Cert: cert, Key: key, CertPEM: certPEM, KeyPEM: keyPEM}, nil
+	return &certutil.KeyPairArtifacts{Cert: cert, Key: key, CertPEM: certPEM, KeyPEM: keyPEM}, nil
 }
 
-func (r *Reconciler) CreateCAChain(ca *KeyPairArtifacts, begin, end time.Time) (*KeyPairArtifacts, error) {
+func (r *Reconciler) CreateCAChain(ca *certutil.KeyPairArtifacts, begin, end time.Time) (*certutil.KeyPairArtifacts, error) {
+	serial, err := r.nextSerialNumber()
+	if err != nil {
+		return nil, err
+	}
 	templ := &x509.Certificate{
-		SerialNumber: big.NewInt(2),
+		SerialNumber: serial,
 		Subject: pkix.Name{
 			CommonName:   r.CAChainName,
 			Organization: []string{r.CAOrganization},
@@ -459,19 +769,19 @@ func (r *Reconciler) CreateCAChain(ca *KeyPairArtifacts, begin, end time.Time) (
 		},
 		NotBefore:             begin,
 		NotAfter:              end,
-		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment | x509.KeyUsageCertSign,
 		BasicConstraintsValid: true,
 		IsCA:                  true,
 	}
-	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	key, err := r.generateKey()
 	if err != nil {
 		return nil, err
 	}
+	templ.KeyUsage = keyUsage(key) | x509.KeyUsageCertSign
 	der, err := x509.CreateCertificate(rand.Reader, templ, ca.Cert, key.Public(), ca.Key)
 	if err != nil {
 		return nil, err
 	}
-	certPEM, keyPEM, err := pemEncode(der, key)
+	certPEM, keyPEM, err := r.pemEncode(der, key)
 	if err != nil {
 		return nil, err
 	}
@@ -480,53 +790,149 @@ func (r *Reconciler) CreateCAChain(ca *KeyPairArtifacts, begin, end time.Time) (
 		return nil, err
 	}
 
-	return &KeyPairArtifacts{Cert: cert, Key: key, CertPEM: certPEM, KeyPEM: keyPEM}, nil
+	return &certutil.KeyPairArtifacts{Cert: cert, Key: key, CertPEM: certPEM, KeyPEM: keyPEM}, nil
 }
 
-func (r *Reconciler) CreateCertPEM(ca *KeyPairArtifacts, begin, end time.Time) ([]byte, []byte, error) {
+func (r *Reconciler) CreateCertPEM(ca *certutil.KeyPairArtifacts, begin, end time.Time) ([]byte, []byte, error) {
+	serial, err := r.nextSerialNumber()
+	if err != nil {
+		return nil, nil, err
+	}
 	templ := &x509.Certificate{
-		SerialNumber: big.NewInt(1),
+		SerialNumber: serial,
 		Subject: pkix.Name{
 			CommonName: r.dnsName,
 		},
 		DNSNames: []string{
 			r.dnsName,
 		},
+		IPAddresses:           r.ExtraIPSANs,
 		NotBefore:             begin,
 		NotAfter:              end,
-		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
 		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
 		BasicConstraintsValid: true,
 	}
-	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	key, err := r.generateKey()
 	if err != nil {
 		return nil, nil, err
 	}
+	templ.KeyUsage = keyUsage(key)
 	der, err := x509.CreateCertificate(rand.Reader, templ, ca.Cert, key.Public(), ca.Key)
 	if err != nil {
 		return nil, nil, err
 	}
-	certPEM, keyPEM, err := pemEncode(der, key)
+	certPEM, keyPEM, err := r.pemEncode(der, key)
 	if err != nil {
 		return nil, nil, err
 	}
 	return certPEM, keyPEM, nil
 }
 
-func pemEncode(certificateDER []byte, key *rsa.PrivateKey) ([]byte, []byte, error) {
+func (r *Reconciler) keyAlgorithm() KeyAlgorithm {
+	if r.KeyAlgorithm == "" {
+		return KeyAlgorithmRSA2048
+	}
+	return r.KeyAlgorithm
+}
+
+func (r *Reconciler) keyEncoding() KeyEncoding {
+	if r.KeyEncoding == "" {
+		return KeyEncodingPKCS1
+	}
+	return r.KeyEncoding
+}
+
+func (r *Reconciler) generateKey() (crypto.Signer, error) {
+	switch alg := r.keyAlgorithm(); alg {
+	case KeyAlgorithmRSA2048:
+		return rsa.GenerateKey(rand.Reader, 2048)
+	case KeyAlgorithmRSA4096:
+		return rsa.GenerateKey(rand.Reader, 4096)
+	case KeyAlgorithmECDSAP256:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case KeyAlgorithmECDSAP384:
+		return ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	default:
+		return nil, fmt.Errorf("unsupported key algorithm %q", alg)
+	}
+}
+
+// matchesKeyAlgorithm returns true if the PEM encoded key is of the configured algorithm and encoding.
+func (r *Reconciler) matchesKeyAlgorithm(keyPEM []byte) bool {
+	key, err := certutil.ParsePrivateKey(keyPEM)
+	if err != nil {
+		return false
+	}
+	if block, _ := pem.Decode(keyPEM); (block.Type == pkcs8BlockType) != (r.keyEncoding() == KeyEncodingPKCS8) {
+		return false
+	}
+	alg := r.keyAlgorithm()
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return (alg == KeyAlgorithmRSA2048 && k.N.BitLen() == 2048) || (alg == KeyAlgorithmRSA4096 && k.N.BitLen() == 4096)
+	case *ecdsa.PrivateKey:
+		return (alg == KeyAlgorithmECDSAP256 && k.Curve == elliptic.P256()) || (alg == KeyAlgorithmECDSAP384 && k.Curve == elliptic.P384())
+	}
+	return false
+}
+
+// keyUsage returns the key usages of a certificate for the key,
+// only RSA keys can be used for key encipherment.
+func keyUsage(key crypto.Signer) x509.KeyUsage {
+	if _, ok := key.(*rsa.PrivateKey); ok {
+		return x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment
+	}
+	return x509.KeyUsageDigitalSignature
+}
+
+func (r *Reconciler) pemEncode(certificateDER []byte, key crypto.Signer) ([]byte, []byte, error) {
 	certBuf := &bytes.Buffer{}
 	if err := pem.Encode(certBuf, &pem.Block{Type: "CERTIFICATE", Bytes: certificateDER}); err != nil {
 		return nil, nil, err
 	}
-	keyBuf := &bytes.Buffer{}
-	if err := pem.Encode(keyBuf, &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}); err != nil {
+	keyPEM, err := pemEncodeKey(key, r.keyEncoding())
+	if err != nil {
 		return nil, nil, err
 	}
-	return certBuf.Bytes(), keyBuf.Bytes(), nil
+	return certBuf.Bytes(), keyPEM, nil
+}
+
+// pemEncodeKey encodes keys in PKCS#8 format with the PKCS8 encoding,
+// and RSA keys in PKCS#1 and ECDSA keys in SEC 1 format otherwise.
+func pemEncodeKey(key crypto.Signer, encoding KeyEncoding) ([]byte, error) {
+	if encoding == KeyEncodingPKCS8 {
+		der, err := x509.MarshalPKCS8PrivateKey(key)
+		if err != nil {
+			return nil, err
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: pkcs8BlockType, Bytes: der}), nil
+	}
+	var block *pem.Block
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		block = &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(k)}
+	case *ecdsa.PrivateKey:
+		der, err := x509.MarshalECPrivateKey(k)
+		if err != nil {
+			return nil, err
+		}
+		block = &pem.Block{Type: "EC PRIVATE KEY", Bytes: der}
+	default:
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	return pem.EncodeToMemory(block), nil
 }
 
-func (r *Reconciler) writeSecret(cert, key []byte, caArtifacts *KeyPairArtifacts, secret *corev1.Secret) error {
+func (r *Reconciler) writeSecret(cert, key []byte, caArtifacts *certutil.KeyPairArtifacts, secret *corev1.Secret) error {
 	populateSecret(cert, key, caArtifacts, secret)
+	r.serialMu.Lock()
+	if r.lastSerial != nil {
+		if secret.Annotations == nil {
+			secret.Annotations = make(map[string]string)
+		}
+		secret.Annotations[LastSerialAnnotation] = r.lastSerial.String()
+	}
+	r.serialMu.Unlock()
 	return r.Update(context.Background(), secret)
 }
 
@@ -550,7 +956,7 @@ func CheckCerts(c CertInfo, dnsName string, at time.Time) error {
 	if err != nil {
 		return err
 	}
-	ok, err := ValidCert(ca, cert, key, dnsName, at)
+	ok, err := certutil.ValidCert(ca, cert, key, dnsName, at)
 	if err != nil {
 		return err
 	}
@@ -17,18 +17,39 @@ package crds
 import (
 	"bytes"
 	"context"
+	"crypto"
+	"crypto/ecdsa"
 	"crypto/rsa"
 	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
 	client "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"github.com/external-secrets/external-secrets/pkg/controllers/crds/certutil"
 )
 
 const (
@@ -113,6 +134,147 @@ func TestUpdateCRD(t *testing.T) {
 	}
 }
 
+func TestUpdateCRDRestartsDependentDeployments(t *testing.T) {
+	rec := newReconciler()
+	svc := newService()
+	secret := newSecret()
+	crd := newCRD()
+	deployment := appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "webhook",
+			Namespace: "default",
+		},
+	}
+	failPatch := true
+	c := client.NewClientBuilder().WithObjects(&svc, &secret, &crd, &deployment).WithInterceptorFuncs(interceptor.Funcs{
+		Patch: func(ctx context.Context, c crclient.WithWatch, obj crclient.Object, patch crclient.Patch, opts ...crclient.PatchOption) error {
+			if failPatch {
+				return errors.New("patch failed")
+			}
+			return c.Patch(ctx, obj, patch, opts...)
+		},
+	}).Build()
+	rec.Client = c
+	rec.DependentDeployments = []types.NamespacedName{
+		{Name: "webhook", Namespace: "default"},
+		// missing deployments must not fail the reconciliation
+		{Name: "missing", Namespace: "default"},
+	}
+	ctx := context.Background()
+	req := ctrl.Request{
+		NamespacedName: types.NamespacedName{
+			Name: "one",
+		},
+	}
+	deploymentName := types.NamespacedName{Name: "webhook", Namespace: "default"}
+	secretName := types.NamespacedName{Name: secret.Name, Namespace: secret.Namespace}
+	restartedAt := func() (string, bool) {
+		t.Helper()
+		var d appsv1.Deployment
+		if err := c.Get(ctx, deploymentName, &d); err != nil {
+			t.Fatalf("Failed getting deployment: %v", err)
+		}
+		v, ok := d.Spec.Template.Annotations[RestartedAtAnnotation]
+		return v, ok
+	}
+
+	// the secret is empty: certs are created, but nothing can use them yet
+	if err := rec.updateCRD(ctx, req); err != nil {
+		t.Fatalf("Failed updating CRD: %v", err)
+	}
+	if _, ok := restartedAt(); ok {
+		t.Fatalf("expected deployment not to be restarted when the certs are created")
+	}
+
+	// the certs are still valid: the deployment is left alone
+	if err := rec.updateCRD(ctx, req); err != nil {
+		t.Fatalf("Failed updating CRD: %v", err)
+	}
+	if _, ok := restartedAt(); ok {
+		t.Fatalf("expected deployment not to be restarted without rotation")
+	}
+
+	// the CA is invalid and rotated: the failed restart is returned and retried
+	if err := c.Get(ctx, secretName, &secret); err != nil {
+		t.Fatalf("Failed getting secret: %v", err)
+	}
+	secret.Data[caCertName] = []byte("invalid")
+	if err := c.Update(ctx, &secret); err != nil {
+		t.Fatalf("Failed updating secret: %v", err)
+	}
+	if err := rec.updateCRD(ctx, req); err == nil {
+		t.Fatalf("expected the failed restart to be returned")
+	}
+	failPatch = false
+	if err := rec.updateCRD(ctx, req); err != nil {
+		t.Fatalf("Failed updating CRD: %v", err)
+	}
+	if _, ok := restartedAt(); !ok {
+		t.Fatalf("expected %s annotation to be set after rotation", RestartedAtAnnotation)
+	}
+}
+
+func TestUpdateCRDPublishesCA(t *testing.T) {
+	rec := newReconciler()
+	rec.CAConfigMapName = "webhook-ca"
+	svc := newService()
+	secret := newSecret()
+	crd := newCRD()
+	c := client.NewClientBuilder().WithObjects(&svc, &secret, &crd).Build()
+	rec.Client = c
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "one"}}
+	cmName := types.NamespacedName{Name: "webhook-ca", Namespace: "default"}
+	secretName := types.NamespacedName{Name: secret.Name, Namespace: secret.Namespace}
+	assertPublished := func() {
+		t.Helper()
+		if err := c.Get(ctx, secretName, &secret); err != nil {
+			t.Fatalf("Failed getting secret: %v", err)
+		}
+		var cm corev1.ConfigMap
+		if err := c.Get(ctx, cmName, &cm); err != nil {
+			t.Fatalf("Failed getting CA ConfigMap: %v", err)
+		}
+		if cm.Data[caCertName] == "" || cm.Data[caCertName] != string(secret.Data[caCertName]) {
+			t.Errorf("expected the CA ConfigMap to contain the CA of the secret")
+		}
+	}
+
+	if err := rec.updateCRD(ctx, req); err != nil {
+		t.Fatalf("Failed updating CRD: %v", err)
+	}
+	assertPublished()
+
+	// a rotated CA is published
+	secret.Data[caCertName] = []byte("invalid")
+	if err := c.Update(ctx, &secret); err != nil {
+		t.Fatalf("Failed updating secret: %v", err)
+	}
+	if err := rec.updateCRD(ctx, req); err != nil {
+		t.Fatalf("Failed updating CRD: %v", err)
+	}
+	assertPublished()
+
+	// a deleted ConfigMap is restored
+	if err := c.Delete(ctx, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: cmName.Name, Namespace: cmName.Namespace}}); err != nil {
+		t.Fatalf("Failed deleting CA ConfigMap: %v", err)
+	}
+	if err := rec.updateCRD(ctx, req); err != nil {
+		t.Fatalf("Failed updating CRD: %v", err)
+	}
+	assertPublished()
+
+	if !rec.isCAConfigMap(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "webhook-ca", Namespace: "default"}}) {
+		t.Error("expected the CA ConfigMap to be watched")
+	}
+	if rec.isCAConfigMap(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "webhook-ca", Namespace: "other"}}) {
+		t.Error("expected ConfigMaps in other namespaces not to be watched")
+	}
+	if got := rec.findCRDsForConfigMap(ctx, nil); len(got) != len(rec.CrdResources) {
+		t.Errorf("expected all CRDs to be reconciled, got %v", got)
+	}
+}
+
 func TestInjectSvcToConversionWebhook(t *testing.T) {
 	svc := newService()
 	crd := newCRD()
@@ -147,7 +309,7 @@ func TestInjectCertToConversionWebhook(t *testing.T) {
 }
 func TestPopulateSecret(t *testing.T) {
 	secret := newSecret()
-	caArtifacts := KeyPairArtifacts{
+	caArtifacts := certutil.KeyPairArtifacts{
 		Cert:    &x509.Certificate{},
 		Key:     &rsa.PrivateKey{},
 		CertPEM: []byte("foobarca"),
@@ -195,6 +357,60 @@ func TestCreateCertPEM(t *testing.T) {
 		t.Errorf(invalidCerts, certPEM, keyPEM)
 	}
 }
+func TestSerialNumbers(t *testing.T) {
+	rec := newReconciler()
+	serials := map[string]bool{}
+	var last *big.Int
+	checkSerial := func(serial *big.Int) {
+		t.Helper()
+		if serials[serial.String()] {
+			t.Fatalf("serial number %s was issued twice", serial)
+		}
+		serials[serial.String()] = true
+		if serial.Sign() <= 0 || (last != nil && serial.Cmp(last) <= 0) {
+			t.Fatalf("serial number %s is not larger than %v", serial, last)
+		}
+		last = serial
+	}
+	for i := 0; i < 2; i++ {
+		caArtifacts, err := rec.CreateCACert(time.Now(), time.Now().AddDate(1, 0, 0))
+		if err != nil {
+			t.Fatalf(failedCreateCaCerts, err)
+		}
+		checkSerial(caArtifacts.Cert.SerialNumber)
+		certPEM, _, err := rec.CreateCertPEM(caArtifacts, time.Now(), time.Now().AddDate(1, 0, 0))
+		if err != nil {
+			t.Fatalf(failedCreateServerCerts, err)
+		}
+		cert, err := parseCert(certPEM)
+		if err != nil {
+			t.Fatalf("could not parse certificate: %v", err)
+		}
+		checkSerial(cert.SerialNumber)
+	}
+
+	// the last serial number is persisted in the secret and continued after a restart
+	secret := newSecret()
+	rec.Client = client.NewClientBuilder().WithObjects(&secret).Build()
+	if err := rec.refreshCerts(nil, true, &secret); err != nil {
+		t.Fatalf("could not refresh certificates: %v", err)
+	}
+	if secret.Annotations[LastSerialAnnotation] != rec.lastSerial.String() {
+		t.Fatalf("expected the last serial number %s in the secret, got %q", rec.lastSerial, secret.Annotations[LastSerialAnnotation])
+	}
+	last = rec.lastSerial
+	restarted := newReconciler()
+	restarted.Client = rec.Client
+	if err := restarted.refreshCerts(nil, true, &secret); err != nil {
+		t.Fatalf("could not refresh certificates: %v", err)
+	}
+	artifacts, err := buildArtifactsFromSecret(&secret)
+	if err != nil {
+		t.Fatalf("could not parse CA: %v", err)
+	}
+	checkSerial(artifacts.Cert.SerialNumber)
+}
+
 func TestValidCert(t *testing.T) {
 	rec := newReconciler()
 	rec.dnsName = dnsName
@@ -206,7 +422,7 @@ func TestValidCert(t *testing.T) {
 	if err != nil {
 		t.Errorf(failedCreateServerCerts, err)
 	}
-	ok, err := ValidCert(caArtifacts.CertPEM, certPEM, keyPEM, dnsName, time.Now())
+	ok, err := certutil.ValidCert(caArtifacts.CertPEM, certPEM, keyPEM, dnsName, time.Now())
 	if err != nil {
 		t.Errorf("error validating cert: %v", err)
 	}
@@ -332,3 +548,515 @@ func TestCheckCertChain(t *testing.T) {
 		t.Error("expected failure due to wrong certificate name, got success")
 	}
 }
+
+func TestExternalCertMode(t *testing.T) {
+	rec := newReconciler()
+	rec.dnsName = "foo.default.svc"
+	rec.ExternalCertMode = true
+	rec.CertDir = t.TempDir()
+	rec.certDirEvents = make(chan event.GenericEvent, 10)
+	recorder := record.NewFakeRecorder(10)
+	rec.recorder = recorder
+	crd := newCRD()
+	c := client.NewClientBuilder().WithObjects(&crd).Build()
+	rec.Client = c
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "one"}}
+
+	if err := rec.updateCRD(ctx, req); err == nil {
+		t.Fatal("expected injection to fail before the certificates have been loaded")
+	}
+	done := make(chan error)
+	go func() {
+		done <- rec.watchCertDir(ctx, 100*time.Millisecond)
+	}()
+	select {
+	case e := <-recorder.Events:
+		if !strings.Contains(e, reasonExternalCertTimeout) {
+			t.Errorf("unexpected event %q", e)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a warning event after the timeout")
+	}
+
+	caArtifacts, err := rec.CreateCACert(time.Now().AddDate(0, 0, -1), time.Now().AddDate(0, 0, 2))
+	if err != nil {
+		t.Fatalf(failedCreateCaCerts, err)
+	}
+	certPEM, keyPEM, err := rec.CreateCertPEM(caArtifacts, time.Now(), time.Now().AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf(failedCreateServerCerts, err)
+	}
+	os.WriteFile(filepath.Join(rec.CertDir, caCertName), caArtifacts.CertPEM, 0644)
+	os.WriteFile(filepath.Join(rec.CertDir, keyName), keyPEM, 0644)
+	os.WriteFile(filepath.Join(rec.CertDir, certName), certPEM, 0644)
+	for range rec.CrdResources {
+		select {
+		case <-rec.certDirEvents:
+		case <-time.After(5 * time.Second):
+			t.Fatal("expected the CRDs to be reconciled after the certificates appeared")
+		}
+	}
+
+	if err := rec.updateCRD(ctx, req); err != nil {
+		t.Fatalf("Failed updating CRD: %v", err)
+	}
+	if err := c.Get(ctx, req.NamespacedName, &crd); err != nil {
+		t.Fatalf("Failed getting CRD: %v", err)
+	}
+	if !bytes.Equal(crd.Spec.Conversion.Webhook.ClientConfig.CABundle, caArtifacts.CertPEM) {
+		t.Error("expected the CA bundle of the cert dir to be injected")
+	}
+	if crd.Spec.Conversion.Webhook.ClientConfig.Service.Name != "foo" {
+		t.Error("expected the service to be injected")
+	}
+	var secret corev1.Secret
+	if err := c.Get(ctx, types.NamespacedName{Name: "foo", Namespace: "default"}, &secret); !apierrors.IsNotFound(err) {
+		t.Errorf("expected the secret not to be created, got %v", err)
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("unexpected error watching cert dir: %v", err)
+	}
+}
+
+func TestCertBundleValidator(t *testing.T) {
+	rec := newReconciler()
+	rec.dnsName = dnsName
+	caArtifacts, err := rec.CreateCACert(time.Now().AddDate(0, 0, -1), time.Now().AddDate(0, 0, 2))
+	if err != nil {
+		t.Fatalf(failedCreateCaCerts, err)
+	}
+	certPEM, keyPEM, err := rec.CreateCertPEM(caArtifacts, time.Now(), time.Now().AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf(failedCreateServerCerts, err)
+	}
+	dir := t.TempDir()
+	cert := CertInfo{
+		CertDir:  dir,
+		CertName: "tls.crt",
+		CAName:   "ca.crt",
+		KeyName:  "tls.key",
+	}
+	os.WriteFile(filepath.Join(dir, cert.CAName), caArtifacts.CertPEM, 0644)
+	os.WriteFile(filepath.Join(dir, cert.CertName), certPEM, 0644)
+	os.WriteFile(filepath.Join(dir, cert.KeyName), keyPEM, 0644)
+	v := &CertBundleValidator{Certs: cert, DNSName: rec.dnsName}
+
+	tests := []struct {
+		name      string
+		target    string
+		lookahead time.Duration
+		status    int
+	}{
+		{name: "valid", target: CertBundlePath, status: http.StatusOK},
+		{name: "valid dnsName", target: CertBundlePath + "?dnsName=" + dnsName, status: http.StatusOK},
+		{name: "wrong dnsName", target: CertBundlePath + "?dnsName=wrong", status: http.StatusServiceUnavailable},
+		{name: "expired", target: CertBundlePath, lookahead: 48 * time.Hour, status: http.StatusServiceUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v.Lookahead = tt.lookahead
+			w := httptest.NewRecorder()
+			v.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.target, http.NoBody))
+			if w.Code != tt.status {
+				t.Fatalf("expected status %d, got %d: %s", tt.status, w.Code, w.Body.String())
+			}
+			if tt.status == http.StatusOK {
+				return
+			}
+			var body map[string]string
+			if err := json.Unmarshal(w.Body.Bytes(), &body); err != nil {
+				t.Fatalf("unexpected body %q: %v", w.Body.String(), err)
+			}
+			if body["error"] == "" {
+				t.Errorf("expected error in body, got %q", w.Body.String())
+			}
+		})
+	}
+}
+
+func TestKeyAlgorithms(t *testing.T) {
+	for _, alg := range KeyAlgorithms {
+		t.Run(string(alg), func(t *testing.T) {
+			rec := newReconciler()
+			rec.dnsName = dnsName
+			rec.KeyAlgorithm = alg
+			caArtifacts, err := rec.CreateCACert(time.Now(), time.Now().AddDate(1, 0, 0))
+			if err != nil {
+				t.Fatalf(failedCreateCaCerts, err)
+			}
+			if !rec.validCACert(caArtifacts.CertPEM, caArtifacts.KeyPEM) {
+				t.Errorf(invalidCerts, caArtifacts.CertPEM, caArtifacts.KeyPEM)
+			}
+			certPEM, keyPEM, err := rec.CreateCertPEM(caArtifacts, time.Now(), time.Now().AddDate(1, 0, 0))
+			if err != nil {
+				t.Fatalf(failedCreateServerCerts, err)
+			}
+			ok, err := certutil.ValidCert(caArtifacts.CertPEM, certPEM, keyPEM, dnsName, time.Now())
+			if err != nil || !ok {
+				t.Errorf("certificate is invalid: %v", err)
+			}
+
+			secret := newSecret()
+			populateSecret(certPEM, keyPEM, caArtifacts, &secret)
+			artifacts, err := buildArtifactsFromSecret(&secret)
+			if err != nil {
+				t.Fatalf("could not parse the CA from the secret: %v", err)
+			}
+			if !artifacts.Key.Public().(interface{ Equal(crypto.PublicKey) bool }).Equal(caArtifacts.Key.Public()) {
+				t.Errorf("the parsed CA key does not match the generated one")
+			}
+		})
+	}
+}
+
+func TestKeyEncodings(t *testing.T) {
+	tests := []struct {
+		alg       KeyAlgorithm
+		enc       KeyEncoding
+		blockType string
+	}{
+		{alg: KeyAlgorithmRSA2048, enc: "", blockType: "RSA PRIVATE KEY"},
+		{alg: KeyAlgorithmRSA2048, enc: KeyEncodingPKCS1, blockType: "RSA PRIVATE KEY"},
+		{alg: KeyAlgorithmRSA2048, enc: KeyEncodingPKCS8, blockType: "PRIVATE KEY"},
+		{alg: KeyAlgorithmECDSAP256, enc: KeyEncodingPKCS1, blockType: "EC PRIVATE KEY"},
+		{alg: KeyAlgorithmECDSAP256, enc: KeyEncodingPKCS8, blockType: "PRIVATE KEY"},
+	}
+	for _, tc := range tests {
+		t.Run(string(tc.alg)+"/"+string(tc.enc), func(t *testing.T) {
+			rec := newReconciler()
+			secret := newSecret()
+			rec.Client = client.NewClientBuilder().WithObjects(&secret).Build()
+			rec.dnsName = dnsName
+			rec.KeyAlgorithm = tc.alg
+			rec.KeyEncoding = tc.enc
+			if _, err := rec.refreshCertIfNeeded(&secret); err != nil {
+				t.Fatalf("could not create certs: %v", err)
+			}
+			for _, name := range []string{caKeyName, keyName} {
+				if block, _ := pem.Decode(secret.Data[name]); block == nil || block.Type != tc.blockType {
+					t.Errorf("expected %s to be a %q block", name, tc.blockType)
+				}
+			}
+			ok, err := certutil.ValidCert(secret.Data[caCertName], secret.Data[certName], secret.Data[keyName], dnsName, time.Now())
+			if err != nil || !ok {
+				t.Errorf("certificate is invalid: %v", err)
+			}
+			artifacts, err := buildArtifactsFromSecret(&secret)
+			if err != nil {
+				t.Fatalf("could not parse the CA from the secret: %v", err)
+			}
+			if !rec.validCACert(artifacts.CertPEM, artifacts.KeyPEM) {
+				t.Error("expected the CA to be valid")
+			}
+		})
+	}
+}
+
+func TestRefreshCertOnKeyEncodingChange(t *testing.T) {
+	rec := newReconciler()
+	secret := newSecret()
+	rec.Client = client.NewClientBuilder().WithObjects(&secret).Build()
+	rec.dnsName = dnsName
+	if _, err := rec.refreshCertIfNeeded(&secret); err != nil {
+		t.Fatalf("could not create certs: %v", err)
+	}
+
+	rec.KeyEncoding = KeyEncodingPKCS8
+	if _, err := rec.refreshCertIfNeeded(&secret); err != nil {
+		t.Fatalf("could not refresh certs: %v", err)
+	}
+	for _, name := range []string{caKeyName, keyName} {
+		key, err := certutil.ParsePrivateKey(secret.Data[name])
+		if err != nil {
+			t.Fatalf("could not parse %s: %v", name, err)
+		}
+		if _, ok := key.(*rsa.PrivateKey); !ok {
+			t.Errorf("expected %s to be an RSA key, got %T", name, key)
+		}
+		if block, _ := pem.Decode(secret.Data[name]); block.Type != "PRIVATE KEY" {
+			t.Errorf("expected %s to be encoded in PKCS#8, got %q", name, block.Type)
+		}
+	}
+}
+
+func TestParseKeyEncoding(t *testing.T) {
+	enc, err := ParseKeyEncoding("PKCS8")
+	if err != nil || enc != KeyEncodingPKCS8 {
+		t.Errorf("expected PKCS8, got %q: %v", enc, err)
+	}
+	if _, err := ParseKeyEncoding("DER"); err == nil {
+		t.Error("expected unsupported key encoding to fail")
+	}
+	rec := newReconciler()
+	rec.KeyEncoding = "DER"
+	if err := rec.SetupWithManager(nil, controller.Options{}); err == nil {
+		t.Error("expected SetupWithManager to reject the unsupported key encoding")
+	}
+}
+
+func TestRefreshCertOnKeyAlgorithmChange(t *testing.T) {
+	rec := newReconciler()
+	secret := newSecret()
+	rec.Client = client.NewClientBuilder().WithObjects(&secret).Build()
+	rec.dnsName = dnsName
+	if _, err := rec.refreshCertIfNeeded(&secret); err != nil {
+		t.Fatalf("could not create certs: %v", err)
+	}
+	if _, ok := secret.Data[caKeyName]; !ok {
+		t.Fatalf("expected the CA key to be stored")
+	}
+	caCert := secret.Data[caCertName]
+
+	rec.KeyAlgorithm = KeyAlgorithmECDSAP256
+	if _, err := rec.refreshCertIfNeeded(&secret); err != nil {
+		t.Fatalf("could not refresh certs: %v", err)
+	}
+	if bytes.Equal(caCert, secret.Data[caCertName]) {
+		t.Errorf("expected the CA to be rotated")
+	}
+	for _, name := range []string{caKeyName, keyName} {
+		key, err := certutil.ParsePrivateKey(secret.Data[name])
+		if err != nil {
+			t.Fatalf("could not parse %s: %v", name, err)
+		}
+		if _, ok := key.(*ecdsa.PrivateKey); !ok {
+			t.Errorf("expected %s to be an ECDSA key, got %T", name, key)
+		}
+	}
+}
+
+func TestParseKeyAlgorithm(t *testing.T) {
+	alg, err := ParseKeyAlgorithm("ECDSA-P384")
+	if err != nil || alg != KeyAlgorithmECDSAP384 {
+		t.Errorf("expected ECDSA-P384, got %q: %v", alg, err)
+	}
+	if _, err := ParseKeyAlgorithm("DSA-1024"); err == nil {
+		t.Error("expected unsupported key algorithm to fail")
+	}
+}
+
+func TestSetupWithManagerRejectsKeyAlgorithm(t *testing.T) {
+	rec := newReconciler()
+	rec.KeyAlgorithm = "RSA-1024"
+	// the key algorithm is validated before the manager is used
+	if err := rec.SetupWithManager(nil, controller.Options{}); err == nil {
+		t.Error("expected unsupported key algorithm to fail")
+	}
+}
+
+func TestExtraIPSANs(t *testing.T) {
+	rec := newReconciler()
+	secret := newSecret()
+	rec.Client = client.NewClientBuilder().WithObjects(&secret).Build()
+	rec.dnsName = dnsName
+	if _, err := rec.refreshCertIfNeeded(&secret); err != nil {
+		t.Fatalf("could not create certs: %v", err)
+	}
+	ip := net.ParseIP("10.96.0.10")
+	if ok, _ := certutil.ValidCertForIP(secret.Data[caCertName], secret.Data[certName], secret.Data[keyName], ip, time.Now()); ok {
+		t.Fatalf("expected the certificate not to be valid for %s", ip)
+	}
+
+	// the server certificate is renewed once the IP address is configured
+	rec.ExtraIPSANs = []net.IP{ip}
+	if _, err := rec.refreshCertIfNeeded(&secret); err != nil {
+		t.Fatalf("could not refresh certs: %v", err)
+	}
+	ok, err := certutil.ValidCertForIP(secret.Data[caCertName], secret.Data[certName], secret.Data[keyName], ip, time.Now())
+	if err != nil || !ok {
+		t.Errorf("expected the certificate to be valid for %s: %v", ip, err)
+	}
+	if !rec.validServerCert(secret.Data[caCertName], secret.Data[certName], secret.Data[keyName]) {
+		t.Error("expected the certificate to be valid for the DNS name")
+	}
+}
+
+func TestRotationJitter(t *testing.T) {
+	first, second := newReconciler(), newReconciler()
+	first.RotationJitterFraction = DefaultRotationJitterFraction
+	first.PodName = "external-secrets-cert-controller-7d9c5b6f4-abcde"
+	second.RotationJitterFraction = DefaultRotationJitterFraction
+	second.PodName = "external-secrets-cert-controller-7d9c5b6f4-fghij"
+
+	if first.lookaheadInterval() == second.lookaheadInterval() {
+		t.Errorf("expected different lookahead intervals for different pods, got %v", first.lookaheadInterval())
+	}
+	if first.lookaheadInterval() != first.lookaheadInterval() {
+		t.Error("expected the lookahead interval of a pod to be stable")
+	}
+	maxInterval := LookaheadInterval + time.Duration(DefaultRotationJitterFraction*float64(LookaheadInterval))
+	for _, rec := range []*Reconciler{&first, &second} {
+		if got := rec.lookaheadInterval(); got < LookaheadInterval || got > maxInterval {
+			t.Errorf("expected the lookahead interval to be between %v and %v, got %v", LookaheadInterval, maxInterval, got)
+		}
+	}
+
+	// a certificate within the jitter of one pod is only rotated by that pod
+	rotating, keeping := &first, &second
+	if rotating.lookaheadInterval() < keeping.lookaheadInterval() {
+		rotating, keeping = keeping, rotating
+	}
+	rotating.dnsName, keeping.dnsName = dnsName, dnsName
+	end := time.Now().Add((rotating.lookaheadInterval() + keeping.lookaheadInterval()) / 2)
+	caArtifacts, err := rotating.CreateCACert(time.Now(), end.AddDate(1, 0, 0))
+	if err != nil {
+		t.Fatalf(failedCreateCaCerts, err)
+	}
+	certPEM, keyPEM, err := rotating.CreateCertPEM(caArtifacts, time.Now(), end)
+	if err != nil {
+		t.Fatalf(failedCreateServerCerts, err)
+	}
+	if rotating.validServerCert(caArtifacts.CertPEM, certPEM, keyPEM) {
+		t.Error("expected the pod with the longer lookahead to rotate the certificate")
+	}
+	if !keeping.validServerCert(caArtifacts.CertPEM, certPEM, keyPEM) {
+		t.Error("expected the pod with the shorter lookahead to keep the certificate")
+	}
+
+	first.RotationJitterFraction = 0
+	if got := first.lookaheadInterval(); got != LookaheadInterval {
+		t.Errorf("expected no jitter, got %v", got)
+	}
+}
+
+func TestSetupWithManagerRejectsRotationJitterFraction(t *testing.T) {
+	rec := newReconciler()
+	rec.RotationJitterFraction = 0.6
+	if err := rec.SetupWithManager(nil, controller.Options{}); err == nil {
+		t.Error("expected SetupWithManager to reject the rotation jitter fraction")
+	}
+}
+
+func TestExternalCA(t *testing.T) {
+	rec := newReconciler()
+	rec.dnsName = dnsName
+	rec.CAChainName = "external-secrets-intermediate"
+	root, err := rec.CreateCACert(time.Now().Add(-time.Hour), time.Now().AddDate(20, 0, 0))
+	if err != nil {
+		t.Fatalf(failedCreateCaCerts, err)
+	}
+	rootSecret := corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "root-ca", Namespace: "pki"},
+		Data:       map[string][]byte{certName: root.CertPEM, keyName: root.KeyPEM},
+	}
+	secret := newSecret()
+	rec.Client = client.NewClientBuilder().WithObjects(&secret, &rootSecret).Build()
+	if _, err := rec.refreshCertIfNeeded(&secret); err != nil {
+		t.Fatalf("could not create certs: %v", err)
+	}
+	selfSignedCA := secret.Data[caCertName]
+
+	rec.ExternalCASecret = &types.NamespacedName{Name: "root-ca", Namespace: "pki"}
+	if _, err := rec.refreshCertIfNeeded(&secret); err != nil {
+		t.Fatalf("could not refresh certs: %v", err)
+	}
+	bundle := secret.Data[caCertName]
+	if bytes.Equal(bundle, selfSignedCA) {
+		t.Fatal("expected the self-signed CA to be replaced by the intermediate")
+	}
+	certs := decodeCertificates(t, bundle)
+	if len(certs) != 2 {
+		t.Fatalf("expected the CA bundle to contain the intermediate and the root, got %d certificates", len(certs))
+	}
+	if certs[0].Subject.CommonName != rec.CAChainName || !certs[0].IsCA {
+		t.Errorf("expected the intermediate CA first, got %q", certs[0].Subject.CommonName)
+	}
+	if !certs[1].Equal(root.Cert) {
+		t.Error("expected the root CA last")
+	}
+	if err := certs[0].CheckSignatureFrom(root.Cert); err != nil {
+		t.Errorf("expected the intermediate to be issued by the root: %v", err)
+	}
+	serverCerts := decodeCertificates(t, secret.Data[certName])
+	if len(serverCerts) != 1 {
+		t.Fatalf("expected a single server certificate, got %d", len(serverCerts))
+	}
+	if err := serverCerts[0].CheckSignatureFrom(certs[0]); err != nil {
+		t.Errorf("expected the server certificate to be issued by the intermediate: %v", err)
+	}
+	ok, err := certutil.ValidCert(bundle, secret.Data[certName], secret.Data[keyName], dnsName, time.Now())
+	if err != nil || !ok {
+		t.Errorf("expected the server certificate to be valid with the CA bundle: %v", err)
+	}
+
+	// valid certificates are kept
+	if _, err := rec.refreshCertIfNeeded(&secret); err != nil {
+		t.Fatalf("could not refresh certs: %v", err)
+	}
+	if !bytes.Equal(bundle, secret.Data[caCertName]) {
+		t.Error("expected the intermediate CA not to be rotated")
+	}
+
+	// a new root issues a new intermediate
+	newRoot, err := rec.CreateCACert(time.Now().Add(-time.Hour), time.Now().AddDate(20, 0, 0))
+	if err != nil {
+		t.Fatalf(failedCreateCaCerts, err)
+	}
+	rootSecret.Data = map[string][]byte{certName: newRoot.CertPEM, keyName: newRoot.KeyPEM}
+	if err := rec.Update(context.Background(), &rootSecret); err != nil {
+		t.Fatalf("could not update root CA: %v", err)
+	}
+	if _, err := rec.refreshCertIfNeeded(&secret); err != nil {
+		t.Fatalf("could not refresh certs: %v", err)
+	}
+	if !bytes.HasSuffix(secret.Data[caCertName], newRoot.CertPEM) {
+		t.Error("expected the CA bundle to end with the new root")
+	}
+
+	rec.ExternalCASecret = &types.NamespacedName{Name: "missing", Namespace: "pki"}
+	if _, err := rec.refreshCertIfNeeded(&secret); err == nil {
+		t.Error("expected a missing external CA secret to fail")
+	}
+}
+
+func decodeCertificates(t *testing.T, bundle []byte) []*x509.Certificate {
+	t.Helper()
+	var certs []*x509.Certificate
+	for block, rest := pem.Decode(bundle); block != nil; block, rest = pem.Decode(rest) {
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			t.Fatalf("could not parse certificate: %v", err)
+		}
+		certs = append(certs, cert)
+	}
+	return certs
+}
+
+func TestCertExpiryMetric(t *testing.T) {
+	rec := newReconciler()
+	secret := newSecret()
+	rec.Client = client.NewClientBuilder().WithObjects(&secret).Build()
+	rec.dnsName = dnsName
+	if _, err := rec.refreshCertIfNeeded(&secret); err != nil {
+		t.Fatalf("could not create certs: %v", err)
+	}
+	assertExpiry := func() {
+		t.Helper()
+		for certType, key := range map[string]string{certTypeCA: caCertName, certTypeServer: certName} {
+			cert, err := parseCert(secret.Data[key])
+			if err != nil {
+				t.Fatalf("could not parse %s certificate: %v", certType, err)
+			}
+			if got := testutil.ToFloat64(certExpiry.WithLabelValues(certType)); got != float64(cert.NotAfter.Unix()) {
+				t.Errorf("expected %s expiry %d, got %v", certType, cert.NotAfter.Unix(), got)
+			}
+		}
+	}
+	assertExpiry()
+
+	// the metric is set from the stored secret at startup
+	certExpiry.Reset()
+	if err := rec.ObserveCertExpiry(context.Background()); err != nil {
+		t.Fatalf("could not observe certificate expiry: %v", err)
+	}
+	assertExpiry()
+
+	rec.SecretName = "missing"
+	if err := rec.ObserveCertExpiry(context.Background()); err != nil {
+		t.Errorf("expected a missing secret to be ignored: %v", err)
+	}
+}
@@ -0,0 +1,153 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crds
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	corev1 "k8s.io/api/core/v1"
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+// ExternalCertTimeout is how long the Reconciler waits in ExternalCertMode for valid
+// certificates in CertDir before it records a warning event on the CRDs.
+const ExternalCertTimeout = 5 * time.Minute
+
+const reasonExternalCertTimeout = "ExternalCertTimeout"
+
+// injectExternalCA injects the CA bundle loaded from CertDir into the CRD,
+// the secret is neither read nor written.
+func (r *Reconciler) injectExternalCA(ctx context.Context, req ctrl.Request) error {
+	caPEM := r.externalCABundle.Load()
+	if caPEM == nil {
+		return fmt.Errorf("no valid certificates have been loaded from %s yet", r.CertDir)
+	}
+	var updatedResource apiext.CustomResourceDefinition
+	if err := r.Get(ctx, req.NamespacedName, &updatedResource); err != nil {
+		return err
+	}
+	svc := types.NamespacedName{
+		Name:      r.SvcName,
+		Namespace: r.SvcNamespace,
+	}
+	if err := injectService(&updatedResource, svc); err != nil {
+		return err
+	}
+	if err := injectCert(&updatedResource, *caPEM); err != nil {
+		return err
+	}
+	if err := r.publishCA(ctx, *caPEM); err != nil {
+		return fmt.Errorf("failed to publish the CA bundle to ConfigMap %s: %w", r.caConfigMapName(), err)
+	}
+	return r.Update(ctx, &updatedResource)
+}
+
+// watchCertDir loads the certificates from CertDir and reloads them whenever the directory
+// changes, all CRDs are reconciled after the CA bundle has changed. A warning event is
+// recorded on the CRDs if no valid certificates have been loaded after timeout.
+func (r *Reconciler) watchCertDir(ctx context.Context, timeout time.Duration) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+	// mounted secrets are updated by swapping a symlink in the directory, so the directory is watched
+	if err := watcher.Add(r.CertDir); err != nil {
+		return fmt.Errorf("could not watch cert dir %s: %w", r.CertDir, err)
+	}
+	r.reloadCertDir(ctx)
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case _, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			r.reloadCertDir(ctx)
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			r.Log.Error(err, "error watching cert dir", "dir", r.CertDir)
+		case <-timer.C:
+			if r.externalCABundle.Load() == nil {
+				r.recordExternalCertTimeout(ctx, timeout)
+			}
+		}
+	}
+}
+
+// reloadCertDir loads the CA bundle from CertDir if the certificates are valid,
+// and reconciles all CRDs if it has changed.
+func (r *Reconciler) reloadCertDir(ctx context.Context) {
+	certs := CertInfo{
+		CertDir:  r.CertDir,
+		CertName: certName,
+		KeyName:  keyName,
+		CAName:   caCertName,
+	}
+	dnsName := fmt.Sprintf("%v.%v.svc", r.SvcName, r.SvcNamespace)
+	if err := CheckCerts(certs, dnsName, time.Now()); err != nil {
+		// the files are written one by one, the next event reloads them again
+		r.Log.Info("certificates in cert dir are not valid", "dir", r.CertDir, "error", err.Error())
+		return
+	}
+	caPEM, err := os.ReadFile(filepath.Join(r.CertDir, caCertName))
+	if err != nil {
+		r.Log.Error(err, "could not read CA bundle", "dir", r.CertDir)
+		return
+	}
+	if old := r.externalCABundle.Load(); old != nil && bytes.Equal(*old, caPEM) {
+		return
+	}
+	r.externalCABundle.Store(&caPEM)
+	r.Log.Info("loaded CA bundle from cert dir", "dir", r.CertDir)
+	for _, name := range r.CrdResources {
+		crd := &apiext.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		select {
+		case r.certDirEvents <- event.GenericEvent{Object: crd}:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// recordExternalCertTimeout records a warning event on the CRDs, which are not injected
+// until valid certificates appear in CertDir.
+func (r *Reconciler) recordExternalCertTimeout(ctx context.Context, timeout time.Duration) {
+	r.Log.Error(errors.New("no valid certificates"), "certificates did not appear in cert dir", "dir", r.CertDir, "timeout", timeout.String())
+	for _, name := range r.CrdResources {
+		var crd apiext.CustomResourceDefinition
+		if err := r.Get(ctx, types.NamespacedName{Name: name}, &crd); err != nil {
+			continue
+		}
+		r.recorder.Eventf(&crd, corev1.EventTypeWarning, reasonExternalCertTimeout,
+			"no valid certificates appeared in %s within %s", r.CertDir, timeout)
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crds
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	certTypeCA     = "ca"
+	certTypeServer = "server"
+)
+
+// certExpiry is the NotAfter time of the managed certificates as a Unix timestamp.
+var (
+	certExpiry = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "external_secrets",
+		Subsystem: "webhook",
+		Name:      "cert_expiry_seconds",
+		Help:      "The expiry of the webhook certificates managed by the cert controller as a Unix timestamp",
+	}, []string{"type"})
+	registerMetricsOnce sync.Once
+)
+
+func registerMetrics() {
+	registerMetricsOnce.Do(func() {
+		metrics.Registry.MustRegister(certExpiry)
+	})
+}
+
+// ObserveCertExpiry sets the expiry metric from the certificates in the secret,
+// so that it is known before the first reconcile. A missing secret is not an error.
+func (r *Reconciler) ObserveCertExpiry(ctx context.Context) error {
+	var secret corev1.Secret
+	err := r.Get(ctx, types.NamespacedName{Name: r.SecretName, Namespace: r.SecretNamespace}, &secret)
+	if err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	return observeCertExpiry(&secret)
+}
+
+// observeCertExpiry sets the expiry metric of the CA and the server certificate in the secret.
+func observeCertExpiry(secret *corev1.Secret) error {
+	var errs []error
+	for certType, key := range map[string]string{certTypeCA: caCertName, certTypeServer: certName} {
+		cert, err := parseCert(secret.Data[key])
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		certExpiry.WithLabelValues(certType).Set(float64(cert.NotAfter.Unix()))
+	}
+	return errors.Join(errs...)
+}
+
+func parseCert(certPEM []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return nil, errors.New("failed to decode certificate PEM")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
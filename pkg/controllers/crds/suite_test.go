@@ -84,6 +84,7 @@ var _ = BeforeSuite(func() {
 		"foo", "default", "foo", "default", []string{
 			"secretstores.test.io",
 		})
+	rec.CAConfigMapName = "foo-ca"
 	rec.SetupWithManager(k8sManager, controller.Options{})
 	Expect(err).ToNot(HaveOccurred())
 
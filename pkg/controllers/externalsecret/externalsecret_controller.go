@@ -62,10 +62,12 @@ const (
 	errInvalidKeys          = "secret keys from spec.dataFrom.%v[%d] can only have alphanumeric,'-', '_' or '.' characters. Convert them using rewrite (https://external-secrets.io/latest/guides-datafrom-rewrite)"
 	errUpdateSecret         = "could not update Secret"
 	errPatchStatus          = "unable to patch status"
+	errPatchPaused          = "unable to set paused condition: %w"
 	errGetExistingSecret    = "could not get existing secret: %w"
 	errSetCtrlReference     = "could not set ExternalSecret controller reference: %w"
 	errFetchTplFrom         = "error fetching templateFrom data: %w"
 	errGetSecretData        = "could not get secret data from provider"
+	errGetSecretMetadata    = "could not get secret metadata from provider"
 	errDeleteSecret         = "could not delete secret"
 	errApplyTemplate        = "could not apply template: %w"
 	errExecTpl              = "could not execute template: %w"
@@ -78,6 +80,9 @@ const (
 
 const externalSecretSecretNameKey = ".spec.target.name"
 
+// expiryMargin is the time before the expiry of generated values at which they are renewed.
+const expiryMargin = 5 * time.Minute
+
 // Reconciler reconciles a ExternalSecret object.
 type Reconciler struct {
 	client.Client
@@ -147,6 +152,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	// skip reconciliation if deletion timestamp is set on external secret
 	if externalSecret.DeletionTimestamp != nil {
+		if controllerutil.ContainsFinalizer(&externalSecret, leaseFinalizer) {
+			r.revokeLeases(ctx, log, &externalSecret, externalSecret.Status.Leases)
+			return ctrl.Result{}, r.setLeaseFinalizer(ctx, &externalSecret, false)
+		}
 		log.Info("skipping as it is in deletion")
 		return ctrl.Result{}, nil
 	}
@@ -154,6 +163,17 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	// if extended metrics is enabled, refine the time series vector
 	resourceLabels = ctrlmetrics.RefineLabels(resourceLabels, externalSecret.Labels)
 
+	// the target secret is left as is while syncing is paused,
+	// removing the annotation changes the resource version and resyncs
+	paused := isPaused(externalSecret)
+	if err := r.setPausedCondition(ctx, &externalSecret, paused); err != nil {
+		return ctrl.Result{}, err
+	}
+	if paused {
+		log.V(1).Info("skipping as syncing is paused")
+		return ctrl.Result{}, nil
+	}
+
 	if shouldSkipClusterSecretStore(r, externalSecret) {
 		log.Info("skipping cluster secret store as it is disabled")
 		return ctrl.Result{}, nil
@@ -192,8 +212,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	// 1. resource generation hasn't changed
 	// 2. refresh interval is 0
 	// 3. if we're still within refresh-interval
-	if !shouldRefresh(externalSecret) && isSecretValid(existingSecret) {
+	// 4. generated values of the secret do not expire soon
+	renewIn, expires := renewAfter(existingSecret)
+	if !shouldRefresh(externalSecret) && isSecretValid(existingSecret) && (!expires || renewIn > 0) {
 		refreshInt = (externalSecret.Spec.RefreshInterval.Duration - timeSinceLastRefresh) + 5*time.Second
+		if expires && (refreshInt <= 0 || renewIn < refreshInt) {
+			refreshInt = renewIn
+		}
 		log.V(1).Info("skipping refresh", "rv", getResourceVersion(externalSecret), "nr", refreshInt.Seconds())
 		return ctrl.Result{RequeueAfter: refreshInt}, nil
 	}
@@ -211,6 +236,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		}
 	}()
 
+	// leased credentials are renewed while the ExternalSecret is unchanged,
+	// new credentials are only issued once the leases can not be renewed
+	if len(externalSecret.Status.Leases) > 0 && refreshInt > 0 &&
+		externalSecret.Status.SyncedResourceVersion == getResourceVersion(externalSecret) &&
+		isSecretValid(existingSecret) &&
+		r.renewLeases(ctx, log, &externalSecret, refreshInt) {
+		r.markAsDone(&externalSecret, start, log)
+		return ctrl.Result{RequeueAfter: refreshInt}, nil
+	}
+
 	secret := &v1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      secretName,
@@ -220,11 +255,28 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		Data:      make(map[string][]byte),
 	}
 
-	dataMap, err := r.getProviderSecretData(ctx, &externalSecret)
+	dataMap, leases, expiresAt, err := r.getProviderSecretData(ctx, &externalSecret)
 	if err != nil {
+		r.revokeLeases(ctx, log, &externalSecret, leases)
 		r.markAsFailed(log, errGetSecretData, err, &externalSecret, syncCallsError.With(resourceLabels))
 		return ctrl.Result{}, err
 	}
+	var metadata map[string]string
+	if externalSecret.Spec.SyncMetadata {
+		metadata, err = r.getProviderSecretMetadata(ctx, &externalSecret)
+		if err != nil {
+			r.revokeLeases(ctx, log, &externalSecret, leases)
+			r.markAsFailed(log, errGetSecretMetadata, err, &externalSecret, syncCallsError.With(resourceLabels))
+			return ctrl.Result{}, err
+		}
+	}
+	if len(leases) > 0 {
+		if err := r.setLeaseFinalizer(ctx, &externalSecret, true); err != nil {
+			r.revokeLeases(ctx, log, &externalSecret, leases)
+			r.markAsFailed(log, errGetSecretData, err, &externalSecret, syncCallsError.With(resourceLabels))
+			return ctrl.Result{}, err
+		}
+	}
 
 	// if no data was found we can delete the secret if needed.
 	if len(dataMap) == 0 {
@@ -244,6 +296,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 				return ctrl.Result{}, err
 			}
 
+			r.replaceLeases(ctx, log, &externalSecret, nil)
 			conditionSynced := NewExternalSecretCondition(esv1beta1.ExternalSecretReady, v1.ConditionTrue, esv1beta1.ConditionReasonSecretDeleted, "secret deleted due to DeletionPolicy")
 			SetExternalSecretCondition(&externalSecret, *conditionSynced)
 			return ctrl.Result{RequeueAfter: refreshInt}, nil
@@ -288,6 +341,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 			secret.Labels[esv1beta1.LabelOwner] = lblValue
 		}
 
+		setMetadataAnnotations(secret, metadata)
+		setExpiryAnnotation(secret, expiresAt)
+		setLeasesAnnotation(secret, leases)
 		secret.Annotations[esv1beta1.AnnotationDataHash] = r.computeDataHashAnnotation(&existingSecret, secret)
 
 		return nil
@@ -312,6 +368,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		if created {
 			delErr := deleteOrphanedSecrets(ctx, r.Client, &externalSecret)
 			if delErr != nil {
+				// the secret holds the new credentials already
+				r.replaceLeases(ctx, log, &externalSecret, leases)
 				msg := fmt.Sprintf("failed to clean up orphaned secrets: %v", delErr)
 				r.markAsFailed(log, msg, delErr, &externalSecret, syncCallsError.With(resourceLabels))
 				return ctrl.Result{}, delErr
@@ -320,12 +378,21 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	if err != nil {
+		r.revokeLeases(ctx, log, &externalSecret, leases)
 		r.markAsFailed(log, errUpdateSecret, err, &externalSecret, syncCallsError.With(resourceLabels))
 		return ctrl.Result{}, err
 	}
 
+	r.replaceLeases(ctx, log, &externalSecret, leases)
 	r.markAsDone(&externalSecret, start, log)
 
+	// generated values are renewed before they expire, at the latest a minute from now
+	if !expiresAt.IsZero() {
+		renewIn := max(time.Until(expiresAt)-expiryMargin, time.Minute)
+		if refreshInt <= 0 || renewIn < refreshInt {
+			refreshInt = renewIn
+		}
+	}
 	return ctrl.Result{
 		RequeueAfter: refreshInt,
 	}, nil
@@ -397,7 +464,7 @@ func (r *Reconciler) createOrUpdateSecret(ctx context.Context, secret *v1.Secret
 		return true, nil
 	}
 
-	existing := secret.DeepCopyObject()
+	existing := secret.DeepCopy()
 	if err := mutationFunc(); err != nil {
 		return false, err
 	}
@@ -409,13 +476,14 @@ func (r *Reconciler) createOrUpdateSecret(ctx context.Context, secret *v1.Secret
 	if err := r.Client.Update(ctx, secret, client.FieldOwner(fqdn)); err != nil {
 		return false, err
 	}
-	r.recorder.Event(es, v1.EventTypeNormal, esv1beta1.ReasonUpdated, "Updated Secret")
+	NewDiffReporter(r.recorder).Report(es, existing.Data, secret.Data)
 	return false, nil
 }
 
 func (r *Reconciler) patchSecret(ctx context.Context, secret *v1.Secret, mutationFunc func() error, es *esv1beta1.ExternalSecret) error {
 	fqdn := fmt.Sprintf(fieldOwnerTemplate, es.Name)
-	err := r.Client.Get(ctx, client.ObjectKeyFromObject(secret), secret.DeepCopy())
+	current := secret.DeepCopy()
+	err := r.Client.Get(ctx, client.ObjectKeyFromObject(secret), current)
 	if apierrors.IsNotFound(err) {
 		return fmt.Errorf(errPolicyMergeNotFound, secret.Name)
 	}
@@ -451,7 +519,8 @@ func (r *Reconciler) patchSecret(ctx context.Context, secret *v1.Secret, mutatio
 	if err := r.Client.Patch(ctx, secret, client.Apply, client.FieldOwner(fqdn), client.ForceOwnership); err != nil {
 		return fmt.Errorf(errPolicyMergePatch, secret.Name, err)
 	}
-	r.recorder.Event(es, v1.EventTypeNormal, esv1beta1.ReasonUpdated, "Updated Secret")
+	// the patch response holds all keys of the Secret, including those not managed by the ExternalSecret
+	NewDiffReporter(r.recorder).Report(es, current.Data, secret.Data)
 	return nil
 }
 
@@ -597,6 +666,40 @@ func shouldRefresh(es esv1beta1.ExternalSecret) bool {
 	return es.Status.RefreshTime.Add(es.Spec.RefreshInterval.Duration).Before(time.Now())
 }
 
+// renewAfter returns the time until the generated values of the secret must be renewed,
+// false if the secret has no expiry annotation.
+func renewAfter(secret v1.Secret) (time.Duration, bool) {
+	expiresAt, err := time.Parse(time.RFC3339, secret.Annotations[esv1beta1.AnnotationExpiresAt])
+	if err != nil {
+		return 0, false
+	}
+	return time.Until(expiresAt) - expiryMargin, true
+}
+
+func isPaused(es esv1beta1.ExternalSecret) bool {
+	return es.Annotations[esv1beta1.AnnotationPaused] == "true"
+}
+
+// setPausedCondition sets the Paused condition while syncing is paused and removes it
+// once syncing resumes. The other conditions are kept as they describe the last sync.
+func (r *Reconciler) setPausedCondition(ctx context.Context, es *esv1beta1.ExternalSecret, paused bool) error {
+	if (GetExternalSecretCondition(es.Status, esv1beta1.ExternalSecretPaused) != nil) == paused {
+		return nil
+	}
+	p := client.MergeFrom(es.DeepCopy())
+	if paused {
+		cond := NewExternalSecretCondition(esv1beta1.ExternalSecretPaused, v1.ConditionTrue, esv1beta1.ConditionReasonSyncPaused,
+			fmt.Sprintf("syncing is paused by the %s annotation", esv1beta1.AnnotationPaused))
+		SetExternalSecretCondition(es, *cond)
+	} else {
+		RemoveExternalSecretCondition(es, esv1beta1.ExternalSecretPaused)
+	}
+	if err := r.Status().Patch(ctx, es, p); err != nil {
+		return fmt.Errorf(errPatchPaused, err)
+	}
+	return nil
+}
+
 func shouldReconcile(es esv1beta1.ExternalSecret) bool {
 	if es.Spec.Target.Immutable && hasSyncedCondition(es) {
 		return false
@@ -639,6 +742,12 @@ func (r *Reconciler) computeDataHashAnnotation(existing, secret *v1.Secret) stri
 	return utils.ObjectHash(data)
 }
 
+// SetRecorder sets the event recorder used by the reconciler.
+// This is only needed when the reconciler is not set up with a Manager.
+func (r *Reconciler) SetRecorder(recorder record.EventRecorder) {
+	r.recorder = recorder
+}
+
 // SetupWithManager returns a new controller builder that will be started by the provided Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, opts controller.Options) error {
 	r.recorder = mgr.GetEventRecorderFor("external-secrets")
@@ -0,0 +1,85 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsecret
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+// DiffReporter emits Events describing which keys of a Secret changed in a sync.
+// Values are compared by their SHA-256 hash and never end up in the Events.
+type DiffReporter struct {
+	recorder record.EventRecorder
+}
+
+// NewDiffReporter returns a DiffReporter emitting Events with the recorder.
+func NewDiffReporter(recorder record.EventRecorder) *DiffReporter {
+	return &DiffReporter{recorder: recorder}
+}
+
+// Report emits a Normal Event on obj listing the keys added, removed and modified
+// between the old and the new data of the Secret.
+func (d *DiffReporter) Report(obj runtime.Object, oldData, newData map[string][]byte) {
+	d.recorder.Event(obj, v1.EventTypeNormal, esv1beta1.ReasonUpdated, diffMessage(oldData, newData))
+}
+
+// diffMessage returns e.g. "Updated Secret: added keys [a b], removed keys [c], modified keys [d]",
+// changes without any keys are left out.
+func diffMessage(oldData, newData map[string][]byte) string {
+	var added, removed, modified []string
+	for k, v := range newData {
+		old, ok := oldData[k]
+		switch {
+		case !ok:
+			added = append(added, k)
+		case sha256.Sum256(old) != sha256.Sum256(v):
+			modified = append(modified, k)
+		}
+	}
+	for k := range oldData {
+		if _, ok := newData[k]; !ok {
+			removed = append(removed, k)
+		}
+	}
+
+	var changes []string
+	for _, c := range []struct {
+		name string
+		keys []string
+	}{
+		{"added", added},
+		{"removed", removed},
+		{"modified", modified},
+	} {
+		if len(c.keys) == 0 {
+			continue
+		}
+		sort.Strings(c.keys)
+		changes = append(changes, fmt.Sprintf("%s keys %v", c.name, c.keys))
+	}
+	if len(changes) == 0 {
+		return "Updated Secret"
+	}
+	return "Updated Secret: " + strings.Join(changes, ", ")
+}
@@ -0,0 +1,63 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsecret
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/client-go/tools/record"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+func TestDiffReporter(t *testing.T) {
+	tests := map[string]struct {
+		oldData map[string][]byte
+		newData map[string][]byte
+		want    string
+	}{
+		"added, removed and modified keys": {
+			oldData: map[string][]byte{"user": []byte("s3cr3t-admin"), "password": []byte("s3cr3t-old"), "host": []byte("s3cr3t-db"), "port": []byte("s3cr3t-5432")},
+			newData: map[string][]byte{"user": []byte("s3cr3t-admin"), "password": []byte("s3cr3t-new"), "url": []byte("s3cr3t-db:5432"), "ca.crt": []byte("s3cr3t----")},
+			want:    "Normal Updated Updated Secret: added keys [ca.crt url], removed keys [host port], modified keys [password]",
+		},
+		"only modified keys": {
+			oldData: map[string][]byte{"token": []byte("s3cr3t-a")},
+			newData: map[string][]byte{"token": []byte("s3cr3t-b")},
+			want:    "Normal Updated Updated Secret: modified keys [token]",
+		},
+		"keys added to an empty secret": {
+			newData: map[string][]byte{"token": []byte("s3cr3t-a")},
+			want:    "Normal Updated Updated Secret: added keys [token]",
+		},
+		"metadata only": {
+			oldData: map[string][]byte{"token": []byte("s3cr3t-a")},
+			newData: map[string][]byte{"token": []byte("s3cr3t-a")},
+			want:    "Normal Updated Updated Secret",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			recorder := record.NewFakeRecorder(1)
+			NewDiffReporter(recorder).Report(&esv1beta1.ExternalSecret{}, tc.oldData, tc.newData)
+			event := <-recorder.Events
+			assert.Equal(t, tc.want, event)
+			for _, v := range tc.newData {
+				assert.NotContains(t, event, string(v))
+			}
+		})
+	}
+}
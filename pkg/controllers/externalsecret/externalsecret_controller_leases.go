@@ -0,0 +1,143 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsecret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/controllers/secretstore"
+)
+
+const (
+	// leaseFinalizer makes sure the leases of an ExternalSecret are revoked before it is deleted.
+	leaseFinalizer = "externalsecret.externalsecrets.io/leases"
+
+	errRevokeLeases = "could not revoke leases"
+	errRenewLeases  = "could not renew leases, rotating credentials"
+	errLeaseStore   = "could not get store %s/%s for lease %s: %w"
+	errPatchLease   = "could not update lease finalizer: %w"
+)
+
+// setLeaseFinalizer adds or removes the lease finalizer without touching the in-memory status.
+func (r *Reconciler) setLeaseFinalizer(ctx context.Context, externalSecret *esv1beta1.ExternalSecret, set bool) error {
+	if controllerutil.ContainsFinalizer(externalSecret, leaseFinalizer) == set {
+		return nil
+	}
+	updated := externalSecret.DeepCopy()
+	if set {
+		controllerutil.AddFinalizer(updated, leaseFinalizer)
+	} else {
+		controllerutil.RemoveFinalizer(updated, leaseFinalizer)
+	}
+	patch := client.MergeFromWithOptions(externalSecret.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	if err := r.Patch(ctx, updated, patch); err != nil {
+		return fmt.Errorf(errPatchLease, err)
+	}
+	externalSecret.Finalizers = updated.Finalizers
+	externalSecret.ResourceVersion = updated.ResourceVersion
+	return nil
+}
+
+// renewLeases renews the leases of the synced credentials so that they outlive the next
+// refresh. It returns false when a lease can not be renewed or reaches its maximum TTL
+// before the next refresh, the credentials must be rotated then.
+func (r *Reconciler) renewLeases(ctx context.Context, log logr.Logger, externalSecret *esv1beta1.ExternalSecret, refreshInterval time.Duration) bool {
+	mgr := secretstore.NewManager(r.Client, r.ControllerClass, false)
+	defer mgr.Close(ctx)
+
+	for _, lease := range externalSecret.Status.Leases {
+		storeClient, err := mgr.Get(ctx, lease.StoreRef, externalSecret.Namespace, nil)
+		if err != nil {
+			log.Error(fmt.Errorf(errLeaseStore, lease.StoreRef.Kind, lease.StoreRef.Name, lease.ID, err), errRenewLeases)
+			return false
+		}
+		leaseClient, ok := storeClient.(esv1beta1.LeaseClient)
+		if !ok {
+			return false
+		}
+		ttl, err := leaseClient.RenewLease(ctx, lease.ID, 2*refreshInterval)
+		if err != nil {
+			log.Error(err, errRenewLeases)
+			return false
+		}
+		if ttl <= refreshInterval {
+			log.V(1).Info("lease expires before the next refresh, rotating credentials", "lease", lease.ID, "ttl", ttl)
+			return false
+		}
+	}
+	return true
+}
+
+// replaceLeases records the leases of the synced credentials and revokes the superseded ones.
+// It must only be called once the target Secret holds the new credentials.
+func (r *Reconciler) replaceLeases(ctx context.Context, log logr.Logger, externalSecret *esv1beta1.ExternalSecret, leases []esv1beta1.ExternalSecretLease) {
+	var superseded []esv1beta1.ExternalSecretLease
+	for _, lease := range externalSecret.Status.Leases {
+		if !slices.Contains(leases, lease) {
+			superseded = append(superseded, lease)
+		}
+	}
+	externalSecret.Status.Leases = leases
+	r.revokeLeases(ctx, log, externalSecret, superseded)
+	if len(leases) == 0 {
+		if err := r.setLeaseFinalizer(ctx, externalSecret, false); err != nil {
+			log.Error(err, errPatchLease)
+		}
+	}
+}
+
+// revokeLeases revokes leases with the stores that issued them. Leases that can not be
+// revoked expire with their TTL, so failures are only reported.
+func (r *Reconciler) revokeLeases(ctx context.Context, log logr.Logger, externalSecret *esv1beta1.ExternalSecret, leases []esv1beta1.ExternalSecretLease) {
+	if len(leases) == 0 {
+		return
+	}
+	mgr := secretstore.NewManager(r.Client, r.ControllerClass, false)
+	defer mgr.Close(ctx)
+
+	var errs error
+	for _, lease := range leases {
+		storeClient, err := mgr.Get(ctx, lease.StoreRef, externalSecret.Namespace, nil)
+		if err != nil {
+			// there is nothing to revoke the lease with once the store is gone
+			if !apierrors.IsNotFound(err) {
+				errs = errors.Join(errs, fmt.Errorf(errLeaseStore, lease.StoreRef.Kind, lease.StoreRef.Name, lease.ID, err))
+			}
+			continue
+		}
+		leaseClient, ok := storeClient.(esv1beta1.LeaseClient)
+		if !ok {
+			continue
+		}
+		if err := leaseClient.RevokeLease(ctx, lease.ID); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+	if errs != nil {
+		log.Error(errs, errRevokeLeases)
+		r.recorder.Event(externalSecret, v1.EventTypeWarning, esv1beta1.ReasonRevokeFailed, errs.Error())
+	}
+}
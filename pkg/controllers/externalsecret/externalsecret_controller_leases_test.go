@@ -0,0 +1,237 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsecret
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/provider/testing/fake"
+)
+
+type leaseClient struct {
+	*fake.Client
+	mu      sync.Mutex
+	issued  []string
+	renewed []string
+	revoked []string
+	// ttl is returned when a lease is renewed
+	ttl      time.Duration
+	renewErr error
+}
+
+func (c *leaseClient) IssuedLeases() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	issued := c.issued
+	c.issued = nil
+	return issued
+}
+
+func (c *leaseClient) RenewLease(_ context.Context, leaseID string, _ time.Duration) (time.Duration, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.renewErr != nil {
+		return 0, c.renewErr
+	}
+	c.renewed = append(c.renewed, leaseID)
+	return c.ttl, nil
+}
+
+func (c *leaseClient) RevokeLease(_ context.Context, leaseID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.revoked = append(c.revoked, leaseID)
+	return nil
+}
+
+// issue records a lease for the next credentials read.
+func (c *leaseClient) issue(leaseID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.issued = append(c.issued, leaseID)
+}
+
+// state returns copies of the renewed and revoked leases.
+func (c *leaseClient) state() (renewed, revoked []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return slices.Clone(c.renewed), slices.Clone(c.revoked)
+}
+
+func newLeaseReconciler(t *testing.T, lc *leaseClient, objs ...client.Object) *Reconciler {
+	t.Helper()
+	fakeProvider.WithNew(func(context.Context, esv1beta1.GenericStore, client.Client, string) (esv1beta1.SecretsClient, error) {
+		return lc, nil
+	})
+	scheme := runtime.NewScheme()
+	require.NoError(t, esv1beta1.AddToScheme(scheme))
+	store := &esv1beta1.SecretStore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "store",
+			Namespace: "default",
+		},
+		Spec: esv1beta1.SecretStoreSpec{
+			Provider: &esv1beta1.SecretStoreProvider{
+				AWS: &esv1beta1.AWSProvider{
+					Service: esv1beta1.AWSServiceSecretsManager,
+				},
+			},
+		},
+	}
+	return &Reconciler{
+		Client:   clientfake.NewClientBuilder().WithScheme(scheme).WithObjects(append(objs, store)...).Build(),
+		recorder: record.NewFakeRecorder(10),
+	}
+}
+
+func lease(id string) esv1beta1.ExternalSecretLease {
+	return esv1beta1.ExternalSecretLease{
+		StoreRef: esv1beta1.SecretStoreRef{Name: "store", Kind: esv1beta1.SecretStoreKind},
+		ID:       id,
+	}
+}
+
+func TestGetProviderSecretDataCollectsLeases(t *testing.T) {
+	defer fakeProvider.Reset()
+	lc := &leaseClient{Client: fake.New().WithGetSecret([]byte("secret"), nil), issued: []string{"ldap/creds/role/1"}}
+	r := newLeaseReconciler(t, lc)
+	es := &esv1beta1.ExternalSecret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "es",
+			Namespace: "default",
+		},
+		Spec: esv1beta1.ExternalSecretSpec{
+			SecretStoreRef: esv1beta1.SecretStoreRef{Name: "store"},
+			Data: []esv1beta1.ExternalSecretData{
+				{SecretKey: "username", RemoteRef: esv1beta1.ExternalSecretDataRemoteRef{Key: "role", Property: "username"}},
+				{SecretKey: "password", RemoteRef: esv1beta1.ExternalSecretDataRemoteRef{Key: "role", Property: "password"}},
+			},
+		},
+	}
+
+	data, leases, _, err := r.getProviderSecretData(context.Background(), es)
+	require.NoError(t, err)
+	assert.Len(t, data, 2)
+	assert.Equal(t, []esv1beta1.ExternalSecretLease{lease("ldap/creds/role/1")}, leases)
+}
+
+func TestReplaceLeases(t *testing.T) {
+	defer fakeProvider.Reset()
+	lc := &leaseClient{Client: fake.New()}
+	r := newLeaseReconciler(t, lc, &esv1beta1.ExternalSecret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "es",
+			Namespace:  "default",
+			Finalizers: []string{leaseFinalizer},
+		},
+		Status: esv1beta1.ExternalSecretStatus{
+			Leases: []esv1beta1.ExternalSecretLease{lease("old"), lease("kept")},
+		},
+	})
+	var es esv1beta1.ExternalSecret
+	require.NoError(t, r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "es"}, &es))
+
+	r.replaceLeases(context.Background(), logr.Discard(), &es, []esv1beta1.ExternalSecretLease{lease("kept"), lease("new")})
+	assert.Equal(t, []string{"old"}, lc.revoked)
+	assert.Equal(t, []esv1beta1.ExternalSecretLease{lease("kept"), lease("new")}, es.Status.Leases)
+	assert.Contains(t, es.Finalizers, leaseFinalizer)
+
+	// the finalizer is removed once no leases are left
+	r.replaceLeases(context.Background(), logr.Discard(), &es, nil)
+	assert.Equal(t, []string{"old", "kept", "new"}, lc.revoked)
+	assert.Empty(t, es.Status.Leases)
+	var updated esv1beta1.ExternalSecret
+	require.NoError(t, r.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "es"}, &updated))
+	assert.NotContains(t, updated.Finalizers, leaseFinalizer)
+}
+
+func TestRenewLeases(t *testing.T) {
+	tests := []struct {
+		name        string
+		ttl         time.Duration
+		renewErr    error
+		leases      []esv1beta1.ExternalSecretLease
+		wantRenewed bool
+	}{
+		{
+			name:        "leases outlive the next refresh",
+			ttl:         2 * time.Hour,
+			leases:      []esv1beta1.ExternalSecretLease{lease("a"), lease("b")},
+			wantRenewed: true,
+		},
+		{
+			name:   "lease reaches its max TTL before the next refresh",
+			ttl:    30 * time.Minute,
+			leases: []esv1beta1.ExternalSecretLease{lease("a")},
+		},
+		{
+			name:     "lease can not be renewed",
+			renewErr: errors.New("lease not found"),
+			leases:   []esv1beta1.ExternalSecretLease{lease("a")},
+		},
+		{
+			name: "store of the lease is gone",
+			ttl:  2 * time.Hour,
+			leases: []esv1beta1.ExternalSecretLease{
+				{StoreRef: esv1beta1.SecretStoreRef{Name: "gone"}, ID: "gone"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer fakeProvider.Reset()
+			lc := &leaseClient{Client: fake.New(), ttl: tt.ttl, renewErr: tt.renewErr}
+			r := newLeaseReconciler(t, lc)
+			es := &esv1beta1.ExternalSecret{
+				ObjectMeta: metav1.ObjectMeta{Name: "es", Namespace: "default"},
+				Status:     esv1beta1.ExternalSecretStatus{Leases: tt.leases},
+			}
+
+			got := r.renewLeases(context.Background(), logr.Discard(), es, time.Hour)
+			assert.Equal(t, tt.wantRenewed, got)
+			// renewal never revokes the leases of the synced credentials
+			_, revoked := lc.state()
+			assert.Empty(t, revoked)
+			assert.Equal(t, tt.leases, es.Status.Leases)
+		})
+	}
+}
+
+func TestRevokeLeasesOfDeletedStore(t *testing.T) {
+	defer fakeProvider.Reset()
+	lc := &leaseClient{Client: fake.New()}
+	r := newLeaseReconciler(t, lc)
+	es := &esv1beta1.ExternalSecret{ObjectMeta: metav1.ObjectMeta{Name: "es", Namespace: "default"}}
+	gone := esv1beta1.ExternalSecretLease{StoreRef: esv1beta1.SecretStoreRef{Name: "gone"}, ID: "gone"}
+
+	r.revokeLeases(context.Background(), logr.Discard(), es, []esv1beta1.ExternalSecretLease{gone, lease("issued")})
+	assert.Equal(t, []string{"issued"}, lc.revoked)
+	assert.Empty(t, r.recorder.(*record.FakeRecorder).Events)
+}
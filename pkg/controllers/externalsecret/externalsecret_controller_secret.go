@@ -19,11 +19,16 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"regexp"
+	"strings"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/restmapper"
@@ -39,8 +44,51 @@ import (
 	_ "github.com/external-secrets/external-secrets/pkg/provider/register"
 )
 
-// getProviderSecretData returns the provider's secret data with the provided ExternalSecret.
-func (r *Reconciler) getProviderSecretData(ctx context.Context, externalSecret *esv1beta1.ExternalSecret) (map[string][]byte, error) {
+// defaultProviderTimeout is used when the store does not define spec.timeout.
+const defaultProviderTimeout = 30 * time.Second
+
+// getProviderClient returns the provider client of the referenced store
+// along with a context that is cancelled once the store timeout expires.
+// The timeout covers building the client as well, which authenticates with the provider.
+// The returned cancel func must always be called.
+func getProviderClient(ctx context.Context, cmgr *secretstore.Manager, storeRef esv1beta1.SecretStoreRef, namespace string, sourceRef *esv1beta1.StoreGeneratorSourceRef) (esv1beta1.SecretsClient, context.Context, context.CancelFunc, error) {
+	store, err := cmgr.ResolveStore(ctx, storeRef, namespace, sourceRef)
+	if err != nil {
+		return nil, ctx, func() {}, &storeError{err: err}
+	}
+	callCtx, cancel := context.WithTimeout(ctx, providerTimeout(store))
+	client, err := cmgr.GetFromStore(callCtx, store, namespace)
+	if err != nil {
+		return nil, callCtx, cancel, &storeError{err: err}
+	}
+	return client, callCtx, cancel, nil
+}
+
+// storeError is returned when the store can not be used at all, e.g. when it does not exist
+// or the authentication fails. Every further entry would fail with the same error.
+type storeError struct {
+	err error
+}
+
+func (e *storeError) Error() string {
+	return e.err.Error()
+}
+
+func (e *storeError) Unwrap() error {
+	return e.err
+}
+
+func providerTimeout(store esv1beta1.GenericStore) time.Duration {
+	if spec := store.GetSpec(); spec != nil && spec.Timeout != nil && spec.Timeout.Duration > 0 {
+		return spec.Timeout.Duration
+	}
+	return defaultProviderTimeout
+}
+
+// getProviderSecretData returns the provider's secret data with the provided ExternalSecret
+// along with the leases issued for it and the earliest expiry of generated values, which
+// is zero if no generated value expires.
+func (r *Reconciler) getProviderSecretData(ctx context.Context, externalSecret *esv1beta1.ExternalSecret) (map[string][]byte, []esv1beta1.ExternalSecretLease, time.Time, error) {
 	// We MUST NOT create multiple instances of a provider client (mostly due to limitations with GCP)
 	// Clientmanager keeps track of the client instances
 	// that are created during the fetching process and closes clients
@@ -48,9 +96,14 @@ func (r *Reconciler) getProviderSecretData(ctx context.Context, externalSecret *
 	mgr := secretstore.NewManager(r.Client, r.ControllerClass, r.EnableFloodGate)
 	defer mgr.Close(ctx)
 
+	// fetch errors of single entries are collected so that every failing entry gets reported,
+	// an error of the store itself stops the fetching.
+	var errs error
+	var expiresAt time.Time
 	providerData := make(map[string][]byte)
 	for i, remoteRef := range externalSecret.Spec.DataFrom {
 		var secretMap map[string][]byte
+		var genExpiresAt time.Time
 		var err error
 
 		if remoteRef.Find != nil {
@@ -58,7 +111,7 @@ func (r *Reconciler) getProviderSecretData(ctx context.Context, externalSecret *
 		} else if remoteRef.Extract != nil {
 			secretMap, err = r.handleExtractSecrets(ctx, externalSecret, remoteRef, mgr, i)
 		} else if remoteRef.SourceRef != nil && remoteRef.SourceRef.GeneratorRef != nil {
-			secretMap, err = r.handleGenerateSecrets(ctx, externalSecret.Namespace, remoteRef, i)
+			secretMap, genExpiresAt, err = r.handleGenerateSecrets(ctx, externalSecret.Namespace, remoteRef, i)
 		}
 		if errors.Is(err, esv1beta1.NoSecretErr) && externalSecret.Spec.Target.DeletionPolicy != esv1beta1.DeletionPolicyRetain {
 			r.recorder.Event(
@@ -70,7 +123,16 @@ func (r *Reconciler) getProviderSecretData(ctx context.Context, externalSecret *
 			continue
 		}
 		if err != nil {
-			return nil, err
+			r.recorder.Eventf(externalSecret, v1.EventTypeWarning, esv1beta1.ReasonFetchFailed,
+				"failed to fetch .dataFrom[%d]%s: %v", i, describeDataFromRef(remoteRef), err)
+			errs = errors.Join(errs, err)
+			if isStoreError(err) {
+				return nil, mgr.Leases(), time.Time{}, errs
+			}
+			continue
+		}
+		if !genExpiresAt.IsZero() && (expiresAt.IsZero() || genExpiresAt.Before(expiresAt)) {
+			expiresAt = genExpiresAt
 		}
 		providerData = utils.MergeByteMap(providerData, secretMap)
 	}
@@ -82,19 +144,147 @@ func (r *Reconciler) getProviderSecretData(ctx context.Context, externalSecret *
 			continue
 		}
 		if err != nil {
-			return nil, fmt.Errorf("error retrieving secret at .data[%d], key: %s, err: %w", i, secretRef.RemoteRef.Key, err)
+			r.recorder.Eventf(externalSecret, v1.EventTypeWarning, esv1beta1.ReasonFetchFailed,
+				"failed to fetch .data[%d] key=%s: %v", i, secretRef.RemoteRef.Key, err)
+			errs = errors.Join(errs, fmt.Errorf("error retrieving secret at .data[%d], key: %s, err: %w", i, secretRef.RemoteRef.Key, err))
+			if isStoreError(err) {
+				return nil, mgr.Leases(), time.Time{}, errs
+			}
 		}
 	}
+	leases := mgr.Leases()
+	if errs != nil {
+		return nil, leases, time.Time{}, errs
+	}
 
-	return providerData, nil
+	return providerData, leases, expiresAt, nil
+}
+
+// getProviderSecretMetadata returns the metadata of the secrets referenced by .data and
+// .dataFrom[].extract, the keys of later entries override those of earlier ones.
+// Stores which do not implement MetadataClient and missing secrets are skipped.
+func (r *Reconciler) getProviderSecretMetadata(ctx context.Context, externalSecret *esv1beta1.ExternalSecret) (map[string]string, error) {
+	mgr := secretstore.NewManager(r.Client, r.ControllerClass, r.EnableFloodGate)
+	defer mgr.Close(ctx)
+
+	metadata := make(map[string]string)
+	fetch := func(sourceRef *esv1beta1.StoreGeneratorSourceRef, ref esv1beta1.ExternalSecretDataRemoteRef) error {
+		client, callCtx, cancel, err := getProviderClient(ctx, mgr, externalSecret.Spec.SecretStoreRef, externalSecret.Namespace, sourceRef)
+		defer cancel()
+		if err != nil {
+			return err
+		}
+		metadataClient, ok := client.(esv1beta1.MetadataClient)
+		if !ok {
+			return nil
+		}
+		secretMetadata, err := metadataClient.GetSecretMetadata(callCtx, ref)
+		if errors.Is(err, esv1beta1.NoSecretErr) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		maps.Copy(metadata, secretMetadata)
+		return nil
+	}
+	for i, remoteRef := range externalSecret.Spec.DataFrom {
+		if remoteRef.Extract == nil {
+			continue
+		}
+		if err := fetch(remoteRef.SourceRef, *remoteRef.Extract); err != nil {
+			return nil, fmt.Errorf("error retrieving metadata at .dataFrom[%d], key: %s, err: %w", i, remoteRef.Extract.Key, err)
+		}
+	}
+	for i, secretRef := range externalSecret.Spec.Data {
+		if err := fetch(toStoreGenSourceRef(secretRef.SourceRef), secretRef.RemoteRef); err != nil {
+			return nil, fmt.Errorf("error retrieving metadata at .data[%d], key: %s, err: %w", i, secretRef.RemoteRef.Key, err)
+		}
+	}
+	return metadata, nil
+}
+
+// setExpiryAnnotation sets the expiry of the generated values of the secret,
+// the annotation is removed if no generated value expires.
+func setExpiryAnnotation(secret *v1.Secret, expiresAt time.Time) {
+	if expiresAt.IsZero() {
+		delete(secret.Annotations, esv1beta1.AnnotationExpiresAt)
+		return
+	}
+	if secret.Annotations == nil {
+		secret.Annotations = make(map[string]string)
+	}
+	secret.Annotations[esv1beta1.AnnotationExpiresAt] = expiresAt.UTC().Format(time.RFC3339)
+}
+
+// setLeasesAnnotation sets the IDs of the leases of the dynamic credentials in the secret,
+// the annotation is removed if the secret holds no leased credentials.
+func setLeasesAnnotation(secret *v1.Secret, leases []esv1beta1.ExternalSecretLease) {
+	if len(leases) == 0 {
+		delete(secret.Annotations, esv1beta1.AnnotationLeases)
+		return
+	}
+	ids := make([]string, 0, len(leases))
+	for _, lease := range leases {
+		ids = append(ids, lease.ID)
+	}
+	if secret.Annotations == nil {
+		secret.Annotations = make(map[string]string)
+	}
+	secret.Annotations[esv1beta1.AnnotationLeases] = strings.Join(ids, ",")
+}
+
+// invalidAnnotationChars matches the characters which are not allowed in annotation names.
+var invalidAnnotationChars = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+
+// setMetadataAnnotations replaces the metadata annotations of the secret. Characters of
+// the metadata keys which are not allowed in annotation names are replaced with '-',
+// keys which still do not form a valid annotation name are skipped.
+func setMetadataAnnotations(secret *v1.Secret, metadata map[string]string) {
+	for k := range secret.Annotations {
+		if strings.HasPrefix(k, esv1beta1.AnnotationMetadataPrefix) {
+			delete(secret.Annotations, k)
+		}
+	}
+	for k, v := range metadata {
+		name := esv1beta1.AnnotationMetadataPrefix + invalidAnnotationChars.ReplaceAllString(k, "-")
+		if len(validation.IsQualifiedName(name)) > 0 {
+			continue
+		}
+		if secret.Annotations == nil {
+			secret.Annotations = make(map[string]string)
+		}
+		secret.Annotations[name] = v
+	}
+}
+
+func isStoreError(err error) bool {
+	var storeErr *storeError
+	return errors.As(err, &storeErr)
+}
+
+// describeDataFromRef returns the remote key or search of a dataFrom entry for use in events.
+func describeDataFromRef(ref esv1beta1.ExternalSecretDataFromRemoteRef) string {
+	switch {
+	case ref.Extract != nil:
+		return fmt.Sprintf(" key=%s", ref.Extract.Key)
+	case ref.Find != nil && ref.Find.Name != nil:
+		return fmt.Sprintf(" find.name=%s", ref.Find.Name.RegExp)
+	case ref.Find != nil && ref.Find.Path != nil:
+		return fmt.Sprintf(" find.path=%s", *ref.Find.Path)
+	case ref.SourceRef != nil && ref.SourceRef.GeneratorRef != nil:
+		return fmt.Sprintf(" generator=%s/%s", ref.SourceRef.GeneratorRef.Kind, ref.SourceRef.GeneratorRef.Name)
+	}
+	return ""
 }
 
 func (r *Reconciler) handleSecretData(ctx context.Context, i int, externalSecret esv1beta1.ExternalSecret, secretRef esv1beta1.ExternalSecretData, providerData map[string][]byte, cmgr *secretstore.Manager) error {
-	client, err := cmgr.Get(ctx, externalSecret.Spec.SecretStoreRef, externalSecret.Namespace, toStoreGenSourceRef(secretRef.SourceRef))
+	client, callCtx, cancel, err := getProviderClient(ctx, cmgr, externalSecret.Spec.SecretStoreRef, externalSecret.Namespace, toStoreGenSourceRef(secretRef.SourceRef))
+	defer cancel()
 	if err != nil {
 		return err
 	}
-	secretData, err := client.GetSecret(ctx, secretRef.RemoteRef)
+	secretData, err := client.GetSecret(callCtx, secretRef.RemoteRef)
 	if err != nil {
 		return err
 	}
@@ -115,27 +305,36 @@ func toStoreGenSourceRef(ref *esv1beta1.StoreSourceRef) *esv1beta1.StoreGenerato
 	}
 }
 
-func (r *Reconciler) handleGenerateSecrets(ctx context.Context, namespace string, remoteRef esv1beta1.ExternalSecretDataFromRemoteRef, i int) (map[string][]byte, error) {
+// handleGenerateSecrets returns the values of the generator along with their expiry,
+// which is zero if the generator does not implement ExpiringGenerator.
+func (r *Reconciler) handleGenerateSecrets(ctx context.Context, namespace string, remoteRef esv1beta1.ExternalSecretDataFromRemoteRef, i int) (map[string][]byte, time.Time, error) {
 	genDef, err := r.getGeneratorDefinition(ctx, namespace, remoteRef.SourceRef.GeneratorRef)
 	if err != nil {
-		return nil, err
+		return nil, time.Time{}, err
 	}
 	gen, err := genv1alpha1.GetGenerator(genDef)
 	if err != nil {
-		return nil, err
+		return nil, time.Time{}, err
 	}
 	secretMap, err := gen.Generate(ctx, genDef, r.Client, namespace)
 	if err != nil {
-		return nil, fmt.Errorf(errGenerate, i, err)
+		return nil, time.Time{}, fmt.Errorf(errGenerate, i, err)
+	}
+	// the expiry is read before the keys are rewritten
+	var expiresAt time.Time
+	if expiring, ok := gen.(genv1alpha1.ExpiringGenerator); ok {
+		if exp, ok := expiring.ExpiresAt(secretMap); ok {
+			expiresAt = exp
+		}
 	}
 	secretMap, err = utils.RewriteMap(remoteRef.Rewrite, secretMap)
 	if err != nil {
-		return nil, fmt.Errorf(errRewrite, i, err)
+		return nil, time.Time{}, fmt.Errorf(errRewrite, i, err)
 	}
 	if !utils.ValidateKeys(secretMap) {
-		return nil, fmt.Errorf(errInvalidKeys, "generator", i)
+		return nil, time.Time{}, fmt.Errorf(errInvalidKeys, "generator", i)
 	}
-	return secretMap, err
+	return secretMap, expiresAt, err
 }
 
 // getGeneratorDefinition returns the generator JSON for a given sourceRef
@@ -182,11 +381,12 @@ func (r *Reconciler) getGeneratorDefinition(ctx context.Context, namespace strin
 }
 
 func (r *Reconciler) handleExtractSecrets(ctx context.Context, externalSecret *esv1beta1.ExternalSecret, remoteRef esv1beta1.ExternalSecretDataFromRemoteRef, cmgr *secretstore.Manager, i int) (map[string][]byte, error) {
-	client, err := cmgr.Get(ctx, externalSecret.Spec.SecretStoreRef, externalSecret.Namespace, remoteRef.SourceRef)
+	client, callCtx, cancel, err := getProviderClient(ctx, cmgr, externalSecret.Spec.SecretStoreRef, externalSecret.Namespace, remoteRef.SourceRef)
+	defer cancel()
 	if err != nil {
 		return nil, err
 	}
-	secretMap, err := client.GetSecretMap(ctx, *remoteRef.Extract)
+	secretMap, err := client.GetSecretMap(callCtx, *remoteRef.Extract)
 	if err != nil {
 		return nil, err
 	}
@@ -211,11 +411,12 @@ func (r *Reconciler) handleExtractSecrets(ctx context.Context, externalSecret *e
 }
 
 func (r *Reconciler) handleFindAllSecrets(ctx context.Context, externalSecret *esv1beta1.ExternalSecret, remoteRef esv1beta1.ExternalSecretDataFromRemoteRef, cmgr *secretstore.Manager, i int) (map[string][]byte, error) {
-	client, err := cmgr.Get(ctx, externalSecret.Spec.SecretStoreRef, externalSecret.Namespace, remoteRef.SourceRef)
+	client, callCtx, cancel, err := getProviderClient(ctx, cmgr, externalSecret.Spec.SecretStoreRef, externalSecret.Namespace, remoteRef.SourceRef)
+	defer cancel()
 	if err != nil {
 		return nil, err
 	}
-	secretMap, err := client.GetAllSecrets(ctx, *remoteRef.Find)
+	secretMap, err := client.GetAllSecrets(callCtx, *remoteRef.Find)
 	if err != nil {
 		return nil, err
 	}
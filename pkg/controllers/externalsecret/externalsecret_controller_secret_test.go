@@ -0,0 +1,337 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalsecret
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/provider/testing/fake"
+)
+
+func TestGetProviderSecretDataRecordsFetchFailures(t *testing.T) {
+	defer fakeProvider.Reset()
+	fc := fake.New().
+		WithGetSecret(nil, errors.New("boom")).
+		WithGetSecretMap(nil, errors.New("denied"))
+	fakeProvider.WithNew(func(context.Context, esv1beta1.GenericStore, client.Client, string) (esv1beta1.SecretsClient, error) {
+		return fc, nil
+	})
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, esv1beta1.AddToScheme(scheme))
+	store := &esv1beta1.SecretStore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "store",
+			Namespace: "default",
+		},
+		Spec: esv1beta1.SecretStoreSpec{
+			Provider: &esv1beta1.SecretStoreProvider{
+				AWS: &esv1beta1.AWSProvider{
+					Service: esv1beta1.AWSServiceSecretsManager,
+				},
+			},
+		},
+	}
+	recorder := record.NewFakeRecorder(10)
+	r := &Reconciler{
+		Client:   clientfake.NewClientBuilder().WithScheme(scheme).WithObjects(store).Build(),
+		recorder: recorder,
+	}
+	es := &esv1beta1.ExternalSecret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "es",
+			Namespace: "default",
+		},
+		Spec: esv1beta1.ExternalSecretSpec{
+			SecretStoreRef: esv1beta1.SecretStoreRef{Name: "store"},
+			Data: []esv1beta1.ExternalSecretData{
+				{SecretKey: "a", RemoteRef: esv1beta1.ExternalSecretDataRemoteRef{Key: "key-a"}},
+				{SecretKey: "b", RemoteRef: esv1beta1.ExternalSecretDataRemoteRef{Key: "key-b"}},
+			},
+			DataFrom: []esv1beta1.ExternalSecretDataFromRemoteRef{
+				{Extract: &esv1beta1.ExternalSecretDataRemoteRef{Key: "key-c"}},
+			},
+		},
+	}
+
+	_, _, _, err := r.getProviderSecretData(context.Background(), es)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "key: key-a")
+	assert.ErrorContains(t, err, "key: key-b")
+
+	close(recorder.Events)
+	var events []string
+	for e := range recorder.Events {
+		events = append(events, e)
+	}
+	assert.Equal(t, []string{
+		"Warning FetchFailed failed to fetch .dataFrom[0] key=key-c: denied",
+		"Warning FetchFailed failed to fetch .data[0] key=key-a: boom",
+		"Warning FetchFailed failed to fetch .data[1] key=key-b: boom",
+	}, events)
+}
+
+func TestGetProviderSecretDataTimesOutBuildingTheClient(t *testing.T) {
+	defer fakeProvider.Reset()
+	fakeProvider.WithNew(func(ctx context.Context, _ esv1beta1.GenericStore, _ client.Client, _ string) (esv1beta1.SecretsClient, error) {
+		// a hung authentication endpoint
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, esv1beta1.AddToScheme(scheme))
+	store := &esv1beta1.SecretStore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "store",
+			Namespace: "default",
+		},
+		Spec: esv1beta1.SecretStoreSpec{
+			Timeout: &metav1.Duration{Duration: 50 * time.Millisecond},
+			Provider: &esv1beta1.SecretStoreProvider{
+				AWS: &esv1beta1.AWSProvider{
+					Service: esv1beta1.AWSServiceSecretsManager,
+				},
+			},
+		},
+	}
+	r := &Reconciler{
+		Client:   clientfake.NewClientBuilder().WithScheme(scheme).WithObjects(store).Build(),
+		recorder: record.NewFakeRecorder(10),
+	}
+	es := &esv1beta1.ExternalSecret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "es",
+			Namespace: "default",
+		},
+		Spec: esv1beta1.ExternalSecretSpec{
+			SecretStoreRef: esv1beta1.SecretStoreRef{Name: "store"},
+			Data: []esv1beta1.ExternalSecretData{
+				{SecretKey: "a", RemoteRef: esv1beta1.ExternalSecretDataRemoteRef{Key: "key-a"}},
+			},
+		},
+	}
+
+	_, _, _, err := r.getProviderSecretData(context.Background(), es)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestGetProviderSecretDataStopsAtStoreErrors(t *testing.T) {
+	defer fakeProvider.Reset()
+	calls := 0
+	fakeProvider.WithNew(func(context.Context, esv1beta1.GenericStore, client.Client, string) (esv1beta1.SecretsClient, error) {
+		calls++
+		return nil, errors.New("authentication failed")
+	})
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, esv1beta1.AddToScheme(scheme))
+	store := &esv1beta1.SecretStore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "store",
+			Namespace: "default",
+		},
+		Spec: esv1beta1.SecretStoreSpec{
+			Provider: &esv1beta1.SecretStoreProvider{
+				AWS: &esv1beta1.AWSProvider{
+					Service: esv1beta1.AWSServiceSecretsManager,
+				},
+			},
+		},
+	}
+	recorder := record.NewFakeRecorder(10)
+	r := &Reconciler{
+		Client:   clientfake.NewClientBuilder().WithScheme(scheme).WithObjects(store).Build(),
+		recorder: recorder,
+	}
+	es := &esv1beta1.ExternalSecret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "es",
+			Namespace: "default",
+		},
+		Spec: esv1beta1.ExternalSecretSpec{
+			SecretStoreRef: esv1beta1.SecretStoreRef{Name: "store"},
+			Data: []esv1beta1.ExternalSecretData{
+				{SecretKey: "a", RemoteRef: esv1beta1.ExternalSecretDataRemoteRef{Key: "key-a"}},
+				{SecretKey: "b", RemoteRef: esv1beta1.ExternalSecretDataRemoteRef{Key: "key-b"}},
+			},
+			DataFrom: []esv1beta1.ExternalSecretDataFromRemoteRef{
+				{Extract: &esv1beta1.ExternalSecretDataRemoteRef{Key: "key-c"}},
+			},
+		},
+	}
+
+	_, _, _, err := r.getProviderSecretData(context.Background(), es)
+	assert.EqualError(t, err, "authentication failed")
+	assert.Equal(t, 1, calls)
+
+	close(recorder.Events)
+	var events []string
+	for e := range recorder.Events {
+		events = append(events, e)
+	}
+	assert.Equal(t, []string{
+		"Warning FetchFailed failed to fetch .dataFrom[0] key=key-c: authentication failed",
+	}, events)
+}
+
+type metadataClient struct {
+	*fake.Client
+	metadata map[string]map[string]string
+}
+
+func (c *metadataClient) GetSecretMetadata(_ context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string]string, error) {
+	metadata, ok := c.metadata[ref.Key]
+	if !ok {
+		return nil, esv1beta1.NoSecretErr
+	}
+	return metadata, nil
+}
+
+func TestGetProviderSecretMetadata(t *testing.T) {
+	defer fakeProvider.Reset()
+	mc := &metadataClient{Client: fake.New(), metadata: map[string]map[string]string{
+		"key-a": {"team": "payments", "description": "database password"},
+		"key-c": {"team": "billing", "rotation": "30d"},
+	}}
+	fakeProvider.WithNew(func(context.Context, esv1beta1.GenericStore, client.Client, string) (esv1beta1.SecretsClient, error) {
+		return mc, nil
+	})
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, esv1beta1.AddToScheme(scheme))
+	store := &esv1beta1.SecretStore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "store",
+			Namespace: "default",
+		},
+		Spec: esv1beta1.SecretStoreSpec{
+			Provider: &esv1beta1.SecretStoreProvider{
+				AWS: &esv1beta1.AWSProvider{
+					Service: esv1beta1.AWSServiceSecretsManager,
+				},
+			},
+		},
+	}
+	r := &Reconciler{
+		Client:   clientfake.NewClientBuilder().WithScheme(scheme).WithObjects(store).Build(),
+		recorder: record.NewFakeRecorder(10),
+	}
+	es := &esv1beta1.ExternalSecret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "es",
+			Namespace: "default",
+		},
+		Spec: esv1beta1.ExternalSecretSpec{
+			SecretStoreRef: esv1beta1.SecretStoreRef{Name: "store"},
+			SyncMetadata:   true,
+			Data: []esv1beta1.ExternalSecretData{
+				{SecretKey: "a", RemoteRef: esv1beta1.ExternalSecretDataRemoteRef{Key: "key-a"}},
+				// secrets without metadata are skipped
+				{SecretKey: "b", RemoteRef: esv1beta1.ExternalSecretDataRemoteRef{Key: "key-b"}},
+			},
+			DataFrom: []esv1beta1.ExternalSecretDataFromRemoteRef{
+				{Extract: &esv1beta1.ExternalSecretDataRemoteRef{Key: "key-c"}},
+			},
+		},
+	}
+
+	metadata, err := r.getProviderSecretMetadata(context.Background(), es)
+	require.NoError(t, err)
+	// .data is fetched after .dataFrom and overrides its keys
+	assert.Equal(t, map[string]string{
+		"team":        "payments",
+		"description": "database password",
+		"rotation":    "30d",
+	}, metadata)
+
+	// stores which do not support metadata are skipped
+	fakeProvider.WithNew(func(context.Context, esv1beta1.GenericStore, client.Client, string) (esv1beta1.SecretsClient, error) {
+		return fake.New(), nil
+	})
+	metadata, err = r.getProviderSecretMetadata(context.Background(), es)
+	require.NoError(t, err)
+	assert.Empty(t, metadata)
+}
+
+func TestSetMetadataAnnotations(t *testing.T) {
+	secret := &v1.Secret{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+		"keep":                           "me",
+		"external-secrets.io/meta.stale": "old",
+	}}}
+	setMetadataAnnotations(secret, map[string]string{
+		"description":                   "database password",
+		"aws:cloudformation:stack-name": "db",
+		"team name":                     "payments",
+		"-":                             "invalid",
+	})
+	assert.Equal(t, map[string]string{
+		"keep":                                 "me",
+		"external-secrets.io/meta.description": "database password",
+		"external-secrets.io/meta.aws-cloudformation-stack-name": "db",
+		"external-secrets.io/meta.team-name":                     "payments",
+	}, secret.Annotations)
+
+	setMetadataAnnotations(secret, nil)
+	assert.Equal(t, map[string]string{"keep": "me"}, secret.Annotations)
+}
+
+func TestSetExpiryAnnotation(t *testing.T) {
+	secret := &v1.Secret{}
+	expiresAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+	setExpiryAnnotation(secret, expiresAt)
+	assert.Equal(t, map[string]string{"external-secrets.io/expires-at": "2024-05-01T10:00:00Z"}, secret.Annotations)
+
+	renewIn, expires := renewAfter(*secret)
+	assert.True(t, expires)
+	assert.Less(t, renewIn, time.Duration(0))
+
+	setExpiryAnnotation(secret, time.Now().Add(time.Hour))
+	renewIn, expires = renewAfter(*secret)
+	assert.True(t, expires)
+	assert.InDelta(t, time.Hour-expiryMargin, renewIn, float64(time.Second))
+
+	setExpiryAnnotation(secret, time.Time{})
+	assert.Empty(t, secret.Annotations)
+	_, expires = renewAfter(*secret)
+	assert.False(t, expires)
+}
+
+func TestSetLeasesAnnotation(t *testing.T) {
+	secret := &v1.Secret{}
+	setLeasesAnnotation(secret, []esv1beta1.ExternalSecretLease{
+		{ID: "database/creds/readonly/9c1d"},
+		{ID: "ldap/creds/dev/7a8b"},
+	})
+	assert.Equal(t, map[string]string{
+		"external-secrets.io/leases": "database/creds/readonly/9c1d,ldap/creds/dev/7a8b",
+	}, secret.Annotations)
+
+	setLeasesAnnotation(secret, nil)
+	assert.Empty(t, secret.Annotations)
+}
@@ -1170,6 +1170,61 @@ var _ = Describe("ExternalSecret controller", Serial, func() {
 		}
 	}
 
+	// leased credentials are renewed on refresh while their leases outlive the refresh interval
+	renewLeasedCredentials := func(tc *testCase) {
+		lc := &leaseClient{Client: fakeProvider, ttl: time.Hour}
+		lc.issue("lease-1")
+		fakeProvider.WithGetSecret([]byte("first"), nil)
+		fakeProvider.WithNew(func(context.Context, esv1beta1.GenericStore, client.Client, string) (esv1beta1.SecretsClient, error) {
+			return lc, nil
+		})
+		tc.externalSecret.Spec.RefreshInterval = &metav1.Duration{Duration: time.Second}
+		tc.checkSecret = func(es *esv1beta1.ExternalSecret, secret *v1.Secret) {
+			Expect(string(secret.Data[targetProp])).To(Equal("first"))
+
+			fakeProvider.WithGetSecret([]byte("second"), nil)
+			Eventually(func() []string {
+				renewed, _ := lc.state()
+				return renewed
+			}, timeout, interval).Should(ContainElement("lease-1"))
+
+			sec := &v1.Secret{}
+			Expect(k8sClient.Get(context.Background(), client.ObjectKeyFromObject(secret), sec)).To(Succeed())
+			Expect(string(sec.Data[targetProp])).To(Equal("first"))
+			_, revoked := lc.state()
+			Expect(revoked).To(BeEmpty())
+		}
+	}
+
+	// leased credentials are rotated once their leases expire before the next refresh,
+	// the old lease is only revoked after the secret holds the new credentials
+	rotateLeasedCredentials := func(tc *testCase) {
+		lc := &leaseClient{Client: fakeProvider, ttl: 0}
+		lc.issue("lease-1")
+		fakeProvider.WithGetSecret([]byte("first"), nil)
+		fakeProvider.WithNew(func(context.Context, esv1beta1.GenericStore, client.Client, string) (esv1beta1.SecretsClient, error) {
+			return lc, nil
+		})
+		tc.externalSecret.Spec.RefreshInterval = &metav1.Duration{Duration: time.Second}
+		tc.checkSecret = func(es *esv1beta1.ExternalSecret, secret *v1.Secret) {
+			Expect(string(secret.Data[targetProp])).To(Equal("first"))
+
+			fakeProvider.WithGetSecret([]byte("second"), nil)
+			lc.issue("lease-2")
+			sec := &v1.Secret{}
+			Eventually(func() string {
+				if err := k8sClient.Get(context.Background(), client.ObjectKeyFromObject(secret), sec); err != nil {
+					return ""
+				}
+				return string(sec.Data[targetProp])
+			}, timeout, interval).Should(Equal("second"))
+			Eventually(func() []string {
+				_, revoked := lc.state()
+				return revoked
+			}, timeout, interval).Should(ContainElement("lease-1"))
+		}
+	}
+
 	// when a provider secret was deleted it must be deleted from
 	// the secret aswell
 	refreshSecretValueMap := func(tc *testCase) {
@@ -1253,6 +1308,58 @@ var _ = Describe("ExternalSecret controller", Serial, func() {
 		}
 	}
 
+	// syncing stops while the paused annotation is set and resumes once it is removed
+	pauseAndResume := func(tc *testCase) {
+		const targetProp = "targetProperty"
+		const secretVal = "someValue"
+		fakeProvider.WithGetSecret([]byte(secretVal), nil)
+		tc.externalSecret.Spec.RefreshInterval = &metav1.Duration{Duration: time.Second}
+		tc.checkSecret = func(es *esv1beta1.ExternalSecret, secret *v1.Secret) {
+			Expect(string(secret.Data[targetProp])).To(Equal(secretVal))
+			ctx := context.Background()
+			esKey := types.NamespacedName{Name: es.Name, Namespace: es.Namespace}
+			setPaused := func(paused string) {
+				Eventually(func() error {
+					var current esv1beta1.ExternalSecret
+					if err := k8sClient.Get(ctx, esKey, &current); err != nil {
+						return err
+					}
+					if current.Annotations == nil {
+						current.Annotations = map[string]string{}
+					}
+					current.Annotations[esv1beta1.AnnotationPaused] = paused
+					return k8sClient.Update(ctx, &current)
+				}, timeout, interval).Should(Succeed())
+			}
+			isPausedCondition := func() bool {
+				var current esv1beta1.ExternalSecret
+				if err := k8sClient.Get(ctx, esKey, &current); err != nil {
+					return false
+				}
+				cond := GetExternalSecretCondition(current.Status, esv1beta1.ExternalSecretPaused)
+				return cond != nil && cond.Status == v1.ConditionTrue && cond.Reason == esv1beta1.ConditionReasonSyncPaused
+			}
+			secretValue := func() string {
+				sec := &v1.Secret{}
+				err := k8sClient.Get(ctx, types.NamespacedName{Name: ExternalSecretTargetSecretName, Namespace: ExternalSecretNamespace}, sec)
+				if err != nil {
+					return ""
+				}
+				return string(sec.Data[targetProp])
+			}
+
+			setPaused("true")
+			Eventually(isPausedCondition, timeout, interval).Should(BeTrue())
+			const newValue = "NEW VALUE"
+			fakeProvider.WithGetSecret([]byte(newValue), nil)
+			Consistently(secretValue, time.Second*5, time.Second).Should(Equal(secretVal))
+
+			setPaused("false")
+			Eventually(secretValue, timeout, interval).Should(Equal(newValue))
+			Eventually(isPausedCondition, timeout, interval).Should(BeFalse())
+		}
+	}
+
 	refreshintervalZero := func(tc *testCase) {
 		const targetProp = "targetProperty"
 		const secretVal = "someValue"
@@ -1720,6 +1827,32 @@ var _ = Describe("ExternalSecret controller", Serial, func() {
 		}
 	}
 
+	// when a provider call exceeds the store timeout
+	// the call is cancelled and an error condition must be set.
+	providerTimeoutErrCondition := func(tc *testCase) {
+		tc.secretStore.GetSpec().Timeout = &metav1.Duration{Duration: time.Millisecond * 100}
+		fc := fake.New()
+		fc.GetSecretFn = func(ctx context.Context, _ esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		fakeProvider.WithNew(func(context.Context, esv1beta1.GenericStore, client.Client, string) (esv1beta1.SecretsClient, error) {
+			return fc, nil
+		})
+		tc.checkCondition = func(es *esv1beta1.ExternalSecret) bool {
+			cond := GetExternalSecretCondition(es.Status, esv1beta1.ExternalSecretReady)
+			if cond == nil || cond.Status != v1.ConditionFalse || cond.Reason != esv1beta1.ConditionReasonSecretSyncedError {
+				return false
+			}
+			return true
+		}
+		tc.checkExternalSecret = func(es *esv1beta1.ExternalSecret) {
+			cond := GetExternalSecretCondition(es.Status, esv1beta1.ExternalSecretReady)
+			Expect(cond.Message).To(Equal(errGetSecretData))
+			Expect(externalSecretConditionShouldBe(ExternalSecretName, ExternalSecretNamespace, esv1beta1.ExternalSecretReady, v1.ConditionFalse, 1.0)).To(BeTrue())
+		}
+	}
+
 	// when a provider errors in a GetSecret call
 	// a error condition must be set.
 	providerErrCondition := func(tc *testCase) {
@@ -2277,9 +2410,12 @@ var _ = Describe("ExternalSecret controller", Serial, func() {
 		Entry("should refresh secret from template", refreshWithTemplate),
 		Entry("should be able to use only metadata from template", onlyMetadataFromTemplate),
 		Entry("should refresh secret value when provider secret changes", refreshSecretValue),
+		Entry("should renew leased credentials instead of rotating them", renewLeasedCredentials),
+		Entry("should rotate leased credentials once their leases expire", rotateLeasedCredentials),
 		Entry("should refresh secret map when provider secret changes", refreshSecretValueMap),
 		Entry("should refresh secret map when provider secret changes when using a template", refreshSecretValueMapTemplate),
 		Entry("should not refresh secret value when provider secret changes but refreshInterval is zero", refreshintervalZero),
+		Entry("should not refresh secret value while syncing is paused", pauseAndResume),
 		Entry("should fetch secret using dataFrom", syncWithDataFrom),
 		Entry("should rewrite secret using dataFrom", syncAndRewriteWithDataFrom),
 		Entry("should not automatically convert from extract if rewrite is used", invalidExtractKeysErrCondition),
@@ -2288,6 +2424,7 @@ var _ = Describe("ExternalSecret controller", Serial, func() {
 		Entry("should not automatically convert from find if rewrite is used", invalidFindKeysErrCondition),
 		Entry("should fetch secret using dataFrom and a template", syncWithDataFromTemplate),
 		Entry("should set error condition when provider errors", providerErrCondition),
+		Entry("should set error condition when a provider call times out", providerTimeoutErrCondition),
 		Entry("should set an error condition when store does not exist", storeMissingErrCondition),
 		Entry("should set an error condition when store provider constructor fails", storeConstructErrCondition),
 		Entry("should not process store with mismatching controller field", ignoreMismatchController),
@@ -0,0 +1,221 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package testsupport allows to exercise the ExternalSecret reconcile loop
+// in unit tests without envtest or a running cluster.
+package testsupport
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	"github.com/external-secrets/external-secrets/pkg/controllers/externalsecret"
+	"github.com/external-secrets/external-secrets/pkg/controllers/externalsecret/esmetrics"
+	ctrlmetrics "github.com/external-secrets/external-secrets/pkg/controllers/metrics"
+
+	// Loading the fake provider backing the store.
+	_ "github.com/external-secrets/external-secrets/pkg/provider/fake"
+)
+
+// StoreName is the name of the ClusterSecretStore created by the FakeReconciler.
+const StoreName = "fake"
+
+// defaultRefreshInterval mirrors the default set on the CRD.
+const defaultRefreshInterval = time.Hour
+
+var setUpMetrics sync.Once
+
+// FakeReconciler runs the ExternalSecret reconciler against an in-memory
+// Kubernetes client and a ClusterSecretStore backed by the fake provider.
+// Admission webhooks are not involved: objects are stored as they are passed in.
+type FakeReconciler struct {
+	// Client is the in-memory client used by the reconciler.
+	Client client.Client
+	// Recorder collects the events emitted by the reconciler.
+	Recorder *record.FakeRecorder
+
+	t          testing.TB
+	reconciler *externalsecret.Reconciler
+	lastSynced *corev1.Secret
+}
+
+// NewFakeReconciler returns a FakeReconciler. The given objects are added
+// to the client next to the fake ClusterSecretStore.
+func NewFakeReconciler(t testing.TB, objs ...client.Object) *FakeReconciler {
+	t.Helper()
+	setUpMetrics.Do(func() {
+		ctrlmetrics.SetUpLabelNames(false)
+		esmetrics.SetUpMetrics()
+	})
+
+	scheme := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{
+		clientgoscheme.AddToScheme,
+		esv1beta1.AddToScheme,
+		genv1alpha1.AddToScheme,
+	} {
+		if err := add(scheme); err != nil {
+			t.Fatalf("unable to build scheme: %v", err)
+		}
+	}
+
+	store := &esv1beta1.ClusterSecretStore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: StoreName,
+		},
+		Spec: esv1beta1.SecretStoreSpec{
+			Provider: &esv1beta1.SecretStoreProvider{
+				Fake: &esv1beta1.FakeProvider{},
+			},
+		},
+	}
+	cl := clientfake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(append(objs, store)...).
+		WithStatusSubresource(&esv1beta1.ExternalSecret{}, &esv1beta1.SecretStore{}, &esv1beta1.ClusterSecretStore{}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			// the fake client does not support getting the status subresource,
+			// which is part of the object anyway.
+			SubResourceGet: func(ctx context.Context, c client.Client, _ string, obj, subResource client.Object, _ ...client.SubResourceGetOption) error {
+				return c.Get(ctx, client.ObjectKeyFromObject(obj), subResource)
+			},
+		}).
+		Build()
+
+	recorder := record.NewFakeRecorder(100)
+	r := &externalsecret.Reconciler{
+		Client:                    cl,
+		Log:                       log.Log.WithName("testsupport"),
+		Scheme:                    scheme,
+		RequeueInterval:           defaultRefreshInterval,
+		ClusterSecretStoreEnabled: true,
+	}
+	r.SetRecorder(recorder)
+
+	return &FakeReconciler{
+		Client:     cl,
+		Recorder:   recorder,
+		t:          t,
+		reconciler: r,
+	}
+}
+
+// StoreRef returns a reference to the fake ClusterSecretStore.
+func (f *FakeReconciler) StoreRef() esv1beta1.SecretStoreRef {
+	return esv1beta1.SecretStoreRef{
+		Name: StoreName,
+		Kind: esv1beta1.ClusterSecretStoreKind,
+	}
+}
+
+// SetSecret adds or replaces a secret in the fake ClusterSecretStore.
+func (f *FakeReconciler) SetSecret(key, value string) {
+	f.t.Helper()
+	ctx := context.Background()
+	var store esv1beta1.ClusterSecretStore
+	if err := f.Client.Get(ctx, types.NamespacedName{Name: StoreName}, &store); err != nil {
+		f.t.Fatalf("unable to get store: %v", err)
+	}
+	data := store.Spec.Provider.Fake.Data[:0]
+	for _, d := range store.Spec.Provider.Fake.Data {
+		if d.Key != key {
+			data = append(data, d)
+		}
+	}
+	store.Spec.Provider.Fake.Data = append(data, esv1beta1.FakeProviderData{Key: key, Value: value})
+	if err := f.Client.Update(ctx, &store); err != nil {
+		f.t.Fatalf("unable to update store: %v", err)
+	}
+}
+
+// Reconcile creates or updates the ExternalSecret and runs a single reconciliation for it.
+// The target secret, if any, is available through LastSyncedSecret afterwards.
+func (f *FakeReconciler) Reconcile(es esv1beta1.ExternalSecret) (ctrl.Result, error) {
+	f.t.Helper()
+	ctx := context.Background()
+	es = *es.DeepCopy()
+	// the fake client does not apply the defaults of the CRD
+	if es.Spec.RefreshInterval == nil {
+		es.Spec.RefreshInterval = &metav1.Duration{Duration: defaultRefreshInterval}
+	}
+	if es.Spec.Target.DeletionPolicy == "" {
+		es.Spec.Target.DeletionPolicy = esv1beta1.DeletionPolicyRetain
+	}
+
+	key := types.NamespacedName{Name: es.Name, Namespace: es.Namespace}
+	var existing esv1beta1.ExternalSecret
+	err := f.Client.Get(ctx, key, &existing)
+	switch {
+	case apierrors.IsNotFound(err):
+		es.ResourceVersion = ""
+		err = f.Client.Create(ctx, &es)
+	case err == nil:
+		es.ResourceVersion = existing.ResourceVersion
+		err = f.Client.Update(ctx, &es)
+	}
+	if err != nil {
+		f.t.Fatalf("unable to store ExternalSecret: %v", err)
+	}
+
+	res, err := f.reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+
+	secretName := es.Spec.Target.Name
+	if secretName == "" {
+		secretName = es.Name
+	}
+	var secret corev1.Secret
+	getErr := f.Client.Get(ctx, types.NamespacedName{Name: secretName, Namespace: es.Namespace}, &secret)
+	switch {
+	case apierrors.IsNotFound(getErr):
+		f.lastSynced = nil
+	case getErr != nil:
+		f.t.Fatalf("unable to get target secret: %v", getErr)
+	default:
+		f.lastSynced = &secret
+	}
+	return res, err
+}
+
+// LastSyncedSecret returns the target secret as observed after the last call to Reconcile,
+// or nil if it does not exist.
+func (f *FakeReconciler) LastSyncedSecret() *corev1.Secret {
+	return f.lastSynced
+}
+
+// ExternalSecret returns the current state of the ExternalSecret, including its status.
+func (f *FakeReconciler) ExternalSecret(namespace, name string) *esv1beta1.ExternalSecret {
+	f.t.Helper()
+	var es esv1beta1.ExternalSecret
+	if err := f.Client.Get(context.Background(), types.NamespacedName{Name: name, Namespace: namespace}, &es); err != nil {
+		f.t.Fatalf("unable to get ExternalSecret: %v", err)
+	}
+	return &es
+}
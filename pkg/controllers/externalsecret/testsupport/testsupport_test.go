@@ -0,0 +1,81 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testsupport
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+func newExternalSecret(f *FakeReconciler) esv1beta1.ExternalSecret {
+	return esv1beta1.ExternalSecret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "es",
+			Namespace: "default",
+		},
+		Spec: esv1beta1.ExternalSecretSpec{
+			SecretStoreRef: f.StoreRef(),
+			Target: esv1beta1.ExternalSecretTarget{
+				Name:           "target",
+				CreationPolicy: esv1beta1.CreatePolicyOwner,
+			},
+			Data: []esv1beta1.ExternalSecretData{
+				{
+					SecretKey: "password",
+					RemoteRef: esv1beta1.ExternalSecretDataRemoteRef{Key: "/db/password"},
+				},
+			},
+		},
+	}
+}
+
+func TestFakeReconcilerSyncsSecret(t *testing.T) {
+	f := NewFakeReconciler(t)
+	f.SetSecret("/db/password", "s3cr3t")
+
+	res, err := f.Reconcile(newExternalSecret(f))
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, res.RequeueAfter)
+
+	secret := f.LastSyncedSecret()
+	require.NotNil(t, secret)
+	assert.Equal(t, "s3cr3t", string(secret.Data["password"]))
+
+	es := f.ExternalSecret("default", "es")
+	require.NotEmpty(t, es.Status.Conditions)
+	assert.Equal(t, esv1beta1.ConditionReasonSecretSynced, es.Status.Conditions[0].Reason)
+
+	// a new value is picked up once the ExternalSecret changes
+	f.SetSecret("/db/password", "rotated")
+	updated := newExternalSecret(f)
+	updated.Labels = map[string]string{"rotate": "true"}
+	_, err = f.Reconcile(updated)
+	require.NoError(t, err)
+	assert.Equal(t, "rotated", string(f.LastSyncedSecret().Data["password"]))
+}
+
+func TestFakeReconcilerMissingSecret(t *testing.T) {
+	f := NewFakeReconciler(t)
+
+	_, err := f.Reconcile(newExternalSecret(f))
+	assert.Error(t, err)
+	assert.Nil(t, f.LastSyncedSecret())
+}
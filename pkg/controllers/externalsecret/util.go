@@ -68,6 +68,16 @@ func SetExternalSecretCondition(es *esv1beta1.ExternalSecret, condition esv1beta
 	esmetrics.UpdateExternalSecretCondition(es, &condition, 1.0)
 }
 
+// RemoveExternalSecretCondition removes the condition with the provided type from the external secret.
+func RemoveExternalSecretCondition(es *esv1beta1.ExternalSecret, condType esv1beta1.ExternalSecretConditionType) {
+	currentCond := GetExternalSecretCondition(es.Status, condType)
+	if currentCond == nil {
+		return
+	}
+	es.Status.Conditions = filterOutCondition(es.Status.Conditions, condType)
+	esmetrics.UpdateExternalSecretCondition(es, currentCond, 0.0)
+}
+
 // filterOutCondition returns an empty set of conditions with the provided type.
 func filterOutCondition(conditions []esv1beta1.ExternalSecretStatusCondition, condType esv1beta1.ExternalSecretConditionType) []esv1beta1.ExternalSecretStatusCondition {
 	newConditions := make([]esv1beta1.ExternalSecretStatusCondition, 0, len(conditions))
@@ -223,3 +223,30 @@ func TestSetExternalSecretCondition(t *testing.T) {
 		})
 	}
 }
+
+func TestRemoveExternalSecretCondition(t *testing.T) {
+	ready := esv1beta1.ExternalSecretStatusCondition{
+		Type:   esv1beta1.ExternalSecretReady,
+		Status: corev1.ConditionTrue,
+	}
+	paused := esv1beta1.ExternalSecretStatusCondition{
+		Type:   esv1beta1.ExternalSecretPaused,
+		Status: corev1.ConditionTrue,
+	}
+	es := &esv1beta1.ExternalSecret{
+		Status: esv1beta1.ExternalSecretStatus{
+			Conditions: []esv1beta1.ExternalSecretStatusCondition{ready, paused},
+		},
+	}
+
+	RemoveExternalSecretCondition(es, esv1beta1.ExternalSecretPaused)
+	if diff := cmp.Diff([]esv1beta1.ExternalSecretStatusCondition{ready}, es.Status.Conditions); diff != "" {
+		t.Errorf("(-got, +want)\n%s", diff)
+	}
+
+	// removing a missing condition is a no-op
+	RemoveExternalSecretCondition(es, esv1beta1.ExternalSecretPaused)
+	if diff := cmp.Diff([]esv1beta1.ExternalSecretStatusCondition{ready}, es.Status.Conditions); diff != "" {
+		t.Errorf("(-got, +want)\n%s", diff)
+	}
+}
@@ -51,6 +51,9 @@ type Manager struct {
 
 	// store clients by provider type
 	clientMap map[clientKey]*clientVal
+
+	// leases issued by clients that have been closed already
+	leases []esv1beta1.ExternalSecretLease
 }
 
 type clientKey struct {
@@ -105,6 +108,16 @@ func (m *Manager) GetFromStore(ctx context.Context, store esv1beta1.GenericStore
 // Do not close the client returned from this func, instead close
 // the manager once you're done with recinciling the external secret.
 func (m *Manager) Get(ctx context.Context, storeRef esv1beta1.SecretStoreRef, namespace string, sourceRef *esv1beta1.StoreGeneratorSourceRef) (esv1beta1.SecretsClient, error) {
+	store, err := m.ResolveStore(ctx, storeRef, namespace, sourceRef)
+	if err != nil {
+		return nil, err
+	}
+	return m.GetFromStore(ctx, store, namespace)
+}
+
+// ResolveStore returns the store from the given storeRef or sourceRef.secretStoreRef
+// once it has been verified that it can be used from the given namespace.
+func (m *Manager) ResolveStore(ctx context.Context, storeRef esv1beta1.SecretStoreRef, namespace string, sourceRef *esv1beta1.StoreGeneratorSourceRef) (esv1beta1.GenericStore, error) {
 	if sourceRef != nil && sourceRef.SecretStoreRef != nil {
 		storeRef = *sourceRef.SecretStoreRef
 	}
@@ -131,7 +144,7 @@ func (m *Manager) Get(ctx context.Context, storeRef esv1beta1.SecretStoreRef, na
 			return nil, err
 		}
 	}
-	return m.GetFromStore(ctx, store, namespace)
+	return store, nil
 }
 
 // returns a previously stored client from the cache if store and store-version match
@@ -159,6 +172,7 @@ func (m *Manager) getStoredClient(ctx context.Context, storeProvider esv1beta1.P
 		"store", storeName)
 	// if we have a client, but it points to a different store
 	// we must clean it up
+	m.collectLeases(val)
 	val.client.Close(ctx)
 	delete(m.clientMap, idx)
 	return nil
@@ -193,6 +207,32 @@ func (m *Manager) getStore(ctx context.Context, storeRef *esv1beta1.SecretStoreR
 	return &store, nil
 }
 
+// Leases returns the leases issued by the clients of the manager since the last call.
+func (m *Manager) Leases() []esv1beta1.ExternalSecretLease {
+	for _, val := range m.clientMap {
+		m.collectLeases(val)
+	}
+	leases := m.leases
+	m.leases = nil
+	return leases
+}
+
+func (m *Manager) collectLeases(val *clientVal) {
+	leaseClient, ok := val.client.(esv1beta1.LeaseClient)
+	if !ok {
+		return
+	}
+	for _, id := range leaseClient.IssuedLeases() {
+		m.leases = append(m.leases, esv1beta1.ExternalSecretLease{
+			StoreRef: esv1beta1.SecretStoreRef{
+				Name: val.store.GetName(),
+				Kind: val.store.GetKind(),
+			},
+			ID: id,
+		})
+	}
+}
+
 // Close cleans up all clients.
 func (m *Manager) Close(ctx context.Context) error {
 	var errs []string
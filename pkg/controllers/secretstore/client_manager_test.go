@@ -17,6 +17,7 @@ package secretstore
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
@@ -465,3 +466,61 @@ func (c *MockFakeClient) Close(_ context.Context) error {
 	c.closeCalled = true
 	return nil
 }
+
+type MockLeaseClient struct {
+	MockFakeClient
+	leases []string
+}
+
+func (c *MockLeaseClient) IssuedLeases() []string {
+	leases := c.leases
+	c.leases = nil
+	return leases
+}
+
+func (c *MockLeaseClient) RenewLease(_ context.Context, _ string, increment time.Duration) (time.Duration, error) {
+	return increment, nil
+}
+
+func (c *MockLeaseClient) RevokeLease(_ context.Context, _ string) error {
+	return nil
+}
+
+func TestManagerLeases(t *testing.T) {
+	storeA := &esv1beta1.SecretStore{
+		TypeMeta:   metav1.TypeMeta{Kind: esv1beta1.SecretStoreKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "foo"},
+	}
+	storeB := &esv1beta1.ClusterSecretStore{
+		TypeMeta:   metav1.TypeMeta{Kind: esv1beta1.ClusterSecretStoreKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "b"},
+	}
+	clientA := &MockLeaseClient{leases: []string{"ldap/creds/a/1"}}
+	clientB := &MockLeaseClient{leases: []string{"ldap/creds/b/1", "ldap/creds/b/2"}}
+	mgr := &Manager{
+		log: logr.Discard(),
+		clientMap: map[clientKey]*clientVal{
+			{providerType: "a"}: {client: clientA, store: storeA},
+			{providerType: "b"}: {client: clientB, store: storeB},
+			{providerType: "c"}: {client: &MockFakeClient{}, store: storeA},
+		},
+	}
+
+	assert.ElementsMatch(t, []esv1beta1.ExternalSecretLease{
+		{StoreRef: esv1beta1.SecretStoreRef{Name: "a", Kind: esv1beta1.SecretStoreKind}, ID: "ldap/creds/a/1"},
+		{StoreRef: esv1beta1.SecretStoreRef{Name: "b", Kind: esv1beta1.ClusterSecretStoreKind}, ID: "ldap/creds/b/1"},
+		{StoreRef: esv1beta1.SecretStoreRef{Name: "b", Kind: esv1beta1.ClusterSecretStoreKind}, ID: "ldap/creds/b/2"},
+	}, mgr.Leases())
+	assert.Empty(t, mgr.Leases())
+
+	// leases of clients that are cleaned up due to a store switch are kept
+	clientA.leases = []string{"ldap/creds/a/2"}
+	mgr.clientMap = map[clientKey]*clientVal{
+		storeKey(&WrapProvider{}): {client: clientA, store: storeA},
+	}
+	assert.Nil(t, mgr.getStoredClient(context.Background(), &WrapProvider{}, storeB))
+	assert.True(t, clientA.closeCalled)
+	assert.Equal(t, []esv1beta1.ExternalSecretLease{
+		{StoreRef: esv1beta1.SecretStoreRef{Name: "a", Kind: esv1beta1.SecretStoreKind}, ID: "ldap/creds/a/2"},
+	}, mgr.Leases())
+}
@@ -16,11 +16,13 @@ package secretstore
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/go-logr/logr"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -34,6 +36,7 @@ const (
 	errStoreClient         = "could not get provider client: %w"
 	errValidationFailed    = "could not validate provider: %w"
 	errPatchStatus         = "unable to patch status: %w"
+	errPatchActiveCluster  = "unable to record the active cluster"
 	errUnableCreateClient  = "unable to create client"
 	errUnableValidateStore = "unable to validate store"
 	errUnableGetProvider   = "unable to get store provider"
@@ -64,7 +67,7 @@ func reconcile(ctx context.Context, req ctrl.Request, ss esapi.GenericStore, cl
 	// validateStore modifies the store conditions
 	// we have to patch the status
 	log.V(1).Info("validating")
-	err := validateStore(ctx, req.Namespace, controllerClass, ss, cl, gaugeVecGetter, recorder)
+	err := validateStore(ctx, req.Namespace, controllerClass, ss, cl, log, gaugeVecGetter, recorder)
 	if err != nil {
 		log.Error(err, "unable to validate store")
 		return ctrl.Result{}, err
@@ -91,7 +94,7 @@ func reconcile(ctx context.Context, req ctrl.Request, ss esapi.GenericStore, cl
 // validateStore tries to construct a new client
 // if it fails sets a condition and writes events.
 func validateStore(ctx context.Context, namespace, controllerClass string, store esapi.GenericStore,
-	client client.Client, gaugeVecGetter metrics.GaugeVevGetter, recorder record.EventRecorder) error {
+	client client.Client, log logr.Logger, gaugeVecGetter metrics.GaugeVevGetter, recorder record.EventRecorder) error {
 	mgr := NewManager(client, controllerClass, false)
 	defer mgr.Close(ctx)
 	cl, err := mgr.GetFromStore(ctx, store, namespace)
@@ -109,9 +112,39 @@ func validateStore(ctx context.Context, namespace, controllerClass string, store
 		return fmt.Errorf(errValidationFailed, err)
 	}
 
+	if cc, ok := cl.(clusterClient); ok && cc.ActiveCluster() != "" {
+		if err := setActiveCluster(ctx, client, store, cc.ActiveCluster()); err != nil {
+			// the store can be used without the annotation
+			log.Error(err, errPatchActiveCluster)
+		}
+	}
+
 	return nil
 }
 
+// clusterClient is implemented by the clients of providers failing over between clusters.
+type clusterClient interface {
+	// ActiveCluster returns the address of the cluster in use.
+	ActiveCluster() string
+}
+
+// setActiveCluster records the address of the cluster in use in an annotation of the store.
+func setActiveCluster(ctx context.Context, cl client.Client, store esapi.GenericStore, address string) error {
+	if store.GetAnnotations()[esapi.AnnotationVaultActiveCluster] == address {
+		return nil
+	}
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{esapi.AnnotationVaultActiveCluster: address},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	// the store is not modified, its status is patched from its original version
+	return cl.Patch(ctx, store.Copy(), client.RawPatch(types.MergePatchType, patch))
+}
+
 // ShouldProcessStore returns true if the store should be processed.
 func ShouldProcessStore(store esapi.GenericStore, class string) bool {
 	if store == nil || store.GetSpec().Controller == "" || store.GetSpec().Controller == class {
@@ -17,8 +17,13 @@ package webhookconfig
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
+	"io/fs"
 	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 	"time"
@@ -27,7 +32,9 @@ import (
 	admissionregistration "k8s.io/api/admissionregistration/v1"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -37,6 +44,8 @@ import (
 	"github.com/external-secrets/external-secrets/pkg/constants"
 )
 
+// +kubebuilder:rbac:groups=apiregistration.k8s.io,resources=apiservices,verbs=get;list;watch;update
+
 type Reconciler struct {
 	client.Client
 	Log             logr.Logger
@@ -47,6 +56,11 @@ type Reconciler struct {
 	SvcNamespace    string
 	SecretName      string
 	SecretNamespace string
+	// CertDir is read for the CA bundle instead of the secret if it is set,
+	// e.g. when the certificates are managed outside of the cert controller.
+	CertDir string
+	// InjectAPIServices enables the injection of the ca cert into APIServices.
+	InjectAPIServices bool
 
 	// store state for the readiness probe.
 	// we're ready when we're not the leader or
@@ -76,6 +90,13 @@ func New(k8sClient client.Client, scheme *runtime.Scheme, leaderChan <-chan stru
 	}
 }
 
+var (
+	validatingWebhookGVK = admissionregistration.SchemeGroupVersion.WithKind("ValidatingWebhookConfiguration")
+	mutatingWebhookGVK   = admissionregistration.SchemeGroupVersion.WithKind("MutatingWebhookConfiguration")
+	// APIServiceGVK is the kind of the APIServices, they are handled as unstructured objects.
+	APIServiceGVK = schema.GroupVersionKind{Group: "apiregistration.k8s.io", Version: "v1", Kind: "APIService"}
+)
+
 const (
 	ReasonUpdateFailed   = "UpdateFailed"
 	errWebhookNotReady   = "webhook not ready"
@@ -87,48 +108,96 @@ const (
 )
 
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	updated, res, err := r.reconcileConfig(ctx, req, &admissionregistration.ValidatingWebhookConfiguration{}, r.updateValidatingWebhook)
+	if !updated {
+		return res, err
+	}
+
+	// right now we only have one single
+	// webhook config we care about
+	r.webhookReadyMu.Lock()
+	defer r.webhookReadyMu.Unlock()
+	r.webhookReady = true
+	return res, nil
+}
+
+// mutatingReconciler injects the ca cert into the MutatingWebhookConfigurations.
+// They do not take part in the readiness check, as the chart does not install any.
+type mutatingReconciler struct {
+	*Reconciler
+}
+
+func (r mutatingReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	_, res, err := r.reconcileConfig(ctx, req, &admissionregistration.MutatingWebhookConfiguration{}, r.updateMutatingWebhook)
+	return res, err
+}
+
+// apiServiceReconciler injects the ca cert into the APIServices.
+type apiServiceReconciler struct {
+	*Reconciler
+}
+
+func (r apiServiceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	apiService := &unstructured.Unstructured{}
+	apiService.SetGroupVersionKind(APIServiceGVK)
+	_, res, err := r.reconcileConfig(ctx, req, apiService, r.updateAPIService)
+	return res, err
+}
+
+// reconcileConfig updates the webhook config cfg of req and returns true if it has been updated.
+func (r *Reconciler) reconcileConfig(ctx context.Context, req ctrl.Request, cfg client.Object,
+	update func(context.Context, client.Object) error) (bool, ctrl.Result, error) {
 	log := r.Log.WithValues("Webhookconfig", req.NamespacedName)
-	var cfg admissionregistration.ValidatingWebhookConfiguration
-	err := r.Get(ctx, req.NamespacedName, &cfg)
+	err := r.Get(ctx, req.NamespacedName, cfg)
 	if apierrors.IsNotFound(err) {
-		return ctrl.Result{}, nil
+		return false, ctrl.Result{}, nil
 	} else if err != nil {
 		log.Error(err, "unable to get Webhookconfig")
-		return ctrl.Result{}, err
+		return false, ctrl.Result{}, err
 	}
 
-	if cfg.Labels[constants.WellKnownLabelKey] != constants.WellKnownLabelValueWebhook {
+	if cfg.GetLabels()[constants.WellKnownLabelKey] != constants.WellKnownLabelValueWebhook {
 		log.Info("ignoring webhook due to missing labels", constants.WellKnownLabelKey, constants.WellKnownLabelValueWebhook)
-		return ctrl.Result{}, nil
+		return false, ctrl.Result{}, nil
 	}
 
 	log.Info("updating webhook config")
-	err = r.updateConfig(ctx, &cfg)
+	err = update(ctx, cfg)
 	if err != nil {
 		log.Error(err, "could not update webhook config")
-		r.recorder.Eventf(&cfg, v1.EventTypeWarning, ReasonUpdateFailed, err.Error())
-		return ctrl.Result{
+		r.recorder.Eventf(cfg, v1.EventTypeWarning, ReasonUpdateFailed, err.Error())
+		return false, ctrl.Result{
 			RequeueAfter: time.Minute,
 		}, err
 	}
 	log.Info("updated webhook config")
-
-	// right now we only have one single
-	// webhook config we care about
-	r.webhookReadyMu.Lock()
-	defer r.webhookReadyMu.Unlock()
-	r.webhookReady = true
-	return ctrl.Result{
+	return true, ctrl.Result{
 		RequeueAfter: r.RequeueDuration,
 	}, nil
 }
 
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, opts controller.Options) error {
 	r.recorder = mgr.GetEventRecorderFor("validating-webhook-configuration")
-	return ctrl.NewControllerManagedBy(mgr).
+	err := ctrl.NewControllerManagedBy(mgr).
 		WithOptions(opts).
 		For(&admissionregistration.ValidatingWebhookConfiguration{}).
 		Complete(r)
+	if err != nil {
+		return err
+	}
+	err = ctrl.NewControllerManagedBy(mgr).
+		WithOptions(opts).
+		For(&admissionregistration.MutatingWebhookConfiguration{}).
+		Complete(mutatingReconciler{r})
+	if err != nil || !r.InjectAPIServices {
+		return err
+	}
+	apiService := &unstructured.Unstructured{}
+	apiService.SetGroupVersionKind(APIServiceGVK)
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(opts).
+		For(apiService).
+		Complete(apiServiceReconciler{r})
 }
 
 func (r *Reconciler) ReadyCheck(_ *http.Request) error {
@@ -164,39 +233,137 @@ func (r *Reconciler) ReadyCheck(_ *http.Request) error {
 	return nil
 }
 
+func (r *Reconciler) updateValidatingWebhook(ctx context.Context, cfg client.Object) error {
+	return r.updateConfig(ctx, cfg, validatingWebhookGVK)
+}
+
+func (r *Reconciler) updateMutatingWebhook(ctx context.Context, cfg client.Object) error {
+	return r.updateConfig(ctx, cfg, mutatingWebhookGVK)
+}
+
+// updateAPIService reads the ca cert and updates the APIService.
+func (r *Reconciler) updateAPIService(ctx context.Context, cfg client.Object) error {
+	crt, err := r.caCert(ctx)
+	if err != nil {
+		return err
+	}
+	apiService, ok := cfg.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expected an unstructured APIService, got %T", cfg)
+	}
+	r.Log.Info("injecting ca certificate and service name", "cacrt", base64.StdEncoding.EncodeToString(crt), "name", cfg.GetName())
+	svc := types.NamespacedName{
+		Name:      r.SvcName,
+		Namespace: r.SvcNamespace,
+	}
+	if err := injectCertToAPIService(apiService, svc, crt); err != nil {
+		return err
+	}
+	return r.Update(ctx, apiService)
+}
+
 // reads the ca cert and updates the webhook config.
-func (r *Reconciler) updateConfig(ctx context.Context, cfg *admissionregistration.ValidatingWebhookConfiguration) error {
+func (r *Reconciler) updateConfig(ctx context.Context, cfg client.Object, gvk schema.GroupVersionKind) error {
+	crt, err := r.caCert(ctx)
+	if err != nil {
+		return err
+	}
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cfg)
+	if err != nil {
+		return err
+	}
+	obj := &unstructured.Unstructured{Object: u}
+	obj.SetGroupVersionKind(gvk)
+	r.Log.Info("injecting ca certificate and service names", "cacrt", base64.StdEncoding.EncodeToString(crt), "name", cfg.GetName())
+	svc := types.NamespacedName{
+		Name:      r.SvcName,
+		Namespace: r.SvcNamespace,
+	}
+	if err := injectCertToWebhookConfig(obj, gvk, []string{"clientConfig"}, svc, crt); err != nil {
+		return err
+	}
+	return r.Update(ctx, obj)
+}
+
+// caCert reads the ca cert from CertDir if it is set, and from the secret otherwise.
+func (r *Reconciler) caCert(ctx context.Context) ([]byte, error) {
+	if r.CertDir != "" {
+		crt, err := os.ReadFile(filepath.Join(r.CertDir, caCertName))
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf(errCACertNotReady)
+		}
+		return crt, err
+	}
 	secret := v1.Secret{}
 	secretName := types.NamespacedName{
 		Name:      r.SecretName,
 		Namespace: r.SecretNamespace,
 	}
-	err := r.Get(context.Background(), secretName, &secret)
+	err := r.Get(ctx, secretName, &secret)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
 	crt, ok := secret.Data[caCertName]
 	if !ok {
-		return fmt.Errorf(errCACertNotReady)
-	}
-	if err := r.inject(cfg, r.SvcName, r.SvcNamespace, crt); err != nil {
-		return err
+		return nil, fmt.Errorf(errCACertNotReady)
 	}
-	return r.Update(ctx, cfg)
+	return crt, nil
 }
 
-func (r *Reconciler) inject(cfg *admissionregistration.ValidatingWebhookConfiguration, svcName, svcNamespace string, certData []byte) error {
-	r.Log.Info("injecting ca certificate and service names", "cacrt", base64.StdEncoding.EncodeToString(certData), "name", cfg.Name)
-	for idx, w := range cfg.Webhooks {
-		if !strings.HasSuffix(w.Name, "external-secrets.io") {
-			r.Log.Info("skipping webhook", "name", cfg.Name, "webhook-name", w.Name)
+// injectCertToWebhookConfig sets the service and the base64 encoded ca cert of the client config
+// at clientConfigPath of the webhooks of obj, which must be of kind gvk. Only the webhooks named
+// *external-secrets.io are patched.
+func injectCertToWebhookConfig(obj *unstructured.Unstructured, gvk schema.GroupVersionKind, clientConfigPath []string,
+	svc types.NamespacedName, certData []byte) error {
+	if obj.GroupVersionKind() != gvk {
+		return fmt.Errorf("expected %s, got %s", gvk, obj.GroupVersionKind())
+	}
+	field := func(fields ...string) []string {
+		return append(slices.Clone(clientConfigPath), fields...)
+	}
+	webhooks, _, err := unstructured.NestedSlice(obj.Object, "webhooks")
+	if err != nil {
+		return err
+	}
+	for idx, w := range webhooks {
+		webhook, ok := w.(map[string]any)
+		if !ok {
+			return fmt.Errorf("webhook %d of %s is not an object", idx, obj.GetName())
+		}
+		name, _, _ := unstructured.NestedString(webhook, "name")
+		if !strings.HasSuffix(name, "external-secrets.io") {
 			continue
 		}
 		// we just patch the relevant fields
-		cfg.Webhooks[idx].ClientConfig.Service.Name = svcName
-		cfg.Webhooks[idx].ClientConfig.Service.Namespace = svcNamespace
-		cfg.Webhooks[idx].ClientConfig.CABundle = certData
+		if _, ok, _ := unstructured.NestedMap(webhook, field("service")...); ok {
+			if err := unstructured.SetNestedField(webhook, svc.Name, field("service", "name")...); err != nil {
+				return err
+			}
+			if err := unstructured.SetNestedField(webhook, svc.Namespace, field("service", "namespace")...); err != nil {
+				return err
+			}
+		}
+		if err := unstructured.SetNestedField(webhook, base64.StdEncoding.EncodeToString(certData), field("caBundle")...); err != nil {
+			return err
+		}
+		webhooks[idx] = webhook
 	}
-	return nil
+	return unstructured.SetNestedSlice(obj.Object, webhooks, "webhooks")
+}
+
+// injectCertToAPIService sets the service, if the APIService is served by one,
+// and the base64 encoded ca cert of the APIService.
+func injectCertToAPIService(obj *unstructured.Unstructured, svc types.NamespacedName, certData []byte) error {
+	if obj.GroupVersionKind() != APIServiceGVK {
+		return fmt.Errorf("expected %s, got %s", APIServiceGVK, obj.GroupVersionKind())
+	}
+	if _, ok, _ := unstructured.NestedMap(obj.Object, "spec", "service"); ok {
+		if err := unstructured.SetNestedField(obj.Object, svc.Name, "spec", "service", "name"); err != nil {
+			return err
+		}
+		if err := unstructured.SetNestedField(obj.Object, svc.Namespace, "spec", "service", "namespace"); err != nil {
+			return err
+		}
+	}
+	return unstructured.SetNestedField(obj.Object, base64.StdEncoding.EncodeToString(certData), "spec", "caBundle")
 }
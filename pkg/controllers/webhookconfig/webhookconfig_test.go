@@ -17,13 +17,24 @@ package webhookconfig
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
 	"time"
 
+	"github.com/go-logr/logr"
 	admissionregistration "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	pointer "k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/external-secrets/external-secrets/pkg/constants"
 
@@ -229,6 +240,212 @@ var _ = Describe("ValidatingWebhookConfig reconcile", Ordered, func() {
 
 })
 
+func TestUpdateConfigFromCertDir(t *testing.T) {
+	certDir := t.TempDir()
+	vwc := makeValidatingWebhookConfig()
+	scheme := runtime.NewScheme()
+	if err := admissionregistration.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	r := &Reconciler{
+		Client:       fake.NewClientBuilder().WithScheme(scheme).WithObjects(vwc).Build(),
+		Log:          logr.Discard(),
+		SvcName:      ctrlSvcName,
+		SvcNamespace: ctrlSvcNamespace,
+		CertDir:      certDir,
+	}
+	ctx := context.Background()
+
+	// the secret is not read, so a missing ca cert in the cert dir is not ready
+	if err := r.updateValidatingWebhook(ctx, vwc); err == nil || err.Error() != errCACertNotReady {
+		t.Fatalf("expected %q, got %v", errCACertNotReady, err)
+	}
+
+	if err := os.WriteFile(filepath.Join(certDir, caCertName), []byte(defaultCACert), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.updateValidatingWebhook(ctx, vwc); err != nil {
+		t.Fatal(err)
+	}
+	var got admissionregistration.ValidatingWebhookConfiguration
+	if err := r.Get(ctx, types.NamespacedName{Name: vwc.Name}, &got); err != nil {
+		t.Fatal(err)
+	}
+	for _, w := range got.Webhooks {
+		if !bytes.Equal(w.ClientConfig.CABundle, []byte(defaultCACert)) {
+			t.Errorf("webhook %s: unexpected ca bundle %q", w.Name, w.ClientConfig.CABundle)
+		}
+		if w.ClientConfig.Service.Name != ctrlSvcName || w.ClientConfig.Service.Namespace != ctrlSvcNamespace {
+			t.Errorf("webhook %s: unexpected service %s/%s", w.Name, w.ClientConfig.Service.Namespace, w.ClientConfig.Service.Name)
+		}
+	}
+}
+
+func TestInjectCertToWebhookConfig(t *testing.T) {
+	svc := types.NamespacedName{Name: ctrlSvcName, Namespace: ctrlSvcNamespace}
+	caBundle := base64.StdEncoding.EncodeToString([]byte(defaultCACert))
+	for _, gvk := range []schema.GroupVersionKind{validatingWebhookGVK, mutatingWebhookGVK} {
+		t.Run(gvk.Kind, func(t *testing.T) {
+			obj := &unstructured.Unstructured{Object: map[string]any{
+				"metadata": map[string]any{"name": "eso"},
+				"webhooks": []any{
+					map[string]any{
+						"name": "secretstores.external-secrets.io",
+						"clientConfig": map[string]any{
+							"service": map[string]any{"name": "noop", "namespace": "noop", "path": "/validate"},
+						},
+					},
+					map[string]any{
+						"name":         "url.external-secrets.io",
+						"clientConfig": map[string]any{"url": "https://eso.example.com/validate"},
+					},
+					map[string]any{
+						"name": "other.example.com",
+						"clientConfig": map[string]any{
+							"service":  map[string]any{"name": "other", "namespace": "other"},
+							"caBundle": "Cg==",
+						},
+					},
+				},
+			}}
+			obj.SetGroupVersionKind(gvk)
+			if err := injectCertToWebhookConfig(obj, gvk, []string{"clientConfig"}, svc, []byte(defaultCACert)); err != nil {
+				t.Fatal(err)
+			}
+			webhooks, _, _ := unstructured.NestedSlice(obj.Object, "webhooks")
+			want := []map[string]string{
+				{"caBundle": caBundle, "service.name": ctrlSvcName, "service.namespace": ctrlSvcNamespace, "service.path": "/validate"},
+				{"caBundle": caBundle, "url": "https://eso.example.com/validate"},
+				{"caBundle": "Cg==", "service.name": "other", "service.namespace": "other"},
+			}
+			for idx, w := range webhooks {
+				for field, value := range want[idx] {
+					got, _, _ := unstructured.NestedString(w.(map[string]any), append([]string{"clientConfig"}, strings.Split(field, ".")...)...)
+					if got != value {
+						t.Errorf("webhook %d: expected %s to be %q, got %q", idx, field, value, got)
+					}
+				}
+			}
+			if _, ok, _ := unstructured.NestedMap(webhooks[1].(map[string]any), "clientConfig", "service"); ok {
+				t.Errorf("webhook with url must not get a service")
+			}
+		})
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(mutatingWebhookGVK)
+	if err := injectCertToWebhookConfig(obj, validatingWebhookGVK, []string{"clientConfig"}, svc, []byte(defaultCACert)); err == nil {
+		t.Errorf("expected an error for a mismatching kind")
+	}
+}
+
+func TestUpdateMutatingWebhook(t *testing.T) {
+	mwc := &admissionregistration.MutatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "eso-mutating",
+			Labels: map[string]string{
+				constants.WellKnownLabelKey: constants.WellKnownLabelValueWebhook,
+			},
+		},
+		Webhooks: []admissionregistration.MutatingWebhook{
+			{
+				Name: "defaults.external-secrets.io",
+				ClientConfig: admissionregistration.WebhookClientConfig{
+					Service: &admissionregistration.ServiceReference{Name: "noop", Namespace: "noop"},
+				},
+			},
+		},
+	}
+	secret := makeSecret()
+	scheme := runtime.NewScheme()
+	if err := admissionregistration.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	r := &Reconciler{
+		Client:          fake.NewClientBuilder().WithScheme(scheme).WithObjects(mwc, secret).Build(),
+		Log:             logr.Discard(),
+		SvcName:         ctrlSvcName,
+		SvcNamespace:    ctrlSvcNamespace,
+		SecretName:      secret.Name,
+		SecretNamespace: secret.Namespace,
+	}
+	ctx := context.Background()
+	res, err := mutatingReconciler{r}.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: mwc.Name}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.RequeueAfter != r.RequeueDuration {
+		t.Errorf("unexpected requeue after %s", res.RequeueAfter)
+	}
+	var got admissionregistration.MutatingWebhookConfiguration
+	if err := r.Get(ctx, types.NamespacedName{Name: mwc.Name}, &got); err != nil {
+		t.Fatal(err)
+	}
+	cc := got.Webhooks[0].ClientConfig
+	if !bytes.Equal(cc.CABundle, []byte(defaultCACert)) || cc.Service.Name != ctrlSvcName || cc.Service.Namespace != ctrlSvcNamespace {
+		t.Errorf("unexpected client config %+v", cc)
+	}
+	// mutating webhook configs do not make the validating webhook ready
+	if r.webhookReady {
+		t.Errorf("expected the webhook not to be ready")
+	}
+}
+
+func TestUpdateAPIService(t *testing.T) {
+	apiService := &unstructured.Unstructured{Object: map[string]any{
+		"metadata": map[string]any{
+			"name": "v1beta1.metrics.external-secrets.io",
+			"labels": map[string]any{
+				constants.WellKnownLabelKey: constants.WellKnownLabelValueWebhook,
+			},
+		},
+		"spec": map[string]any{
+			"group":                "metrics.external-secrets.io",
+			"version":              "v1beta1",
+			"groupPriorityMinimum": int64(100),
+			"versionPriority":      int64(100),
+			"service":              map[string]any{"name": "noop", "namespace": "noop", "port": int64(443)},
+		},
+	}}
+	apiService.SetGroupVersionKind(APIServiceGVK)
+	secret := makeSecret()
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	scheme.AddKnownTypeWithName(APIServiceGVK, &unstructured.Unstructured{})
+	r := &Reconciler{
+		Client:          fake.NewClientBuilder().WithScheme(scheme).WithObjects(apiService, secret).Build(),
+		Log:             logr.Discard(),
+		SvcName:         ctrlSvcName,
+		SvcNamespace:    ctrlSvcNamespace,
+		SecretName:      secret.Name,
+		SecretNamespace: secret.Namespace,
+	}
+	ctx := context.Background()
+	key := types.NamespacedName{Name: apiService.GetName()}
+	if _, err := (apiServiceReconciler{r}).Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatal(err)
+	}
+	got := &unstructured.Unstructured{}
+	got.SetGroupVersionKind(APIServiceGVK)
+	if err := r.Get(ctx, key, got); err != nil {
+		t.Fatal(err)
+	}
+	caBundle, _, _ := unstructured.NestedString(got.Object, "spec", "caBundle")
+	if caBundle != base64.StdEncoding.EncodeToString([]byte(defaultCACert)) {
+		t.Errorf("unexpected caBundle %q", caBundle)
+	}
+	name, _, _ := unstructured.NestedString(got.Object, "spec", "service", "name")
+	namespace, _, _ := unstructured.NestedString(got.Object, "spec", "service", "namespace")
+	if name != ctrlSvcName || namespace != ctrlSvcNamespace {
+		t.Errorf("unexpected service %s/%s", namespace, name)
+	}
+}
+
 func makeValidatingWebhookConfig() *admissionregistration.ValidatingWebhookConfiguration {
 	return &admissionregistration.ValidatingWebhookConfiguration{
 		ObjectMeta: metav1.ObjectMeta{
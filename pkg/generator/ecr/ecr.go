@@ -20,7 +20,9 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
@@ -76,12 +78,19 @@ func (g *Generator) generate(
 		return nil, fmt.Errorf(errCreateSess, err)
 	}
 	client := ecrFunc(sess)
-	out, err := client.GetAuthorizationToken(&ecr.GetAuthorizationTokenInput{})
+	in := &ecr.GetAuthorizationTokenInput{}
+	if len(res.Spec.RegistryIDs) > 0 {
+		in.RegistryIds = aws.StringSlice(res.Spec.RegistryIDs)
+	}
+	out, err := client.GetAuthorizationToken(in)
 	if err != nil {
 		return nil, fmt.Errorf(errGetToken, err)
 	}
-	if len(out.AuthorizationData) != 1 {
-		return nil, fmt.Errorf("unexpected number of authorization tokens. expected 1, found %d", len(out.AuthorizationData))
+	// ECR returns the same token with the endpoint of each registry,
+	// the endpoint of the first registry is returned
+	expected := max(1, len(res.Spec.RegistryIDs))
+	if len(out.AuthorizationData) != expected {
+		return nil, fmt.Errorf("unexpected number of authorization tokens. expected %d, found %d", expected, len(out.AuthorizationData))
 	}
 
 	// AuthorizationToken is base64 encoded {username}:{password} string
@@ -103,6 +112,15 @@ func (g *Generator) generate(
 	}, nil
 }
 
+// ExpiresAt returns the expiry of the authorization token, which is valid for 12 hours.
+func (g *Generator) ExpiresAt(data map[string][]byte) (time.Time, bool) {
+	exp, err := strconv.ParseInt(string(data["expires_at"]), 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(exp, 0), true
+}
+
 type ecrFactoryFunc func(aws *session.Session) ecriface.ECRAPI
 
 func ecrFactory(aws *session.Session) ecriface.ECRAPI {
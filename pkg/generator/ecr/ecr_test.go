@@ -22,6 +22,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecr"
 	"github.com/aws/aws-sdk-go/service/ecr/ecriface"
@@ -116,6 +117,47 @@ spec:
 				"expires_at":     []byte("1234"),
 			},
 		},
+		{
+			name: "registry ids",
+			args: args{
+				authTokenFunc: func(in *ecr.GetAuthorizationTokenInput) (*ecr.GetAuthorizationTokenOutput, error) {
+					if !reflect.DeepEqual(aws.StringValueSlice(in.RegistryIds), []string{"111111111111", "222222222222"}) {
+						return nil, errors.New("unexpected registry ids")
+					}
+					t := time.Unix(5678, 0)
+					token := utilpointer.To(base64.StdEncoding.EncodeToString([]byte("AWS:token")))
+					return &ecr.GetAuthorizationTokenOutput{
+						AuthorizationData: []*ecr.AuthorizationData{
+							{
+								AuthorizationToken: token,
+								ProxyEndpoint:      utilpointer.To("https://111111111111.dkr.ecr.eu-west-1.amazonaws.com"),
+								ExpiresAt:          &t,
+							},
+							{
+								AuthorizationToken: token,
+								ProxyEndpoint:      utilpointer.To("https://222222222222.dkr.ecr.eu-west-1.amazonaws.com"),
+								ExpiresAt:          &t,
+							},
+						},
+					}, nil
+				},
+				jsonSpec: &apiextensions.JSON{
+					Raw: []byte(`apiVersion: generators.external-secrets.io/v1alpha1
+kind: ECRAuthorizationToken
+spec:
+  region: eu-west-1
+  registryIDs:
+  - "111111111111"
+  - "222222222222"`),
+				},
+			},
+			want: map[string][]byte{
+				"username":       []byte("AWS"),
+				"password":       []byte("token"),
+				"proxy_endpoint": []byte("https://111111111111.dkr.ecr.eu-west-1.amazonaws.com"),
+				"expires_at":     []byte("5678"),
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -142,6 +184,17 @@ spec:
 	}
 }
 
+func TestExpiresAt(t *testing.T) {
+	g := &Generator{}
+	exp, ok := g.ExpiresAt(map[string][]byte{"expires_at": []byte("1234")})
+	if !ok || !exp.Equal(time.Unix(1234, 0)) {
+		t.Errorf("Generator.ExpiresAt() = %v, %v, want %v", exp, ok, time.Unix(1234, 0))
+	}
+	if _, ok := g.ExpiresAt(map[string][]byte{}); ok {
+		t.Error("Generator.ExpiresAt() without expires_at should not expire")
+	}
+}
+
 type FakeECR struct {
 	ecriface.ECRAPI
 	authTokenFunc func(*ecr.GetAuthorizationTokenInput) (*ecr.GetAuthorizationTokenOutput, error)
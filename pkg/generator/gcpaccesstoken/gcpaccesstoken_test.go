@@ -0,0 +1,224 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcpaccesstoken
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+	v1 "k8s.io/api/core/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/provider/gcp/secretmanager"
+)
+
+const (
+	fakeAccessToken = "ya29.fake-access-token"
+	fakeExpiresIn   = 3599
+)
+
+// newTokenServer returns a fake OAuth2 token endpoint that exchanges
+// the signed JWT of a service account for an access token.
+func newTokenServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/token" {
+			http.NotFound(w, r)
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if r.PostForm.Get("grant_type") != "urn:ietf:params:oauth:grant-type:jwt-bearer" || r.PostForm.Get("assertion") == "" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": fakeAccessToken,
+			"token_type":   "Bearer",
+			"expires_in":   fakeExpiresIn,
+		})
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// serviceAccountKey returns a service account key file that uses tokenURI.
+func serviceAccountKey(t *testing.T, tokenURI string) []byte {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	})
+	data, err := json.Marshal(map[string]string{
+		"type":           "service_account",
+		"project_id":     "foobar",
+		"private_key_id": "1234",
+		"private_key":    string(keyPEM),
+		"client_email":   "eso@foobar.iam.gserviceaccount.com",
+		"token_uri":      tokenURI,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestGenerate(t *testing.T) {
+	srv := newTokenServer(t)
+	kube := clientfake.NewClientBuilder().WithObjects(&v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "example",
+			Namespace: "foobar",
+		},
+		Data: map[string][]byte{
+			"sa":      serviceAccountKey(t, srv.URL+"/token"),
+			"invalid": serviceAccountKey(t, srv.URL+"/invalid"),
+		},
+	}).Build()
+	secretRefSpec := func(key string) *apiextensions.JSON {
+		return &apiextensions.JSON{
+			Raw: []byte(`apiVersion: generators.external-secrets.io/v1alpha1
+kind: GCPAccessToken
+spec:
+  auth:
+    secretRef:
+      secretAccessKeySecretRef:
+        name: "example"
+        key: "` + key + `"
+`),
+		}
+	}
+
+	tests := []struct {
+		name        string
+		jsonSpec    *apiextensions.JSON
+		tokenSource tokenSourceFunc
+		want        map[string][]byte
+		wantErr     bool
+	}{
+		{
+			name:        "nil spec",
+			tokenSource: secretmanager.NewTokenSource,
+			wantErr:     true,
+		},
+		{
+			name:        "service account key",
+			jsonSpec:    secretRefSpec("sa"),
+			tokenSource: secretmanager.NewTokenSource,
+			want: map[string][]byte{
+				"token": []byte(fakeAccessToken),
+			},
+		},
+		{
+			name:        "token request rejected",
+			jsonSpec:    secretRefSpec("invalid"),
+			tokenSource: secretmanager.NewTokenSource,
+			wantErr:     true,
+		},
+		{
+			name:        "missing service account key",
+			jsonSpec:    secretRefSpec("missing"),
+			tokenSource: secretmanager.NewTokenSource,
+			wantErr:     true,
+		},
+		{
+			name: "workload identity",
+			jsonSpec: &apiextensions.JSON{
+				Raw: []byte(`apiVersion: generators.external-secrets.io/v1alpha1
+kind: GCPAccessToken
+spec:
+  projectID: "foobar"
+  auth:
+    workloadIdentity:
+      clusterLocation: europe-west1
+      clusterName: example
+      serviceAccountRef:
+        name: "eso"
+`),
+			},
+			tokenSource: func(ctx context.Context, auth v1beta1.GCPSMAuth, projectID string, storeKind string, kube client.Client, namespace string) (oauth2.TokenSource, error) {
+				if auth.WorkloadIdentity == nil || auth.WorkloadIdentity.ServiceAccountRef.Name != "eso" || projectID != "foobar" {
+					t.Errorf("unexpected auth %+v for project %q", auth, projectID)
+				}
+				return oauth2.StaticTokenSource(&oauth2.Token{
+					AccessToken: "1234",
+					Expiry:      time.Unix(5555, 0),
+				}), nil
+			},
+			want: map[string][]byte{
+				"token":  []byte("1234"),
+				"expiry": []byte("5555"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &Generator{}
+			got, err := g.generate(context.Background(), tt.jsonSpec, kube, "foobar", tt.tokenSource)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Generator.Generate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if _, ok := tt.want["expiry"]; !ok {
+				// the expiry of the fake token server is relative to the time of the request
+				exp, ok := g.ExpiresAt(got)
+				if !ok || time.Until(exp) <= 0 || time.Until(exp) > fakeExpiresIn*time.Second {
+					t.Errorf("unexpected expiry %q", got["expiry"])
+				}
+				tt.want["expiry"] = got["expiry"]
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Generator.Generate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpiresAt(t *testing.T) {
+	g := &Generator{}
+	exp, ok := g.ExpiresAt(map[string][]byte{"expiry": []byte(strconv.FormatInt(5555, 10))})
+	if !ok || !exp.Equal(time.Unix(5555, 0)) {
+		t.Errorf("ExpiresAt() = %v, %v", exp, ok)
+	}
+	if _, ok := g.ExpiresAt(map[string][]byte{"token": []byte("1234")}); ok {
+		t.Errorf("ExpiresAt() without expiry should not expire")
+	}
+}
@@ -0,0 +1,39 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package uuid
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+func TestGenerate(t *testing.T) {
+	g := &Generator{}
+	first, err := g.Generate(context.Background(), &apiextensions.JSON{Raw: []byte(`{}`)}, nil, "default")
+	require.NoError(t, err)
+	require.Contains(t, first, "uuid")
+	id, err := uuid.Parse(string(first["uuid"]))
+	require.NoError(t, err)
+	assert.Equal(t, uuid.Version(4), id.Version())
+
+	second, err := g.Generate(context.Background(), nil, nil, "default")
+	require.NoError(t, err)
+	assert.NotEqual(t, first["uuid"], second["uuid"])
+}
@@ -0,0 +1,161 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vaultdynamic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/client-go/kubernetes"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlcfg "sigs.k8s.io/controller-runtime/pkg/client/config"
+	"sigs.k8s.io/yaml"
+
+	genv1alpha1 "github.com/external-secrets/external-secrets/apis/generators/v1alpha1"
+	provider "github.com/external-secrets/external-secrets/pkg/provider/vault"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+// SSHCertificateGenerator signs an SSH key with the SSH secrets engine of Vault.
+type SSHCertificateGenerator struct{}
+
+const (
+	defaultSSHPath = "ssh"
+
+	errNoRole          = "no role in spec"
+	errGetPrivateKey   = "unable to get private key: %w"
+	errParsePrivateKey = "unable to parse private key: %w"
+	errSignKey         = "unable to sign public key: %w"
+	errNoSignedKey     = "no signed_key in the response of Vault"
+	errParseSignedKey  = "unable to parse signed key: %w"
+)
+
+func (g *SSHCertificateGenerator) Generate(ctx context.Context, jsonSpec *apiextensions.JSON, kube client.Client, namespace string) (map[string][]byte, error) {
+	c := &provider.Provider{NewVaultClient: provider.NewVaultClient}
+
+	// see Generator.Generate, the Kubernetes auth needs a clientset to request tokens
+	restCfg, err := ctrlcfg.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	clientset, err := kubernetes.NewForConfig(restCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.generate(ctx, c, jsonSpec, kube, clientset.CoreV1(), namespace)
+}
+
+func (g *SSHCertificateGenerator) generate(ctx context.Context, c *provider.Provider, jsonSpec *apiextensions.JSON, kube client.Client, corev1 typedcorev1.CoreV1Interface, namespace string) (map[string][]byte, error) {
+	if jsonSpec == nil {
+		return nil, errors.New(errNoSpec)
+	}
+	res, err := parseSSHSpec(jsonSpec.Raw)
+	if err != nil {
+		return nil, fmt.Errorf(errParseSpec, err)
+	}
+	if res.Spec.Provider == nil {
+		return nil, errors.New("no Vault provider config in spec")
+	}
+	if res.Spec.Role == "" {
+		return nil, errors.New(errNoRole)
+	}
+
+	// the private key must be in the namespace of the generator, like the auth secrets of the provider
+	ref := res.Spec.PrivateKeySecretRef
+	ref.Namespace = nil
+	privateKey, err := resolvers.SecretKeyRef(ctx, kube, "", namespace, &ref)
+	if err != nil {
+		return nil, fmt.Errorf(errGetPrivateKey, err)
+	}
+	signer, err := ssh.ParsePrivateKey([]byte(privateKey))
+	if err != nil {
+		return nil, fmt.Errorf(errParsePrivateKey, err)
+	}
+	publicKey := ssh.MarshalAuthorizedKey(signer.PublicKey())
+
+	cl, err := c.NewGeneratorClient(ctx, kube, corev1, res.Spec.Provider, namespace)
+	if err != nil {
+		return nil, fmt.Errorf(errVaultClient, err)
+	}
+
+	mount := strings.Trim(res.Spec.Path, "/")
+	if mount == "" {
+		mount = defaultSSHPath
+	}
+	params := map[string]any{
+		"public_key": string(publicKey),
+	}
+	if res.Spec.CertType != "" {
+		params["cert_type"] = res.Spec.CertType
+	}
+	if len(res.Spec.ValidPrincipals) > 0 {
+		params["valid_principals"] = strings.Join(res.Spec.ValidPrincipals, ",")
+	}
+	if res.Spec.TTL != "" {
+		params["ttl"] = res.Spec.TTL
+	}
+	if res.Spec.KeyID != "" {
+		params["key_id"] = res.Spec.KeyID
+	}
+	if len(res.Spec.Extensions) > 0 {
+		params["extensions"] = res.Spec.Extensions
+	}
+	// the sign endpoint of the SSH secrets engine only accepts POST
+	result, err := cl.Logical().WriteWithContext(ctx, mount+"/sign/"+res.Spec.Role, params)
+	if err != nil {
+		return nil, fmt.Errorf(errSignKey, err)
+	}
+	if result == nil {
+		return nil, fmt.Errorf(errSignKey, errors.New("empty response from Vault"))
+	}
+	signedKey, ok := result.Data["signed_key"].(string)
+	if !ok || signedKey == "" {
+		return nil, errors.New(errNoSignedKey)
+	}
+	parsed, _, _, _, err := ssh.ParseAuthorizedKey([]byte(signedKey))
+	if err != nil {
+		return nil, fmt.Errorf(errParseSignedKey, err)
+	}
+	cert, ok := parsed.(*ssh.Certificate)
+	if !ok {
+		return nil, fmt.Errorf(errParseSignedKey, errors.New("not a certificate"))
+	}
+
+	return map[string][]byte{
+		"private_key":   []byte(privateKey),
+		"public_key":    publicKey,
+		"signed_key":    []byte(signedKey),
+		"serial_number": []byte(strconv.FormatUint(cert.Serial, 10)),
+		"valid_before":  []byte(time.Unix(int64(cert.ValidBefore), 0).UTC().Format(time.RFC3339)),
+	}, nil
+}
+
+func parseSSHSpec(data []byte) (*genv1alpha1.VaultSSHCertificate, error) {
+	var spec genv1alpha1.VaultSSHCertificate
+	err := yaml.Unmarshal(data, &spec)
+	return &spec, err
+}
+
+func init() {
+	genv1alpha1.Register(genv1alpha1.VaultSSHCertificateKind, &SSHCertificateGenerator{})
+}
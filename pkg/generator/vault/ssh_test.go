@@ -0,0 +1,158 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vaultdynamic
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+	apiextensions "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	utilfake "github.com/external-secrets/external-secrets/pkg/provider/util/fake"
+	provider "github.com/external-secrets/external-secrets/pkg/provider/vault"
+	"github.com/external-secrets/external-secrets/pkg/provider/vault/fake"
+)
+
+const sshSpec = `apiVersion: generators.external-secrets.io/v1alpha1
+kind: VaultSSHCertificate
+spec:
+  provider:
+    auth:
+      kubernetes:
+        role: test
+        serviceAccountRef:
+          name: "testing"
+  role: deploy
+  privateKeySecretRef:
+    name: ssh-key
+    key: ssh-privatekey`
+
+func TestVaultSSHCertificateGenerator(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := ssh.MarshalPrivateKey(key, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKey := pem.EncodeToMemory(block)
+
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "testing",
+			Namespace: "testing",
+		},
+	}
+	keySecret := func(value []byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "ssh-key",
+				Namespace: "testing",
+			},
+			Data: map[string][]byte{
+				"ssh-privatekey": value,
+			},
+		}
+	}
+
+	cases := map[string]testCase{
+		"NilSpec": {
+			reason: "Raise an error with empty spec.",
+			args: args{
+				jsonSpec: nil,
+			},
+			want: want{
+				err: errors.New("no config spec provided"),
+			},
+		},
+		"MissingRole": {
+			reason: "Raise an error without a role of the SSH secrets engine.",
+			args: args{
+				jsonSpec: &apiextensions.JSON{
+					Raw: []byte(`apiVersion: generators.external-secrets.io/v1alpha1
+kind: VaultSSHCertificate
+spec:
+  provider:
+    auth:
+      kubernetes:
+        role: test
+        serviceAccountRef:
+          name: "testing"
+  privateKeySecretRef:
+    name: ssh-key
+    key: ssh-privatekey`),
+				},
+			},
+			want: want{
+				err: errors.New("no role in spec"),
+			},
+		},
+		"MissingPrivateKey": {
+			reason: "Raise an error when the Secret of the private key does not exist.",
+			args: args{
+				jsonSpec: &apiextensions.JSON{Raw: []byte(sshSpec)},
+				kube:     clientfake.NewClientBuilder().Build(),
+			},
+			want: want{
+				err: errors.New(`unable to get private key: cannot get Kubernetes secret "ssh-key": secrets "ssh-key" not found`),
+			},
+		},
+		"InvalidPrivateKey": {
+			reason: "Raise an error when the private key can not be parsed.",
+			args: args{
+				jsonSpec: &apiextensions.JSON{Raw: []byte(sshSpec)},
+				kube:     clientfake.NewClientBuilder().WithObjects(keySecret([]byte("not a key"))).Build(),
+			},
+			want: want{
+				err: errors.New("unable to parse private key: ssh: no key found"),
+			},
+		},
+		"EmptyVaultResponse": {
+			reason: "Fail on empty response from Vault.",
+			args: args{
+				corev1:   utilfake.NewCreateTokenMock().WithToken("ok"),
+				jsonSpec: &apiextensions.JSON{Raw: []byte(sshSpec)},
+				kube:     clientfake.NewClientBuilder().WithObjects(serviceAccount, keySecret(privateKey)).Build(),
+			},
+			want: want{
+				err: errors.New("unable to sign public key: empty response from Vault"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &provider.Provider{NewVaultClient: fake.ClientWithLoginMock}
+			gen := &SSHCertificateGenerator{}
+			val, err := gen.generate(context.Background(), c, tc.args.jsonSpec, tc.args.kube, tc.args.corev1, "testing")
+			if diff := cmp.Diff(tc.want.err.Error(), err.Error()); diff != "" {
+				t.Errorf("\n%s\nvault.Generate(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.val, val); diff != "" {
+				t.Errorf("\n%s\nvault.Generate(...): -want val, +got val:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ansiblevault
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/tidwall/gjson"
+	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	vaultTag = "!vault"
+
+	errReadOnly         = "the Ansible Vault provider is read only"
+	errFindUnsupported  = "find is not supported by the Ansible Vault provider"
+	errGetConfigMap     = "unable to get ConfigMap %s: %w"
+	errNoNamespace      = "the namespace of the ConfigMap is required"
+	errDecryptKey       = "unable to decrypt key %s: %w"
+	errDecryptVariable  = "unable to decrypt variable at line %d of key %s: %w"
+	errParseKey         = "unable to parse key %s as YAML: %w"
+	errPropertyNotFound = "property %s does not exist in key %s"
+	errNotAMap          = "key %s is not a YAML mapping"
+)
+
+// client decrypts the keys of a ConfigMap encrypted with Ansible Vault.
+// https://docs.ansible.com/ansible/latest/vault_guide/index.html
+type client struct {
+	kube      kclient.Client
+	name      string
+	namespace string
+	password  []byte
+
+	// the ConfigMap is read once per client, which is created for every reconcile
+	mu        sync.Mutex
+	configMap *corev1.ConfigMap
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+// GetSecret returns the decrypted value of the ConfigMap key. Property selects a variable
+// of the YAML document, e.g. "db.password", variables encrypted with encrypt_string are decrypted.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	content, err := c.entry(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Property == "" && isEncrypted(content) {
+		plaintext, err := decrypt(content, c.password)
+		if err != nil {
+			return nil, fmt.Errorf(errDecryptKey, ref.Key, err)
+		}
+		return plaintext, nil
+	}
+	raw, err := c.document(ref.Key, content)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Property == "" {
+		return raw, nil
+	}
+	res := gjson.GetBytes(raw, ref.Property)
+	if !res.Exists() {
+		return nil, fmt.Errorf(errPropertyNotFound, ref.Property, ref.Key)
+	}
+	return valueOf(res), nil
+}
+
+// GetSecretMap returns the top level variables of the YAML document of the ConfigMap key.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	content, err := c.entry(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := c.document(ref.Key, content)
+	if err != nil {
+		return nil, err
+	}
+	res := gjson.ParseBytes(raw)
+	if ref.Property != "" {
+		res = res.Get(ref.Property)
+	}
+	if !res.IsObject() {
+		return nil, fmt.Errorf(errNotAMap, ref.Key)
+	}
+	data := make(map[string][]byte)
+	res.ForEach(func(k, v gjson.Result) bool {
+		data[k.String()] = valueOf(v)
+		return true
+	})
+	return data, nil
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindUnsupported)
+}
+
+func (c *client) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
+	return false, errors.New(errReadOnly)
+}
+
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	// a ClusterSecretStore without namespace reads the ConfigMap of every ExternalSecret namespace
+	if c.namespace == "" {
+		return esv1beta1.ValidationResultUnknown, nil
+	}
+	if _, err := c.load(context.Background()); err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+func (c *client) load(ctx context.Context) (*corev1.ConfigMap, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.configMap != nil {
+		return c.configMap, nil
+	}
+	if c.namespace == "" {
+		return nil, errors.New(errNoNamespace)
+	}
+	cm := &corev1.ConfigMap{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: c.name, Namespace: c.namespace}, cm); err != nil {
+		return nil, fmt.Errorf(errGetConfigMap, c.name, err)
+	}
+	c.configMap = cm
+	return cm, nil
+}
+
+// entry returns the content of the ConfigMap key.
+func (c *client) entry(ctx context.Context, key string) ([]byte, error) {
+	cm, err := c.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := cm.Data[key]; ok {
+		return []byte(v), nil
+	}
+	if v, ok := cm.BinaryData[key]; ok {
+		return v, nil
+	}
+	return nil, esv1beta1.NoSecretError{}
+}
+
+// document returns the YAML document of the ConfigMap key as JSON. Encrypted files are
+// decrypted first, then the variables tagged with !vault are decrypted.
+func (c *client) document(key string, content []byte) ([]byte, error) {
+	if isEncrypted(content) {
+		plaintext, err := decrypt(content, c.password)
+		if err != nil {
+			return nil, fmt.Errorf(errDecryptKey, key, err)
+		}
+		content = plaintext
+	}
+	var root yaml.Node
+	if err := yaml.Unmarshal(content, &root); err != nil {
+		return nil, fmt.Errorf(errParseKey, key, err)
+	}
+	if err := c.decryptNodes(key, &root); err != nil {
+		return nil, err
+	}
+	var doc any
+	if err := root.Decode(&doc); err != nil {
+		return nil, fmt.Errorf(errParseKey, key, err)
+	}
+	return json.Marshal(doc)
+}
+
+// decryptNodes replaces the scalars tagged with !vault by their plaintext.
+func (c *client) decryptNodes(key string, node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode && node.Tag == vaultTag {
+		plaintext, err := decrypt([]byte(node.Value), c.password)
+		if err != nil {
+			return fmt.Errorf(errDecryptVariable, node.Line, key, err)
+		}
+		node.Tag = "!!str"
+		node.Value = string(plaintext)
+		return nil
+	}
+	for _, child := range node.Content {
+		if err := c.decryptNodes(key, child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func valueOf(res gjson.Result) []byte {
+	if res.Type == gjson.String {
+		return []byte(res.Str)
+	}
+	return []byte(res.Raw)
+}
@@ -0,0 +1,186 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ansiblevault
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+// varsYAML contains "hunter2" encrypted like "ansible-vault encrypt_string" does, with the
+// fixed salt of the fixtures in decrypt_test.go.
+const varsYAML = `
+db_user: app
+db_password: !vault |
+  $ANSIBLE_VAULT;1.1;AES256
+  30303031303230333034303530363037303830393061306230633064306530663130313131323133
+  3134313531363137313831393161316231633164316531660a623632353935626537303730643465
+  66643230383434376437366238323333383863356337346430356166326334643261303334303564
+  3663373636376566650a313032303865333733666230366637323237376533353163663733396365
+  3037
+settings:
+  timeout: 30
+`
+
+func newTestClient(t *testing.T) *client {
+	t.Helper()
+	kube := clientfake.NewClientBuilder().WithObjects(
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "vault", Namespace: "default"},
+			Data: map[string]string{
+				"token":        encryptedString,
+				"database.yml": encryptedFile,
+				"vars.yml":     varsYAML,
+				"broken.yml":   "password: !vault |\n  $ANSIBLE_VAULT;1.1;AES256\n  3031\n",
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "vault-password", Namespace: "default"},
+			Data:       map[string][]byte{"password": []byte(testPassword + "\n")},
+		},
+	).Build()
+	store := &esv1beta1.SecretStore{
+		ObjectMeta: metav1.ObjectMeta{Name: "ansible-vault", Namespace: "default"},
+		Spec: esv1beta1.SecretStoreSpec{
+			Provider: &esv1beta1.SecretStoreProvider{
+				AnsibleVault: &esv1beta1.AnsibleVaultProvider{
+					ConfigMap:         esv1beta1.AnsibleVaultConfigMapRef{Name: "vault"},
+					PasswordSecretRef: esmeta.SecretKeySelector{Name: "vault-password", Key: "password"},
+				},
+			},
+		},
+	}
+	c, err := (&Provider{}).NewClient(context.Background(), store, kube, "default")
+	require.NoError(t, err)
+	return c.(*client)
+}
+
+func TestGetSecret(t *testing.T) {
+	c := newTestClient(t)
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"encrypted string": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "token"},
+			want: "s3cr3t",
+		},
+		"encrypted file": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "database.yml"},
+			want: "db:\n  user: app\n  password: hunter2\nport: 5432\n",
+		},
+		"property of an encrypted file": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "database.yml", Property: "db.password"},
+			want: "hunter2",
+		},
+		"non string property": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "database.yml", Property: "port"},
+			want: "5432",
+		},
+		"encrypted variable": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "vars.yml", Property: "db_password"},
+			want: "hunter2",
+		},
+		"file with encrypted variables": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "vars.yml"},
+			want: `{"db_password":"hunter2","db_user":"app","settings":{"timeout":30}}`,
+		},
+		"missing property": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "vars.yml", Property: "missing"},
+			wantErr: "property missing does not exist in key vars.yml",
+		},
+		"missing key": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "missing"},
+			wantErr: esv1beta1.NoSecretError{}.Error(),
+		},
+		"invalid encrypted variable": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "broken.yml", Property: "password"},
+			wantErr: "unable to decrypt variable at line 1 of key broken.yml",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestGetSecretMap(t *testing.T) {
+	c := newTestClient(t)
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    map[string][]byte
+		wantErr string
+	}{
+		"encrypted variables": {
+			ref: esv1beta1.ExternalSecretDataRemoteRef{Key: "vars.yml"},
+			want: map[string][]byte{
+				"db_user":     []byte("app"),
+				"db_password": []byte("hunter2"),
+				"settings":    []byte(`{"timeout":30}`),
+			},
+		},
+		"mapping of an encrypted file": {
+			ref: esv1beta1.ExternalSecretDataRemoteRef{Key: "database.yml", Property: "db"},
+			want: map[string][]byte{
+				"user":     []byte("app"),
+				"password": []byte("hunter2"),
+			},
+		},
+		"not a mapping": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "token"},
+			wantErr: "key token is not a YAML mapping",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecretMap(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	c := newTestClient(t)
+	res, err := c.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+
+	c = &client{kube: c.kube, name: "missing", namespace: "default"}
+	res, err = c.Validate()
+	assert.ErrorContains(t, err, "unable to get ConfigMap missing")
+	assert.Equal(t, esv1beta1.ValidationResultError, res)
+}
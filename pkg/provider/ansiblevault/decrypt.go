@@ -0,0 +1,125 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ansiblevault
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// The functions below implement decryption of the Ansible Vault formats 1.1 and 1.2,
+// see https://docs.ansible.com/ansible/latest/vault_guide/vault_using_encrypted_content.html.
+
+const (
+	vaultHeader      = "$ANSIBLE_VAULT"
+	cipherAES256     = "AES256"
+	pbkdf2Iterations = 10000
+	aesKeyLength     = 32
+	hmacKeyLength    = 32
+
+	errInvalidHeader      = "invalid Ansible Vault header %q"
+	errUnsupportedVersion = "unsupported Ansible Vault format version %s, only 1.1 and 1.2 are supported"
+	errUnsupportedCipher  = "unsupported Ansible Vault cipher %s, only AES256 is supported"
+	errInvalidPayload     = "invalid Ansible Vault payload: %w"
+	errMalformedPayload   = "payload must contain the salt, the HMAC and the ciphertext"
+	errHMACMismatch       = "HMAC mismatch, the vault password is wrong or the value has been modified"
+	errInvalidPadding     = "invalid padding of the decrypted value"
+)
+
+// isEncrypted returns whether data starts with the header of an Ansible Vault payload.
+func isEncrypted(data []byte) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(data), []byte(vaultHeader+";"))
+}
+
+// decrypt decrypts an Ansible Vault payload, e.g.
+//
+//	$ANSIBLE_VAULT;1.2;AES256;prod
+//	6332...
+//
+// The vault ID of format 1.2 is ignored, the store holds a single password.
+func decrypt(data, password []byte) ([]byte, error) {
+	header, body, _ := strings.Cut(strings.TrimSpace(string(data)), "\n")
+	fields := strings.Split(strings.TrimSpace(header), ";")
+	if len(fields) < 3 || fields[0] != vaultHeader {
+		return nil, fmt.Errorf(errInvalidHeader, header)
+	}
+	switch fields[1] {
+	case "1.1", "1.2":
+	default:
+		return nil, fmt.Errorf(errUnsupportedVersion, fields[1])
+	}
+	if fields[2] != cipherAES256 {
+		return nil, fmt.Errorf(errUnsupportedCipher, fields[2])
+	}
+
+	// the body is hex encoded and wrapped, it contains the hex encoded salt, HMAC and ciphertext separated by newlines
+	payload, err := hex.DecodeString(strings.Join(strings.Fields(body), ""))
+	if err != nil {
+		return nil, fmt.Errorf(errInvalidPayload, err)
+	}
+	parts := strings.Split(string(payload), "\n")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf(errInvalidPayload, errors.New(errMalformedPayload))
+	}
+	var salt, mac, ciphertext []byte
+	for i, dst := range []*[]byte{&salt, &mac, &ciphertext} {
+		if *dst, err = hex.DecodeString(parts[i]); err != nil {
+			return nil, fmt.Errorf(errInvalidPayload, err)
+		}
+	}
+
+	key := pbkdf2.Key(password, salt, pbkdf2Iterations, aesKeyLength+hmacKeyLength+aes.BlockSize, sha256.New)
+	aesKey, hmacKey, iv := key[:aesKeyLength], key[aesKeyLength:aesKeyLength+hmacKeyLength], key[aesKeyLength+hmacKeyLength:]
+
+	h := hmac.New(sha256.New, hmacKey)
+	h.Write(ciphertext)
+	if !hmac.Equal(h.Sum(nil), mac) {
+		return nil, errors.New(errHMACMismatch)
+	}
+
+	block, err := aes.NewCipher(aesKey)
+	if err != nil {
+		return nil, err
+	}
+	plaintext := make([]byte, len(ciphertext))
+	cipher.NewCTR(block, iv).XORKeyStream(plaintext, ciphertext)
+	return unpad(plaintext)
+}
+
+// unpad removes the PKCS#7 padding Ansible adds before encrypting.
+func unpad(data []byte) ([]byte, error) {
+	if len(data) == 0 || len(data)%aes.BlockSize != 0 {
+		return nil, errors.New(errInvalidPadding)
+	}
+	n := int(data[len(data)-1])
+	if n == 0 || n > aes.BlockSize {
+		return nil, errors.New(errInvalidPadding)
+	}
+	for _, b := range data[len(data)-n:] {
+		if int(b) != n {
+			return nil, errors.New(errInvalidPadding)
+		}
+	}
+	return data[:len(data)-n], nil
+}
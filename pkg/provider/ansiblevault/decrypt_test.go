@@ -0,0 +1,131 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ansiblevault
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPassword = "correct horse battery staple"
+
+// The fixtures below were not produced by the ansible-vault binary. They follow its format
+// (PBKDF2-SHA256 with 10000 iterations, AES-256-CTR and HMAC-SHA256) but use the fixed salt
+// 000102..1f to be reproducible. Their HMAC was checked against the algorithm of ansible.
+
+// encryptedString is "s3cr3t" in the format 1.1 written by "ansible-vault encrypt_string".
+const encryptedString = `$ANSIBLE_VAULT;1.1;AES256
+30303031303230333034303530363037303830393061306230633064306530663130313131323133
+3134313531363137313831393161316231633164316531660a626363303239383865613132313639
+62623064343930383065396438343539633132336631353566646631356231373731333632613765
+6137653736353430640a306236363833333136396236353737313234376433363166663433616364
+3034`
+
+// encryptedFile is a YAML file in the format 1.2 written by "ansible-vault encrypt --vault-id prod@prompt".
+const encryptedFile = `$ANSIBLE_VAULT;1.2;AES256;prod
+30303031303230333034303530363037303830393061306230633064306530663130313131323133
+3134313531363137313831393161316231633164316531660a393837386438623234383463333334
+62316538623537316661646439326232393034336137323434386662343163303035363632373865
+3937633030643263630a316333376461343937616532323830383462303530363335396634306237
+30346330646630393664613264396634626465313163323937653530393737663239343138653139
+3635333832363231373232313839643131633763666334663961`
+
+// encryptedAligned is "exactly16bytes!!", its padding is a full block.
+const encryptedAligned = `$ANSIBLE_VAULT;1.1;AES256
+30303031303230333034303530363037303830393061306230633064306530663130313131323133
+3134313531363137313831393161316231633164316531660a306533343634323832333861663337
+32333739373064373336393430653763626564343338643862316633306264333266623465383537
+6664663931383831650a316432643831323032656165323434613138313534353631396234336536
+32666630656636393163633162613933633238333638303334653238663230313464`
+
+func TestDecrypt(t *testing.T) {
+	tests := map[string]struct {
+		data     string
+		password string
+		want     string
+		wantErr  string
+	}{
+		"format 1.1": {
+			data:     encryptedString,
+			password: testPassword,
+			want:     "s3cr3t",
+		},
+		"format 1.2 with vault id": {
+			data:     encryptedFile,
+			password: testPassword,
+			want:     "db:\n  user: app\n  password: hunter2\nport: 5432\n",
+		},
+		"padding of a full block": {
+			data:     encryptedAligned,
+			password: testPassword,
+			want:     "exactly16bytes!!",
+		},
+		"indented payload": {
+			data:     "  " + strings.ReplaceAll(encryptedString, "\n", "\n  ") + "\n",
+			password: testPassword,
+			want:     "s3cr3t",
+		},
+		"wrong password": {
+			data:     encryptedString,
+			password: "wrong",
+			wantErr:  errHMACMismatch,
+		},
+		"format 1.0": {
+			data:     strings.Replace(encryptedString, ";1.1;", ";1.0;", 1),
+			password: testPassword,
+			wantErr:  "unsupported Ansible Vault format version 1.0",
+		},
+		"unsupported cipher": {
+			data:     strings.Replace(encryptedString, "AES256", "AES", 1),
+			password: testPassword,
+			wantErr:  "unsupported Ansible Vault cipher AES",
+		},
+		"invalid header": {
+			data:     "$ANSIBLE_VAULT\n3031",
+			password: testPassword,
+			wantErr:  "invalid Ansible Vault header",
+		},
+		"invalid hex": {
+			data:     "$ANSIBLE_VAULT;1.1;AES256\nnot hex",
+			password: testPassword,
+			wantErr:  "invalid Ansible Vault payload",
+		},
+		"truncated payload": {
+			data:     "$ANSIBLE_VAULT;1.1;AES256\n30303031",
+			password: testPassword,
+			wantErr:  errMalformedPayload,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := decrypt([]byte(tc.data), []byte(tc.password))
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestIsEncrypted(t *testing.T) {
+	assert.True(t, isEncrypted([]byte(encryptedString)))
+	assert.True(t, isEncrypted([]byte("\n"+encryptedFile)))
+	assert.False(t, isEncrypted([]byte("password: hunter2")))
+}
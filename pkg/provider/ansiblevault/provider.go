@@ -0,0 +1,101 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ansiblevault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+const (
+	errStoreIsNil                  = "store is nil"
+	errNoStoreTypeOrWrongStoreType = "no store type or wrong store type"
+	errConfigMapNameRequired       = "configMap.name is required"
+	errNamespaceNotAllowed         = "configMap.namespace can only be defined in a ClusterSecretStore"
+	errCannotResolvePassword       = "cannot resolve vault password: %w"
+)
+
+// Provider satisfies the provider interface.
+type Provider struct{}
+
+var _ esv1beta1.Provider = &Provider{}
+
+func (p *Provider) NewClient(ctx context.Context, store esv1beta1.GenericStore, kube kclient.Client, namespace string) (esv1beta1.SecretsClient, error) {
+	cfg, err := getConfig(store)
+	if err != nil {
+		return nil, err
+	}
+	password, err := resolvers.SecretKeyRef(ctx, kube, store.GetKind(), namespace, &cfg.PasswordSecretRef)
+	if err != nil {
+		return nil, fmt.Errorf(errCannotResolvePassword, err)
+	}
+	c := &client{
+		kube:      kube,
+		name:      cfg.ConfigMap.Name,
+		namespace: namespace,
+		// Ansible strips the whitespace of password files as well
+		password: []byte(strings.TrimSpace(password)),
+	}
+	if store.GetKind() == esv1beta1.ClusterSecretStoreKind && cfg.ConfigMap.Namespace != nil {
+		c.namespace = *cfg.ConfigMap.Namespace
+	}
+	return c, nil
+}
+
+func getConfig(store esv1beta1.GenericStore) (*esv1beta1.AnsibleVaultProvider, error) {
+	if store == nil {
+		return nil, errors.New(errStoreIsNil)
+	}
+	spec := store.GetSpec()
+	if spec == nil || spec.Provider == nil || spec.Provider.AnsibleVault == nil {
+		return nil, errors.New(errNoStoreTypeOrWrongStoreType)
+	}
+	cfg := spec.Provider.AnsibleVault
+	if cfg.ConfigMap.Name == "" {
+		return nil, errors.New(errConfigMapNameRequired)
+	}
+	ns := cfg.ConfigMap.Namespace
+	if store.GetKind() != esv1beta1.ClusterSecretStoreKind && ns != nil && *ns != store.GetNamespace() {
+		return nil, errors.New(errNamespaceNotAllowed)
+	}
+	if err := utils.ValidateReferentSecretSelector(store, cfg.PasswordSecretRef); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnings, error) {
+	_, err := getConfig(store)
+	return nil, err
+}
+
+func (p *Provider) Capabilities() esv1beta1.SecretStoreCapabilities {
+	return esv1beta1.SecretStoreReadOnly
+}
+
+func init() {
+	esv1beta1.Register(&Provider{}, &esv1beta1.SecretStoreProvider{
+		AnsibleVault: &esv1beta1.AnsibleVaultProvider{},
+	})
+}
@@ -0,0 +1,78 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ansiblevault
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+func TestValidateStore(t *testing.T) {
+	otherNamespace := "other"
+	password := esmeta.SecretKeySelector{Name: "vault-password", Key: "password"}
+	tests := map[string]struct {
+		cfg     esv1beta1.AnsibleVaultProvider
+		wantErr string
+	}{
+		"valid": {
+			cfg: esv1beta1.AnsibleVaultProvider{
+				ConfigMap:         esv1beta1.AnsibleVaultConfigMapRef{Name: "vault"},
+				PasswordSecretRef: password,
+			},
+		},
+		"missing configmap name": {
+			cfg:     esv1beta1.AnsibleVaultProvider{PasswordSecretRef: password},
+			wantErr: errConfigMapNameRequired,
+		},
+		"namespace in a SecretStore": {
+			cfg: esv1beta1.AnsibleVaultProvider{
+				ConfigMap:         esv1beta1.AnsibleVaultConfigMapRef{Name: "vault", Namespace: &otherNamespace},
+				PasswordSecretRef: password,
+			},
+			wantErr: errNamespaceNotAllowed,
+		},
+		"password in other namespace": {
+			cfg: esv1beta1.AnsibleVaultProvider{
+				ConfigMap:         esv1beta1.AnsibleVaultConfigMapRef{Name: "vault"},
+				PasswordSecretRef: esmeta.SecretKeySelector{Name: "vault-password", Key: "password", Namespace: &otherNamespace},
+			},
+			wantErr: "namespace should either be empty or match the namespace of the SecretStore",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := esv1beta1.SecretStore{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+				Spec: esv1beta1.SecretStoreSpec{
+					Provider: &esv1beta1.SecretStoreProvider{
+						AnsibleVault: &tc.cfg,
+					},
+				},
+			}
+			p := &Provider{}
+			_, err := p.ValidateStore(&s)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
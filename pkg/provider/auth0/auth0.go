@@ -0,0 +1,160 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth0
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"golang.org/x/oauth2"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	propertyClientID     = "clientId"
+	propertyClientSecret = "clientSecret"
+	propertyDomain       = "domain"
+
+	errUnexpectedStatus  = "unexpected status code from Auth0: %d: %s"
+	errUnmarshalResponse = "unable to unmarshal Auth0 response: %w"
+	errNoClientSecret    = "application %q has no client secret, e.g. it is a single page or native application"
+	errReadOnly          = "the Auth0 provider is read only"
+	errFindUnsupported   = "find is not supported by the Auth0 provider"
+)
+
+// client reads the credentials of applications with the Auth0 Management API.
+// https://auth0.com/docs/api/management/v2/clients/get-clients-by-id
+type client struct {
+	httpClient  *http.Client
+	tokenSource oauth2.TokenSource
+	url         string
+	domain      string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+type application struct {
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+}
+
+// GetSecret returns the property of the credentials of the application with the client ID key,
+// or all of them as JSON if no property is given.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	if err := validateProperty(ref.Property); err != nil {
+		return nil, err
+	}
+	creds, err := c.credentials(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Property == "" {
+		return utils.JSONMarshal(creds)
+	}
+	return []byte(creds[ref.Property]), nil
+}
+
+// GetSecretMap returns the client ID, client secret and domain of the application with the client ID key.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	creds, err := c.credentials(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	secretMap := make(map[string][]byte, len(creds))
+	for k, v := range creds {
+		secretMap[k] = []byte(v)
+	}
+	return secretMap, nil
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindUnsupported)
+}
+
+func (c *client) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
+	return false, errors.New(errReadOnly)
+}
+
+// Validate requests an access token for the Management API.
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	if _, err := c.tokenSource.Token(); err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+// credentials returns the client ID and client secret of an application and the domain it authenticates with.
+func (c *client) credentials(ctx context.Context, clientID string) (map[string]string, error) {
+	if clientID == "" {
+		return nil, errors.New(errKeyRequired)
+	}
+	query := url.Values{
+		"fields":         []string{"client_id,client_secret"},
+		"include_fields": []string{"true"},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+"/clients/"+url.PathEscape(clientID)+"?"+query.Encode(), http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, esv1beta1.NoSecretError{}
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(errUnexpectedStatus, resp.StatusCode, respBody)
+	}
+	var app application
+	if err := json.Unmarshal(respBody, &app); err != nil {
+		return nil, fmt.Errorf(errUnmarshalResponse, err)
+	}
+	if app.ClientSecret == "" {
+		return nil, fmt.Errorf(errNoClientSecret, clientID)
+	}
+	return map[string]string{
+		propertyClientID:     app.ClientID,
+		propertyClientSecret: app.ClientSecret,
+		propertyDomain:       c.domain,
+	}, nil
+}
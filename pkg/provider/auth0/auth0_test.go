@@ -0,0 +1,152 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth0
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	testClientID     = "mgmt-client-id"
+	testClientSecret = "mgmt-client-secret"
+	testToken        = "access-token"
+
+	webApp    = "4Kl1kPZ3z7nTf2x9QwErTy6uIoPaSdFg"
+	spaApp    = "8Jh3gFd5sA2qW4eR6tY8uI0oP1lKjHgF"
+	tenant    = "acme.eu.auth0.com"
+	webSecret = "Xq9sT2vB8nM4kL6jH1gF3dS5aZ7xC0vBnM2kL4jH6gF8dS0aZ2xC4vB6nM8kL0jH"
+)
+
+// newFakeAuth0 serves the token endpoint and the clients endpoint of the Management API.
+func newFakeAuth0(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	var srv *httptest.Server
+	mux.HandleFunc("POST /oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("grant_type") != "client_credentials" || r.FormValue("audience") != srv.URL+"/api/v2/" ||
+			r.FormValue("client_id") != testClientID || r.FormValue("client_secret") != testClientSecret {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"access_denied","error_description":"Unauthorized"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"` + testToken + `","token_type":"Bearer","expires_in":86400,"scope":"read:clients read:client_keys"}`))
+	})
+	apps := map[string]map[string]any{
+		webApp: {"client_id": webApp, "client_secret": webSecret},
+		spaApp: {"client_id": spaApp},
+	}
+	mux.HandleFunc("GET /api/v2/clients/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Query().Get("fields") != "client_id,client_secret" || r.URL.Query().Get("include_fields") != "true" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		app, ok := apps[r.PathValue("id")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"statusCode":404,"error":"Not Found","message":"The client does not exist","errorCode":"inexistent_client"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(app)
+	})
+	srv = httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestGetSecret(t *testing.T) {
+	c := newClient(newFakeAuth0(t).URL, tenant, testClientID, testClientSecret)
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"client id": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: webApp, Property: "clientId"},
+			want: webApp,
+		},
+		"client secret": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: webApp, Property: "clientSecret"},
+			want: webSecret,
+		},
+		"domain": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: webApp, Property: "domain"},
+			want: tenant,
+		},
+		"all credentials": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: webApp},
+			want: `{"clientId":"` + webApp + `","clientSecret":"` + webSecret + `","domain":"` + tenant + `"}`,
+		},
+		"invalid property": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: webApp, Property: "client_secret"},
+			wantErr: `invalid property "client_secret": must be one of "clientId", "clientSecret" or "domain"`,
+		},
+		"application without secret": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: spaApp},
+			wantErr: `application "` + spaApp + `" has no client secret, e.g. it is a single page or native application`,
+		},
+		"missing application": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "missing"},
+			wantErr: esv1beta1.NoSecretError{}.Error(),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestGetSecretMapWithCustomDomain(t *testing.T) {
+	c := newClient(newFakeAuth0(t).URL, "login.acme.com", testClientID, testClientSecret)
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: webApp})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"clientId":     []byte(webApp),
+		"clientSecret": []byte(webSecret),
+		"domain":       []byte("login.acme.com"),
+	}, got)
+}
+
+func TestValidate(t *testing.T) {
+	srv := newFakeAuth0(t)
+	result, err := newClient(srv.URL, tenant, testClientID, testClientSecret).Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, result)
+
+	result, err = newClient(srv.URL, tenant, testClientID, "wrong").Validate()
+	assert.Error(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultError, result)
+}
@@ -0,0 +1,154 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth0
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+const (
+	errStoreIsNil                  = "store is nil"
+	errNoStoreTypeOrWrongStoreType = "no store type or wrong store type"
+	errDomainRequired              = "domain is required"
+	errInvalidDomain               = "invalid domain %q: must be a host name without scheme or path"
+	errCannotResolveClientID       = "cannot resolve client id: %w"
+	errCannotResolveClientSecret   = "cannot resolve client secret: %w"
+	errKeyRequired                 = "key is required, it is the client ID of the application"
+	errInvalidProperty             = "invalid property %q: must be one of %q, %q or %q"
+)
+
+// Provider satisfies the provider interface.
+type Provider struct{}
+
+var _ esv1beta1.Provider = &Provider{}
+
+func (p *Provider) NewClient(ctx context.Context, store esv1beta1.GenericStore, kube kclient.Client, namespace string) (esv1beta1.SecretsClient, error) {
+	cfg, err := getConfig(store)
+	if err != nil {
+		return nil, err
+	}
+	clientID, err := resolvers.SecretKeyRef(ctx, kube, store.GetKind(), namespace, &cfg.Auth.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf(errCannotResolveClientID, err)
+	}
+	clientSecret, err := resolvers.SecretKeyRef(ctx, kube, store.GetKind(), namespace, &cfg.Auth.ClientSecret)
+	if err != nil {
+		return nil, fmt.Errorf(errCannotResolveClientSecret, err)
+	}
+	domain := cfg.Domain
+	if cfg.CustomDomain != "" {
+		domain = cfg.CustomDomain
+	}
+	return newClient("https://"+cfg.Domain, domain, clientID, clientSecret), nil
+}
+
+// newClient returns a client of the Management API of the tenant at tenantURL.
+// The access token is requested with the client credentials grant, its audience
+// is the Management API of the tenant domain even if a custom domain is used.
+func newClient(tenantURL, domain, clientID, clientSecret string) *client {
+	cc := &clientcredentials.Config{
+		ClientID:       clientID,
+		ClientSecret:   clientSecret,
+		TokenURL:       tenantURL + "/oauth/token",
+		EndpointParams: url.Values{"audience": []string{tenantURL + "/api/v2/"}},
+		AuthStyle:      oauth2.AuthStyleInParams,
+	}
+	// the token is requested lazily, it must not be bound to the context of this call
+	tokenCtx := context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{Timeout: 30 * time.Second})
+	tokenSource := cc.TokenSource(tokenCtx)
+	return &client{
+		httpClient:  oauth2.NewClient(tokenCtx, tokenSource),
+		tokenSource: tokenSource,
+		url:         tenantURL + "/api/v2",
+		domain:      domain,
+	}
+}
+
+func getConfig(store esv1beta1.GenericStore) (*esv1beta1.Auth0Provider, error) {
+	if store == nil {
+		return nil, errors.New(errStoreIsNil)
+	}
+	spec := store.GetSpec()
+	if spec == nil || spec.Provider == nil || spec.Provider.Auth0 == nil {
+		return nil, errors.New(errNoStoreTypeOrWrongStoreType)
+	}
+	cfg := spec.Provider.Auth0
+	if cfg.Domain == "" {
+		return nil, errors.New(errDomainRequired)
+	}
+	for _, domain := range []string{cfg.Domain, cfg.CustomDomain} {
+		if domain != "" && !validDomain(domain) {
+			return nil, fmt.Errorf(errInvalidDomain, domain)
+		}
+	}
+	if err := utils.ValidateReferentSecretSelector(store, cfg.Auth.ClientID); err != nil {
+		return nil, err
+	}
+	if err := utils.ValidateReferentSecretSelector(store, cfg.Auth.ClientSecret); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// validDomain checks that domain is only a host name, it is used to build the URLs of the tenant.
+func validDomain(domain string) bool {
+	u, err := url.Parse("https://" + domain)
+	return err == nil && u.Host == domain && u.Port() == ""
+}
+
+func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnings, error) {
+	_, err := getConfig(store)
+	return nil, err
+}
+
+// ValidateRemoteRef checks that the key is set and the property is one of the returned keys.
+func (p *Provider) ValidateRemoteRef(_ esv1beta1.GenericStore, ref esv1beta1.ExternalSecretDataRemoteRef) error {
+	if ref.Key == "" {
+		return errors.New(errKeyRequired)
+	}
+	return validateProperty(ref.Property)
+}
+
+func validateProperty(property string) error {
+	switch property {
+	case "", propertyClientID, propertyClientSecret, propertyDomain:
+		return nil
+	}
+	return fmt.Errorf(errInvalidProperty, property, propertyClientID, propertyClientSecret, propertyDomain)
+}
+
+func (p *Provider) Capabilities() esv1beta1.SecretStoreCapabilities {
+	return esv1beta1.SecretStoreReadOnly
+}
+
+func init() {
+	esv1beta1.Register(&Provider{}, &esv1beta1.SecretStoreProvider{
+		Auth0: &esv1beta1.Auth0Provider{},
+	})
+}
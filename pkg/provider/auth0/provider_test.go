@@ -0,0 +1,91 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth0
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+func TestValidateStore(t *testing.T) {
+	namespace := "other"
+	validAuth := esv1beta1.Auth0Auth{
+		ClientID:     esmeta.SecretKeySelector{Name: "auth0", Key: "client-id"},
+		ClientSecret: esmeta.SecretKeySelector{Name: "auth0", Key: "client-secret"},
+	}
+	tests := map[string]struct {
+		cfg     esv1beta1.Auth0Provider
+		wantErr string
+	}{
+		"valid": {
+			cfg: esv1beta1.Auth0Provider{Domain: "acme.eu.auth0.com", Auth: validAuth},
+		},
+		"valid with custom domain": {
+			cfg: esv1beta1.Auth0Provider{Domain: "acme.us.auth0.com", CustomDomain: "login.acme.com", Auth: validAuth},
+		},
+		"missing domain": {
+			cfg:     esv1beta1.Auth0Provider{Auth: validAuth},
+			wantErr: errDomainRequired,
+		},
+		"domain with scheme": {
+			cfg:     esv1beta1.Auth0Provider{Domain: "https://acme.auth0.com", Auth: validAuth},
+			wantErr: `invalid domain "https://acme.auth0.com"`,
+		},
+		"custom domain with path": {
+			cfg:     esv1beta1.Auth0Provider{Domain: "acme.auth0.com", CustomDomain: "acme.com/login", Auth: validAuth},
+			wantErr: `invalid domain "acme.com/login"`,
+		},
+		"client secret in other namespace": {
+			cfg: esv1beta1.Auth0Provider{
+				Domain: "acme.auth0.com",
+				Auth: esv1beta1.Auth0Auth{
+					ClientID:     validAuth.ClientID,
+					ClientSecret: esmeta.SecretKeySelector{Name: "auth0", Key: "client-secret", Namespace: &namespace},
+				},
+			},
+			wantErr: "namespace should either be empty or match the namespace of the SecretStore",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := esv1beta1.SecretStore{
+				Spec: esv1beta1.SecretStoreSpec{
+					Provider: &esv1beta1.SecretStoreProvider{
+						Auth0: &tc.cfg,
+					},
+				},
+			}
+			p := &Provider{}
+			_, err := p.ValidateStore(&s)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestValidateRemoteRef(t *testing.T) {
+	p := &Provider{}
+	assert.NoError(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: "4Kl1kPZ3z7nTf2x9QwErTy6uIoPaSdFg"}))
+	assert.NoError(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: "4Kl1kPZ3z7nTf2x9QwErTy6uIoPaSdFg", Property: "domain"}))
+	assert.EqualError(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{}), errKeyRequired)
+	assert.ErrorContains(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: "4Kl1kPZ3z7nTf2x9QwErTy6uIoPaSdFg", Property: "secret"}), "invalid property")
+}
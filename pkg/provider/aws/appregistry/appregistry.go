@@ -0,0 +1,211 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appregistry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	"github.com/aws/aws-sdk-go/service/appregistry/appregistryiface"
+	"github.com/tidwall/gjson"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/find"
+)
+
+const (
+	errReadOnly           = "the AppRegistry provider is read only"
+	errListAttributeGroup = "unable to list the attribute groups of application %q: %w"
+	errGetAttributeGroup  = "unable to get attribute group %q: %w"
+	errPropertyNotFound   = "property %q not found in attribute group %q"
+	errNotAnObject        = "attributes of attribute group %q are not a JSON object"
+	errInvalidFindName    = "invalid find.name: %w"
+)
+
+type client struct {
+	api         appregistryiface.AppRegistryAPI
+	application string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+// GetSecret returns the attributes of the attribute group ref.Key as JSON,
+// or a single value of them if property is set. property is a gjson path, e.g. "database.port".
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	attributes, err := c.attributes(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Property == "" {
+		return []byte(attributes), nil
+	}
+	result := gjson.Get(attributes, ref.Property)
+	if !result.Exists() {
+		return nil, fmt.Errorf(errPropertyNotFound, ref.Property, ref.Key)
+	}
+	return resultValue(result), nil
+}
+
+// GetSecretMap returns the top level keys of the attributes, nested values are returned as JSON.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	attributes, err := c.attributes(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	doc := gjson.Parse(attributes)
+	if ref.Property != "" {
+		doc = doc.Get(ref.Property)
+		if !doc.Exists() {
+			return nil, fmt.Errorf(errPropertyNotFound, ref.Property, ref.Key)
+		}
+	}
+	if !doc.IsObject() {
+		return nil, fmt.Errorf(errNotAnObject, ref.Key)
+	}
+	secretData := make(map[string][]byte)
+	doc.ForEach(func(k, v gjson.Result) bool {
+		secretData[k.String()] = resultValue(v)
+		return true
+	})
+	return secretData, nil
+}
+
+// GetAllSecrets returns the attributes of every attribute group of the application
+// matching the name regexp and the tags, keyed by the name of the attribute group.
+func (c *client) GetAllSecrets(ctx context.Context, ref esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	var matcher *find.Matcher
+	if ref.Name != nil {
+		m, err := find.New(*ref.Name)
+		if err != nil {
+			return nil, fmt.Errorf(errInvalidFindName, err)
+		}
+		matcher = m
+	}
+	groups, err := c.attributeGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	secretData := make(map[string][]byte)
+	for _, group := range groups {
+		name := aws.StringValue(group.Name)
+		if matcher != nil && !matcher.MatchName(name) {
+			continue
+		}
+		out, err := c.api.GetAttributeGroupWithContext(ctx, &appregistry.GetAttributeGroupInput{
+			AttributeGroup: group.Id,
+		})
+		if err != nil {
+			return nil, fmt.Errorf(errGetAttributeGroup, name, err)
+		}
+		if !matchTags(ref.Tags, out.Tags) {
+			continue
+		}
+		secretData[name] = []byte(aws.StringValue(out.Attributes))
+	}
+	return secretData, nil
+}
+
+func (c *client) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
+	return false, errors.New(errReadOnly)
+}
+
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	_, err := c.api.GetApplicationWithContext(context.Background(), &appregistry.GetApplicationInput{
+		Application: aws.String(c.application),
+	})
+	if err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+// attributes returns the attributes of the attribute group with the given name, ID or ARN.
+// Attribute groups which are not associated with the application of the store are not found.
+func (c *client) attributes(ctx context.Context, name string) (string, error) {
+	groups, err := c.attributeGroups(ctx)
+	if err != nil {
+		return "", err
+	}
+	var group *appregistry.AttributeGroupDetails
+	for _, g := range groups {
+		if name == aws.StringValue(g.Name) || name == aws.StringValue(g.Id) || name == aws.StringValue(g.Arn) {
+			group = g
+			break
+		}
+	}
+	if group == nil {
+		return "", esv1beta1.NoSecretError{}
+	}
+	out, err := c.api.GetAttributeGroupWithContext(ctx, &appregistry.GetAttributeGroupInput{
+		AttributeGroup: group.Id,
+	})
+	var aerr awserr.Error
+	if errors.As(err, &aerr) && aerr.Code() == appregistry.ErrCodeResourceNotFoundException {
+		return "", esv1beta1.NoSecretError{}
+	}
+	if err != nil {
+		return "", fmt.Errorf(errGetAttributeGroup, name, err)
+	}
+	return aws.StringValue(out.Attributes), nil
+}
+
+// attributeGroups lists the attribute groups associated with the application.
+func (c *client) attributeGroups(ctx context.Context) ([]*appregistry.AttributeGroupDetails, error) {
+	var groups []*appregistry.AttributeGroupDetails
+	err := c.api.ListAttributeGroupsForApplicationPagesWithContext(ctx, &appregistry.ListAttributeGroupsForApplicationInput{
+		Application: aws.String(c.application),
+	}, func(out *appregistry.ListAttributeGroupsForApplicationOutput, _ bool) bool {
+		groups = append(groups, out.AttributeGroupsDetails...)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf(errListAttributeGroup, c.application, err)
+	}
+	return groups, nil
+}
+
+func matchTags(want map[string]string, tags map[string]*string) bool {
+	for k, v := range want {
+		tag, ok := tags[k]
+		if !ok || aws.StringValue(tag) != v {
+			return false
+		}
+	}
+	return true
+}
+
+func resultValue(result gjson.Result) []byte {
+	if result.Type == gjson.String {
+		return []byte(result.Str)
+	}
+	return []byte(result.Raw)
+}
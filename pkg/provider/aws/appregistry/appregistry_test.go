@@ -0,0 +1,212 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appregistry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	"github.com/aws/aws-sdk-go/service/appregistry/appregistryiface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+type fakeGroup struct {
+	id         string
+	attributes string
+	tags       map[string]string
+}
+
+type fakeAppRegistry struct {
+	appregistryiface.AppRegistryAPI
+	// groups by application and attribute group name
+	groups map[string]map[string]fakeGroup
+}
+
+func (f *fakeAppRegistry) ListAttributeGroupsForApplicationPagesWithContext(_ aws.Context, in *appregistry.ListAttributeGroupsForApplicationInput, fn func(*appregistry.ListAttributeGroupsForApplicationOutput, bool) bool, _ ...request.Option) error {
+	groups, ok := f.groups[aws.StringValue(in.Application)]
+	if !ok {
+		return awserr.New(appregistry.ErrCodeResourceNotFoundException, "application not found", nil)
+	}
+	// one page per attribute group
+	for name, g := range groups {
+		out := &appregistry.ListAttributeGroupsForApplicationOutput{
+			AttributeGroupsDetails: []*appregistry.AttributeGroupDetails{{
+				Name: aws.String(name),
+				Id:   aws.String(g.id),
+				Arn:  aws.String("arn:aws:servicecatalog:eu-west-1:123456789012:/attribute-groups/" + g.id),
+			}},
+		}
+		if !fn(out, false) {
+			break
+		}
+	}
+	return nil
+}
+
+func (f *fakeAppRegistry) GetAttributeGroupWithContext(_ aws.Context, in *appregistry.GetAttributeGroupInput, _ ...request.Option) (*appregistry.GetAttributeGroupOutput, error) {
+	for _, groups := range f.groups {
+		for name, g := range groups {
+			if g.id != aws.StringValue(in.AttributeGroup) {
+				continue
+			}
+			return &appregistry.GetAttributeGroupOutput{
+				Name:       aws.String(name),
+				Id:         aws.String(g.id),
+				Attributes: aws.String(g.attributes),
+				Tags:       aws.StringMap(g.tags),
+			}, nil
+		}
+	}
+	return nil, awserr.New(appregistry.ErrCodeResourceNotFoundException, "attribute group not found", nil)
+}
+
+func (f *fakeAppRegistry) GetApplicationWithContext(_ aws.Context, in *appregistry.GetApplicationInput, _ ...request.Option) (*appregistry.GetApplicationOutput, error) {
+	if _, ok := f.groups[aws.StringValue(in.Application)]; !ok {
+		return nil, awserr.New(appregistry.ErrCodeResourceNotFoundException, "application not found", nil)
+	}
+	return &appregistry.GetApplicationOutput{Name: in.Application}, nil
+}
+
+func newTestClient() *client {
+	return &client{
+		api: &fakeAppRegistry{
+			groups: map[string]map[string]fakeGroup{
+				"payments": {
+					"database": {
+						id:         "0aqmvxvgmry0ecc4mjhwypun6i",
+						attributes: `{"host":"db.example.com","port":5432,"options":{"sslmode":"require"}}`,
+						tags:       map[string]string{"team": "payments"},
+					},
+					"feature-flags": {
+						id:         "1bqmvxvgmry0ecc4mjhwypun6i",
+						attributes: `{"checkout":true}`,
+					},
+				},
+				"billing": {
+					"invoices": {
+						id:         "2cqmvxvgmry0ecc4mjhwypun6i",
+						attributes: `{"bucket":"invoices"}`,
+					},
+				},
+			},
+		},
+		application: "payments",
+	}
+}
+
+func TestGetSecret(t *testing.T) {
+	c := newTestClient()
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"attributes": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "feature-flags"},
+			want: `{"checkout":true}`,
+		},
+		"by id": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "1bqmvxvgmry0ecc4mjhwypun6i"},
+			want: `{"checkout":true}`,
+		},
+		"property": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "database", Property: "host"},
+			want: "db.example.com",
+		},
+		"number property": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "database", Property: "port"},
+			want: "5432",
+		},
+		"nested property": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "database", Property: "options.sslmode"},
+			want: "require",
+		},
+		"missing property": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "database", Property: "user"},
+			wantErr: `property "user" not found in attribute group "database"`,
+		},
+		"group of other application": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "invoices"},
+			wantErr: esv1beta1.NoSecretError{}.Error(),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestGetSecretMap(t *testing.T) {
+	c := newTestClient()
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "database"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"host":    []byte("db.example.com"),
+		"port":    []byte("5432"),
+		"options": []byte(`{"sslmode":"require"}`),
+	}, got)
+
+	_, err = c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "database", Property: "port"})
+	assert.ErrorContains(t, err, `attributes of attribute group "database" are not a JSON object`)
+}
+
+func TestGetAllSecrets(t *testing.T) {
+	c := newTestClient()
+	got, err := c.GetAllSecrets(context.Background(), esv1beta1.ExternalSecretFind{
+		Name: &esv1beta1.FindName{RegExp: "^feature-"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"feature-flags": []byte(`{"checkout":true}`)}, got)
+
+	got, err = c.GetAllSecrets(context.Background(), esv1beta1.ExternalSecretFind{
+		Tags: map[string]string{"team": "payments"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"database"}, keys(got))
+}
+
+func TestValidate(t *testing.T) {
+	c := newTestClient()
+	res, err := c.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+
+	c.application = "unknown"
+	res, err = c.Validate()
+	assert.Error(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultError, res)
+}
+
+func keys(m map[string][]byte) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	return out
+}
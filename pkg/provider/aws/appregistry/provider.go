@@ -0,0 +1,111 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appregistry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/appregistry"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	awsauth "github.com/external-secrets/external-secrets/pkg/provider/aws/auth"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	errStoreIsNil                  = "store is nil"
+	errNoStoreTypeOrWrongStoreType = "no store type or wrong store type"
+	errRegionRequired              = "region is required"
+	errApplicationRequired         = "application is required"
+	errUnableCreateSession         = "unable to create session: %w"
+)
+
+// Provider satisfies the provider interface.
+type Provider struct{}
+
+var _ esv1beta1.Provider = &Provider{}
+
+func (p *Provider) NewClient(ctx context.Context, store esv1beta1.GenericStore, kube kclient.Client, namespace string) (esv1beta1.SecretsClient, error) {
+	cfg, err := getConfig(store)
+	if err != nil {
+		return nil, err
+	}
+	sess, err := awsauth.NewStoreSession(ctx, cfg.Auth, cfg.Role, cfg.Region, store.GetKind(), kube, namespace, awsauth.DefaultSTSProvider, awsauth.DefaultJWTProvider)
+	if err != nil {
+		return nil, fmt.Errorf(errUnableCreateSession, err)
+	}
+	return &client{
+		api:         appregistry.New(sess),
+		application: cfg.Application,
+	}, nil
+}
+
+func getConfig(store esv1beta1.GenericStore) (*esv1beta1.AppRegistryProvider, error) {
+	if store == nil {
+		return nil, errors.New(errStoreIsNil)
+	}
+	spec := store.GetSpec()
+	if spec == nil || spec.Provider == nil || spec.Provider.AppRegistry == nil {
+		return nil, errors.New(errNoStoreTypeOrWrongStoreType)
+	}
+	cfg := spec.Provider.AppRegistry
+	if cfg.Region == "" {
+		return nil, errors.New(errRegionRequired)
+	}
+	if cfg.Application == "" {
+		return nil, errors.New(errApplicationRequired)
+	}
+	return cfg, nil
+}
+
+func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnings, error) {
+	cfg, err := getConfig(store)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Auth.SecretRef != nil {
+		if err := utils.ValidateReferentSecretSelector(store, cfg.Auth.SecretRef.AccessKeyID); err != nil {
+			return nil, fmt.Errorf("invalid auth.secretRef.accessKeyIDSecretRef: %w", err)
+		}
+		if err := utils.ValidateReferentSecretSelector(store, cfg.Auth.SecretRef.SecretAccessKey); err != nil {
+			return nil, fmt.Errorf("invalid auth.secretRef.secretAccessKeySecretRef: %w", err)
+		}
+		if cfg.Auth.SecretRef.SessionToken != nil {
+			if err := utils.ValidateReferentSecretSelector(store, *cfg.Auth.SecretRef.SessionToken); err != nil {
+				return nil, fmt.Errorf("invalid auth.secretRef.sessionTokenSecretRef: %w", err)
+			}
+		}
+	}
+	if cfg.Auth.JWTAuth != nil && cfg.Auth.JWTAuth.ServiceAccountRef != nil {
+		if err := utils.ValidateReferentServiceAccountSelector(store, *cfg.Auth.JWTAuth.ServiceAccountRef); err != nil {
+			return nil, fmt.Errorf("invalid auth.jwt.serviceAccountRef: %w", err)
+		}
+	}
+	return nil, nil
+}
+
+func (p *Provider) Capabilities() esv1beta1.SecretStoreCapabilities {
+	return esv1beta1.SecretStoreReadOnly
+}
+
+func init() {
+	esv1beta1.Register(&Provider{}, &esv1beta1.SecretStoreProvider{
+		AppRegistry: &esv1beta1.AppRegistryProvider{},
+	})
+}
@@ -0,0 +1,75 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package appregistry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+func TestValidateStore(t *testing.T) {
+	namespace := "other"
+	tests := map[string]struct {
+		cfg     esv1beta1.AppRegistryProvider
+		wantErr string
+	}{
+		"valid": {
+			cfg: esv1beta1.AppRegistryProvider{Region: "eu-west-1", Application: "payments"},
+		},
+		"missing region": {
+			cfg:     esv1beta1.AppRegistryProvider{Application: "payments"},
+			wantErr: errRegionRequired,
+		},
+		"missing application": {
+			cfg:     esv1beta1.AppRegistryProvider{Region: "eu-west-1"},
+			wantErr: errApplicationRequired,
+		},
+		"secret in other namespace": {
+			cfg: esv1beta1.AppRegistryProvider{
+				Region:      "eu-west-1",
+				Application: "payments",
+				Auth: esv1beta1.AWSAuth{
+					SecretRef: &esv1beta1.AWSAuthSecretRef{
+						AccessKeyID:     esmeta.SecretKeySelector{Name: "aws", Key: "id", Namespace: &namespace},
+						SecretAccessKey: esmeta.SecretKeySelector{Name: "aws", Key: "secret"},
+					},
+				},
+			},
+			wantErr: "invalid auth.secretRef.accessKeyIDSecretRef",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := esv1beta1.SecretStore{
+				Spec: esv1beta1.SecretStoreSpec{
+					Provider: &esv1beta1.SecretStoreProvider{
+						AppRegistry: &tc.cfg,
+					},
+				},
+			}
+			p := &Provider{}
+			_, err := p.ValidateStore(&s)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
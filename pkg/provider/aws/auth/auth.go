@@ -161,13 +161,25 @@ func New(ctx context.Context, store esv1beta1.GenericStore, kube client.Client,
 // * static credentials from a Kind=Secret, optionally with doing a AssumeRole.
 // * sdk default provider chain, see: https://docs.aws.amazon.com/sdk-for-java/v1/developer-guide/credentials.html#credentials-default
 func NewGeneratorSession(ctx context.Context, auth esv1beta1.AWSAuth, role, region string, kube client.Client, namespace string, assumeRoler STSProvider, jwtProvider jwtProviderFactory) (*session.Session, error) {
+	return newSessionFromAuth(ctx, auth, role, region, "", kube, namespace, assumeRoler, jwtProvider)
+}
+
+// NewStoreSession creates a new aws session for providers that are not configured
+// with an AWSProvider but embed an AWSAuth, authentication works like NewGeneratorSession.
+// Secrets and service accounts are resolved like the ones of the given store kind.
+func NewStoreSession(ctx context.Context, auth esv1beta1.AWSAuth, role, region, storeKind string, kube client.Client, namespace string, assumeRoler STSProvider, jwtProvider jwtProviderFactory) (*session.Session, error) {
+	return newSessionFromAuth(ctx, auth, role, region, storeKind, kube, namespace, assumeRoler, jwtProvider)
+}
+
+func newSessionFromAuth(ctx context.Context, auth esv1beta1.AWSAuth, role, region, storeKind string, kube client.Client, namespace string, assumeRoler STSProvider, jwtProvider jwtProviderFactory) (*session.Session, error) {
 	var creds *credentials.Credentials
 	var err error
+	isClusterKind := storeKind == esv1beta1.ClusterSecretStoreKind
 
 	// use credentials via service account token
 	jwtAuth := auth.JWTAuth
 	if jwtAuth != nil {
-		creds, err = credsFromServiceAccount(ctx, auth, region, false, kube, namespace, jwtProvider)
+		creds, err = credsFromServiceAccount(ctx, auth, region, isClusterKind, kube, namespace, jwtProvider)
 		if err != nil {
 			return nil, err
 		}
@@ -177,7 +189,7 @@ func NewGeneratorSession(ctx context.Context, auth esv1beta1.AWSAuth, role, regi
 	secretRef := auth.SecretRef
 	if secretRef != nil {
 		log.V(1).Info("using credentials from secretRef")
-		creds, err = credsFromSecretRef(ctx, auth, "", kube, namespace)
+		creds, err = credsFromSecretRef(ctx, auth, storeKind, kube, namespace)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,305 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/aws/aws-sdk-go/service/iot/iotiface"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	propertyCertificateID  = "certificateId"
+	propertyCertificateArn = "certificateArn"
+	propertyCertificatePem = "certificatePem"
+	propertyPrivateKey     = "privateKey"
+	propertyPublicKey      = "publicKey"
+
+	errReadOnly          = "the AWS IoT provider can not push secrets"
+	errFindAll           = "the AWS IoT provider can not find secrets"
+	errDescribeThing     = "unable to describe thing %q: %w"
+	errCreateCertificate = "unable to create certificate for thing %q: %w"
+	errAttachCertificate = "unable to attach certificate %s to thing %q: %w"
+	errAttachPolicy      = "unable to attach policy %q to certificate %s: %w"
+	errDescribeCert      = "unable to describe certificate %s: %w"
+	errCertificateStatus = "certificate %s is %s"
+	errRevokeCertificate = "unable to delete certificate %s: %w"
+	errNoValidity        = "certificate %s has no validity"
+)
+
+// properties are the values of a provisioned certificate.
+var properties = []string{propertyCertificateID, propertyCertificateArn, propertyCertificatePem, propertyPrivateKey, propertyPublicKey}
+
+type client struct {
+	api         iotiface.IoTAPI
+	policyNames []string
+
+	// certificates created by the client, by thing name, so that the
+	// values of separate remoteRefs belong to the same certificate
+	certificates map[string]map[string][]byte
+	// certificate IDs created since the last call to IssuedLeases
+	leases []string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+var _ esv1beta1.LeaseClient = &client{}
+
+// GetSecret provisions a certificate for the thing ref.Key and returns one of its values,
+// or all of them as JSON if no property is set.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	values, err := c.certificate(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Property == "" {
+		out := make(map[string]string, len(values))
+		for k, v := range values {
+			out[k] = string(v)
+		}
+		return utils.JSONMarshal(out)
+	}
+	value, ok := values[ref.Property]
+	if !ok {
+		return nil, fmt.Errorf(errInvalidProperty, ref.Property, properties)
+	}
+	return value, nil
+}
+
+// GetSecretMap provisions a certificate for the thing ref.Key and returns all of its values.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	values, err := c.certificate(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	secretData := make(map[string][]byte, len(values))
+	for k, v := range values {
+		secretData[k] = v
+	}
+	return secretData, nil
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindAll)
+}
+
+func (c *client) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
+	return false, errors.New(errReadOnly)
+}
+
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	_, err := c.api.ListThingsWithContext(context.Background(), &iot.ListThingsInput{
+		MaxResults: aws.Int64(1),
+	})
+	if err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+// IssuedLeases returns the IDs of the certificates created since the last call.
+func (c *client) IssuedLeases() []string {
+	leases := c.leases
+	c.leases = nil
+	return leases
+}
+
+// RenewLease checks that a certificate is still active and returns the time until it expires.
+// Certificates can not be extended, increment is ignored.
+func (c *client) RenewLease(ctx context.Context, leaseID string, _ time.Duration) (time.Duration, error) {
+	out, err := c.api.DescribeCertificateWithContext(ctx, &iot.DescribeCertificateInput{
+		CertificateId: aws.String(leaseID),
+	})
+	if err != nil {
+		return 0, fmt.Errorf(errDescribeCert, leaseID, err)
+	}
+	desc := out.CertificateDescription
+	if status := aws.StringValue(desc.Status); status != iot.CertificateStatusActive {
+		return 0, fmt.Errorf(errCertificateStatus, leaseID, status)
+	}
+	if desc.Validity == nil || desc.Validity.NotAfter == nil {
+		return 0, fmt.Errorf(errNoValidity, leaseID)
+	}
+	return time.Until(*desc.Validity.NotAfter), nil
+}
+
+// RevokeLease detaches a certificate from its things and policies, deactivates and deletes it.
+// Certificates which do not exist anymore are ignored.
+func (c *client) RevokeLease(ctx context.Context, leaseID string) error {
+	out, err := c.api.DescribeCertificateWithContext(ctx, &iot.DescribeCertificateInput{
+		CertificateId: aws.String(leaseID),
+	})
+	if isNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf(errRevokeCertificate, leaseID, err)
+	}
+	if err := c.deleteCertificate(ctx, leaseID, aws.StringValue(out.CertificateDescription.CertificateArn)); err != nil {
+		return fmt.Errorf(errRevokeCertificate, leaseID, err)
+	}
+	return nil
+}
+
+// certificate returns the values of the certificate of a thing, creating it on the first call.
+func (c *client) certificate(ctx context.Context, thingName string) (map[string][]byte, error) {
+	if values, ok := c.certificates[thingName]; ok {
+		return values, nil
+	}
+	_, err := c.api.DescribeThingWithContext(ctx, &iot.DescribeThingInput{
+		ThingName: aws.String(thingName),
+	})
+	if isNotFound(err) {
+		return nil, esv1beta1.NoSecretError{}
+	}
+	if err != nil {
+		return nil, fmt.Errorf(errDescribeThing, thingName, err)
+	}
+
+	out, err := c.api.CreateKeysAndCertificateWithContext(ctx, &iot.CreateKeysAndCertificateInput{
+		SetAsActive: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf(errCreateCertificate, thingName, err)
+	}
+	id, arn := aws.StringValue(out.CertificateId), aws.StringValue(out.CertificateArn)
+	if err := c.attach(ctx, thingName, id, arn); err != nil {
+		// the certificate is not recorded as a lease yet, do not leave it behind
+		if derr := c.deleteCertificate(ctx, id, arn); derr != nil {
+			err = errors.Join(err, fmt.Errorf(errRevokeCertificate, id, derr))
+		}
+		return nil, err
+	}
+
+	values := map[string][]byte{
+		propertyCertificateID:  []byte(id),
+		propertyCertificateArn: []byte(arn),
+		propertyCertificatePem: []byte(aws.StringValue(out.CertificatePem)),
+	}
+	if out.KeyPair != nil {
+		values[propertyPrivateKey] = []byte(aws.StringValue(out.KeyPair.PrivateKey))
+		values[propertyPublicKey] = []byte(aws.StringValue(out.KeyPair.PublicKey))
+	}
+	if c.certificates == nil {
+		c.certificates = make(map[string]map[string][]byte)
+	}
+	c.certificates[thingName] = values
+	c.leases = append(c.leases, id)
+	return values, nil
+}
+
+// attach attaches the policies of the store and the thing to a new certificate.
+func (c *client) attach(ctx context.Context, thingName, id, arn string) error {
+	for _, policy := range c.policyNames {
+		_, err := c.api.AttachPolicyWithContext(ctx, &iot.AttachPolicyInput{
+			PolicyName: aws.String(policy),
+			Target:     aws.String(arn),
+		})
+		if err != nil {
+			return fmt.Errorf(errAttachPolicy, policy, id, err)
+		}
+	}
+	_, err := c.api.AttachThingPrincipalWithContext(ctx, &iot.AttachThingPrincipalInput{
+		ThingName: aws.String(thingName),
+		Principal: aws.String(arn),
+	})
+	if err != nil {
+		return fmt.Errorf(errAttachCertificate, id, thingName, err)
+	}
+	return nil
+}
+
+// deleteCertificate detaches a certificate from all things and policies, which
+// AWS IoT requires before it can be deleted, deactivates and deletes it.
+func (c *client) deleteCertificate(ctx context.Context, id, arn string) error {
+	var things []*string
+	err := c.api.ListPrincipalThingsPagesWithContext(ctx, &iot.ListPrincipalThingsInput{
+		Principal: aws.String(arn),
+	}, func(out *iot.ListPrincipalThingsOutput, _ bool) bool {
+		things = append(things, out.Things...)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	for _, thing := range things {
+		_, err := c.api.DetachThingPrincipalWithContext(ctx, &iot.DetachThingPrincipalInput{
+			ThingName: thing,
+			Principal: aws.String(arn),
+		})
+		if err != nil && !isNotFound(err) {
+			return err
+		}
+	}
+	var policies []*iot.Policy
+	err = c.api.ListAttachedPoliciesPagesWithContext(ctx, &iot.ListAttachedPoliciesInput{
+		Target: aws.String(arn),
+	}, func(out *iot.ListAttachedPoliciesOutput, _ bool) bool {
+		policies = append(policies, out.Policies...)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	for _, policy := range policies {
+		_, err := c.api.DetachPolicyWithContext(ctx, &iot.DetachPolicyInput{
+			PolicyName: policy.PolicyName,
+			Target:     aws.String(arn),
+		})
+		if err != nil && !isNotFound(err) {
+			return err
+		}
+	}
+	_, err = c.api.UpdateCertificateWithContext(ctx, &iot.UpdateCertificateInput{
+		CertificateId: aws.String(id),
+		NewStatus:     aws.String(iot.CertificateStatusInactive),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = c.api.DeleteCertificateWithContext(ctx, &iot.DeleteCertificateInput{
+		CertificateId: aws.String(id),
+	})
+	if isNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func isNotFound(err error) bool {
+	var aerr awserr.Error
+	return errors.As(err, &aerr) && aerr.Code() == iot.ErrCodeResourceNotFoundException
+}
@@ -0,0 +1,228 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iot
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/iot"
+	"github.com/aws/aws-sdk-go/service/iot/iotiface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+type fakeCertificate struct {
+	arn      string
+	status   string
+	notAfter time.Time
+	things   []string
+	policies []string
+}
+
+type fakeIoT struct {
+	iotiface.IoTAPI
+	things       map[string]bool
+	policies     map[string]bool
+	certificates map[string]*fakeCertificate
+	created      int
+}
+
+func newFakeIoT() *fakeIoT {
+	return &fakeIoT{
+		things:       map[string]bool{"sensor-1": true},
+		policies:     map[string]bool{"telemetry": true},
+		certificates: map[string]*fakeCertificate{},
+	}
+}
+
+func notFound() error {
+	return awserr.New(iot.ErrCodeResourceNotFoundException, "not found", nil)
+}
+
+func (f *fakeIoT) DescribeThingWithContext(_ aws.Context, in *iot.DescribeThingInput, _ ...request.Option) (*iot.DescribeThingOutput, error) {
+	if !f.things[aws.StringValue(in.ThingName)] {
+		return nil, notFound()
+	}
+	return &iot.DescribeThingOutput{ThingName: in.ThingName}, nil
+}
+
+func (f *fakeIoT) CreateKeysAndCertificateWithContext(_ aws.Context, _ *iot.CreateKeysAndCertificateInput, _ ...request.Option) (*iot.CreateKeysAndCertificateOutput, error) {
+	f.created++
+	id := string(rune('a'+f.created-1)) + "0000"
+	cert := &fakeCertificate{
+		arn:      "arn:aws:iot:eu-west-1:123456789012:cert/" + id,
+		status:   iot.CertificateStatusActive,
+		notAfter: time.Now().AddDate(1, 0, 0),
+	}
+	f.certificates[id] = cert
+	return &iot.CreateKeysAndCertificateOutput{
+		CertificateId:  aws.String(id),
+		CertificateArn: aws.String(cert.arn),
+		CertificatePem: aws.String("cert-" + id),
+		KeyPair: &iot.KeyPair{
+			PrivateKey: aws.String("private-" + id),
+			PublicKey:  aws.String("public-" + id),
+		},
+	}, nil
+}
+
+func (f *fakeIoT) byArn(arn string) *fakeCertificate {
+	for _, cert := range f.certificates {
+		if cert.arn == arn {
+			return cert
+		}
+	}
+	return nil
+}
+
+func (f *fakeIoT) AttachPolicyWithContext(_ aws.Context, in *iot.AttachPolicyInput, _ ...request.Option) (*iot.AttachPolicyOutput, error) {
+	if !f.policies[aws.StringValue(in.PolicyName)] {
+		return nil, notFound()
+	}
+	cert := f.byArn(aws.StringValue(in.Target))
+	cert.policies = append(cert.policies, aws.StringValue(in.PolicyName))
+	return &iot.AttachPolicyOutput{}, nil
+}
+
+func (f *fakeIoT) AttachThingPrincipalWithContext(_ aws.Context, in *iot.AttachThingPrincipalInput, _ ...request.Option) (*iot.AttachThingPrincipalOutput, error) {
+	cert := f.byArn(aws.StringValue(in.Principal))
+	cert.things = append(cert.things, aws.StringValue(in.ThingName))
+	return &iot.AttachThingPrincipalOutput{}, nil
+}
+
+func (f *fakeIoT) DescribeCertificateWithContext(_ aws.Context, in *iot.DescribeCertificateInput, _ ...request.Option) (*iot.DescribeCertificateOutput, error) {
+	cert, ok := f.certificates[aws.StringValue(in.CertificateId)]
+	if !ok {
+		return nil, notFound()
+	}
+	return &iot.DescribeCertificateOutput{CertificateDescription: &iot.CertificateDescription{
+		CertificateId:  in.CertificateId,
+		CertificateArn: aws.String(cert.arn),
+		Status:         aws.String(cert.status),
+		Validity:       &iot.CertificateValidity{NotAfter: aws.Time(cert.notAfter)},
+	}}, nil
+}
+
+func (f *fakeIoT) ListPrincipalThingsPagesWithContext(_ aws.Context, in *iot.ListPrincipalThingsInput, fn func(*iot.ListPrincipalThingsOutput, bool) bool, _ ...request.Option) error {
+	fn(&iot.ListPrincipalThingsOutput{Things: aws.StringSlice(f.byArn(aws.StringValue(in.Principal)).things)}, true)
+	return nil
+}
+
+func (f *fakeIoT) DetachThingPrincipalWithContext(_ aws.Context, in *iot.DetachThingPrincipalInput, _ ...request.Option) (*iot.DetachThingPrincipalOutput, error) {
+	f.byArn(aws.StringValue(in.Principal)).things = nil
+	return &iot.DetachThingPrincipalOutput{}, nil
+}
+
+func (f *fakeIoT) ListAttachedPoliciesPagesWithContext(_ aws.Context, in *iot.ListAttachedPoliciesInput, fn func(*iot.ListAttachedPoliciesOutput, bool) bool, _ ...request.Option) error {
+	var policies []*iot.Policy
+	for _, name := range f.byArn(aws.StringValue(in.Target)).policies {
+		policies = append(policies, &iot.Policy{PolicyName: aws.String(name)})
+	}
+	fn(&iot.ListAttachedPoliciesOutput{Policies: policies}, true)
+	return nil
+}
+
+func (f *fakeIoT) DetachPolicyWithContext(_ aws.Context, in *iot.DetachPolicyInput, _ ...request.Option) (*iot.DetachPolicyOutput, error) {
+	f.byArn(aws.StringValue(in.Target)).policies = nil
+	return &iot.DetachPolicyOutput{}, nil
+}
+
+func (f *fakeIoT) UpdateCertificateWithContext(_ aws.Context, in *iot.UpdateCertificateInput, _ ...request.Option) (*iot.UpdateCertificateOutput, error) {
+	f.certificates[aws.StringValue(in.CertificateId)].status = aws.StringValue(in.NewStatus)
+	return &iot.UpdateCertificateOutput{}, nil
+}
+
+func (f *fakeIoT) DeleteCertificateWithContext(_ aws.Context, in *iot.DeleteCertificateInput, _ ...request.Option) (*iot.DeleteCertificateOutput, error) {
+	cert := f.certificates[aws.StringValue(in.CertificateId)]
+	if cert.status != iot.CertificateStatusInactive || len(cert.things) > 0 || len(cert.policies) > 0 {
+		return nil, errors.New("certificate is in use")
+	}
+	delete(f.certificates, aws.StringValue(in.CertificateId))
+	return &iot.DeleteCertificateOutput{}, nil
+}
+
+func TestGetSecret(t *testing.T) {
+	fake := newFakeIoT()
+	c := &client{api: fake, policyNames: []string{"telemetry"}}
+	ctx := context.Background()
+
+	// separate remoteRefs of the same thing share a certificate
+	cert, err := c.GetSecret(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: "sensor-1", Property: propertyCertificatePem})
+	require.NoError(t, err)
+	key, err := c.GetSecret(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: "sensor-1", Property: propertyPrivateKey})
+	require.NoError(t, err)
+	assert.Equal(t, "cert-a0000", string(cert))
+	assert.Equal(t, "private-a0000", string(key))
+	assert.Equal(t, 1, fake.created)
+	assert.Equal(t, []string{"a0000"}, c.IssuedLeases())
+	assert.Empty(t, c.IssuedLeases())
+
+	created := fake.certificates["a0000"]
+	assert.Equal(t, []string{"sensor-1"}, created.things)
+	assert.Equal(t, []string{"telemetry"}, created.policies)
+
+	all, err := c.GetSecretMap(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: "sensor-1"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		propertyCertificateID:  []byte("a0000"),
+		propertyCertificateArn: []byte(created.arn),
+		propertyCertificatePem: []byte("cert-a0000"),
+		propertyPrivateKey:     []byte("private-a0000"),
+		propertyPublicKey:      []byte("public-a0000"),
+	}, all)
+
+	_, err = c.GetSecret(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: "sensor-2"})
+	assert.ErrorIs(t, err, esv1beta1.NoSecretError{})
+	_, err = c.GetSecret(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: "sensor-1", Property: "endpoint"})
+	assert.ErrorContains(t, err, `invalid property "endpoint"`)
+}
+
+func TestGetSecretAttachFailure(t *testing.T) {
+	fake := newFakeIoT()
+	c := &client{api: fake, policyNames: []string{"missing"}}
+	_, err := c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "sensor-1"})
+	assert.ErrorContains(t, err, `unable to attach policy "missing"`)
+	assert.Empty(t, fake.certificates, "the certificate must be deleted")
+	assert.Empty(t, c.IssuedLeases())
+}
+
+func TestLeases(t *testing.T) {
+	fake := newFakeIoT()
+	c := &client{api: fake, policyNames: []string{"telemetry"}}
+	ctx := context.Background()
+	_, err := c.GetSecret(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: "sensor-1"})
+	require.NoError(t, err)
+
+	ttl, err := c.RenewLease(ctx, "a0000", time.Hour)
+	require.NoError(t, err)
+	assert.Greater(t, ttl, 364*24*time.Hour)
+
+	fake.certificates["a0000"].status = iot.CertificateStatusRevoked
+	_, err = c.RenewLease(ctx, "a0000", time.Hour)
+	assert.ErrorContains(t, err, "certificate a0000 is REVOKED")
+
+	require.NoError(t, c.RevokeLease(ctx, "a0000"))
+	assert.Empty(t, fake.certificates)
+	// certificates which were deleted already are ignored
+	require.NoError(t, c.RevokeLease(ctx, "a0000"))
+}
@@ -0,0 +1,77 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+func TestValidateStore(t *testing.T) {
+	namespace := "other"
+	tests := map[string]struct {
+		cfg     esv1beta1.AWSIoTProvider
+		wantErr string
+	}{
+		"valid": {
+			cfg: esv1beta1.AWSIoTProvider{Region: "eu-west-1", PolicyNames: []string{"telemetry"}},
+		},
+		"missing region": {
+			cfg:     esv1beta1.AWSIoTProvider{},
+			wantErr: errRegionRequired,
+		},
+		"secret in other namespace": {
+			cfg: esv1beta1.AWSIoTProvider{
+				Region: "eu-west-1",
+				Auth: esv1beta1.AWSAuth{
+					SecretRef: &esv1beta1.AWSAuthSecretRef{
+						AccessKeyID:     esmeta.SecretKeySelector{Name: "aws", Key: "id", Namespace: &namespace},
+						SecretAccessKey: esmeta.SecretKeySelector{Name: "aws", Key: "secret"},
+					},
+				},
+			},
+			wantErr: "invalid auth.secretRef.accessKeyIDSecretRef",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := esv1beta1.SecretStore{
+				Spec: esv1beta1.SecretStoreSpec{
+					Provider: &esv1beta1.SecretStoreProvider{
+						AWSIoT: &tc.cfg,
+					},
+				},
+			}
+			p := &Provider{}
+			_, err := p.ValidateStore(&s)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestValidateRemoteRef(t *testing.T) {
+	p := &Provider{}
+	assert.NoError(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: "sensor-1"}))
+	assert.NoError(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: "sensor-1", Property: "privateKey"}))
+	assert.Error(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: "sensor-1", Property: "private_key"}))
+}
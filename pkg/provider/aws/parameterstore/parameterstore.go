@@ -181,11 +181,14 @@ func (pm *ParameterStore) PushSecret(ctx context.Context, secret *corev1.Secret,
 	stringValue := string(value)
 	secretName := data.GetRemoteKey()
 
+	// Intelligent-Tiering stores values larger than 4KB as advanced parameters and keeps the
+	// tier of existing advanced parameters, which can't be reverted to the standard tier.
 	secretRequest := ssm.PutParameterInput{
 		Name:      &secretName,
 		Value:     &stringValue,
 		Type:      &parameterTypeFormat,
 		Overwrite: &overwrite,
+		Tier:      aws.String(ssm.ParameterTierIntelligentTiering),
 	}
 
 	if parameterTypeFormat == "SecureString" {
@@ -451,21 +454,41 @@ func (pm *ParameterStore) GetSecret(ctx context.Context, ref esv1beta1.ExternalS
 		}
 		return nil, fmt.Errorf("invalid secret received. parameter value is nil for key: %s", ref.Key)
 	}
+	value, err := parameterJSON(out.Parameter)
+	if err != nil {
+		return nil, err
+	}
 	idx := strings.Index(ref.Property, ".")
 	if idx > -1 {
 		refProperty := strings.ReplaceAll(ref.Property, ".", "\\.")
-		val := gjson.Get(*out.Parameter.Value, refProperty)
+		val := gjson.Get(value, refProperty)
 		if val.Exists() {
 			return []byte(val.String()), nil
 		}
 	}
-	val := gjson.Get(*out.Parameter.Value, ref.Property)
+	val := gjson.Get(value, ref.Property)
 	if !val.Exists() {
 		return nil, fmt.Errorf("key %s does not exist in secret %s", ref.Property, ref.Key)
 	}
 	return []byte(val.String()), nil
 }
 
+// parameterJSON returns the value of a parameter as JSON to select a property from,
+// the items of a StringList parameter are returned as a JSON array.
+func parameterJSON(param *ssm.Parameter) (string, error) {
+	if param.Value == nil {
+		return "", nil
+	}
+	if param.Type == nil || *param.Type != ssm.ParameterTypeStringList {
+		return *param.Value, nil
+	}
+	items, err := json.Marshal(strings.Split(*param.Value, ","))
+	if err != nil {
+		return "", fmt.Errorf("unable to serialize string list: %w", err)
+	}
+	return string(items), nil
+}
+
 func (pm *ParameterStore) getParameterTags(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (*ssm.GetParameterOutput, error) {
 	param := ssm.GetParameterOutput{
 		Parameter: &ssm.Parameter{
@@ -23,6 +23,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
@@ -560,6 +561,67 @@ func TestPushSecretCalledOnlyOnce(t *testing.T) {
 	assert.Equal(t, 0, client.PutParameterWithContextCalledN)
 }
 
+func TestPushSecretTier(t *testing.T) {
+	managedByESO := ssm.Tag{
+		Key:   &managedBy,
+		Value: &externalSecrets,
+	}
+	tests := map[string]struct {
+		value string
+		// existingTier is the tier of the parameter before the push, empty if it does not exist
+		existingTier string
+	}{
+		"new standard": {
+			value: strings.Repeat("a", 4096),
+		},
+		"new advanced": {
+			value: strings.Repeat("a", 4097),
+		},
+		"update advanced with a small value": {
+			value:        "small",
+			existingTier: ssm.ParameterTierAdvanced,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var input *ssm.PutParameterInput
+			client := fakeps.Client{
+				PutParameterWithContextFn: func(_ aws.Context, in *ssm.PutParameterInput, _ ...request.Option) (*ssm.PutParameterOutput, error) {
+					input = in
+					// like AWS, an advanced parameter can't be updated as a standard parameter
+					tier := aws.StringValue(in.Tier)
+					if tc.existingTier == ssm.ParameterTierAdvanced && (tier == "" || tier == ssm.ParameterTierStandard) {
+						return nil, awserr.New("ValidationException", "This parameter uses the advanced-parameter tier. You can't downgrade a parameter from the advanced-parameter tier to the standard-parameter tier.", nil)
+					}
+					return &ssm.PutParameterOutput{}, nil
+				},
+				GetParameterWithContextFn:        fakeps.NewGetParameterWithContextFn(nil, &ssm.ParameterNotFound{}),
+				ListTagsForResourceWithContextFn: fakeps.NewListTagsForResourceWithContextFn(&ssm.ListTagsForResourceOutput{TagList: []*ssm.Tag{&managedByESO}}, nil),
+			}
+			if tc.existingTier != "" {
+				client.GetParameterWithContextFn = fakeps.NewGetParameterWithContextFn(&ssm.GetParameterOutput{
+					Parameter: &ssm.Parameter{
+						Name:  aws.String(remoteKey),
+						Value: aws.String(strings.Repeat("a", 5000)),
+					},
+				}, nil)
+			}
+			secret := &corev1.Secret{
+				Data: map[string][]byte{
+					"key": []byte(tc.value),
+				},
+			}
+			ps := ParameterStore{
+				client: &client,
+			}
+
+			require.NoError(t, ps.PushSecret(context.TODO(), secret, fake.PushSecretData{SecretKey: "key", RemoteKey: remoteKey}))
+			require.NotNil(t, input)
+			assert.Equal(t, ssm.ParameterTierIntelligentTiering, aws.StringValue(input.Tier))
+		})
+	}
+}
+
 // test the ssm<->aws interface
 // make sure correct values are passed and errors are handled accordingly.
 func TestGetSecret(t *testing.T) {
@@ -582,6 +644,21 @@ func TestGetSecret(t *testing.T) {
 		pstc.remoteRef.Property = "/shmoo.boom"
 	}
 
+	// good case: string list is returned as is
+	setStringList := func(pstc *parameterstoreTestCase) {
+		pstc.apiOutput.Parameter.Type = aws.String(ssm.ParameterTypeStringList)
+		pstc.apiOutput.Parameter.Value = aws.String("a,b,c")
+		pstc.expectedSecret = "a,b,c"
+	}
+
+	// good case: extract item of a string list
+	setStringListProperty := func(pstc *parameterstoreTestCase) {
+		pstc.apiOutput.Parameter.Type = aws.String(ssm.ParameterTypeStringList)
+		pstc.apiOutput.Parameter.Value = aws.String("a,b,c")
+		pstc.remoteRef.Property = "1"
+		pstc.expectedSecret = "b"
+	}
+
 	// bad case: missing property
 	setMissingProperty := func(pstc *parameterstoreTestCase) {
 		pstc.apiOutput.Parameter.Value = aws.String(`{"/shmoo": "bang"}`)
@@ -656,6 +733,8 @@ func TestGetSecret(t *testing.T) {
 		makeValidParameterStoreTestCaseCustom(setParameterValueNil),
 		makeValidParameterStoreTestCaseCustom(setAPIError),
 		makeValidParameterStoreTestCaseCustom(setExtractPropertyWithDot),
+		makeValidParameterStoreTestCaseCustom(setStringList),
+		makeValidParameterStoreTestCaseCustom(setStringListProperty),
 		makeValidParameterStoreTestCaseCustom(setParameterValueNotFound),
 		makeValidParameterStoreTestCaseCustom(setMetadataString),
 		makeValidParameterStoreTestCaseCustom(setMetadataProperty),
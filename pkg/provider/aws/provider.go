@@ -17,6 +17,8 @@ package aws
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -38,6 +40,7 @@ import (
 
 // https://github.com/external-secrets/external-secrets/issues/644
 var _ esv1beta1.Provider = &Provider{}
+var _ esv1beta1.RemoteRefValidator = &Provider{}
 
 // Provider satisfies the provider interface.
 type Provider struct{}
@@ -48,6 +51,14 @@ const (
 	errRegionNotFound         = "region not found: %s"
 	errInitAWSProvider        = "unable to initialize aws provider: %s"
 	errInvalidSecretsManager  = "invalid SecretsManager settings: %s"
+	errInvalidSecretName      = "invalid key %q: secret names may only contain alphanumeric characters and /_+=.@- and be at most 512 characters long"
+	errInvalidParameterName   = "invalid key %q: parameter names may only contain alphanumeric characters and /_.-"
+)
+
+var (
+	secretNameRegexp = regexp.MustCompile(`^[A-Za-z0-9/_+=.@-]{1,512}$`)
+	// parameter names may carry a version or label selector, e.g. /app/db:3.
+	parameterNameRegexp = regexp.MustCompile(`^[A-Za-z0-9/_.-]+(:[A-Za-z0-9_.-]+)?$`)
 )
 
 // Capabilities return the provider supported capabilities (ReadOnly, WriteOnly, ReadWrite).
@@ -99,6 +110,29 @@ func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnin
 	return nil, nil
 }
 
+// ValidateRemoteRef checks the key against the naming rules of the service.
+// Keys given as ARN are not checked.
+func (p *Provider) ValidateRemoteRef(store esv1beta1.GenericStore, ref esv1beta1.ExternalSecretDataRemoteRef) error {
+	prov, err := util.GetAWSProvider(store)
+	if err != nil {
+		return err
+	}
+	if strings.HasPrefix(ref.Key, "arn:") {
+		return nil
+	}
+	switch prov.Service {
+	case esv1beta1.AWSServiceSecretsManager:
+		if !secretNameRegexp.MatchString(ref.Key) {
+			return fmt.Errorf(errInvalidSecretName, ref.Key)
+		}
+	case esv1beta1.AWSServiceParameterStore:
+		if !parameterNameRegexp.MatchString(ref.Key) {
+			return fmt.Errorf(errInvalidParameterName, ref.Key)
+		}
+	}
+	return nil
+}
+
 func validateRegion(prov *esv1beta1.AWSProvider) error {
 	resolver := endpoints.DefaultResolver()
 	partitions := resolver.(endpoints.EnumPartitions).Partitions()
@@ -481,6 +481,72 @@ func TestValidateStore(t *testing.T) {
 	}
 }
 
+func TestValidateRemoteRef(t *testing.T) {
+	tests := []struct {
+		name    string
+		service esv1beta1.AWSServiceType
+		key     string
+		wantErr bool
+	}{
+		{
+			name:    "secret name",
+			service: esv1beta1.AWSServiceSecretsManager,
+			key:     "prod/app+db@eu=1.0_x-y",
+		},
+		{
+			name:    "secret name with invalid characters",
+			service: esv1beta1.AWSServiceSecretsManager,
+			key:     "prod:app",
+			wantErr: true,
+		},
+		{
+			name:    "secret name too long",
+			service: esv1beta1.AWSServiceSecretsManager,
+			key:     strings.Repeat("a", 513),
+			wantErr: true,
+		},
+		{
+			name:    "secret arn",
+			service: esv1beta1.AWSServiceSecretsManager,
+			key:     "arn:aws:secretsmanager:eu-west-1:123456789012:secret:prod/app-AbCdEf",
+		},
+		{
+			name:    "parameter name",
+			service: esv1beta1.AWSServiceParameterStore,
+			key:     "/prod/app/db.password",
+		},
+		{
+			name:    "parameter name with selector",
+			service: esv1beta1.AWSServiceParameterStore,
+			key:     "/prod/app/db:3",
+		},
+		{
+			name:    "parameter name with invalid characters",
+			service: esv1beta1.AWSServiceParameterStore,
+			key:     "/prod/app+db",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Provider{}
+			store := &esv1beta1.SecretStore{
+				Spec: esv1beta1.SecretStoreSpec{
+					Provider: &esv1beta1.SecretStoreProvider{
+						AWS: &esv1beta1.AWSProvider{
+							Service: tt.service,
+						},
+					},
+				},
+			}
+			err := p.ValidateRemoteRef(store, esv1beta1.ExternalSecretDataRemoteRef{Key: tt.key})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateRemoteRef() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestValidRetryInput(t *testing.T) {
 	invalid := "Invalid"
 	spec := &esv1beta1.SecretStore{
@@ -0,0 +1,105 @@
+//go:build integration
+
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	testingfake "github.com/external-secrets/external-secrets/pkg/provider/testing/fake"
+	"github.com/external-secrets/external-secrets/pkg/provider/testing/testinfra"
+)
+
+var localStack *testinfra.LocalStack
+
+func TestMain(m *testing.M) {
+	ctx := context.Background()
+	l, err := testinfra.StartLocalStack(ctx, "secretsmanager")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	localStack = l
+	code := m.Run()
+	_ = l.Terminate(ctx)
+	os.Exit(code)
+}
+
+// newIntegrationClient returns a client of the Secrets Manager API of LocalStack,
+// every test uses a new client as fetched secrets are cached by the client.
+func newIntegrationClient(t *testing.T) *SecretsManager {
+	t.Helper()
+	sess, err := localStack.Session()
+	require.NoError(t, err)
+	sm, err := New(sess, sess.Config, &esv1beta1.SecretsManager{ForceDeleteWithoutRecovery: true}, false)
+	require.NoError(t, err)
+	return sm
+}
+
+func TestIntegrationPushAndGetSecret(t *testing.T) {
+	ctx := context.Background()
+	secret := &corev1.Secret{Data: map[string][]byte{"config": []byte(`{"user":"admin","password":"s3cr3t"}`)}}
+	ref := testingfake.PushSecretData{SecretKey: "config", RemoteKey: "integration/push"}
+	require.NoError(t, newIntegrationClient(t).PushSecret(ctx, secret, ref))
+
+	sm := newIntegrationClient(t)
+	got, err := sm.GetSecret(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: "integration/push", Property: "password"})
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t", string(got))
+
+	gotMap, err := sm.GetSecretMap(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: "integration/push"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"user": []byte("admin"), "password": []byte("s3cr3t")}, gotMap)
+}
+
+func TestIntegrationDeleteSecret(t *testing.T) {
+	ctx := context.Background()
+	secret := &corev1.Secret{Data: map[string][]byte{"token": []byte("abc")}}
+	ref := testingfake.PushSecretData{SecretKey: "token", RemoteKey: "integration/delete"}
+	require.NoError(t, newIntegrationClient(t).PushSecret(ctx, secret, ref))
+
+	exists, err := newIntegrationClient(t).SecretExists(ctx, ref)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	require.NoError(t, newIntegrationClient(t).DeleteSecret(ctx, ref))
+	_, err = newIntegrationClient(t).GetSecret(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: "integration/delete"})
+	assert.Error(t, err)
+}
+
+func TestIntegrationGetAllSecrets(t *testing.T) {
+	ctx := context.Background()
+	for _, key := range []string{"integration/find/a", "integration/find/b", "integration/other/c"} {
+		secret := &corev1.Secret{Data: map[string][]byte{"value": []byte(key)}}
+		require.NoError(t, newIntegrationClient(t).PushSecret(ctx, secret, testingfake.PushSecretData{SecretKey: "value", RemoteKey: key}))
+	}
+	got, err := newIntegrationClient(t).GetAllSecrets(ctx, esv1beta1.ExternalSecretFind{
+		Name: &esv1beta1.FindName{RegExp: "^integration/find/"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"integration/find/a": []byte("integration/find/a"),
+		"integration/find/b": []byte("integration/find/b"),
+	}, got)
+}
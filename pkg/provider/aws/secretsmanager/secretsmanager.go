@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -52,6 +53,7 @@ const (
 
 // https://github.com/external-secrets/external-secrets/issues/644
 var _ esv1beta1.SecretsClient = &SecretsManager{}
+var _ esv1beta1.MetadataClient = &SecretsManager{}
 
 // SecretsManager is a provider for AWS SecretsManager.
 type SecretsManager struct {
@@ -166,6 +168,29 @@ func (sm *SecretsManager) fetch(ctx context.Context, ref esv1beta1.ExternalSecre
 	return secretOut, nil
 }
 
+// GetSecretMetadata returns the tags of the secret and its description, with the key description.
+func (sm *SecretsManager) GetSecretMetadata(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string]string, error) {
+	data, err := sm.client.DescribeSecretWithContext(ctx, &awssm.DescribeSecretInput{
+		SecretId: &ref.Key,
+	})
+	metrics.ObserveAPICall(constants.ProviderAWSSM, constants.CallAWSSMDescribeSecret, err)
+	var nf *awssm.ResourceNotFoundException
+	if errors.As(err, &nf) {
+		return nil, esv1beta1.NoSecretErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	metadata := make(map[string]string, len(data.Tags)+1)
+	if aws.StringValue(data.Description) != "" {
+		metadata["description"] = *data.Description
+	}
+	for _, tag := range data.Tags {
+		metadata[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+	}
+	return metadata, nil
+}
+
 func (sm *SecretsManager) DeleteSecret(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) error {
 	secretName := remoteRef.GetRemoteKey()
 	secretValue := awssm.GetSecretValueInput{
@@ -212,12 +237,15 @@ func (sm *SecretsManager) DeleteSecret(ctx context.Context, remoteRef esv1beta1.
 	return err
 }
 
+// SecretExists describes the secret, so that it also finds secrets without a value,
+// which is how the IfNotExists update policy skips pushing to existing secrets.
 func (sm *SecretsManager) SecretExists(ctx context.Context, pushSecretRef esv1beta1.PushSecretRemoteRef) (bool, error) {
 	secretName := pushSecretRef.GetRemoteKey()
-	secretValue := awssm.GetSecretValueInput{
+	secretInput := awssm.DescribeSecretInput{
 		SecretId: &secretName,
 	}
-	_, err := sm.client.GetSecretValueWithContext(ctx, &secretValue)
+	_, err := sm.client.DescribeSecretWithContext(ctx, &secretInput)
+	metrics.ObserveAPICall(constants.ProviderAWSSM, constants.CallAWSSMDescribeSecret, err)
 	if err != nil {
 		return sm.handleSecretError(err)
 	}
@@ -230,7 +258,7 @@ func (sm *SecretsManager) handleSecretError(err error) (bool, error) {
 		return false, err
 	}
 	if aerr.Code() == awssm.ErrCodeResourceNotFoundException {
-		return true, nil
+		return false, nil
 	}
 	return false, err
 }
@@ -569,7 +597,7 @@ func (sm *SecretsManager) createSecretWithContext(ctx context.Context, secretNam
 		},
 		ClientRequestToken: utilpointer.To(initialVersion),
 	}
-	if secretPushFormat == SecretPushFormatString {
+	if pushAsString(secretPushFormat, value) {
 		input.SetSecretBinary(nil).SetSecretString(string(value))
 	}
 
@@ -605,7 +633,7 @@ func (sm *SecretsManager) putSecretValueWithContext(ctx context.Context, secretI
 	if err != nil {
 		return fmt.Errorf("failed to parse metadata: %w", err)
 	}
-	if secretPushFormat == SecretPushFormatString {
+	if pushAsString(secretPushFormat, value) {
 		input.SetSecretBinary(nil).SetSecretString(string(value))
 	}
 
@@ -614,3 +642,9 @@ func (sm *SecretsManager) putSecretValueWithContext(ctx context.Context, secretI
 
 	return err
 }
+
+// pushAsString returns whether value is pushed as SecretString. Values that are not valid
+// UTF-8 are always pushed as SecretBinary, as SecretString would not preserve them.
+func pushAsString(secretPushFormat string, value []byte) bool {
+	return secretPushFormat == SecretPushFormatString && utf8.Valid(value)
+}
@@ -1319,12 +1319,14 @@ func TestSecretsManagerValidate(t *testing.T) {
 func TestSecretExists(t *testing.T) {
 	arn := "arn:aws:secretsmanager:us-east-1:702902267788:secret:foo-bar5-Robbgh"
 	defaultVersion := "00000000-0000-0000-0000-000000000002"
-	secretValueOutput := &awssm.GetSecretValueOutput{
-		ARN:       &arn,
-		VersionId: &defaultVersion,
+	describeSecretOutput := &awssm.DescribeSecretOutput{
+		ARN: &arn,
+		VersionIdsToStages: map[string][]*string{
+			defaultVersion: {aws.String("AWSCURRENT")},
+		},
 	}
 
-	blankSecretValueOutput := &awssm.GetSecretValueOutput{}
+	blankDescribeSecretOutput := &awssm.DescribeSecretOutput{}
 
 	getSecretCorrectErr := awssm.ResourceNotFoundException{}
 	getSecretWrongErr := awssm.InvalidRequestException{}
@@ -1350,7 +1352,7 @@ func TestSecretExists(t *testing.T) {
 			args: args{
 				store: makeValidSecretStore().Spec.Provider.AWS,
 				client: fakesm.Client{
-					GetSecretValueWithContextFn: fakesm.NewGetSecretValueWithContextFn(secretValueOutput, nil),
+					DescribeSecretWithContextFn: fakesm.NewDescribeSecretWithContextFn(describeSecretOutput, nil),
 				},
 				pushSecretData: pushSecretDataWithoutProperty,
 			},
@@ -1359,24 +1361,24 @@ func TestSecretExists(t *testing.T) {
 				wantError: true,
 			},
 		},
-		"SecretExistsReturnsTrueForNonExistingSecret": {
+		"SecretExistsReturnsFalseForNonExistingSecret": {
 			args: args{
 				store: makeValidSecretStore().Spec.Provider.AWS,
 				client: fakesm.Client{
-					GetSecretValueWithContextFn: fakesm.NewGetSecretValueWithContextFn(blankSecretValueOutput, &getSecretCorrectErr),
+					DescribeSecretWithContextFn: fakesm.NewDescribeSecretWithContextFn(blankDescribeSecretOutput, &getSecretCorrectErr),
 				},
 				pushSecretData: pushSecretDataWithoutProperty,
 			},
 			want: want{
 				err:       nil,
-				wantError: true,
+				wantError: false,
 			},
 		},
 		"SecretExistsReturnsFalseForErroredSecret": {
 			args: args{
 				store: makeValidSecretStore().Spec.Provider.AWS,
 				client: fakesm.Client{
-					GetSecretValueWithContextFn: fakesm.NewGetSecretValueWithContextFn(blankSecretValueOutput, &getSecretWrongErr),
+					DescribeSecretWithContextFn: fakesm.NewDescribeSecretWithContextFn(blankDescribeSecretOutput, &getSecretWrongErr),
 				},
 				pushSecretData: pushSecretDataWithoutProperty,
 			},
@@ -1405,6 +1407,138 @@ func TestSecretExists(t *testing.T) {
 	}
 }
 
+func TestPushSecretBinary(t *testing.T) {
+	arn := "arn:aws:secretsmanager:us-east-1:702902267788:secret:foo-bar5-Robbgh"
+	version := "00000000-0000-0000-0000-000000000002"
+	text := []byte("fake-value")
+	binary := []byte{0xde, 0xad, 0xbe, 0xef}
+	stringFormat := &apiextensionsv1.JSON{Raw: []byte(`{"secretPushFormat": "string"}`)}
+
+	type pushed struct {
+		secretString *string
+		secretBinary []byte
+	}
+	tests := map[string]struct {
+		value    []byte
+		metadata *apiextensionsv1.JSON
+		exists   bool
+		want     pushed
+	}{
+		"CreateTextAsString": {
+			value:    text,
+			metadata: stringFormat,
+			want:     pushed{secretString: ptr.To(string(text))},
+		},
+		"CreateBinaryAsBinaryWithStringFormat": {
+			value:    binary,
+			metadata: stringFormat,
+			want:     pushed{secretBinary: binary},
+		},
+		"CreateTextAsBinaryByDefault": {
+			value: text,
+			want:  pushed{secretBinary: text},
+		},
+		"UpdateTextAsString": {
+			value:    text,
+			metadata: stringFormat,
+			exists:   true,
+			want:     pushed{secretString: ptr.To(string(text))},
+		},
+		"UpdateBinaryAsBinaryWithStringFormat": {
+			value:    binary,
+			metadata: stringFormat,
+			exists:   true,
+			want:     pushed{secretBinary: binary},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got pushed
+			client := fakesm.Client{
+				GetSecretValueWithContextFn: fakesm.NewGetSecretValueWithContextFn(&awssm.GetSecretValueOutput{}, &awssm.ResourceNotFoundException{}),
+				CreateSecretWithContextFn: func(_ aws.Context, input *awssm.CreateSecretInput, _ ...request.Option) (*awssm.CreateSecretOutput, error) {
+					got = pushed{secretString: input.SecretString, secretBinary: input.SecretBinary}
+					return &awssm.CreateSecretOutput{ARN: &arn}, nil
+				},
+				PutSecretValueWithContextFn: func(_ aws.Context, input *awssm.PutSecretValueInput, _ ...request.Option) (*awssm.PutSecretValueOutput, error) {
+					got = pushed{secretString: input.SecretString, secretBinary: input.SecretBinary}
+					return &awssm.PutSecretValueOutput{ARN: &arn}, nil
+				},
+				DescribeSecretWithContextFn: fakesm.NewDescribeSecretWithContextFn(&awssm.DescribeSecretOutput{
+					ARN:  &arn,
+					Tags: []*awssm.Tag{{Key: ptr.To(managedBy), Value: ptr.To(externalSecrets)}},
+				}, nil),
+			}
+			if tc.exists {
+				client.GetSecretValueWithContextFn = fakesm.NewGetSecretValueWithContextFn(&awssm.GetSecretValueOutput{
+					ARN:          &arn,
+					SecretString: ptr.To("old-value"),
+					VersionId:    &version,
+				}, nil)
+			}
+			sm := SecretsManager{client: &client}
+			secret := &corev1.Secret{Data: map[string][]byte{"key": tc.value}}
+			err := sm.PushSecret(context.Background(), secret, fake.PushSecretData{SecretKey: "key", RemoteKey: "fake-key", Metadata: tc.metadata})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestGetSecretMetadata(t *testing.T) {
+	notFoundErr := awssm.ResourceNotFoundException{}
+	otherErr := errors.New("boom")
+	tests := map[string]struct {
+		output  *awssm.DescribeSecretOutput
+		err     error
+		want    map[string]string
+		wantErr error
+	}{
+		"tags and description": {
+			output: &awssm.DescribeSecretOutput{
+				Description: aws.String("database password"),
+				Tags: []*awssm.Tag{
+					{Key: aws.String("team"), Value: aws.String("payments")},
+				},
+			},
+			want: map[string]string{
+				"description": "database password",
+				"team":        "payments",
+			},
+		},
+		"no metadata": {
+			output: &awssm.DescribeSecretOutput{},
+			want:   map[string]string{},
+		},
+		"secret not found": {
+			output:  &awssm.DescribeSecretOutput{},
+			err:     &notFoundErr,
+			wantErr: esv1beta1.NoSecretErr,
+		},
+		"describe error": {
+			output:  &awssm.DescribeSecretOutput{},
+			err:     otherErr,
+			wantErr: otherErr,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			sm := &SecretsManager{
+				client: &fakesm.Client{
+					DescribeSecretWithContextFn: fakesm.NewDescribeSecretWithContextFn(tc.output, tc.err),
+				},
+			}
+			got, err := sm.GetSecretMetadata(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "foo"})
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("unexpected error: got %v, want %v", err, tc.wantErr)
+			}
+			if tc.wantErr == nil {
+				assert.Equal(t, tc.want, got)
+			}
+		})
+	}
+}
+
 // FakeCredProvider implements the AWS credentials.Provider interface
 // It is used to inject an error into the AWS session to cause a
 // validation error.
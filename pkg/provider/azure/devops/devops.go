@@ -0,0 +1,221 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package devops
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	apiVersion = "7.1"
+	// keyVaultGroupType is the type of variable groups linked to an Azure Key Vault.
+	keyVaultGroupType = "AzureKeyVault"
+
+	errInvalidKey        = "key %q must have the format <project>/<variable group>"
+	errUnexpectedStatus  = "unexpected status code from Azure DevOps: %d: %s"
+	errUnauthorized      = "Azure DevOps rejected the credentials, check that the personal access token is valid and has not expired"
+	errUnmarshalResponse = "unable to unmarshal Azure DevOps response: %w"
+	errVariableNotFound  = "variable group %q has no variable %q"
+	errSecretVariable    = "variable %q of variable group %q is a secret variable, Azure DevOps does not return the values of secret variables"
+	errKeyVaultGroup     = "variable group %q is linked to an Azure Key Vault, use the Azure Key Vault provider to sync its secrets"
+	errReadOnly          = "the Azure DevOps provider is read only"
+	errFindUnsupported   = "find is not supported by the Azure DevOps provider"
+)
+
+// client reads variable groups with the Azure DevOps REST API.
+// https://learn.microsoft.com/en-us/rest/api/azure/devops/distributedtask/variablegroups
+type client struct {
+	httpClient *http.Client
+	url        string
+	// pat is the personal access token, the httpClient adds a bearer token of the service principal otherwise.
+	pat string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+type variable struct {
+	Value    *string `json:"value"`
+	IsSecret bool    `json:"isSecret"`
+}
+
+type variableGroup struct {
+	ID        int                 `json:"id"`
+	Name      string              `json:"name"`
+	Type      string              `json:"type"`
+	Variables map[string]variable `json:"variables"`
+}
+
+// parseKey splits a key into the project and the name of the variable group.
+func parseKey(key string) (string, string, error) {
+	project, group, ok := strings.Cut(key, "/")
+	// groupName is a filter which supports wildcards
+	if !ok || project == "" || group == "" || strings.Contains(group, "/") || strings.Contains(group, "*") {
+		return "", "", fmt.Errorf(errInvalidKey, key)
+	}
+	return project, group, nil
+}
+
+// GetSecret returns the value of the variable property of the variable group key,
+// or all variables of the group as JSON if no property is given.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	group, err := c.variableGroup(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Property == "" {
+		vars := make(map[string]string, len(group.Variables))
+		for k, v := range variables(group) {
+			vars[k] = string(v)
+		}
+		return utils.JSONMarshal(vars)
+	}
+	v, ok := group.Variables[ref.Property]
+	if !ok {
+		return nil, fmt.Errorf(errVariableNotFound, ref.Key, ref.Property)
+	}
+	if v.IsSecret || v.Value == nil {
+		return nil, fmt.Errorf(errSecretVariable, ref.Property, ref.Key)
+	}
+	return []byte(*v.Value), nil
+}
+
+// GetSecretMap returns the variables of the variable group key. Secret variables are left out.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	group, err := c.variableGroup(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	return variables(group), nil
+}
+
+// variables returns the values of the variables of a group, except for secret variables
+// whose values are not returned by the API.
+func variables(group *variableGroup) map[string][]byte {
+	out := make(map[string][]byte, len(group.Variables))
+	for k, v := range group.Variables {
+		if v.IsSecret || v.Value == nil {
+			continue
+		}
+		out[k] = []byte(*v.Value)
+	}
+	return out
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindUnsupported)
+}
+
+func (c *client) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
+	return false, errors.New(errReadOnly)
+}
+
+// Validate lists a project of the organization to check the credentials.
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	var out struct {
+		Count int `json:"count"`
+	}
+	if err := c.get(context.Background(), "/_apis/projects", url.Values{"$top": []string{"1"}}, &out); err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+// variableGroup returns the variable group named by key.
+func (c *client) variableGroup(ctx context.Context, key string) (*variableGroup, error) {
+	project, name, err := parseKey(key)
+	if err != nil {
+		return nil, err
+	}
+	var out struct {
+		Value []variableGroup `json:"value"`
+	}
+	path := "/" + url.PathEscape(project) + "/_apis/distributedtask/variablegroups"
+	if err := c.get(ctx, path, url.Values{"groupName": []string{name}}, &out); err != nil {
+		return nil, err
+	}
+	for i := range out.Value {
+		// the filter on the name is case insensitive
+		group := &out.Value[i]
+		if !strings.EqualFold(group.Name, name) {
+			continue
+		}
+		if group.Type == keyVaultGroupType {
+			return nil, fmt.Errorf(errKeyVaultGroup, key)
+		}
+		return group, nil
+	}
+	return nil, esv1beta1.NoSecretError{}
+}
+
+func (c *client) get(ctx context.Context, path string, query url.Values, target any) error {
+	query.Set("api-version", apiVersion)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+path+"?"+query.Encode(), http.NoBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.pat != "" {
+		req.SetBasicAuth("", c.pat)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// an invalid personal access token gets the sign-in page with 203 Non-Authoritative Information
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusNonAuthoritativeInfo {
+		return errors.New(errUnauthorized)
+	}
+	// a missing project
+	if resp.StatusCode == http.StatusNotFound {
+		return esv1beta1.NoSecretError{}
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf(errUnexpectedStatus, resp.StatusCode, body)
+	}
+	if err := json.Unmarshal(body, target); err != nil {
+		return fmt.Errorf(errUnmarshalResponse, err)
+	}
+	return nil
+}
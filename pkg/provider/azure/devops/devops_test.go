@@ -0,0 +1,154 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package devops
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	testPAT = "pat"
+
+	variableGroups = `{"count":2,"value":[
+  {"id":1,"name":"Deploy","type":"Vsts","variables":{
+    "host":{"value":"db.example.com"},
+    "port":{"value":"5432"},
+    "password":{"value":null,"isSecret":true}
+  }},
+  {"id":2,"name":"Deploy-Staging","type":"Vsts","variables":{"host":{"value":"staging.example.com"}}}
+]}`
+	keyVaultGroup = `{"count":1,"value":[
+  {"id":3,"name":"KeyVault","type":"AzureKeyVault","variables":{"password":{"isSecret":true,"enabled":true}}}
+]}`
+)
+
+// fakeAzureDevOps serves the variable groups of the project "shop" of an organization.
+func fakeAzureDevOps(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, apiVersion, r.URL.Query().Get("api-version"))
+		if _, pat, _ := r.BasicAuth(); pat != testPAT {
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(http.StatusNonAuthoritativeInfo)
+			_, _ = w.Write([]byte("<html>Sign In</html>"))
+			return
+		}
+		switch r.URL.Path {
+		case "/my-org/_apis/projects":
+			_, _ = w.Write([]byte(`{"count":1,"value":[{"name":"shop"}]}`))
+		case "/my-org/shop/_apis/distributedtask/variablegroups":
+			switch r.URL.Query().Get("groupName") {
+			case "deploy":
+				_, _ = w.Write([]byte(variableGroups))
+			case "KeyVault":
+				_, _ = w.Write([]byte(keyVaultGroup))
+			default:
+				_, _ = w.Write([]byte(`{"count":0,"value":[]}`))
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func newTestClient(t *testing.T, pat string) *client {
+	srv := fakeAzureDevOps(t)
+	t.Cleanup(srv.Close)
+	return &client{httpClient: srv.Client(), url: srv.URL + "/my-org", pat: pat}
+}
+
+func TestGetSecret(t *testing.T) {
+	c := newTestClient(t, testPAT)
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"variable": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "shop/deploy", Property: "host"},
+			want: "db.example.com",
+		},
+		"all variables": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "shop/deploy"},
+			want: `{"host":"db.example.com","port":"5432"}`,
+		},
+		"secret variable": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "shop/deploy", Property: "password"},
+			wantErr: "is a secret variable",
+		},
+		"missing variable": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "shop/deploy", Property: "user"},
+			wantErr: `variable group "shop/deploy" has no variable "user"`,
+		},
+		"missing group": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "shop/other"},
+			wantErr: esv1beta1.NoSecretError{}.Error(),
+		},
+		"missing project": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "blog/deploy"},
+			wantErr: esv1beta1.NoSecretError{}.Error(),
+		},
+		"key vault group": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "shop/KeyVault", Property: "password"},
+			wantErr: "use the Azure Key Vault provider",
+		},
+		"invalid key": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "deploy"},
+			wantErr: "must have the format <project>/<variable group>",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			if tc.ref.Property == "" {
+				assert.JSONEq(t, tc.want, string(got))
+				return
+			}
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestGetSecretMap(t *testing.T) {
+	c := newTestClient(t, testPAT)
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "shop/deploy"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"host": []byte("db.example.com"),
+		"port": []byte("5432"),
+	}, got)
+}
+
+func TestValidate(t *testing.T) {
+	res, err := newTestClient(t, testPAT).Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+
+	res, err = newTestClient(t, "expired").Validate()
+	assert.ErrorContains(t, err, "rejected the credentials")
+	assert.Equal(t, esv1beta1.ValidationResultError, res)
+}
@@ -0,0 +1,150 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package devops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+const (
+	// azureDevOpsScope is the scope of tokens for the Azure DevOps resource.
+	azureDevOpsScope = "499b84ac-1321-427f-aa17-267ca6975798/.default"
+	tokenURLTemplate = "https://login.microsoftonline.com/%s/oauth2/v2.0/token"
+
+	errStoreIsNil                  = "store is nil"
+	errNoStoreTypeOrWrongStoreType = "no store type or wrong store type"
+	errOrganizationURLRequired     = "organizationURL is required"
+	errInvalidURL                  = "invalid url %q: %w"
+	errExactlyOneAuth              = "exactly one of personalAccessToken and servicePrincipal must be set"
+	errTenantIDRequired            = "tenantID of the service principal is required"
+	errCannotResolvePAT            = "cannot resolve personal access token: %w"
+	errCannotResolveClientID       = "cannot resolve client id: %w"
+	errCannotResolveClientSecret   = "cannot resolve client secret: %w"
+)
+
+// Provider satisfies the provider interface.
+type Provider struct{}
+
+var _ esv1beta1.Provider = &Provider{}
+
+func (p *Provider) NewClient(ctx context.Context, store esv1beta1.GenericStore, kube kclient.Client, namespace string) (esv1beta1.SecretsClient, error) {
+	cfg, err := getConfig(store)
+	if err != nil {
+		return nil, err
+	}
+	c := &client{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		url:        strings.TrimSuffix(cfg.OrganizationURL, "/"),
+	}
+	if ref := cfg.Auth.PersonalAccessToken; ref != nil {
+		c.pat, err = resolvers.SecretKeyRef(ctx, kube, store.GetKind(), namespace, ref)
+		if err != nil {
+			return nil, fmt.Errorf(errCannotResolvePAT, err)
+		}
+		return c, nil
+	}
+	sp := cfg.Auth.ServicePrincipal
+	clientID, err := resolvers.SecretKeyRef(ctx, kube, store.GetKind(), namespace, &sp.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf(errCannotResolveClientID, err)
+	}
+	clientSecret, err := resolvers.SecretKeyRef(ctx, kube, store.GetKind(), namespace, &sp.ClientSecret)
+	if err != nil {
+		return nil, fmt.Errorf(errCannotResolveClientSecret, err)
+	}
+	cc := &clientcredentials.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     fmt.Sprintf(tokenURLTemplate, url.PathEscape(sp.TenantID)),
+		Scopes:       []string{azureDevOpsScope},
+	}
+	// the token is requested lazily, it must not be bound to the context of this call
+	tokenCtx := context.WithValue(context.Background(), oauth2.HTTPClient, c.httpClient)
+	c.httpClient = oauth2.NewClient(tokenCtx, cc.TokenSource(tokenCtx))
+	return c, nil
+}
+
+func getConfig(store esv1beta1.GenericStore) (*esv1beta1.AzureDevOpsProvider, error) {
+	if store == nil {
+		return nil, errors.New(errStoreIsNil)
+	}
+	spec := store.GetSpec()
+	if spec == nil || spec.Provider == nil || spec.Provider.AzureDevOps == nil {
+		return nil, errors.New(errNoStoreTypeOrWrongStoreType)
+	}
+	cfg := spec.Provider.AzureDevOps
+	if cfg.OrganizationURL == "" {
+		return nil, errors.New(errOrganizationURLRequired)
+	}
+	if _, err := url.ParseRequestURI(cfg.OrganizationURL); err != nil {
+		return nil, fmt.Errorf(errInvalidURL, cfg.OrganizationURL, err)
+	}
+	pat, sp := cfg.Auth.PersonalAccessToken, cfg.Auth.ServicePrincipal
+	if (pat == nil) == (sp == nil) {
+		return nil, errors.New(errExactlyOneAuth)
+	}
+	if pat != nil {
+		if err := utils.ValidateReferentSecretSelector(store, *pat); err != nil {
+			return nil, err
+		}
+		return cfg, nil
+	}
+	if sp.TenantID == "" {
+		return nil, errors.New(errTenantIDRequired)
+	}
+	if err := utils.ValidateReferentSecretSelector(store, sp.ClientID); err != nil {
+		return nil, err
+	}
+	if err := utils.ValidateReferentSecretSelector(store, sp.ClientSecret); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnings, error) {
+	_, err := getConfig(store)
+	return nil, err
+}
+
+// ValidateRemoteRef checks that the key names a project and a variable group.
+func (p *Provider) ValidateRemoteRef(_ esv1beta1.GenericStore, ref esv1beta1.ExternalSecretDataRemoteRef) error {
+	_, _, err := parseKey(ref.Key)
+	return err
+}
+
+func (p *Provider) Capabilities() esv1beta1.SecretStoreCapabilities {
+	return esv1beta1.SecretStoreReadOnly
+}
+
+func init() {
+	esv1beta1.Register(&Provider{}, &esv1beta1.SecretStoreProvider{
+		AzureDevOps: &esv1beta1.AzureDevOpsProvider{},
+	})
+}
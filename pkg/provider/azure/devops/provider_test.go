@@ -0,0 +1,100 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package devops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+func TestValidateStore(t *testing.T) {
+	namespace := "other"
+	orgURL := "https://dev.azure.com/my-org"
+	pat := &esmeta.SecretKeySelector{Name: "azure-devops", Key: "pat"}
+	sp := &esv1beta1.AzureDevOpsServicePrincipal{
+		TenantID:     "tenant",
+		ClientID:     esmeta.SecretKeySelector{Name: "azure-devops", Key: "client-id"},
+		ClientSecret: esmeta.SecretKeySelector{Name: "azure-devops", Key: "client-secret"},
+	}
+	tests := map[string]struct {
+		cfg     esv1beta1.AzureDevOpsProvider
+		wantErr string
+	}{
+		"personal access token": {
+			cfg: esv1beta1.AzureDevOpsProvider{OrganizationURL: orgURL, Auth: esv1beta1.AzureDevOpsAuth{PersonalAccessToken: pat}},
+		},
+		"service principal": {
+			cfg: esv1beta1.AzureDevOpsProvider{OrganizationURL: orgURL, Auth: esv1beta1.AzureDevOpsAuth{ServicePrincipal: sp}},
+		},
+		"missing organization url": {
+			cfg:     esv1beta1.AzureDevOpsProvider{Auth: esv1beta1.AzureDevOpsAuth{PersonalAccessToken: pat}},
+			wantErr: errOrganizationURLRequired,
+		},
+		"invalid organization url": {
+			cfg:     esv1beta1.AzureDevOpsProvider{OrganizationURL: "my-org", Auth: esv1beta1.AzureDevOpsAuth{PersonalAccessToken: pat}},
+			wantErr: `invalid url "my-org"`,
+		},
+		"no auth": {
+			cfg:     esv1beta1.AzureDevOpsProvider{OrganizationURL: orgURL},
+			wantErr: errExactlyOneAuth,
+		},
+		"both auth methods": {
+			cfg:     esv1beta1.AzureDevOpsProvider{OrganizationURL: orgURL, Auth: esv1beta1.AzureDevOpsAuth{PersonalAccessToken: pat, ServicePrincipal: sp}},
+			wantErr: errExactlyOneAuth,
+		},
+		"missing tenant": {
+			cfg: esv1beta1.AzureDevOpsProvider{OrganizationURL: orgURL, Auth: esv1beta1.AzureDevOpsAuth{
+				ServicePrincipal: &esv1beta1.AzureDevOpsServicePrincipal{ClientID: sp.ClientID, ClientSecret: sp.ClientSecret},
+			}},
+			wantErr: errTenantIDRequired,
+		},
+		"token in other namespace": {
+			cfg: esv1beta1.AzureDevOpsProvider{OrganizationURL: orgURL, Auth: esv1beta1.AzureDevOpsAuth{
+				PersonalAccessToken: &esmeta.SecretKeySelector{Name: "azure-devops", Key: "pat", Namespace: &namespace},
+			}},
+			wantErr: "namespace should either be empty or match the namespace of the SecretStore",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := esv1beta1.SecretStore{
+				Spec: esv1beta1.SecretStoreSpec{
+					Provider: &esv1beta1.SecretStoreProvider{
+						AzureDevOps: &tc.cfg,
+					},
+				},
+			}
+			p := &Provider{}
+			_, err := p.ValidateStore(&s)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestValidateRemoteRef(t *testing.T) {
+	p := &Provider{}
+	assert.NoError(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: "shop/deploy"}))
+	for _, key := range []string{"deploy", "shop/", "/deploy", "shop/deploy/prod", "shop/deploy-*"} {
+		assert.Error(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: key}), key)
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"path"
 	"regexp"
@@ -64,6 +65,9 @@ const (
 	AnnotationTenantID   = "azure.workload.identity/tenant-id"
 	managerLabel         = "external-secrets"
 
+	// managedHSMHostInfix is part of the host of Managed HSM pools, e.g. "myhsm.managedhsm.azure.net".
+	managedHSMHostInfix = ".managedhsm."
+
 	errUnexpectedStoreSpec      = "unexpected store spec"
 	errMissingAuthType          = "cannot initialize Azure Client: no valid authType was specified"
 	errPropNotExist             = "property %s does not exist in key %s"
@@ -81,6 +85,7 @@ const (
 	errMultipleTenantID         = "multiple tenantID found. Check secretRef, 'spec.provider.azurekv.tenantId', and serviceAccountRef"
 	errFindSecret               = "could not find secret %s/%s: %w"
 	errFindDataKey              = "no data for %q in secret '%s/%s'"
+	errManagedHSMObjectType     = "object type %s is not supported by Azure Managed HSM, only keys are"
 
 	errInvalidStore                   = "invalid store"
 	errInvalidStoreSpec               = "invalid store spec"
@@ -303,6 +308,9 @@ func (a *Azure) deleteKeyVaultCertificate(ctx context.Context, certName string)
 
 func (a *Azure) DeleteSecret(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) error {
 	objectType, secretName := getObjType(esv1beta1.ExternalSecretDataRemoteRef{Key: remoteRef.GetRemoteKey()})
+	if err := a.checkObjectType(objectType); err != nil {
+		return err
+	}
 	switch objectType {
 	case defaultObjType:
 		return a.deleteKeyVaultSecret(ctx, secretName)
@@ -317,6 +325,9 @@ func (a *Azure) DeleteSecret(ctx context.Context, remoteRef esv1beta1.PushSecret
 
 func (a *Azure) SecretExists(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) (bool, error) {
 	objectType, secretName := getObjType(esv1beta1.ExternalSecretDataRemoteRef{Key: remoteRef.GetRemoteKey()})
+	if err := a.checkObjectType(objectType); err != nil {
+		return false, err
+	}
 
 	var err error
 	switch objectType {
@@ -521,6 +532,10 @@ func (a *Azure) setKeyVaultKey(ctx context.Context, secretName string, value []b
 			"managed-by": pointer.To(managerLabel),
 		},
 	}
+	// Managed HSM only stores HSM protected keys
+	if isManagedHSM(a.provider.VaultURL) {
+		params.Hsm = pointer.To(true)
+	}
 	_, err = a.baseClient.ImportKey(ctx, *a.provider.VaultURL, secretName, params)
 	metrics.ObserveAPICall(constants.ProviderAzureKV, constants.CallAzureKVImportKey, err)
 	if err != nil {
@@ -550,6 +565,9 @@ func (a *Azure) PushSecret(ctx context.Context, secret *corev1.Secret, data esv1
 	}
 
 	objectType, secretName := getObjType(esv1beta1.ExternalSecretDataRemoteRef{Key: data.GetRemoteKey()})
+	if err := a.checkObjectType(objectType); err != nil {
+		return err
+	}
 	switch objectType {
 	case defaultObjType:
 		return a.setKeyVaultSecret(ctx, secretName, value)
@@ -565,6 +583,10 @@ func (a *Azure) PushSecret(ctx context.Context, secret *corev1.Secret, data esv1
 // Implements store.Client.GetAllSecrets Interface.
 // Retrieves a map[string][]byte with the secret names as key and the secret itself as the calue.
 func (a *Azure) GetAllSecrets(ctx context.Context, ref esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	// find lists secrets
+	if err := a.checkObjectType(defaultObjType); err != nil {
+		return nil, err
+	}
 	basicClient := a.baseClient
 	secretsMap := make(map[string][]byte)
 	checkTags := len(ref.Tags) > 0
@@ -668,6 +690,9 @@ func parseError(err error) error {
 // The Object Type is defined as a prefix in the ref.Name , if no prefix is defined , we assume a secret is required.
 func (a *Azure) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
 	objectType, secretName := getObjType(ref)
+	if err := a.checkObjectType(objectType); err != nil {
+		return nil, err
+	}
 
 	switch objectType {
 	case defaultObjType:
@@ -810,7 +835,7 @@ func getSecretMapProperties(tags map[string]*string, key, property string) map[s
 
 func (a *Azure) authorizerForWorkloadIdentity(ctx context.Context, tokenProvider tokenProviderFunc) (autorest.Authorizer, error) {
 	aadEndpoint := AadEndpointForType(a.provider.EnvironmentType)
-	kvResource := kvResourceForProviderConfig(a.provider.EnvironmentType)
+	kvResource := kvResourceForProvider(a.provider)
 	// If no serviceAccountRef was provided
 	// we expect certain env vars to be present.
 	// They are set by the azure workload identity webhook
@@ -980,7 +1005,7 @@ func (t *tokenProvider) OAuthToken() string {
 
 func (a *Azure) authorizerForManagedIdentity() (autorest.Authorizer, error) {
 	msiConfig := kvauth.NewMSIConfig()
-	msiConfig.Resource = kvResourceForProviderConfig(a.provider.EnvironmentType)
+	msiConfig.Resource = kvResourceForProvider(a.provider)
 	if a.provider.IdentityID != nil {
 		msiConfig.ClientID = *a.provider.IdentityID
 	}
@@ -1032,6 +1057,7 @@ func (a *Azure) getAuthorizerFromCredentials(ctx context.Context) (autorest.Auth
 			clientID,
 			clientSecret,
 			*a.provider.TenantID,
+			kvResourceForProvider(a.provider),
 			a.provider.EnvironmentType,
 		)
 	} else {
@@ -1050,21 +1076,22 @@ func (a *Azure) getAuthorizerFromCredentials(ctx context.Context) (autorest.Auth
 			clientID,
 			[]byte(clientCertificate),
 			*a.provider.TenantID,
+			kvResourceForProvider(a.provider),
 			a.provider.EnvironmentType,
 		)
 	}
 }
 
-func getAuthorizerForClientSecret(clientID, clientSecret, tenantID string, environmentType esv1beta1.AzureEnvironmentType) (autorest.Authorizer, error) {
+func getAuthorizerForClientSecret(clientID, clientSecret, tenantID, resource string, environmentType esv1beta1.AzureEnvironmentType) (autorest.Authorizer, error) {
 	clientCredentialsConfig := kvauth.NewClientCredentialsConfig(clientID, clientSecret, tenantID)
-	clientCredentialsConfig.Resource = kvResourceForProviderConfig(environmentType)
+	clientCredentialsConfig.Resource = resource
 	clientCredentialsConfig.AADEndpoint = AadEndpointForType(environmentType)
 	return clientCredentialsConfig.Authorizer()
 }
 
-func getAuthorizerForClientCertificate(clientID string, certificateBytes []byte, tenantID string, environmentType esv1beta1.AzureEnvironmentType) (autorest.Authorizer, error) {
+func getAuthorizerForClientCertificate(clientID string, certificateBytes []byte, tenantID, resource string, environmentType esv1beta1.AzureEnvironmentType) (autorest.Authorizer, error) {
 	clientCertificateConfig := NewClientInMemoryCertificateConfig(clientID, certificateBytes, tenantID)
-	clientCertificateConfig.Resource = kvResourceForProviderConfig(environmentType)
+	clientCertificateConfig.Resource = resource
 	clientCertificateConfig.AADEndpoint = AadEndpointForType(environmentType)
 	return clientCertificateConfig.Authorizer()
 }
@@ -1125,6 +1152,46 @@ func ServiceManagementEndpointForType(t esv1beta1.AzureEnvironmentType) string {
 	}
 }
 
+// kvResourceForProvider returns the resource tokens are requested for,
+// which differs between Key Vault and Managed HSM.
+func kvResourceForProvider(p *esv1beta1.AzureKVProvider) string {
+	if isManagedHSM(p.VaultURL) {
+		return managedHSMResourceForType(p.EnvironmentType)
+	}
+	return kvResourceForProviderConfig(p.EnvironmentType)
+}
+
+func managedHSMResourceForType(t esv1beta1.AzureEnvironmentType) string {
+	switch t {
+	case esv1beta1.AzureEnvironmentChinaCloud:
+		return "https://managedhsm.azure.cn"
+	case esv1beta1.AzureEnvironmentUSGovernmentCloud:
+		return "https://managedhsm.usgovcloudapi.net"
+	default:
+		return "https://managedhsm.azure.net"
+	}
+}
+
+// isManagedHSM returns true if vaultURL points to a Managed HSM pool,
+// which serves the keys API of Key Vault but does not store secrets or certificates.
+func isManagedHSM(vaultURL *string) bool {
+	if vaultURL == nil {
+		return false
+	}
+	u, err := url.Parse(*vaultURL)
+	if err != nil {
+		return false
+	}
+	return strings.Contains(strings.ToLower(u.Hostname()), managedHSMHostInfix)
+}
+
+func (a *Azure) checkObjectType(objectType string) error {
+	if isManagedHSM(a.provider.VaultURL) && objectType != objectTypeKey {
+		return fmt.Errorf(errManagedHSMObjectType, objectType)
+	}
+	return nil
+}
+
 func kvResourceForProviderConfig(t esv1beta1.AzureEnvironmentType) string {
 	var res string
 	switch t {
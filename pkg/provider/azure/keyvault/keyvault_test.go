@@ -1725,3 +1725,92 @@ func TestAzureKeyVaultSecretExists(t *testing.T) {
 		}
 	}
 }
+
+// importKeyRecorder records the parameters of the imported keys.
+type importKeyRecorder struct {
+	*fake.AzureMockClient
+	params []keyvault.KeyImportParameters
+}
+
+func (r *importKeyRecorder) ImportKey(ctx context.Context, vaultBaseURL, keyName string, parameters keyvault.KeyImportParameters) (keyvault.KeyBundle, error) {
+	r.params = append(r.params, parameters)
+	return r.AzureMockClient.ImportKey(ctx, vaultBaseURL, keyName, parameters)
+}
+
+func TestAzureManagedHSM(t *testing.T) {
+	hsmURL := "https://myhsm.managedhsm.azure.net"
+	mock := &fake.AzureMockClient{}
+	mock.WithKey("", "", "", keyvault.KeyBundle{Key: newKVJWK([]byte(jwkPubRSA))}, nil)
+	az := &Azure{
+		baseClient: mock,
+		provider:   &esv1beta1.AzureKVProvider{VaultURL: &hsmURL},
+	}
+
+	got, err := az.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "key/signing"})
+	if err != nil {
+		t.Fatalf("unexpected error getting a key: %v", err)
+	}
+	if len(got) == 0 {
+		t.Errorf("expected the JWK of the key")
+	}
+
+	wantErr := "object type secret is not supported by Azure Managed HSM, only keys are"
+	if _, err := az.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "signing"}); err == nil || err.Error() != wantErr {
+		t.Errorf("unexpected error getting a secret: %v", err)
+	}
+	if _, err := az.GetAllSecrets(context.Background(), esv1beta1.ExternalSecretFind{}); err == nil || err.Error() != wantErr {
+		t.Errorf("unexpected error finding secrets: %v", err)
+	}
+	wantErr = "object type cert is not supported by Azure Managed HSM, only keys are"
+	if _, err := az.SecretExists(context.Background(), testingfake.PushSecretData{RemoteKey: "cert/tls"}); err == nil || err.Error() != wantErr {
+		t.Errorf("unexpected error checking a certificate: %v", err)
+	}
+
+	// pushed keys are HSM protected
+	pushMock := &fake.AzureMockClient{}
+	pushMock.WithKey("", "", "", keyvault.KeyBundle{}, autorest.DetailedError{StatusCode: 404})
+	pushMock.WithImportKey(keyvault.KeyBundle{}, nil)
+	recorder := &importKeyRecorder{AzureMockClient: pushMock}
+	az.baseClient = recorder
+	secret := &corev1.Secret{Data: map[string][]byte{"key": []byte("0123456789abcdef")}}
+	err = az.PushSecret(context.Background(), secret, testingfake.PushSecretData{SecretKey: "key", RemoteKey: "key/symmetric"})
+	if err != nil {
+		t.Fatalf("unexpected error pushing a key: %v", err)
+	}
+	if len(recorder.params) != 1 || recorder.params[0].Hsm == nil || !*recorder.params[0].Hsm {
+		t.Errorf("expected the key to be imported as HSM protected key: %+v", recorder.params)
+	}
+}
+
+func TestKVResourceForProvider(t *testing.T) {
+	tests := map[string]struct {
+		vaultURL string
+		env      esv1beta1.AzureEnvironmentType
+		want     string
+	}{
+		"key vault": {
+			vaultURL: "https://myvault.vault.azure.net",
+			want:     "https://vault.azure.net",
+		},
+		"managed hsm": {
+			vaultURL: "https://myhsm.managedhsm.azure.net/",
+			want:     "https://managedhsm.azure.net",
+		},
+		"managed hsm in china": {
+			vaultURL: "https://myhsm.managedhsm.azure.cn",
+			env:      esv1beta1.AzureEnvironmentChinaCloud,
+			want:     "https://managedhsm.azure.cn",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := kvResourceForProvider(&esv1beta1.AzureKVProvider{
+				VaultURL:        &tc.vaultURL,
+				EnvironmentType: tc.env,
+			})
+			if got != tc.want {
+				t.Errorf("kvResourceForProvider() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,270 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	errInvalidKey         = "key %q must have the format <workspace>/<variable> or <workspace>/<repository>/<variable>"
+	errUnexpectedStatus   = "unexpected status code from Bitbucket: %d: %s"
+	errUnmarshalResponse  = "unable to unmarshal Bitbucket response: %w"
+	errSecuredVariable    = "variable %q is secured, Bitbucket does not return the values of secured variables"
+	errPropertyNotAllowed = "property is not supported, Bitbucket variables hold a single value"
+	errFindUnsupported    = "find is not supported by the Bitbucket provider"
+	errUnexpectedNextPage = "unexpected url of the next page: %s"
+)
+
+var variableKeyRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// client reads and writes Pipelines variables of workspaces and repositories with the Bitbucket Cloud API.
+// https://developer.atlassian.com/cloud/bitbucket/rest/api-group-pipelines/
+type client struct {
+	httpClient *http.Client
+	url        string
+	// username and password of an app password, the httpClient adds an OAuth token otherwise.
+	username string
+	password string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+type variable struct {
+	UUID    string `json:"uuid,omitempty"`
+	Key     string `json:"key"`
+	Value   string `json:"value,omitempty"`
+	Secured bool   `json:"secured"`
+}
+
+type variablesPage struct {
+	Values []variable `json:"values"`
+	Next   string     `json:"next"`
+}
+
+// parseKey returns the path of the variables of the workspace or repository and the key of the variable.
+func parseKey(key string) (string, string, error) {
+	parts := strings.Split(key, "/")
+	for _, p := range parts {
+		if p == "" {
+			return "", "", fmt.Errorf(errInvalidKey, key)
+		}
+	}
+	name := parts[len(parts)-1]
+	if !variableKeyRegexp.MatchString(name) {
+		return "", "", fmt.Errorf(errInvalidKey, key)
+	}
+	switch len(parts) {
+	case 2:
+		return "/workspaces/" + url.PathEscape(parts[0]) + "/pipelines-config/variables", name, nil
+	case 3:
+		return "/repositories/" + url.PathEscape(parts[0]) + "/" + url.PathEscape(parts[1]) + "/pipelines_config/variables", name, nil
+	default:
+		return "", "", fmt.Errorf(errInvalidKey, key)
+	}
+}
+
+// GetSecret returns the value of the variable key.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	if ref.Property != "" {
+		return nil, errors.New(errPropertyNotAllowed)
+	}
+	path, name, err := parseKey(ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	v, err := c.variable(ctx, path, name)
+	if err != nil {
+		return nil, err
+	}
+	if v.Secured {
+		return nil, fmt.Errorf(errSecuredVariable, ref.Key)
+	}
+	return []byte(v.Value), nil
+}
+
+// GetSecretMap decodes the value of the variable key as a JSON object.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	data, err := c.GetSecret(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	kv := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &kv); err != nil {
+		return nil, fmt.Errorf(errUnmarshalResponse, err)
+	}
+	secretData := make(map[string][]byte, len(kv))
+	for k, v := range kv {
+		var s string
+		if err := json.Unmarshal(v, &s); err == nil {
+			secretData[k] = []byte(s)
+			continue
+		}
+		secretData[k] = v
+	}
+	return secretData, nil
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindUnsupported)
+}
+
+// PushSecret creates or updates the variable key as a secured variable.
+func (c *client) PushSecret(ctx context.Context, secret *corev1.Secret, data esv1beta1.PushSecretData) error {
+	if data.GetProperty() != "" {
+		return errors.New(errPropertyNotAllowed)
+	}
+	path, name, err := parseKey(data.GetRemoteKey())
+	if err != nil {
+		return err
+	}
+	value, err := utils.PushSecretValue(secret, data.GetSecretKey())
+	if err != nil {
+		return err
+	}
+	body := variable{Key: name, Value: string(value), Secured: true}
+	existing, err := c.variable(ctx, path, name)
+	if errors.Is(err, esv1beta1.NoSecretError{}) {
+		return c.do(ctx, http.MethodPost, c.url+path+"/", body, nil)
+	}
+	if err != nil {
+		return err
+	}
+	body.UUID = existing.UUID
+	return c.do(ctx, http.MethodPut, c.url+path+"/"+url.PathEscape(existing.UUID), body, nil)
+}
+
+// DeleteSecret deletes the variable, a missing variable is not an error.
+func (c *client) DeleteSecret(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) error {
+	path, name, err := parseKey(remoteRef.GetRemoteKey())
+	if err != nil {
+		return err
+	}
+	v, err := c.variable(ctx, path, name)
+	if errors.Is(err, esv1beta1.NoSecretError{}) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return c.do(ctx, http.MethodDelete, c.url+path+"/"+url.PathEscape(v.UUID), nil, nil)
+}
+
+// SecretExists checks if the variable exists, the values of secured variables can not be compared.
+func (c *client) SecretExists(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) (bool, error) {
+	path, name, err := parseKey(remoteRef.GetRemoteKey())
+	if err != nil {
+		return false, err
+	}
+	_, err = c.variable(ctx, path, name)
+	if errors.Is(err, esv1beta1.NoSecretError{}) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Validate requests the authenticated user or OAuth consumer.
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	if err := c.do(context.Background(), http.MethodGet, c.url+"/user", nil, nil); err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+// variable looks up the variable name in the variables at path, the API has no lookup by key.
+func (c *client) variable(ctx context.Context, path, name string) (*variable, error) {
+	next := c.url + path + "/?pagelen=100"
+	for next != "" {
+		var page variablesPage
+		if err := c.do(ctx, http.MethodGet, next, nil, &page); err != nil {
+			return nil, err
+		}
+		for i := range page.Values {
+			if page.Values[i].Key == name {
+				return &page.Values[i], nil
+			}
+		}
+		// the credentials must not be sent anywhere else
+		if page.Next != "" && !strings.HasPrefix(page.Next, c.url+"/") {
+			return nil, fmt.Errorf(errUnexpectedNextPage, page.Next)
+		}
+		next = page.Next
+	}
+	return nil, esv1beta1.NoSecretError{}
+}
+
+func (c *client) do(ctx context.Context, method, reqURL string, body, target any) error {
+	var reqBody io.Reader = http.NoBody
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// a missing workspace, repository or variable
+	if resp.StatusCode == http.StatusNotFound {
+		return esv1beta1.NoSecretError{}
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf(errUnexpectedStatus, resp.StatusCode, respBody)
+	}
+	if target == nil {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, target); err != nil {
+		return fmt.Errorf(errUnmarshalResponse, err)
+	}
+	return nil
+}
@@ -0,0 +1,273 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1alpha1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1alpha1"
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	testUser     = "deployer"
+	testPassword = "app-password"
+
+	repoVariables      = "/repositories/acme/shop/pipelines_config/variables/"
+	workspaceVariables = "/workspaces/acme/pipelines-config/variables/"
+)
+
+// fakeBitbucket serves the variables of the workspace acme and its repository shop,
+// the variables of the repository are split over two pages.
+type fakeBitbucket struct {
+	url       string
+	mu        sync.Mutex
+	variables map[string][]variable
+	lastUUID  int
+}
+
+func newFakeBitbucket(t *testing.T) *fakeBitbucket {
+	f := &fakeBitbucket{variables: map[string][]variable{
+		repoVariables: {
+			{UUID: "{1}", Key: "DB_HOST", Value: "db.example.com"},
+			{UUID: "{2}", Key: "DB_CONFIG", Value: `{"user":"admin","port":5432}`},
+			{UUID: "{3}", Key: "DB_PASSWORD", Secured: true},
+		},
+		workspaceVariables: {
+			{UUID: "{4}", Key: "REGISTRY", Value: "registry.example.com"},
+		},
+	}, lastUUID: 4}
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+	f.url = srv.URL
+	return f
+}
+
+func (f *fakeBitbucket) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if user, password, _ := r.BasicAuth(); user != testUser || password != testPassword {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if r.URL.Path == "/user" {
+		_, _ = w.Write([]byte(`{"username":"deployer"}`))
+		return
+	}
+	collection := r.URL.Path
+	uuid := ""
+	if !strings.HasSuffix(collection, "/") {
+		i := strings.LastIndex(collection, "/")
+		collection, uuid = collection[:i+1], collection[i+1:]
+	}
+	vars, ok := f.variables[collection]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"type":"error","error":{"message":"Not found"}}`))
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		page := variablesPage{Values: vars}
+		if len(vars) > 2 && r.URL.Query().Get("page") == "" {
+			page = variablesPage{Values: vars[:2], Next: f.url + collection + "?pagelen=100&page=2"}
+		} else if len(vars) > 2 {
+			page = variablesPage{Values: vars[2:]}
+		}
+		for i := range page.Values {
+			if page.Values[i].Secured {
+				page.Values[i].Value = ""
+			}
+		}
+		_ = json.NewEncoder(w).Encode(page)
+	case http.MethodPost:
+		var v variable
+		_ = json.NewDecoder(r.Body).Decode(&v)
+		f.lastUUID++
+		v.UUID = fmt.Sprintf("{%d}", f.lastUUID)
+		f.variables[collection] = append(vars, v)
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(v)
+	case http.MethodPut, http.MethodDelete:
+		for i := range vars {
+			if vars[i].UUID != uuid {
+				continue
+			}
+			if r.Method == http.MethodDelete {
+				f.variables[collection] = append(vars[:i], vars[i+1:]...)
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+			var v variable
+			_ = json.NewDecoder(r.Body).Decode(&v)
+			vars[i] = v
+			_ = json.NewEncoder(w).Encode(v)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// get returns the stored variable, including the values of secured variables.
+func (f *fakeBitbucket) get(collection, key string) *variable {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, v := range f.variables[collection] {
+		if v.Key == key {
+			return &v
+		}
+	}
+	return nil
+}
+
+func newTestClient(t *testing.T) (*client, *fakeBitbucket) {
+	fake := newFakeBitbucket(t)
+	return &client{httpClient: http.DefaultClient, url: fake.url, username: testUser, password: testPassword}, fake
+}
+
+func TestGetSecret(t *testing.T) {
+	c, _ := newTestClient(t)
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"repository variable": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "acme/shop/DB_HOST"},
+			want: "db.example.com",
+		},
+		"workspace variable": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "acme/REGISTRY"},
+			want: "registry.example.com",
+		},
+		"secured variable": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "acme/shop/DB_PASSWORD"},
+			wantErr: "is secured, Bitbucket does not return the values of secured variables",
+		},
+		"missing variable": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "acme/shop/API_TOKEN"},
+			wantErr: esv1beta1.NoSecretError{}.Error(),
+		},
+		"missing repository": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "acme/blog/DB_HOST"},
+			wantErr: esv1beta1.NoSecretError{}.Error(),
+		},
+		"property": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "acme/shop/DB_CONFIG", Property: "user"},
+			wantErr: errPropertyNotAllowed,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestGetSecretMap(t *testing.T) {
+	c, _ := newTestClient(t)
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "acme/shop/DB_CONFIG"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"user": []byte("admin"),
+		"port": []byte("5432"),
+	}, got)
+}
+
+func TestPushSecret(t *testing.T) {
+	c, fake := newTestClient(t)
+	secret := &corev1.Secret{Data: map[string][]byte{"password": []byte("s3cr3t"), "user": []byte("admin")}}
+	push := func(secretKey, remoteKey string) error {
+		return c.PushSecret(context.Background(), secret, esv1alpha1.PushSecretData{
+			Match: esv1alpha1.PushSecretMatch{
+				SecretKey: secretKey,
+				RemoteRef: esv1alpha1.PushSecretRemoteRef{RemoteKey: remoteKey},
+			},
+		})
+	}
+
+	// the variable on the second page is updated
+	require.NoError(t, push("password", "acme/shop/DB_PASSWORD"))
+	assert.Equal(t, &variable{UUID: "{3}", Key: "DB_PASSWORD", Value: "s3cr3t", Secured: true}, fake.get(repoVariables, "DB_PASSWORD"))
+
+	require.NoError(t, push("", "acme/DB_CREDENTIALS"))
+	v := fake.get(workspaceVariables, "DB_CREDENTIALS")
+	require.NotNil(t, v)
+	assert.True(t, v.Secured)
+	assert.JSONEq(t, `{"password":"s3cr3t","user":"admin"}`, v.Value)
+
+	assert.ErrorContains(t, push("password", "acme/shop/DB-PASSWORD"), "must have the format")
+}
+
+func TestSecretExistsAndDelete(t *testing.T) {
+	c, fake := newTestClient(t)
+	ref := esv1alpha1.PushSecretRemoteRef{RemoteKey: "acme/shop/DB_PASSWORD"}
+
+	exists, err := c.SecretExists(context.Background(), ref)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	require.NoError(t, c.DeleteSecret(context.Background(), ref))
+	assert.Nil(t, fake.get(repoVariables, "DB_PASSWORD"))
+	exists, err = c.SecretExists(context.Background(), ref)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// deleting a missing variable or repository succeeds
+	require.NoError(t, c.DeleteSecret(context.Background(), ref))
+	require.NoError(t, c.DeleteSecret(context.Background(), esv1alpha1.PushSecretRemoteRef{RemoteKey: "acme/blog/DB_PASSWORD"}))
+}
+
+func TestNextPageOnOtherHost(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(`{"values":[],"next":"https://attacker.example.com/variables/?page=2"}`))
+	}))
+	defer srv.Close()
+	c := &client{httpClient: srv.Client(), url: srv.URL, username: testUser, password: testPassword}
+	_, err := c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "acme/shop/DB_HOST"})
+	assert.ErrorContains(t, err, "unexpected url of the next page")
+	assert.Equal(t, 1, requests)
+}
+
+func TestValidate(t *testing.T) {
+	c, _ := newTestClient(t)
+	res, err := c.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+
+	c.password = "revoked"
+	res, err = c.Validate()
+	assert.ErrorContains(t, err, "unexpected status code from Bitbucket: 401")
+	assert.Equal(t, esv1beta1.ValidationResultError, res)
+}
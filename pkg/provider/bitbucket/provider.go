@@ -0,0 +1,150 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+const (
+	defaultURL = "https://api.bitbucket.org/2.0"
+	tokenURL   = "https://bitbucket.org/site/oauth2/access_token"
+
+	errStoreIsNil                  = "store is nil"
+	errNoStoreTypeOrWrongStoreType = "no store type or wrong store type"
+	errInvalidURL                  = "invalid url %q: %w"
+	errExactlyOneAuth              = "exactly one of appPassword and oauth must be set"
+	errUsernameRequired            = "username of the app password is required"
+	errCannotResolvePassword       = "cannot resolve app password: %w"
+	errCannotResolveClientID       = "cannot resolve client id: %w"
+	errCannotResolveClientSecret   = "cannot resolve client secret: %w"
+)
+
+// Provider satisfies the provider interface.
+type Provider struct{}
+
+var _ esv1beta1.Provider = &Provider{}
+
+func (p *Provider) NewClient(ctx context.Context, store esv1beta1.GenericStore, kube kclient.Client, namespace string) (esv1beta1.SecretsClient, error) {
+	cfg, err := getConfig(store)
+	if err != nil {
+		return nil, err
+	}
+	apiURL := cfg.URL
+	if apiURL == "" {
+		apiURL = defaultURL
+	}
+	c := &client{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		url:        strings.TrimSuffix(apiURL, "/"),
+	}
+	if ap := cfg.Auth.AppPassword; ap != nil {
+		c.username = ap.Username
+		c.password, err = resolvers.SecretKeyRef(ctx, kube, store.GetKind(), namespace, &ap.Password)
+		if err != nil {
+			return nil, fmt.Errorf(errCannotResolvePassword, err)
+		}
+		return c, nil
+	}
+	clientID, err := resolvers.SecretKeyRef(ctx, kube, store.GetKind(), namespace, &cfg.Auth.OAuth.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf(errCannotResolveClientID, err)
+	}
+	clientSecret, err := resolvers.SecretKeyRef(ctx, kube, store.GetKind(), namespace, &cfg.Auth.OAuth.ClientSecret)
+	if err != nil {
+		return nil, fmt.Errorf(errCannotResolveClientSecret, err)
+	}
+	cc := &clientcredentials.Config{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		TokenURL:     tokenURL,
+	}
+	// the token is requested lazily, it must not be bound to the context of this call
+	tokenCtx := context.WithValue(context.Background(), oauth2.HTTPClient, c.httpClient)
+	c.httpClient = oauth2.NewClient(tokenCtx, cc.TokenSource(tokenCtx))
+	return c, nil
+}
+
+func getConfig(store esv1beta1.GenericStore) (*esv1beta1.BitbucketProvider, error) {
+	if store == nil {
+		return nil, errors.New(errStoreIsNil)
+	}
+	spec := store.GetSpec()
+	if spec == nil || spec.Provider == nil || spec.Provider.Bitbucket == nil {
+		return nil, errors.New(errNoStoreTypeOrWrongStoreType)
+	}
+	cfg := spec.Provider.Bitbucket
+	if cfg.URL != "" {
+		if _, err := url.ParseRequestURI(cfg.URL); err != nil {
+			return nil, fmt.Errorf(errInvalidURL, cfg.URL, err)
+		}
+	}
+	ap, oa := cfg.Auth.AppPassword, cfg.Auth.OAuth
+	if (ap == nil) == (oa == nil) {
+		return nil, errors.New(errExactlyOneAuth)
+	}
+	if ap != nil {
+		if ap.Username == "" {
+			return nil, errors.New(errUsernameRequired)
+		}
+		if err := utils.ValidateReferentSecretSelector(store, ap.Password); err != nil {
+			return nil, err
+		}
+		return cfg, nil
+	}
+	if err := utils.ValidateReferentSecretSelector(store, oa.ClientID); err != nil {
+		return nil, err
+	}
+	if err := utils.ValidateReferentSecretSelector(store, oa.ClientSecret); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnings, error) {
+	_, err := getConfig(store)
+	return nil, err
+}
+
+// ValidateRemoteRef checks that the key names a workspace or repository and a variable.
+func (p *Provider) ValidateRemoteRef(_ esv1beta1.GenericStore, ref esv1beta1.ExternalSecretDataRemoteRef) error {
+	_, _, err := parseKey(ref.Key)
+	return err
+}
+
+func (p *Provider) Capabilities() esv1beta1.SecretStoreCapabilities {
+	return esv1beta1.SecretStoreReadWrite
+}
+
+func init() {
+	esv1beta1.Register(&Provider{}, &esv1beta1.SecretStoreProvider{
+		Bitbucket: &esv1beta1.BitbucketProvider{},
+	})
+}
@@ -0,0 +1,99 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bitbucket
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+func TestValidateStore(t *testing.T) {
+	namespace := "other"
+	appPassword := &esv1beta1.BitbucketAppPassword{
+		Username: "deployer",
+		Password: esmeta.SecretKeySelector{Name: "bitbucket", Key: "app-password"},
+	}
+	oauth := &esv1beta1.BitbucketOAuth{
+		ClientID:     esmeta.SecretKeySelector{Name: "bitbucket", Key: "key"},
+		ClientSecret: esmeta.SecretKeySelector{Name: "bitbucket", Key: "secret"},
+	}
+	tests := map[string]struct {
+		cfg     esv1beta1.BitbucketProvider
+		wantErr string
+	}{
+		"app password": {
+			cfg: esv1beta1.BitbucketProvider{Auth: esv1beta1.BitbucketAuth{AppPassword: appPassword}},
+		},
+		"oauth": {
+			cfg: esv1beta1.BitbucketProvider{Auth: esv1beta1.BitbucketAuth{OAuth: oauth}},
+		},
+		"invalid url": {
+			cfg:     esv1beta1.BitbucketProvider{URL: "api.bitbucket.org", Auth: esv1beta1.BitbucketAuth{AppPassword: appPassword}},
+			wantErr: `invalid url "api.bitbucket.org"`,
+		},
+		"no auth": {
+			wantErr: errExactlyOneAuth,
+		},
+		"both auth methods": {
+			cfg:     esv1beta1.BitbucketProvider{Auth: esv1beta1.BitbucketAuth{AppPassword: appPassword, OAuth: oauth}},
+			wantErr: errExactlyOneAuth,
+		},
+		"missing username": {
+			cfg: esv1beta1.BitbucketProvider{Auth: esv1beta1.BitbucketAuth{AppPassword: &esv1beta1.BitbucketAppPassword{
+				Password: appPassword.Password,
+			}}},
+			wantErr: errUsernameRequired,
+		},
+		"client secret in other namespace": {
+			cfg: esv1beta1.BitbucketProvider{Auth: esv1beta1.BitbucketAuth{OAuth: &esv1beta1.BitbucketOAuth{
+				ClientID:     oauth.ClientID,
+				ClientSecret: esmeta.SecretKeySelector{Name: "bitbucket", Key: "secret", Namespace: &namespace},
+			}}},
+			wantErr: "namespace should either be empty or match the namespace of the SecretStore",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := esv1beta1.SecretStore{
+				Spec: esv1beta1.SecretStoreSpec{
+					Provider: &esv1beta1.SecretStoreProvider{
+						Bitbucket: &tc.cfg,
+					},
+				},
+			}
+			p := &Provider{}
+			_, err := p.ValidateStore(&s)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestValidateRemoteRef(t *testing.T) {
+	p := &Provider{}
+	for _, key := range []string{"acme/REGISTRY", "acme/shop/DB_HOST"} {
+		assert.NoError(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: key}), key)
+	}
+	for _, key := range []string{"DB_HOST", "acme/shop/env/DB_HOST", "acme//DB_HOST", "acme/shop/DB-HOST"} {
+		assert.Error(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: key}), key)
+	}
+}
@@ -169,7 +169,7 @@ func (providerchef *Providerchef) GetSecret(ctx context.Context, ref v1beta1.Ext
 	databagName := ""
 	databagItem := ""
 	nameSplitted := strings.Split(key, "/")
-	if len(nameSplitted) > 1 {
+	if len(nameSplitted) == 2 {
 		databagName = nameSplitted[0]
 		databagItem = nameSplitted[1]
 	}
@@ -242,6 +242,10 @@ func getPropertyFromDatabagItem(jsonByte []byte, propertyName string) ([]byte, e
 	if !result.Exists() {
 		return nil, fmt.Errorf(errNoDatabagItemPropertyFound, propertyName)
 	}
+	// numbers, booleans and nested objects are returned as raw JSON
+	if result.Type != gjson.String {
+		return []byte(result.Raw), nil
+	}
 	return []byte(result.Str), nil
 }
 
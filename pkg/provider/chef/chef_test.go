@@ -165,10 +165,35 @@ func TestChefGetSecret(t *testing.T) {
 		smtc.ref = makeValidRef(smtc.databagName, smtc.databagItemName, "findProperty")
 	}
 
+	tooManySegments := func(smtc *chefTestCase) {
+		smtc.expectedByte = nil
+		smtc.ref = makeValidRef(databagName, "item01/extra", "")
+		smtc.expectError = "invalid key format in data section. Expected value 'databagName/databagItemName'"
+	}
+
+	withNumberProperty := func(smtc *chefTestCase) {
+		smtc.expectedByte = []byte("5432")
+		smtc.ref = makeValidRef("databag04", "item04", "port")
+	}
+
+	withNestedProperty := func(smtc *chefTestCase) {
+		smtc.expectedByte = []byte("admin")
+		smtc.ref = makeValidRef("databag04", "item04", "database.user")
+	}
+
+	withObjectProperty := func(smtc *chefTestCase) {
+		smtc.expectedByte = []byte(`{"user":"admin"}`)
+		smtc.ref = makeValidRef("databag04", "item04", "database")
+	}
+
 	successCases := []*chefTestCase{
 		makeValidChefTestCase(),
 		makeValidChefTestCaseCustom(nilClient),
 		makeValidChefTestCaseCustom(invalidDatabagName),
+		makeValidChefTestCaseCustom(tooManySegments),
+		makeValidChefTestCaseCustom(withNumberProperty),
+		makeValidChefTestCaseCustom(withNestedProperty),
+		makeValidChefTestCaseCustom(withObjectProperty),
 		makeValidChefTestCaseCustom(invalidDatabagItemName),
 		makeValidChefTestCaseCustom(noProperty),
 		makeValidChefTestCaseCustom(withProperty),
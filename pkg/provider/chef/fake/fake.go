@@ -69,6 +69,12 @@ func (mc *ChefMockClient) WithItem(_, _ string, _ error) {
 				return jsonMap, nil
 			case dataBagName == DatabagName && databagItemName == testitem:
 				return math.Inf(1), nil
+			case dataBagName == "databag04" && databagItemName == "item04":
+				return map[string]any{
+					"id":       "item04",
+					"port":     5432,
+					"database": map[string]any{"user": "admin"},
+				}, nil
 			default:
 				str := "https://chef.com/organizations/dev/data/" + dataBagName + "/" + databagItemName + ": 404"
 				return nil, errors.New(str)
@@ -0,0 +1,224 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chefvault
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-chef/chef"
+	"github.com/tidwall/gjson"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/metrics"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	ProviderChefVault        = "ChefVault"
+	CallChefGetDataBagItem   = "GetDataBagItem"
+	CallChefListDataBags     = "ListDataBags"
+	keysItemSuffix           = "_keys"
+	sparseKeyItemSeparator   = "_key_"
+	sparseMode               = "sparse"
+	errReadOnly              = "the chef-vault provider is read only"
+	errFindUnsupported       = "find is not supported by the chef-vault provider"
+	errInvalidKey            = "invalid key %q, expected 'vaultName/itemName'"
+	errGetItem               = "unable to get data bag item %s/%s: %w"
+	errNotShared             = "vault item %s/%s is not shared with %s"
+	errDecryptSharedSecret   = "unable to decrypt the secret of vault item %s/%s: %w"
+	errDecryptField          = "unable to decrypt field %s of vault item %s/%s: %w"
+	errPropertyNotFound      = "property %s not found in vault item %s"
+	errStoreValidationFailed = "unable to list data bags: %w"
+)
+
+// DatabagFetcher is the subset of the data bag API used by the provider.
+type DatabagFetcher interface {
+	GetItem(databagName string, databagItem string) (item chef.DataBagItem, err error)
+	List() (data *chef.DataBagListResult, err error)
+}
+
+// client reads and decrypts chef-vault items.
+// https://github.com/chef/chef-vault/blob/main/THEORY.md
+type client struct {
+	name     string
+	key      *rsa.PrivateKey
+	databags DatabagFetcher
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+// GetSecret returns the decrypted item vaultName/itemName as JSON, property selects a field of the item.
+func (c *client) GetSecret(_ context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	item, err := c.getVaultItem(ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := json.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Property == "" {
+		return raw, nil
+	}
+	res := gjson.GetBytes(raw, ref.Property)
+	if !res.Exists() {
+		return nil, fmt.Errorf(errPropertyNotFound, ref.Property, ref.Key)
+	}
+	if res.Type == gjson.String {
+		return []byte(res.Str), nil
+	}
+	return []byte(res.Raw), nil
+}
+
+// GetSecretMap returns the fields of the decrypted item vaultName/itemName.
+func (c *client) GetSecretMap(_ context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	item, err := c.getVaultItem(ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	data := make(map[string][]byte, len(item))
+	for k, v := range item {
+		b, err := utils.GetByteValue(v)
+		if err != nil {
+			return nil, err
+		}
+		data[k] = b
+	}
+	return data, nil
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindUnsupported)
+}
+
+func (c *client) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
+	return false, errors.New(errReadOnly)
+}
+
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	_, err := c.databags.List()
+	metrics.ObserveAPICall(ProviderChefVault, CallChefListDataBags, err)
+	if err != nil {
+		return esv1beta1.ValidationResultError, fmt.Errorf(errStoreValidationFailed, err)
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+// getVaultItem decrypts the shared secret of the item with the private key of the client,
+// and the fields of the item with the shared secret.
+func (c *client) getVaultItem(key string) (map[string]any, error) {
+	vault, name, ok := strings.Cut(key, "/")
+	if !ok || vault == "" || name == "" || strings.Contains(name, "/") {
+		return nil, fmt.Errorf(errInvalidKey, key)
+	}
+	keys, err := c.getItem(vault, name+keysItemSuffix)
+	if err != nil {
+		return nil, err
+	}
+	var encryptedSecret string
+	if err := unmarshalField(keys, c.name, &encryptedSecret); err != nil {
+		return nil, err
+	}
+	var mode string
+	if err := unmarshalField(keys, "mode", &mode); err != nil {
+		return nil, err
+	}
+	// in sparse mode the secret of every client is stored in its own item
+	if encryptedSecret == "" && mode == sparseMode {
+		sparse, err := c.getItem(vault, name+sparseKeyItemSeparator+c.name)
+		if err != nil && !errors.Is(err, esv1beta1.NoSecretError{}) {
+			return nil, err
+		}
+		if err := unmarshalField(sparse, c.name, &encryptedSecret); err != nil {
+			return nil, err
+		}
+	}
+	if encryptedSecret == "" {
+		return nil, fmt.Errorf(errNotShared, vault, name, c.name)
+	}
+	secret, err := decryptSharedSecret(c.key, encryptedSecret)
+	if err != nil {
+		return nil, fmt.Errorf(errDecryptSharedSecret, vault, name, err)
+	}
+
+	fields, err := c.getItem(vault, name)
+	if err != nil {
+		return nil, err
+	}
+	item := make(map[string]any, len(fields))
+	for k, v := range fields {
+		if k == "id" {
+			continue
+		}
+		value := &encryptedValue{}
+		if err := json.Unmarshal(v, value); err != nil {
+			return nil, fmt.Errorf(errDecryptField, k, vault, name, err)
+		}
+		item[k], err = decryptValue(secret, value)
+		if err != nil {
+			return nil, fmt.Errorf(errDecryptField, k, vault, name, err)
+		}
+	}
+	return item, nil
+}
+
+func (c *client) getItem(databag, name string) (map[string]json.RawMessage, error) {
+	item, err := c.databags.GetItem(databag, name)
+	metrics.ObserveAPICall(ProviderChefVault, CallChefGetDataBagItem, err)
+	var cerr *chef.ErrorResponse
+	if errors.As(err, &cerr) && cerr.Response != nil && cerr.StatusCode() == http.StatusNotFound {
+		return nil, esv1beta1.NoSecretError{}
+	}
+	if err != nil {
+		return nil, fmt.Errorf(errGetItem, databag, name, err)
+	}
+	raw, err := json.Marshal(item)
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// unmarshalField decodes the field name of item into v if it is set.
+func unmarshalField(item map[string]json.RawMessage, name string, v any) error {
+	raw, ok := item[name]
+	if !ok {
+		return nil
+	}
+	return json.Unmarshal(raw, v)
+}
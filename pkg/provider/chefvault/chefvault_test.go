@@ -0,0 +1,255 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chefvault
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chef/chef"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const testClient = "external-secrets"
+
+// fakeDatabags serves data bag items keyed by "databag/item".
+type fakeDatabags map[string]map[string]any
+
+func (f fakeDatabags) GetItem(databag, item string) (chef.DataBagItem, error) {
+	i, ok := f[databag+"/"+item]
+	if !ok {
+		return nil, &chef.ErrorResponse{Response: &http.Response{
+			StatusCode: http.StatusNotFound,
+			Request:    httptest.NewRequest(http.MethodGet, "/data/"+databag+"/"+item, http.NoBody),
+		}}
+	}
+	return i, nil
+}
+
+func (f fakeDatabags) List() (*chef.DataBagListResult, error) {
+	return &chef.DataBagListResult{}, nil
+}
+
+// encrypt encrypts a field like Chef::EncryptedDataBagItem.
+func encrypt(t *testing.T, secret []byte, version int, value any) map[string]any {
+	t.Helper()
+	plaintext, err := json.Marshal(map[string]any{"json_wrapper": value})
+	require.NoError(t, err)
+	key := sha256.Sum256(secret)
+	block, err := aes.NewCipher(key[:])
+	require.NoError(t, err)
+	if version == 3 {
+		iv := make([]byte, 12)
+		_, err = rand.Read(iv)
+		require.NoError(t, err)
+		gcm, err := cipher.NewGCM(block)
+		require.NoError(t, err)
+		sealed := gcm.Seal(nil, iv, plaintext, nil)
+		tagStart := len(sealed) - gcm.Overhead()
+		return map[string]any{
+			"encrypted_data": base64.StdEncoding.EncodeToString(sealed[:tagStart]),
+			"iv":             base64.StdEncoding.EncodeToString(iv),
+			"auth_tag":       base64.StdEncoding.EncodeToString(sealed[tagStart:]),
+			"version":        3,
+			"cipher":         "aes-256-gcm",
+		}
+	}
+	iv := make([]byte, aes.BlockSize)
+	_, err = rand.Read(iv)
+	require.NoError(t, err)
+	pad := aes.BlockSize - len(plaintext)%aes.BlockSize
+	for i := 0; i < pad; i++ {
+		plaintext = append(plaintext, byte(pad))
+	}
+	ciphertext := make([]byte, len(plaintext))
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(ciphertext, plaintext)
+	data := base64.StdEncoding.EncodeToString(ciphertext)
+	field := map[string]any{
+		"encrypted_data": data,
+		"iv":             base64.StdEncoding.EncodeToString(iv),
+		"version":        version,
+		"cipher":         "aes-256-cbc",
+	}
+	if version == 2 {
+		mac := hmac.New(sha256.New, secret)
+		mac.Write([]byte(data))
+		field["hmac"] = base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	}
+	return field
+}
+
+func shareSecret(t *testing.T, key *rsa.PrivateKey, secret []byte) string {
+	t.Helper()
+	encrypted, err := rsa.EncryptPKCS1v15(rand.Reader, &key.PublicKey, secret)
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(encrypted)
+}
+
+func newTestClient(t *testing.T) *client {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	secret := []byte("0123456789abcdef0123456789abcdef")
+	sparseSecret := []byte("fedcba9876543210fedcba9876543210")
+
+	databags := fakeDatabags{
+		"passwords/db": {
+			"id":       "db",
+			"username": encrypt(t, secret, 1, "app"),
+			"password": encrypt(t, secret, 2, "s3cr3t"),
+			"port":     encrypt(t, secret, 3, 5432),
+			"options":  encrypt(t, secret, 3, map[string]any{"sslmode": "require"}),
+		},
+		"passwords/db_keys": {
+			"id":         "db_keys",
+			"admins":     []string{"admin"},
+			"clients":    []string{testClient},
+			"mode":       "default",
+			testClient:   shareSecret(t, key, secret),
+			"web01.node": shareSecret(t, other, secret),
+		},
+		"passwords/api": {
+			"id":    "api",
+			"token": encrypt(t, sparseSecret, 3, "api-token"),
+		},
+		"passwords/api_keys": {
+			"id":      "api_keys",
+			"clients": []string{testClient},
+			"mode":    "sparse",
+		},
+		"passwords/api_key_" + testClient: {
+			"id":       "api_key_" + testClient,
+			testClient: shareSecret(t, key, sparseSecret),
+		},
+		"passwords/other": {
+			"id":    "other",
+			"token": encrypt(t, secret, 3, "other"),
+		},
+		"passwords/other_keys": {
+			"id":         "other_keys",
+			"mode":       "default",
+			"web01.node": shareSecret(t, other, secret),
+		},
+		"passwords/tampered": {
+			"id": "tampered",
+			"password": func() map[string]any {
+				field := encrypt(t, secret, 2, "s3cr3t")
+				field["hmac"] = base64.StdEncoding.EncodeToString([]byte("forged"))
+				return field
+			}(),
+		},
+		"passwords/tampered_keys": {
+			"id":       "tampered_keys",
+			testClient: shareSecret(t, key, secret),
+		},
+	}
+	return &client{
+		name:     testClient,
+		key:      key,
+		databags: databags,
+	}
+}
+
+func TestGetSecret(t *testing.T) {
+	c := newTestClient(t)
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"version 1": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "passwords/db", Property: "username"},
+			want: "app",
+		},
+		"version 2": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "passwords/db", Property: "password"},
+			want: "s3cr3t",
+		},
+		"version 3 number": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "passwords/db", Property: "port"},
+			want: "5432",
+		},
+		"nested property": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "passwords/db", Property: "options.sslmode"},
+			want: "require",
+		},
+		"whole item": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "passwords/api"},
+			want: `{"token":"api-token"}`,
+		},
+		"sparse mode": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "passwords/api", Property: "token"},
+			want: "api-token",
+		},
+		"missing property": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "passwords/db", Property: "host"},
+			wantErr: "property host not found in vault item passwords/db",
+		},
+		"not shared with the client": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "passwords/other"},
+			wantErr: "vault item passwords/other is not shared with external-secrets",
+		},
+		"modified item": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "passwords/tampered"},
+			wantErr: errInvalidHMAC,
+		},
+		"invalid key": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "passwords"},
+			wantErr: "expected 'vaultName/itemName'",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+
+	_, err := c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "passwords/missing"})
+	assert.True(t, errors.Is(err, esv1beta1.NoSecretError{}))
+}
+
+func TestGetSecretMap(t *testing.T) {
+	c := newTestClient(t)
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "passwords/db"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"username": []byte("app"),
+		"password": []byte("s3cr3t"),
+		"port":     []byte("5432"),
+		"options":  []byte(`{"sslmode":"require"}`),
+	}, got)
+}
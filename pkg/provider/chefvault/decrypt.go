@@ -0,0 +1,137 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chefvault
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+const (
+	errUnsupportedVersion = "unsupported encrypted data bag version %d"
+	errInvalidHMAC        = "invalid hmac, the item was modified or the secret is wrong"
+	errInvalidPadding     = "invalid padding, the secret is wrong"
+	errDecodeValue        = "unable to decode the decrypted value: %w"
+)
+
+// encryptedValue is a field of an encrypted data bag item.
+// https://docs.chef.io/data_bags/#encryption
+type encryptedValue struct {
+	EncryptedData string `json:"encrypted_data"`
+	IV            string `json:"iv"`
+	Version       int    `json:"version"`
+	Cipher        string `json:"cipher"`
+	HMAC          string `json:"hmac,omitempty"`
+	AuthTag       string `json:"auth_tag,omitempty"`
+}
+
+// decryptSharedSecret decrypts the secret of a vault item, which chef-vault encrypts
+// with the public key of every client and admin the item is shared with.
+func decryptSharedSecret(key *rsa.PrivateKey, encrypted string) ([]byte, error) {
+	ciphertext, err := base64.StdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return nil, err
+	}
+	return rsa.DecryptPKCS1v15(rand.Reader, key, ciphertext)
+}
+
+// decryptValue decrypts a field with the versions 1 and 2 (aes-256-cbc)
+// or 3 (aes-256-gcm) of the encrypted data bag format.
+func decryptValue(secret []byte, v *encryptedValue) (any, error) {
+	ciphertext, err := base64.StdEncoding.DecodeString(v.EncryptedData)
+	if err != nil {
+		return nil, err
+	}
+	iv, err := base64.StdEncoding.DecodeString(v.IV)
+	if err != nil {
+		return nil, err
+	}
+	key := sha256.Sum256(secret)
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	var plaintext []byte
+	switch v.Version {
+	case 1, 2:
+		if v.Version == 2 {
+			// the hmac is computed with the secret over the base64 encoded data
+			want, err := base64.StdEncoding.DecodeString(v.HMAC)
+			if err != nil {
+				return nil, err
+			}
+			mac := hmac.New(sha256.New, secret)
+			mac.Write([]byte(v.EncryptedData))
+			if !hmac.Equal(mac.Sum(nil), want) {
+				return nil, errors.New(errInvalidHMAC)
+			}
+		}
+		plaintext, err = decryptCBC(block, iv, ciphertext)
+	case 3:
+		var tag []byte
+		tag, err = base64.StdEncoding.DecodeString(v.AuthTag)
+		if err != nil {
+			return nil, err
+		}
+		var gcm cipher.AEAD
+		gcm, err = cipher.NewGCMWithNonceSize(block, len(iv))
+		if err != nil {
+			return nil, err
+		}
+		plaintext, err = gcm.Open(nil, iv, append(ciphertext, tag...), nil)
+	default:
+		return nil, fmt.Errorf(errUnsupportedVersion, v.Version)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// values are wrapped to encrypt any JSON type
+	wrapper := struct {
+		Value any `json:"json_wrapper"`
+	}{}
+	dec := json.NewDecoder(bytes.NewReader(plaintext))
+	dec.UseNumber()
+	if err := dec.Decode(&wrapper); err != nil {
+		return nil, fmt.Errorf(errDecodeValue, err)
+	}
+	return wrapper.Value, nil
+}
+
+func decryptCBC(block cipher.Block, iv, ciphertext []byte) ([]byte, error) {
+	if len(iv) != block.BlockSize() || len(ciphertext) == 0 || len(ciphertext)%block.BlockSize() != 0 {
+		return nil, errors.New(errInvalidPadding)
+	}
+	plaintext := make([]byte, len(ciphertext))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plaintext, ciphertext)
+	pad := int(plaintext[len(plaintext)-1])
+	if pad == 0 || pad > block.BlockSize() {
+		return nil, errors.New(errInvalidPadding)
+	}
+	for _, b := range plaintext[len(plaintext)-pad:] {
+		if int(b) != pad {
+			return nil, errors.New(errInvalidPadding)
+		}
+	}
+	return plaintext[:len(plaintext)-pad], nil
+}
@@ -0,0 +1,126 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chefvault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/go-chef/chef"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+const (
+	// seconds, go-chef does not accept a context.
+	requestTimeout = 25
+
+	errStoreIsNil                  = "store is nil"
+	errNoStoreTypeOrWrongStoreType = "no store type or wrong store type"
+	errMissingUserName             = "missing username"
+	errMissingServerURL            = "missing serverUrl"
+	errServerURLNoEndSlash         = "serverUrl does not end with slash(/)"
+	errInvalidURL                  = "invalid serverUrl: %w"
+	errMissingAuth                 = "missing auth.secretRef.privateKeySecretRef"
+	errCannotResolveSecretKeyRef   = "cannot resolve secret key ref: %w"
+	errInvalidPrivateKey           = "invalid private key: %w"
+	errChefClient                  = "unable to create chef client: %w"
+)
+
+// Provider satisfies the provider interface.
+type Provider struct{}
+
+var _ esv1beta1.Provider = &Provider{}
+
+func (p *Provider) NewClient(ctx context.Context, store esv1beta1.GenericStore, kube kclient.Client, namespace string) (esv1beta1.SecretsClient, error) {
+	cfg, err := getConfig(store)
+	if err != nil {
+		return nil, err
+	}
+	pem, err := resolvers.SecretKeyRef(ctx, kube, store.GetKind(), namespace, &cfg.Auth.SecretRef.SecretKey)
+	if err != nil {
+		return nil, fmt.Errorf(errCannotResolveSecretKeyRef, err)
+	}
+	key, err := chef.PrivateKeyFromString([]byte(pem))
+	if err != nil {
+		return nil, fmt.Errorf(errInvalidPrivateKey, err)
+	}
+	chefClient, err := chef.NewClient(&chef.Config{
+		Name:    cfg.UserName,
+		Key:     pem,
+		BaseURL: cfg.ServerURL,
+		Timeout: requestTimeout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf(errChefClient, err)
+	}
+	return &client{
+		name:     cfg.UserName,
+		key:      key,
+		databags: chefClient.DataBags,
+	}, nil
+}
+
+func getConfig(store esv1beta1.GenericStore) (*esv1beta1.ChefVaultProvider, error) {
+	if store == nil {
+		return nil, errors.New(errStoreIsNil)
+	}
+	spec := store.GetSpec()
+	if spec == nil || spec.Provider == nil || spec.Provider.ChefVault == nil {
+		return nil, errors.New(errNoStoreTypeOrWrongStoreType)
+	}
+	cfg := spec.Provider.ChefVault
+	if cfg.UserName == "" {
+		return nil, errors.New(errMissingUserName)
+	}
+	if cfg.ServerURL == "" {
+		return nil, errors.New(errMissingServerURL)
+	}
+	if !strings.HasSuffix(cfg.ServerURL, "/") {
+		return nil, errors.New(errServerURLNoEndSlash)
+	}
+	if _, err := url.ParseRequestURI(cfg.ServerURL); err != nil {
+		return nil, fmt.Errorf(errInvalidURL, err)
+	}
+	if cfg.Auth == nil || cfg.Auth.SecretRef.SecretKey.Name == "" {
+		return nil, errors.New(errMissingAuth)
+	}
+	if err := utils.ValidateReferentSecretSelector(store, cfg.Auth.SecretRef.SecretKey); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnings, error) {
+	_, err := getConfig(store)
+	return nil, err
+}
+
+func (p *Provider) Capabilities() esv1beta1.SecretStoreCapabilities {
+	return esv1beta1.SecretStoreReadOnly
+}
+
+func init() {
+	esv1beta1.Register(&Provider{}, &esv1beta1.SecretStoreProvider{
+		ChefVault: &esv1beta1.ChefVaultProvider{},
+	})
+}
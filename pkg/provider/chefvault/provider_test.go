@@ -0,0 +1,93 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chefvault
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+func TestValidateStore(t *testing.T) {
+	otherNamespace := "other"
+	auth := &esv1beta1.ChefAuth{SecretRef: esv1beta1.ChefAuthSecretRef{
+		SecretKey: esmeta.SecretKeySelector{Name: "chef", Key: "key.pem"},
+	}}
+	tests := map[string]struct {
+		cfg     esv1beta1.ChefVaultProvider
+		wantErr string
+	}{
+		"valid": {
+			cfg: esv1beta1.ChefVaultProvider{
+				UserName:  "external-secrets",
+				ServerURL: "https://chef.example.com/organizations/example/",
+				Auth:      auth,
+			},
+		},
+		"missing username": {
+			cfg: esv1beta1.ChefVaultProvider{
+				ServerURL: "https://chef.example.com/organizations/example/",
+				Auth:      auth,
+			},
+			wantErr: errMissingUserName,
+		},
+		"server url without slash": {
+			cfg: esv1beta1.ChefVaultProvider{
+				UserName:  "external-secrets",
+				ServerURL: "https://chef.example.com/organizations/example",
+				Auth:      auth,
+			},
+			wantErr: errServerURLNoEndSlash,
+		},
+		"missing auth": {
+			cfg: esv1beta1.ChefVaultProvider{
+				UserName:  "external-secrets",
+				ServerURL: "https://chef.example.com/organizations/example/",
+			},
+			wantErr: errMissingAuth,
+		},
+		"invalid namespace in secret ref": {
+			cfg: esv1beta1.ChefVaultProvider{
+				UserName:  "external-secrets",
+				ServerURL: "https://chef.example.com/organizations/example/",
+				Auth: &esv1beta1.ChefAuth{SecretRef: esv1beta1.ChefAuthSecretRef{
+					SecretKey: esmeta.SecretKeySelector{Name: "chef", Key: "key.pem", Namespace: &otherNamespace},
+				}},
+			},
+			wantErr: "namespace should either be empty or match the namespace of the SecretStore",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := esv1beta1.SecretStore{
+				Spec: esv1beta1.SecretStoreSpec{
+					Provider: &esv1beta1.SecretStoreProvider{
+						ChefVault: &tc.cfg,
+					},
+				},
+			}
+			p := &Provider{}
+			_, err := p.ValidateStore(&s)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
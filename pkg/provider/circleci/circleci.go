@@ -0,0 +1,247 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package circleci
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	errWriteOnly          = "CircleCI context variables are write only, their values can not be read back: use a PushSecret to write them"
+	errUnexpectedStatus   = "unexpected status code from CircleCI: %d: %s"
+	errUnmarshalResponse  = "unable to unmarshal CircleCI response: %w"
+	errContextNotFound    = "context %q not found in %s"
+	errPropertyRequired   = "property is required, it is the name of the environment variable in the context"
+	errInvalidVariable    = "%q is not a valid environment variable name"
+	errContextKeyRequired = "key is required, it is the name of the context"
+)
+
+var variableNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// client writes environment variables of contexts with the CircleCI v2 API.
+// https://circleci.com/docs/api/v2/index.html#tag/Context
+type client struct {
+	httpClient *http.Client
+	url        string
+	ownerSlug  string
+	token      string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+type page[T any] struct {
+	Items         []T    `json:"items"`
+	NextPageToken string `json:"next_page_token"`
+}
+
+type contextItem struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type variableItem struct {
+	Variable string `json:"variable"`
+}
+
+// PushSecret creates or updates the environment variable property of the context key.
+func (c *client) PushSecret(ctx context.Context, secret *corev1.Secret, data esv1beta1.PushSecretData) error {
+	path, err := c.variablePath(ctx, data.GetRemoteKey(), data.GetProperty())
+	if err != nil {
+		return err
+	}
+	value, err := utils.PushSecretValue(secret, data.GetSecretKey())
+	if err != nil {
+		return err
+	}
+	return c.do(ctx, http.MethodPut, path, map[string]string{"value": string(value)}, nil)
+}
+
+// DeleteSecret deletes the environment variable, a missing context or variable is not an error.
+func (c *client) DeleteSecret(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) error {
+	path, err := c.variablePath(ctx, remoteRef.GetRemoteKey(), remoteRef.GetProperty())
+	if errors.Is(err, esv1beta1.NoSecretError{}) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	err = c.do(ctx, http.MethodDelete, path, nil, nil)
+	if errors.Is(err, esv1beta1.NoSecretError{}) {
+		return nil
+	}
+	return err
+}
+
+// SecretExists checks if the context has the environment variable, its value can not be compared.
+func (c *client) SecretExists(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) (bool, error) {
+	if err := validateVariable(remoteRef.GetProperty()); err != nil {
+		return false, err
+	}
+	ctxID, err := c.contextID(ctx, remoteRef.GetRemoteKey())
+	if errors.Is(err, esv1beta1.NoSecretError{}) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	found := false
+	err = list(ctx, c, "/context/"+url.PathEscape(ctxID)+"/environment-variable", url.Values{}, func(v variableItem) bool {
+		found = v.Variable == remoteRef.GetProperty()
+		return found
+	})
+	return found, err
+}
+
+func (c *client) GetSecret(_ context.Context, _ esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	return nil, errors.New(errWriteOnly)
+}
+
+func (c *client) GetSecretMap(_ context.Context, _ esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	return nil, errors.New(errWriteOnly)
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errWriteOnly)
+}
+
+// Validate requests the user of the API token.
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	if err := c.do(context.Background(), http.MethodGet, "/me", nil, nil); err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+func validateVariable(name string) error {
+	if name == "" {
+		return errors.New(errPropertyRequired)
+	}
+	if !variableNameRegexp.MatchString(name) {
+		return fmt.Errorf(errInvalidVariable, name)
+	}
+	return nil
+}
+
+// variablePath returns the path of the environment variable name of the context named key.
+func (c *client) variablePath(ctx context.Context, key, name string) (string, error) {
+	if err := validateVariable(name); err != nil {
+		return "", err
+	}
+	ctxID, err := c.contextID(ctx, key)
+	if err != nil {
+		return "", err
+	}
+	return "/context/" + url.PathEscape(ctxID) + "/environment-variable/" + url.PathEscape(name), nil
+}
+
+// contextID looks up the ID of the context with the given name, the API has no lookup by name.
+func (c *client) contextID(ctx context.Context, name string) (string, error) {
+	if name == "" {
+		return "", errors.New(errContextKeyRequired)
+	}
+	var id string
+	err := list(ctx, c, "/context", url.Values{"owner-slug": []string{c.ownerSlug}}, func(item contextItem) bool {
+		if item.Name == name {
+			id = item.ID
+		}
+		return id != ""
+	})
+	if err != nil {
+		return "", err
+	}
+	if id == "" {
+		return "", fmt.Errorf(errContextNotFound+": %w", name, c.ownerSlug, esv1beta1.NoSecretError{})
+	}
+	return id, nil
+}
+
+// list calls fn for the items of all pages until it returns true.
+func list[T any](ctx context.Context, c *client, path string, query url.Values, fn func(T) bool) error {
+	for {
+		var p page[T]
+		if err := c.do(ctx, http.MethodGet, path+"?"+query.Encode(), nil, &p); err != nil {
+			return err
+		}
+		for _, item := range p.Items {
+			if fn(item) {
+				return nil
+			}
+		}
+		if p.NextPageToken == "" {
+			return nil
+		}
+		query.Set("page-token", p.NextPageToken)
+	}
+}
+
+func (c *client) do(ctx context.Context, method, path string, body, target any) error {
+	var reqBody io.Reader = http.NoBody
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Circle-Token", c.token)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return esv1beta1.NoSecretError{}
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf(errUnexpectedStatus, resp.StatusCode, respBody)
+	}
+	if target == nil {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, target); err != nil {
+		return fmt.Errorf(errUnmarshalResponse, err)
+	}
+	return nil
+}
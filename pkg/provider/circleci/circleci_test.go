@@ -0,0 +1,179 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package circleci
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1alpha1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1alpha1"
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	testToken     = "token"
+	testOwnerSlug = "gh/acme"
+)
+
+// fakeCircleCI serves two pages of contexts of the organization gh/acme and stores
+// the environment variables written to them.
+type fakeCircleCI struct {
+	mu        sync.Mutex
+	variables map[string]map[string]string
+}
+
+func (f *fakeCircleCI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if r.Header.Get("Circle-Token") != testToken {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"message":"You must log in first."}`))
+		return
+	}
+	if r.URL.Path == "/me" {
+		_, _ = w.Write([]byte(`{"id":"1","login":"deployer"}`))
+		return
+	}
+	if r.URL.Path == "/context" {
+		if r.URL.Query().Get("owner-slug") != testOwnerSlug {
+			_, _ = w.Write([]byte(`{"items":[],"next_page_token":null}`))
+			return
+		}
+		if r.URL.Query().Get("page-token") == "" {
+			_, _ = w.Write([]byte(`{"items":[{"id":"ctx-1","name":"staging"}],"next_page_token":"p2"}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"items":[{"id":"ctx-2","name":"production"}],"next_page_token":null}`))
+		return
+	}
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/context/"), "/")
+	vars, ok := f.variables[parts[0]]
+	if !ok || parts[1] != "environment-variable" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if len(parts) == 2 {
+		var items []map[string]string
+		for name := range vars {
+			items = append(items, map[string]string{"variable": name, "context_id": parts[0]})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"items": items})
+		return
+	}
+	switch r.Method {
+	case http.MethodPut:
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		vars[parts[2]] = body["value"]
+		_, _ = w.Write([]byte(`{"variable":"` + parts[2] + `"}`))
+	case http.MethodDelete:
+		if _, ok := vars[parts[2]]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(vars, parts[2])
+		_, _ = w.Write([]byte(`{"message":"Environment variable deleted."}`))
+	}
+}
+
+func newTestClient(t *testing.T) (*client, *fakeCircleCI) {
+	fake := &fakeCircleCI{variables: map[string]map[string]string{"ctx-1": {}, "ctx-2": {}}}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	return &client{httpClient: srv.Client(), url: srv.URL, ownerSlug: testOwnerSlug, token: testToken}, fake
+}
+
+func pushData(secretKey, remoteKey, property string) esv1alpha1.PushSecretData {
+	return esv1alpha1.PushSecretData{
+		Match: esv1alpha1.PushSecretMatch{
+			SecretKey: secretKey,
+			RemoteRef: esv1alpha1.PushSecretRemoteRef{RemoteKey: remoteKey, Property: property},
+		},
+	}
+}
+
+func TestPushSecret(t *testing.T) {
+	c, fake := newTestClient(t)
+	secret := &corev1.Secret{Data: map[string][]byte{"token": []byte("abc"), "user": []byte("deploy")}}
+
+	// the context is on the second page
+	require.NoError(t, c.PushSecret(context.Background(), secret, pushData("token", "production", "DEPLOY_TOKEN")))
+	assert.Equal(t, "abc", fake.variables["ctx-2"]["DEPLOY_TOKEN"])
+
+	require.NoError(t, c.PushSecret(context.Background(), secret, pushData("", "staging", "DEPLOY")))
+	assert.JSONEq(t, `{"token":"abc","user":"deploy"}`, fake.variables["ctx-1"]["DEPLOY"])
+
+	err := c.PushSecret(context.Background(), secret, pushData("token", "production", ""))
+	assert.ErrorContains(t, err, errPropertyRequired)
+	err = c.PushSecret(context.Background(), secret, pushData("token", "production", "DEPLOY-TOKEN"))
+	assert.ErrorContains(t, err, "is not a valid environment variable name")
+	err = c.PushSecret(context.Background(), secret, pushData("token", "development", "DEPLOY_TOKEN"))
+	assert.ErrorContains(t, err, `context "development" not found in gh/acme`)
+}
+
+func TestSecretExistsAndDelete(t *testing.T) {
+	c, _ := newTestClient(t)
+	secret := &corev1.Secret{Data: map[string][]byte{"token": []byte("abc")}}
+	ref := esv1alpha1.PushSecretRemoteRef{RemoteKey: "production", Property: "DEPLOY_TOKEN"}
+
+	exists, err := c.SecretExists(context.Background(), ref)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	require.NoError(t, c.PushSecret(context.Background(), secret, pushData("token", ref.RemoteKey, ref.Property)))
+	exists, err = c.SecretExists(context.Background(), ref)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	require.NoError(t, c.DeleteSecret(context.Background(), ref))
+	exists, err = c.SecretExists(context.Background(), ref)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// deleting a missing variable or context succeeds
+	require.NoError(t, c.DeleteSecret(context.Background(), ref))
+	require.NoError(t, c.DeleteSecret(context.Background(), esv1alpha1.PushSecretRemoteRef{RemoteKey: "development", Property: "DEPLOY_TOKEN"}))
+}
+
+func TestGetSecretIsNotSupported(t *testing.T) {
+	c, _ := newTestClient(t)
+	_, err := c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "production", Property: "DEPLOY_TOKEN"})
+	assert.ErrorContains(t, err, "write only")
+	_, err = c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "production"})
+	assert.ErrorContains(t, err, "write only")
+	_, err = c.GetAllSecrets(context.Background(), esv1beta1.ExternalSecretFind{})
+	assert.ErrorContains(t, err, "write only")
+}
+
+func TestValidate(t *testing.T) {
+	c, _ := newTestClient(t)
+	res, err := c.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+
+	c.token = "invalid"
+	res, err = c.Validate()
+	assert.ErrorContains(t, err, "unexpected status code from CircleCI: 401")
+	assert.Equal(t, esv1beta1.ValidationResultError, res)
+}
@@ -0,0 +1,106 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package circleci
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+const (
+	defaultURL = "https://circleci.com/api/v2"
+
+	errStoreIsNil                  = "store is nil"
+	errNoStoreTypeOrWrongStoreType = "no store type or wrong store type"
+	errInvalidURL                  = "invalid url %q: %w"
+	errOwnerSlugRequired           = "ownerSlug is required"
+	errCannotResolveAPIToken       = "cannot resolve api token: %w"
+)
+
+// Provider satisfies the provider interface.
+type Provider struct{}
+
+var _ esv1beta1.Provider = &Provider{}
+
+func (p *Provider) NewClient(ctx context.Context, store esv1beta1.GenericStore, kube kclient.Client, namespace string) (esv1beta1.SecretsClient, error) {
+	cfg, err := getConfig(store)
+	if err != nil {
+		return nil, err
+	}
+	token, err := resolvers.SecretKeyRef(ctx, kube, store.GetKind(), namespace, &cfg.Auth.APIToken)
+	if err != nil {
+		return nil, fmt.Errorf(errCannotResolveAPIToken, err)
+	}
+	apiURL := cfg.URL
+	if apiURL == "" {
+		apiURL = defaultURL
+	}
+	return &client{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		url:        strings.TrimSuffix(apiURL, "/"),
+		ownerSlug:  cfg.OwnerSlug,
+		token:      token,
+	}, nil
+}
+
+func getConfig(store esv1beta1.GenericStore) (*esv1beta1.CircleCIProvider, error) {
+	if store == nil {
+		return nil, errors.New(errStoreIsNil)
+	}
+	spec := store.GetSpec()
+	if spec == nil || spec.Provider == nil || spec.Provider.CircleCI == nil {
+		return nil, errors.New(errNoStoreTypeOrWrongStoreType)
+	}
+	cfg := spec.Provider.CircleCI
+	if cfg.URL != "" {
+		if _, err := url.ParseRequestURI(cfg.URL); err != nil {
+			return nil, fmt.Errorf(errInvalidURL, cfg.URL, err)
+		}
+	}
+	if cfg.OwnerSlug == "" {
+		return nil, errors.New(errOwnerSlugRequired)
+	}
+	if err := utils.ValidateReferentSecretSelector(store, cfg.Auth.APIToken); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnings, error) {
+	_, err := getConfig(store)
+	return nil, err
+}
+
+func (p *Provider) Capabilities() esv1beta1.SecretStoreCapabilities {
+	return esv1beta1.SecretStoreWriteOnly
+}
+
+func init() {
+	esv1beta1.Register(&Provider{}, &esv1beta1.SecretStoreProvider{
+		CircleCI: &esv1beta1.CircleCIProvider{},
+	})
+}
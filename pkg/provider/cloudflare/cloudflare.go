@@ -0,0 +1,184 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	errWriteOnly         = "Cloudflare Workers secrets are write only, their values can not be read back: use a PushSecret to write them"
+	errUnexpectedStatus  = "unexpected status code from Cloudflare: %d: %s"
+	errUnmarshalResponse = "unable to unmarshal Cloudflare response: %w"
+	errInvalidKey        = "invalid key %q: must be <account id>/<worker name>/<secret name>"
+	errTokenNotActive    = "the Cloudflare API token is %s"
+)
+
+// client writes secrets of Workers with the Cloudflare v4 API.
+// https://developers.cloudflare.com/api/operations/worker-script-put-secret
+type client struct {
+	httpClient *http.Client
+	url        string
+	token      string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+// response is the envelope of all responses of the Cloudflare API.
+type response struct {
+	Result json.RawMessage `json:"result"`
+}
+
+// PushSecret creates or updates the secret named by the key.
+func (c *client) PushSecret(ctx context.Context, secret *corev1.Secret, data esv1beta1.PushSecretData) error {
+	scriptPath, name, err := secretPath(data.GetRemoteKey())
+	if err != nil {
+		return err
+	}
+	value, err := utils.PushSecretValue(secret, data.GetSecretKey())
+	if err != nil {
+		return err
+	}
+	return c.do(ctx, http.MethodPut, scriptPath+"/secrets", map[string]string{
+		"name": name,
+		"text": string(value),
+		"type": "secret_text",
+	}, nil)
+}
+
+// DeleteSecret deletes the secret, a missing worker or secret is not an error.
+func (c *client) DeleteSecret(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) error {
+	scriptPath, name, err := secretPath(remoteRef.GetRemoteKey())
+	if err != nil {
+		return err
+	}
+	err = c.do(ctx, http.MethodDelete, scriptPath+"/secrets/"+url.PathEscape(name), nil, nil)
+	if errors.Is(err, esv1beta1.NoSecretError{}) {
+		return nil
+	}
+	return err
+}
+
+// SecretExists checks if the worker has the secret, its value can not be compared.
+func (c *client) SecretExists(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) (bool, error) {
+	scriptPath, name, err := secretPath(remoteRef.GetRemoteKey())
+	if err != nil {
+		return false, err
+	}
+	err = c.do(ctx, http.MethodGet, scriptPath+"/secrets/"+url.PathEscape(name), nil, nil)
+	if errors.Is(err, esv1beta1.NoSecretError{}) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (c *client) GetSecret(_ context.Context, _ esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	return nil, errors.New(errWriteOnly)
+}
+
+func (c *client) GetSecretMap(_ context.Context, _ esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	return nil, errors.New(errWriteOnly)
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errWriteOnly)
+}
+
+// Validate verifies the API token.
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	var token struct {
+		Status string `json:"status"`
+	}
+	if err := c.do(context.Background(), http.MethodGet, "/user/tokens/verify", nil, &token); err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	if token.Status != "active" {
+		return esv1beta1.ValidationResultError, fmt.Errorf(errTokenNotActive, token.Status)
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+// secretPath splits a key into the path of the worker script and the name of the secret.
+func secretPath(key string) (string, string, error) {
+	parts := strings.Split(key, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", fmt.Errorf(errInvalidKey, key)
+	}
+	return "/accounts/" + url.PathEscape(parts[0]) + "/workers/scripts/" + url.PathEscape(parts[1]), parts[2], nil
+}
+
+func (c *client) do(ctx context.Context, method, path string, body, target any) error {
+	var reqBody io.Reader = http.NoBody
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return esv1beta1.NoSecretError{}
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf(errUnexpectedStatus, resp.StatusCode, respBody)
+	}
+	if target == nil {
+		return nil
+	}
+	var out response
+	if err := json.Unmarshal(respBody, &out); err != nil {
+		return fmt.Errorf(errUnmarshalResponse, err)
+	}
+	if err := json.Unmarshal(out.Result, target); err != nil {
+		return fmt.Errorf(errUnmarshalResponse, err)
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1alpha1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1alpha1"
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	testToken   = "token"
+	testAccount = "023e105f4ecef8ad9ca31a8372d0c353"
+)
+
+// fakeCloudflare stores the secrets written to the worker "api" of testAccount.
+type fakeCloudflare struct {
+	mu      sync.Mutex
+	secrets map[string]string
+}
+
+func (f *fakeCloudflare) handler() http.Handler {
+	mux := http.NewServeMux()
+	prefix := "/accounts/" + testAccount + "/workers/scripts/api/secrets"
+	mux.HandleFunc("GET /user/tokens/verify", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"success":true,"errors":[],"messages":[],"result":{"id":"ed17574386854bf78a67040be0a770b0","status":"active"}}`))
+	})
+	mux.HandleFunc("PUT "+prefix, func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["type"] != "secret_text" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.secrets[body["name"]] = body["text"]
+		_, _ = w.Write([]byte(`{"success":true,"errors":[],"messages":[],"result":{"name":"` + body["name"] + `","type":"secret_text"}}`))
+	})
+	mux.HandleFunc(prefix+"/{name}", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		name := r.PathValue("name")
+		if _, ok := f.secrets[name]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"success":false,"errors":[{"code":10056,"message":"binding not found"}],"messages":[],"result":null}`))
+			return
+		}
+		if r.Method == http.MethodDelete {
+			delete(f.secrets, name)
+		}
+		_, _ = w.Write([]byte(`{"success":true,"errors":[],"messages":[],"result":{"name":"` + name + `","type":"secret_text"}}`))
+	})
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testToken {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"success":false,"errors":[{"code":6003,"message":"Invalid request headers"}],"messages":[],"result":null}`))
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func newTestClient(t *testing.T) (*client, *fakeCloudflare) {
+	fake := &fakeCloudflare{secrets: map[string]string{}}
+	srv := httptest.NewServer(fake.handler())
+	t.Cleanup(srv.Close)
+	return &client{httpClient: srv.Client(), url: srv.URL, token: testToken}, fake
+}
+
+func pushData(secretKey, remoteKey string) esv1alpha1.PushSecretData {
+	return esv1alpha1.PushSecretData{
+		Match: esv1alpha1.PushSecretMatch{
+			SecretKey: secretKey,
+			RemoteRef: esv1alpha1.PushSecretRemoteRef{RemoteKey: remoteKey},
+		},
+	}
+}
+
+func TestPushSecret(t *testing.T) {
+	c, fake := newTestClient(t)
+	secret := &corev1.Secret{Data: map[string][]byte{"token": []byte("abc"), "user": []byte("deploy")}}
+
+	require.NoError(t, c.PushSecret(context.Background(), secret, pushData("token", testAccount+"/api/DEPLOY_TOKEN")))
+	assert.Equal(t, "abc", fake.secrets["DEPLOY_TOKEN"])
+
+	require.NoError(t, c.PushSecret(context.Background(), secret, pushData("", testAccount+"/api/DEPLOY")))
+	assert.JSONEq(t, `{"token":"abc","user":"deploy"}`, fake.secrets["DEPLOY"])
+
+	for _, key := range []string{"DEPLOY_TOKEN", "api/DEPLOY_TOKEN", testAccount + "//DEPLOY_TOKEN", testAccount + "/api/DEPLOY/TOKEN"} {
+		err := c.PushSecret(context.Background(), secret, pushData("token", key))
+		assert.ErrorContains(t, err, "must be <account id>/<worker name>/<secret name>", key)
+	}
+}
+
+func TestSecretExistsAndDelete(t *testing.T) {
+	c, _ := newTestClient(t)
+	secret := &corev1.Secret{Data: map[string][]byte{"token": []byte("abc")}}
+	ref := esv1alpha1.PushSecretRemoteRef{RemoteKey: testAccount + "/api/DEPLOY_TOKEN"}
+
+	exists, err := c.SecretExists(context.Background(), ref)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	require.NoError(t, c.PushSecret(context.Background(), secret, pushData("token", ref.RemoteKey)))
+	exists, err = c.SecretExists(context.Background(), ref)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	require.NoError(t, c.DeleteSecret(context.Background(), ref))
+	exists, err = c.SecretExists(context.Background(), ref)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// deleting a missing secret or worker succeeds
+	require.NoError(t, c.DeleteSecret(context.Background(), ref))
+	require.NoError(t, c.DeleteSecret(context.Background(), esv1alpha1.PushSecretRemoteRef{RemoteKey: testAccount + "/web/DEPLOY_TOKEN"}))
+}
+
+func TestGetSecretIsNotSupported(t *testing.T) {
+	c, _ := newTestClient(t)
+	_, err := c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: testAccount + "/api/DEPLOY_TOKEN"})
+	assert.ErrorContains(t, err, "write only")
+	_, err = c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: testAccount + "/api/DEPLOY_TOKEN"})
+	assert.ErrorContains(t, err, "write only")
+	_, err = c.GetAllSecrets(context.Background(), esv1beta1.ExternalSecretFind{})
+	assert.ErrorContains(t, err, "write only")
+}
+
+func TestValidate(t *testing.T) {
+	c, _ := newTestClient(t)
+	res, err := c.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+
+	c.token = "invalid"
+	res, err = c.Validate()
+	assert.ErrorContains(t, err, "unexpected status code from Cloudflare: 400")
+	assert.Equal(t, esv1beta1.ValidationResultError, res)
+}
@@ -0,0 +1,227 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudinary
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	// propertyAPIKey, propertyAPISecret and propertyCloudName are the credentials of a product environment.
+	propertyAPIKey    = "apiKey"
+	propertyAPISecret = "apiSecret"
+	propertyCloudName = "cloudName"
+
+	errNoProductEnvironment = "no product environment with cloud name %q"
+	errNoAccessKey          = "no enabled access key of product environment %q"
+	errNoNamedAccessKey     = "no enabled access key %q of product environment %q"
+	errUnexpectedStatus     = "unexpected status code from Cloudinary: %d: %s"
+	errUnmarshalResponse    = "unable to unmarshal Cloudinary response: %w"
+	errReadOnly             = "the Cloudinary provider is read only"
+	errFindUnsupported      = "find is not supported by the Cloudinary provider"
+)
+
+// client reads the access keys of product environments with the Cloudinary Provisioning API.
+// https://cloudinary.com/documentation/provisioning_api
+type client struct {
+	httpClient *http.Client
+	url        string
+	accountID  string
+	apiKey     string
+	apiSecret  string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+type subAccount struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	CloudName string `json:"cloud_name"`
+	Enabled   bool   `json:"enabled"`
+}
+
+type subAccountsResponse struct {
+	SubAccounts []subAccount `json:"sub_accounts"`
+}
+
+type accessKey struct {
+	Name      string `json:"name"`
+	APIKey    string `json:"api_key"`
+	APISecret string `json:"api_secret"`
+	Enabled   bool   `json:"enabled"`
+}
+
+type accessKeysResponse struct {
+	AccessKeys []accessKey `json:"access_keys"`
+}
+
+// GetSecret returns a credential of a product environment selected by the property,
+// or all credentials as JSON if the property is empty.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	if err := validateProperty(ref.Property); err != nil {
+		return nil, err
+	}
+	data, err := c.GetSecretMap(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Property != "" {
+		return data[ref.Property], nil
+	}
+	values := make(map[string]string, len(data))
+	for k, v := range data {
+		values[k] = string(v)
+	}
+	return json.Marshal(values)
+}
+
+// GetSecretMap returns the api key, api secret and cloud name of the product environment named by the key.
+// Without an access key name the first enabled access key is used.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	cloudName, keyName, err := parseKey(ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	account, err := c.subAccount(ctx, cloudName)
+	if err != nil {
+		return nil, err
+	}
+	var resp accessKeysResponse
+	if err := c.get(ctx, c.accountPath("sub_accounts", account.ID, "access_keys"), &resp); err != nil {
+		return nil, err
+	}
+	for _, key := range resp.AccessKeys {
+		if !key.Enabled || (keyName != "" && key.Name != keyName) {
+			continue
+		}
+		return map[string][]byte{
+			propertyAPIKey:    []byte(key.APIKey),
+			propertyAPISecret: []byte(key.APISecret),
+			propertyCloudName: []byte(account.CloudName),
+		}, nil
+	}
+	if keyName != "" {
+		return nil, fmt.Errorf(errNoNamedAccessKey, keyName, cloudName)
+	}
+	return nil, fmt.Errorf(errNoAccessKey, cloudName)
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindUnsupported)
+}
+
+func (c *client) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
+	return false, errors.New(errReadOnly)
+}
+
+// Validate lists the product environments of the account to check the provisioning key.
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	var resp subAccountsResponse
+	if err := c.get(context.Background(), c.accountPath("sub_accounts"), &resp); err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+// subAccount returns the product environment with the given cloud name.
+func (c *client) subAccount(ctx context.Context, cloudName string) (*subAccount, error) {
+	var resp subAccountsResponse
+	if err := c.get(ctx, c.accountPath("sub_accounts"), &resp); err != nil {
+		return nil, err
+	}
+	for i := range resp.SubAccounts {
+		if resp.SubAccounts[i].CloudName == cloudName {
+			return &resp.SubAccounts[i], nil
+		}
+	}
+	return nil, esv1beta1.NoSecretError{}
+}
+
+func (c *client) accountPath(elem ...string) string {
+	path := "/v1_1/provisioning/accounts/" + url.PathEscape(c.accountID)
+	for _, e := range elem {
+		path += "/" + url.PathEscape(e)
+	}
+	return path
+}
+
+// parseKey splits a key into the cloud name and the optional access key name.
+func parseKey(key string) (string, string, error) {
+	cloudName, keyName, _ := strings.Cut(key, "/")
+	if cloudName == "" {
+		return "", "", errors.New(errEmptyKey)
+	}
+	return cloudName, keyName, nil
+}
+
+func validateProperty(property string) error {
+	switch property {
+	case "", propertyAPIKey, propertyAPISecret, propertyCloudName:
+		return nil
+	}
+	return fmt.Errorf(errInvalidProperty, property)
+}
+
+func (c *client) get(ctx context.Context, path string, target any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+path, http.NoBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(c.apiKey, c.apiSecret)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return esv1beta1.NoSecretError{}
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf(errUnexpectedStatus, resp.StatusCode, body)
+	}
+	if err := json.Unmarshal(body, target); err != nil {
+		return fmt.Errorf(errUnmarshalResponse, err)
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudinary
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	testAccountID = "a1b2c3d4-e5f6-7890-abcd-ef0123456789"
+	testAPIKey    = "123456789012345"
+	testAPISecret = "provisioning-secret"
+)
+
+// newTestClient serves the product environments demo-cloud, with a disabled and two enabled
+// access keys, and empty-cloud without access keys.
+func newTestClient(t *testing.T) *client {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1_1/provisioning/accounts/"+testAccountID+"/sub_accounts", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(subAccountsResponse{SubAccounts: []subAccount{
+			{ID: "sub-1", Name: "Demo", CloudName: "demo-cloud", Enabled: true},
+			{ID: "sub-2", Name: "Empty", CloudName: "empty-cloud", Enabled: true},
+		}})
+	})
+	mux.HandleFunc("GET /v1_1/provisioning/accounts/"+testAccountID+"/sub_accounts/{id}/access_keys", func(w http.ResponseWriter, r *http.Request) {
+		resp := accessKeysResponse{AccessKeys: []accessKey{}}
+		if r.PathValue("id") == "sub-1" {
+			resp.AccessKeys = []accessKey{
+				{Name: "old", APIKey: "111111111111111", APISecret: "old-secret"},
+				{Name: "main", APIKey: "222222222222222", APISecret: "main-secret", Enabled: true},
+				{Name: "uploads", APIKey: "333333333333333", APISecret: "uploads-secret", Enabled: true},
+			}
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		if !ok || user != testAPIKey || pass != testAPISecret {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":{"message":"Invalid credentials"}}`))
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return &client{
+		httpClient: srv.Client(),
+		url:        srv.URL,
+		accountID:  testAccountID,
+		apiKey:     testAPIKey,
+		apiSecret:  testAPISecret,
+	}
+}
+
+func TestGetSecret(t *testing.T) {
+	c := newTestClient(t)
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"api key": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "demo-cloud", Property: "apiKey"},
+			want: "222222222222222",
+		},
+		"api secret": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "demo-cloud", Property: "apiSecret"},
+			want: "main-secret",
+		},
+		"cloud name": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "demo-cloud", Property: "cloudName"},
+			want: "demo-cloud",
+		},
+		"named access key": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "demo-cloud/uploads", Property: "apiSecret"},
+			want: "uploads-secret",
+		},
+		"all credentials": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "demo-cloud"},
+			want: `{"apiKey":"222222222222222","apiSecret":"main-secret","cloudName":"demo-cloud"}`,
+		},
+		"disabled access key": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "demo-cloud/old", Property: "apiKey"},
+			wantErr: `no enabled access key "old" of product environment "demo-cloud"`,
+		},
+		"no access keys": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "empty-cloud", Property: "apiKey"},
+			wantErr: `no enabled access key of product environment "empty-cloud"`,
+		},
+		"invalid property": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "demo-cloud", Property: "password"},
+			wantErr: `invalid property "password"`,
+		},
+		"empty key": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: ""},
+			wantErr: "key must be the cloud name of a product environment",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+
+	_, err := c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "missing-cloud"})
+	assert.True(t, errors.Is(err, esv1beta1.NoSecretError{}))
+}
+
+func TestGetSecretMap(t *testing.T) {
+	c := newTestClient(t)
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "demo-cloud"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"apiKey":    []byte("222222222222222"),
+		"apiSecret": []byte("main-secret"),
+		"cloudName": []byte("demo-cloud"),
+	}, got)
+}
+
+func TestValidate(t *testing.T) {
+	c := newTestClient(t)
+	res, err := c.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+
+	c.apiSecret = "invalid"
+	res, err = c.Validate()
+	assert.ErrorContains(t, err, "unexpected status code from Cloudinary: 401")
+	assert.Equal(t, esv1beta1.ValidationResultError, res)
+}
@@ -0,0 +1,84 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudinary
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+func TestValidateStore(t *testing.T) {
+	namespace := "other"
+	auth := esv1beta1.CloudinaryAuth{
+		APIKey:    esmeta.SecretKeySelector{Name: "cloudinary", Key: "api-key"},
+		APISecret: esmeta.SecretKeySelector{Name: "cloudinary", Key: "api-secret"},
+	}
+	tests := map[string]struct {
+		cfg     esv1beta1.CloudinaryProvider
+		wantErr string
+	}{
+		"valid": {
+			cfg: esv1beta1.CloudinaryProvider{AccountID: "account", Auth: auth},
+		},
+		"invalid url": {
+			cfg:     esv1beta1.CloudinaryProvider{URL: "api.cloudinary.com", AccountID: "account", Auth: auth},
+			wantErr: `invalid url "api.cloudinary.com"`,
+		},
+		"missing account id": {
+			cfg:     esv1beta1.CloudinaryProvider{Auth: auth},
+			wantErr: "accountId must be set",
+		},
+		"secret in other namespace": {
+			cfg: esv1beta1.CloudinaryProvider{
+				AccountID: "account",
+				Auth: esv1beta1.CloudinaryAuth{
+					APIKey:    auth.APIKey,
+					APISecret: esmeta.SecretKeySelector{Name: "cloudinary", Key: "api-secret", Namespace: &namespace},
+				},
+			},
+			wantErr: "namespace should either be empty or match the namespace of the SecretStore",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := esv1beta1.SecretStore{
+				Spec: esv1beta1.SecretStoreSpec{
+					Provider: &esv1beta1.SecretStoreProvider{
+						Cloudinary: &tc.cfg,
+					},
+				},
+			}
+			p := &Provider{}
+			_, err := p.ValidateStore(&s)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestValidateRemoteRef(t *testing.T) {
+	p := &Provider{}
+	assert.NoError(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: "demo-cloud"}))
+	assert.NoError(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: "demo-cloud/main", Property: "apiSecret"}))
+	assert.Error(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: "demo-cloud", Property: "secret"}))
+	assert.Error(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: ""}))
+}
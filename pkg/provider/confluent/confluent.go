@@ -0,0 +1,311 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package confluent
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	// secret keys of an API key.
+	keyAPIKey     = "apiKey"
+	keyAPISecret  = "apiSecret"
+	keyResourceID = "resourceId"
+
+	// managedDescription marks the API keys created by a PushSecret.
+	managedDescription = "managed-by: external-secrets"
+
+	apiKeysPath = "/iam/v2/api-keys"
+
+	errInvalidKey          = "key %q must be the ID of an API key"
+	errInvalidProperty     = "property %q must be one of apiKey, apiSecret or resourceId"
+	errSecretNotReturned   = "Confluent Cloud only returns the secret of API key %q when it is created"
+	errUnexpectedStatus    = "unexpected status code from Confluent Cloud: %d: %s"
+	errUnmarshalResponse   = "unable to unmarshal Confluent Cloud response: %w"
+	errFindUnsupported     = "find is not supported by the Confluent provider"
+	errOwnerIDRequired     = "ownerID of the store is required to create API keys"
+	errRemoteKeyRequired   = "remoteKey must be the display name of the API key"
+	errResourceIDRequired  = "secretKey must name the key of the Secret holding the resource ID"
+	errKeyForOtherResource = "API key %q already exists for resource %q"
+)
+
+// client reads, creates and deletes API keys with the Confluent Cloud IAM API.
+// https://docs.confluent.io/cloud/current/api.html#tag/API-Keys-(iamv2)
+type client struct {
+	httpClient *http.Client
+	url        string
+	apiKey     string
+	apiSecret  string
+	ownerID    string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+type objectReference struct {
+	ID string `json:"id"`
+}
+
+type apiKeySpec struct {
+	// Secret is only returned when the key is created.
+	Secret      string           `json:"secret,omitempty"`
+	DisplayName string           `json:"display_name,omitempty"`
+	Description string           `json:"description,omitempty"`
+	Owner       *objectReference `json:"owner,omitempty"`
+	Resource    *objectReference `json:"resource,omitempty"`
+}
+
+type apiKey struct {
+	ID   string     `json:"id,omitempty"`
+	Spec apiKeySpec `json:"spec"`
+}
+
+type apiKeyList struct {
+	Data     []apiKey `json:"data"`
+	Metadata struct {
+		Next string `json:"next"`
+	} `json:"metadata"`
+}
+
+// resourceID returns the ID of the resource the key is scoped to, which is empty for a Cloud API key.
+func (k *apiKey) resourceID() string {
+	if k.Spec.Resource == nil {
+		return ""
+	}
+	return k.Spec.Resource.ID
+}
+
+func validateKeyID(key string) error {
+	if key == "" || strings.Contains(key, "/") {
+		return fmt.Errorf(errInvalidKey, key)
+	}
+	return nil
+}
+
+func validateProperty(property string) error {
+	switch property {
+	case "", keyAPIKey, keyAPISecret, keyResourceID:
+		return nil
+	}
+	return fmt.Errorf(errInvalidProperty, property)
+}
+
+// GetSecret returns a field of the API key, or all fields as JSON if no property is given.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	if err := validateProperty(ref.Property); err != nil {
+		return nil, err
+	}
+	data, err := c.GetSecretMap(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Property == "" {
+		values := make(map[string]string, len(data))
+		for k, v := range data {
+			values[k] = string(v)
+		}
+		return utils.JSONMarshal(values)
+	}
+	value, ok := data[ref.Property]
+	if !ok {
+		return nil, fmt.Errorf(errSecretNotReturned, ref.Key)
+	}
+	return value, nil
+}
+
+// GetSecretMap returns the ID and the resource of the API key, and its secret if Confluent Cloud returns it.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	if err := validateKeyID(ref.Key); err != nil {
+		return nil, err
+	}
+	var key apiKey
+	if err := c.do(ctx, http.MethodGet, apiKeysPath+"/"+url.PathEscape(ref.Key), nil, &key); err != nil {
+		return nil, err
+	}
+	data := map[string][]byte{
+		keyAPIKey:     []byte(key.ID),
+		keyResourceID: []byte(key.resourceID()),
+	}
+	if key.Spec.Secret != "" {
+		data[keyAPISecret] = []byte(key.Spec.Secret)
+	}
+	return data, nil
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindUnsupported)
+}
+
+// PushSecret creates an API key with the remote key as display name, owned by the owner of the store
+// and scoped to the resource ID in the Secret. An existing key for the same resource is left as is.
+func (c *client) PushSecret(ctx context.Context, secret *corev1.Secret, data esv1beta1.PushSecretData) error {
+	name := data.GetRemoteKey()
+	if name == "" {
+		return errors.New(errRemoteKeyRequired)
+	}
+	if data.GetSecretKey() == "" || len(secret.Data[data.GetSecretKey()]) == 0 {
+		return errors.New(errResourceIDRequired)
+	}
+	if c.ownerID == "" {
+		return errors.New(errOwnerIDRequired)
+	}
+	resourceID := string(secret.Data[data.GetSecretKey()])
+	keys, err := c.keysByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if key.resourceID() == resourceID {
+			return nil
+		}
+		if key.Spec.Description == managedDescription {
+			return fmt.Errorf(errKeyForOtherResource, name, key.resourceID())
+		}
+	}
+	return c.do(ctx, http.MethodPost, apiKeysPath, apiKey{
+		Spec: apiKeySpec{
+			DisplayName: name,
+			Description: managedDescription,
+			Owner:       &objectReference{ID: c.ownerID},
+			Resource:    &objectReference{ID: resourceID},
+		},
+	}, nil)
+}
+
+// DeleteSecret deletes the API keys with the display name which were created by a PushSecret.
+func (c *client) DeleteSecret(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) error {
+	keys, err := c.keysByName(ctx, remoteRef.GetRemoteKey())
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		if key.Spec.Description != managedDescription {
+			continue
+		}
+		err := c.do(ctx, http.MethodDelete, apiKeysPath+"/"+url.PathEscape(key.ID), nil, nil)
+		if err != nil && !errors.Is(err, esv1beta1.NoSecretError{}) {
+			return err
+		}
+	}
+	return nil
+}
+
+// SecretExists checks if an API key with the display name exists.
+func (c *client) SecretExists(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) (bool, error) {
+	keys, err := c.keysByName(ctx, remoteRef.GetRemoteKey())
+	if err != nil {
+		return false, err
+	}
+	return len(keys) > 0, nil
+}
+
+// Validate lists the API keys to check the credentials.
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	err := c.do(context.Background(), http.MethodGet, apiKeysPath+"?page_size=1", nil, nil)
+	if err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+// keysByName returns the API keys with the display name, of the owner of the store if it is set.
+// The API can not filter by display name, so all pages are read.
+func (c *client) keysByName(ctx context.Context, name string) ([]apiKey, error) {
+	query := url.Values{"page_size": {"100"}}
+	if c.ownerID != "" {
+		query.Set("spec.owner", c.ownerID)
+	}
+	path := apiKeysPath + "?" + query.Encode()
+	var keys []apiKey
+	for path != "" {
+		var list apiKeyList
+		if err := c.do(ctx, http.MethodGet, path, nil, &list); err != nil {
+			return nil, err
+		}
+		for _, key := range list.Data {
+			if key.Spec.DisplayName == name {
+				keys = append(keys, key)
+			}
+		}
+		path = ""
+		if list.Metadata.Next != "" {
+			next, err := url.Parse(list.Metadata.Next)
+			if err != nil {
+				return nil, fmt.Errorf(errUnmarshalResponse, err)
+			}
+			path = next.RequestURI()
+		}
+	}
+	return keys, nil
+}
+
+func (c *client) do(ctx context.Context, method, path string, body, target any) error {
+	var reqBody io.Reader = http.NoBody
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(c.apiKey, c.apiSecret)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return esv1beta1.NoSecretError{}
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf(errUnexpectedStatus, resp.StatusCode, respBody)
+	}
+	if target == nil {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, target); err != nil {
+		return fmt.Errorf(errUnmarshalResponse, err)
+	}
+	return nil
+}
@@ -0,0 +1,252 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package confluent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1alpha1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1alpha1"
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	testAPIKey    = "CLOUDKEY"
+	testAPISecret = "cloud-secret"
+	testOwner     = "sa-123456"
+	testCluster   = "lkc-abc123"
+)
+
+// fakeConfluent serves the API keys, listing one key per page to exercise the pagination.
+type fakeConfluent struct {
+	mu      sync.Mutex
+	keys    map[string]apiKey
+	created int
+}
+
+func newTestClient(t *testing.T) (*client, *fakeConfluent) {
+	f := &fakeConfluent{keys: map[string]apiKey{
+		"ORDERSKEY": {ID: "ORDERSKEY", Spec: apiKeySpec{
+			DisplayName: "orders", Description: managedDescription,
+			Owner: &objectReference{ID: testOwner}, Resource: &objectReference{ID: testCluster},
+		}},
+		"MANUALKEY": {ID: "MANUALKEY", Spec: apiKeySpec{
+			DisplayName: "manual", Owner: &objectReference{ID: testOwner}, Resource: &objectReference{ID: testCluster},
+		}},
+		"OTHERKEY": {ID: "OTHERKEY", Spec: apiKeySpec{
+			DisplayName: "orders", Owner: &objectReference{ID: "u-other"},
+		}},
+	}}
+	srv := httptest.NewServer(f.handler())
+	t.Cleanup(srv.Close)
+	return &client{httpClient: srv.Client(), url: srv.URL, apiKey: testAPIKey, apiSecret: testAPISecret, ownerID: testOwner}, f
+}
+
+func (f *fakeConfluent) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+apiKeysPath, func(w http.ResponseWriter, r *http.Request) {
+		var list apiKeyList
+		ids := make([]string, 0, len(f.keys))
+		for id, key := range f.keys {
+			if owner := r.URL.Query().Get("spec.owner"); owner == "" || key.Spec.Owner.ID == owner {
+				ids = append(ids, id)
+			}
+		}
+		sort.Strings(ids)
+		page, _ := strconv.Atoi(r.URL.Query().Get("page_token"))
+		if page < len(ids) {
+			key := f.keys[ids[page]]
+			key.Spec.Secret = ""
+			list.Data = append(list.Data, key)
+		}
+		if page+1 < len(ids) {
+			q := r.URL.Query()
+			q.Set("page_token", strconv.Itoa(page+1))
+			list.Metadata.Next = "https://api.confluent.cloud" + apiKeysPath + "?" + q.Encode()
+		}
+		_ = json.NewEncoder(w).Encode(list)
+	})
+	mux.HandleFunc("POST "+apiKeysPath, func(w http.ResponseWriter, r *http.Request) {
+		var key apiKey
+		if err := json.NewDecoder(r.Body).Decode(&key); err != nil || key.Spec.Owner == nil || key.Spec.Resource == nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.created++
+		key.ID = fmt.Sprintf("NEWKEY%d", f.created)
+		key.Spec.Secret = "new-secret"
+		f.keys[key.ID] = key
+		w.WriteHeader(http.StatusAccepted)
+		_ = json.NewEncoder(w).Encode(key)
+	})
+	mux.HandleFunc(apiKeysPath+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		key, ok := f.keys[r.PathValue("id")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[{"status":"404","detail":"not found"}]}`))
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			_ = json.NewEncoder(w).Encode(key)
+		case http.MethodDelete:
+			delete(f.keys, key.ID)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != testAPIKey || pass != testAPISecret {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"errors":[{"status":"401","detail":"Unauthorized"}]}`))
+			return
+		}
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func TestGetSecret(t *testing.T) {
+	c, _ := newTestClient(t)
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"all fields": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "ORDERSKEY"},
+			want: `{"apiKey":"ORDERSKEY","resourceId":"` + testCluster + `"}`,
+		},
+		"resource": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "ORDERSKEY", Property: keyResourceID},
+			want: testCluster,
+		},
+		"secret of existing key": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "ORDERSKEY", Property: keyAPISecret},
+			wantErr: `Confluent Cloud only returns the secret of API key "ORDERSKEY" when it is created`,
+		},
+		"missing key": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "MISSING"},
+			wantErr: "Secret does not exist",
+		},
+		"invalid property": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "ORDERSKEY", Property: "owner"},
+			wantErr: `property "owner" must be one of apiKey, apiSecret or resourceId`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestGetSecretMap(t *testing.T) {
+	c, fake := newTestClient(t)
+	key := fake.keys["ORDERSKEY"]
+	key.Spec.Secret = "orders-secret"
+	fake.keys["ORDERSKEY"] = key
+
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "ORDERSKEY"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		keyAPIKey:     []byte("ORDERSKEY"),
+		keyAPISecret:  []byte("orders-secret"),
+		keyResourceID: []byte(testCluster),
+	}, got)
+}
+
+func pushData(secretKey, remoteKey string) esv1alpha1.PushSecretData {
+	return esv1alpha1.PushSecretData{
+		Match: esv1alpha1.PushSecretMatch{
+			SecretKey: secretKey,
+			RemoteRef: esv1alpha1.PushSecretRemoteRef{RemoteKey: remoteKey},
+		},
+	}
+}
+
+func TestPushSecret(t *testing.T) {
+	c, fake := newTestClient(t)
+	ctx := context.Background()
+	secret := &corev1.Secret{Data: map[string][]byte{"cluster": []byte(testCluster), "other": []byte("lkc-other")}}
+
+	// the key already exists for the cluster
+	require.NoError(t, c.PushSecret(ctx, secret, pushData("cluster", "orders")))
+	assert.Zero(t, fake.created)
+
+	require.NoError(t, c.PushSecret(ctx, secret, pushData("cluster", "payments")))
+	require.Contains(t, fake.keys, "NEWKEY1")
+	created := fake.keys["NEWKEY1"].Spec
+	assert.Equal(t, "payments", created.DisplayName)
+	assert.Equal(t, managedDescription, created.Description)
+	assert.Equal(t, testOwner, created.Owner.ID)
+	assert.Equal(t, testCluster, created.Resource.ID)
+
+	assert.EqualError(t, c.PushSecret(ctx, secret, pushData("other", "orders")),
+		`API key "orders" already exists for resource "`+testCluster+`"`)
+	assert.EqualError(t, c.PushSecret(ctx, secret, pushData("", "orders")), errResourceIDRequired)
+
+	c.ownerID = ""
+	assert.EqualError(t, c.PushSecret(ctx, secret, pushData("cluster", "billing")), errOwnerIDRequired)
+}
+
+func TestSecretExistsAndDelete(t *testing.T) {
+	c, fake := newTestClient(t)
+	ctx := context.Background()
+
+	exists, err := c.SecretExists(ctx, esv1alpha1.PushSecretRemoteRef{RemoteKey: "orders"})
+	require.NoError(t, err)
+	assert.True(t, exists)
+	require.NoError(t, c.DeleteSecret(ctx, esv1alpha1.PushSecretRemoteRef{RemoteKey: "orders"}))
+	assert.NotContains(t, fake.keys, "ORDERSKEY")
+	// keys of other owners are not listed
+	assert.Contains(t, fake.keys, "OTHERKEY")
+	exists, err = c.SecretExists(ctx, esv1alpha1.PushSecretRemoteRef{RemoteKey: "orders"})
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// keys which were not created by a PushSecret are kept
+	require.NoError(t, c.DeleteSecret(ctx, esv1alpha1.PushSecretRemoteRef{RemoteKey: "manual"}))
+	assert.Contains(t, fake.keys, "MANUALKEY")
+}
+
+func TestValidate(t *testing.T) {
+	c, _ := newTestClient(t)
+	res, err := c.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+
+	c.apiSecret = "invalid"
+	res, err = c.Validate()
+	assert.ErrorContains(t, err, "unexpected status code from Confluent Cloud: 401")
+	assert.Equal(t, esv1beta1.ValidationResultError, res)
+}
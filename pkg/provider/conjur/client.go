@@ -60,9 +60,16 @@ type Client struct {
 func (c *Client) GetConjurClient(ctx context.Context) (SecretsClient, error) {
 	// if the client is initialized already, return it
 	if c.client != nil {
+		if cloud, ok := c.client.(*cloudClient); ok {
+			return cloud.withContext(ctx), nil
+		}
 		return c.client, nil
 	}
 
+	if cloud := util.GetConjurCloudProvider(c.store); cloud != nil {
+		return c.getConjurCloudClient(ctx, cloud)
+	}
+
 	prov, err := util.GetConjurProvider(c.store)
 	if err != nil {
 		return nil, err
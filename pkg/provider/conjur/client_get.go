@@ -179,7 +179,7 @@ func (c *Client) listSecrets(ctx context.Context, filterFunc resourceFilterFunc)
 		}
 	}
 
-	filteredResources, err := c.client.RetrieveBatchSecrets(filteredResourceNames)
+	filteredResources, err := conjurClient.RetrieveBatchSecrets(filteredResourceNames)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,186 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conjur
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/conjur-api-go/conjurapi/response"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/provider/conjur/util"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+const (
+	cloudMaxRetries = 3
+
+	errCloudUnauthorized = "conjur cloud rejected the access token, check that the authenticator is enabled and allows this workload: %w"
+	errCloudForbidden    = "the workload is not permitted to read this secret in conjur cloud: %w"
+	errCloudRateLimited  = "conjur cloud rate limit exceeded: %w"
+	errCloudRetryStopped = "stopped retrying the rate limited call: %w: %w"
+	errBadOIDCToken      = "could not get Auth.Oidc.IDTokenRef: %w"
+)
+
+// getConjurCloudClient authenticates against the tenant of the Conjur Cloud store.
+func (c *Client) getConjurCloudClient(ctx context.Context, cloud *esv1beta1.ConjurCloudProvider) (SecretsClient, error) {
+	config := conjurapi.Config{
+		ApplianceURL: util.ConjurCloudURL(cloud.Subdomain),
+		Account:      util.ConjurCloudAccount,
+	}
+
+	var conjur SecretsClient
+	var err error
+	switch {
+	case cloud.Auth.Jwt != nil:
+		var prov *esv1beta1.ConjurProvider
+		prov, err = util.GetConjurProvider(c.store)
+		if err != nil {
+			return nil, err
+		}
+		conjur, err = c.newClientFromJwt(ctx, config, prov.Auth.Jwt)
+	case cloud.Auth.Oidc != nil:
+		conjur, err = c.newClientFromOidc(ctx, config, cloud.Auth.Oidc)
+	default:
+		// Should not happen because validate func should catch this
+		return nil, fmt.Errorf("no authentication method provided")
+	}
+	if err != nil {
+		return nil, fmt.Errorf(errConjurClient, mapCloudError(err))
+	}
+
+	cloudConjur := &cloudClient{
+		client:     conjur,
+		retryDelay: time.Second,
+	}
+	c.client = cloudConjur
+	return cloudConjur.withContext(ctx), nil
+}
+
+// newClientFromOidc exchanges the ID token of the store for a Conjur Cloud access token.
+func (c *Client) newClientFromOidc(ctx context.Context, config conjurapi.Config, oidcAuth *esv1beta1.ConjurCloudOIDC) (SecretsClient, error) {
+	idToken, err := resolvers.SecretKeyRef(ctx, c.kube, c.StoreKind, c.namespace, &oidcAuth.IDTokenRef)
+	if err != nil {
+		return nil, fmt.Errorf(errBadOIDCToken, err)
+	}
+	serviceID := oidcAuth.ServiceID
+	if serviceID == "" {
+		serviceID = util.ConjurCloudOIDCServiceID
+	}
+	return c.clientAPI.NewClientFromOIDC(config, idToken, serviceID)
+}
+
+// cloudClient retries the calls rejected by the rate limits of Conjur Cloud
+// and explains its error codes.
+type cloudClient struct {
+	client     SecretsClient
+	retryDelay time.Duration
+	// ctx stops the retries, the SecretsClient methods do not take one
+	ctx context.Context
+}
+
+// withContext returns a copy of the client whose retries stop when ctx is done.
+func (c *cloudClient) withContext(ctx context.Context) *cloudClient {
+	cc := *c
+	cc.ctx = ctx
+	return &cc
+}
+
+func (c *cloudClient) RetrieveSecret(secret string) ([]byte, error) {
+	var result []byte
+	err := c.retry(func() error {
+		var err error
+		result, err = c.client.RetrieveSecret(secret)
+		return err
+	})
+	return result, err
+}
+
+func (c *cloudClient) RetrieveBatchSecrets(variableIDs []string) (map[string][]byte, error) {
+	var result map[string][]byte
+	err := c.retry(func() error {
+		var err error
+		result, err = c.client.RetrieveBatchSecrets(variableIDs)
+		return err
+	})
+	return result, err
+}
+
+func (c *cloudClient) Resources(filter *conjurapi.ResourceFilter) ([]map[string]interface{}, error) {
+	var result []map[string]interface{}
+	err := c.retry(func() error {
+		var err error
+		result, err = c.client.Resources(filter)
+		return err
+	})
+	return result, err
+}
+
+// retry calls fn until it is not rate limited anymore, doubling the delay between attempts.
+// It gives up early when the context of the client is done.
+func (c *cloudClient) retry(fn func() error) error {
+	ctx := c.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	delay := c.retryDelay
+	var err error
+	for attempt := 0; ; attempt++ {
+		err = fn()
+		if attempt == cloudMaxRetries || !isRateLimited(err) {
+			break
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf(errCloudRetryStopped, ctx.Err(), mapCloudError(err))
+		case <-timer.C:
+		}
+		delay *= 2
+	}
+	return mapCloudError(err)
+}
+
+func isRateLimited(err error) bool {
+	var conjurErr *response.ConjurError
+	if !errors.As(err, &conjurErr) {
+		return false
+	}
+	return conjurErr.Code == http.StatusTooManyRequests || conjurErr.Code == http.StatusServiceUnavailable
+}
+
+func mapCloudError(err error) error {
+	var conjurErr *response.ConjurError
+	if !errors.As(err, &conjurErr) {
+		return err
+	}
+	switch conjurErr.Code {
+	case http.StatusUnauthorized:
+		return fmt.Errorf(errCloudUnauthorized, err)
+	case http.StatusForbidden:
+		return fmt.Errorf(errCloudForbidden, err)
+	case http.StatusNotFound:
+		return esv1beta1.NoSecretError{}
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return fmt.Errorf(errCloudRateLimited, err)
+	}
+	return err
+}
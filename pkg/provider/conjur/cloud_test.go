@@ -0,0 +1,135 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conjur
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cyberark/conjur-api-go/conjurapi"
+	"github.com/cyberark/conjur-api-go/conjurapi/response"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+// rateLimitedClient fails with the given status code until it has been called failures times.
+type rateLimitedClient struct {
+	code     int
+	failures int
+	calls    int
+}
+
+func (c *rateLimitedClient) RetrieveSecret(_ string) ([]byte, error) {
+	c.calls++
+	if c.calls <= c.failures {
+		return nil, &response.ConjurError{Code: c.code}
+	}
+	return []byte("secret"), nil
+}
+
+func (c *rateLimitedClient) RetrieveBatchSecrets(_ []string) (map[string][]byte, error) {
+	return nil, nil
+}
+
+func (c *rateLimitedClient) Resources(_ *conjurapi.ResourceFilter) ([]map[string]interface{}, error) {
+	return nil, nil
+}
+
+func TestCloudClientRetrieveSecret(t *testing.T) {
+	cases := map[string]struct {
+		code      int
+		failures  int
+		wantCalls int
+		wantErr   func(error) bool
+	}{
+		"RetriesUntilNotRateLimited": {
+			code:      http.StatusTooManyRequests,
+			failures:  2,
+			wantCalls: 3,
+		},
+		"GivesUpAfterMaxRetries": {
+			code:      http.StatusTooManyRequests,
+			failures:  10,
+			wantCalls: cloudMaxRetries + 1,
+			wantErr: func(err error) bool {
+				var conjurErr *response.ConjurError
+				return errors.As(err, &conjurErr) && conjurErr.Code == http.StatusTooManyRequests
+			},
+		},
+		"NotFoundIsNoSecretError": {
+			code:      http.StatusNotFound,
+			failures:  1,
+			wantCalls: 1,
+			wantErr: func(err error) bool {
+				return errors.Is(err, esv1beta1.NoSecretError{})
+			},
+		},
+		"ForbiddenIsNotRetried": {
+			code:      http.StatusForbidden,
+			failures:  1,
+			wantCalls: 1,
+			wantErr: func(err error) bool {
+				var conjurErr *response.ConjurError
+				return errors.As(err, &conjurErr) && conjurErr.Code == http.StatusForbidden
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock := &rateLimitedClient{code: tc.code, failures: tc.failures}
+			c := &cloudClient{client: mock, retryDelay: time.Millisecond}
+			value, err := c.RetrieveSecret("path/to/secret")
+			if mock.calls != tc.wantCalls {
+				t.Errorf("want %d calls, got %d", tc.wantCalls, mock.calls)
+			}
+			if tc.wantErr == nil {
+				if err != nil || string(value) != "secret" {
+					t.Errorf("want secret, got %q, %v", value, err)
+				}
+				return
+			}
+			if !tc.wantErr(err) {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestCloudClientRetryStopsWhenCanceled(t *testing.T) {
+	mock := &rateLimitedClient{code: http.StatusTooManyRequests, failures: 10}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	c := (&cloudClient{client: mock, retryDelay: time.Hour}).withContext(ctx)
+
+	start := time.Now()
+	_, err := c.RetrieveSecret("path/to/secret")
+	if time.Since(start) > time.Second {
+		t.Errorf("retry did not stop when the context was canceled")
+	}
+	if mock.calls != 1 {
+		t.Errorf("want 1 call, got %d", mock.calls)
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("want context.Canceled, got %v", err)
+	}
+	var conjurErr *response.ConjurError
+	if !errors.As(err, &conjurErr) || conjurErr.Code != http.StatusTooManyRequests {
+		t.Errorf("want the rate limit error, got %v", err)
+	}
+}
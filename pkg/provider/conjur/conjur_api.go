@@ -15,7 +15,6 @@ limitations under the License.
 package conjur
 
 import (
-	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
@@ -37,6 +36,7 @@ type SecretsClient interface {
 type SecretsClientFactory interface {
 	NewClientFromKey(config conjurapi.Config, loginPair authn.LoginPair) (SecretsClient, error)
 	NewClientFromJWT(config conjurapi.Config, jwtToken string, jwtServiceID, jwtHostID string) (SecretsClient, error)
+	NewClientFromOIDC(config conjurapi.Config, idToken string, oidcServiceID string) (SecretsClient, error)
 }
 
 // ClientAPIImpl is an implementation of the ClientAPI interface.
@@ -50,8 +50,26 @@ func (c *ClientAPIImpl) NewClientFromKey(config conjurapi.Config, loginPair auth
 // cannot use the built-in function "conjurapi.NewClientFromJwt" because it requires environment variables
 // see: https://github.com/cyberark/conjur-api-go/blob/b698692392a38e5d38b8440f32ab74206544848a/conjurapi/client.go#L130
 func (c *ClientAPIImpl) NewClientFromJWT(config conjurapi.Config, jwtToken, jwtServiceID, jwtHostID string) (SecretsClient, error) {
-	jwtTokenString := fmt.Sprintf("jwt=%s", jwtToken)
+	var authnJwtURL string
+	// If a hostID is provided, it must be included in the URL
+	if jwtHostID != "" {
+		authnJwtURL = strings.Join([]string{config.ApplianceURL, "authn-jwt", jwtServiceID, config.Account, url.PathEscape(jwtHostID), "authenticate"}, "/")
+	} else {
+		authnJwtURL = strings.Join([]string{config.ApplianceURL, "authn-jwt", jwtServiceID, config.Account, "authenticate"}, "/")
+	}
+
+	return newClientFromAuthenticator(config, authnJwtURL, url.Values{"jwt": {jwtToken}})
+}
+
+// NewClientFromOIDC creates a new Conjur client from an ID token, using the authn-oidc authenticator.
+func (c *ClientAPIImpl) NewClientFromOIDC(config conjurapi.Config, idToken, oidcServiceID string) (SecretsClient, error) {
+	authnOidcURL := strings.Join([]string{config.ApplianceURL, "authn-oidc", oidcServiceID, config.Account, "authenticate"}, "/")
 
+	return newClientFromAuthenticator(config, authnOidcURL, url.Values{"id_token": {idToken}})
+}
+
+// newClientFromAuthenticator posts the credentials to the authenticator and creates a client from the returned access token.
+func newClientFromAuthenticator(config conjurapi.Config, authnURL string, credentials url.Values) (SecretsClient, error) {
 	var httpClient *http.Client
 	if config.IsHttps() {
 		cert, err := config.ReadSSLCert()
@@ -66,15 +84,7 @@ func (c *ClientAPIImpl) NewClientFromJWT(config conjurapi.Config, jwtToken, jwtS
 		httpClient = &http.Client{Timeout: time.Second * 10}
 	}
 
-	var authnJwtURL string
-	// If a hostID is provided, it must be included in the URL
-	if jwtHostID != "" {
-		authnJwtURL = strings.Join([]string{config.ApplianceURL, "authn-jwt", jwtServiceID, config.Account, url.PathEscape(jwtHostID), "authenticate"}, "/")
-	} else {
-		authnJwtURL = strings.Join([]string{config.ApplianceURL, "authn-jwt", jwtServiceID, config.Account, "authenticate"}, "/")
-	}
-
-	req, err := http.NewRequest("POST", authnJwtURL, strings.NewReader(jwtTokenString))
+	req, err := http.NewRequest("POST", authnURL, strings.NewReader(credentials.Encode()))
 	if err != nil {
 		return nil, err
 	}
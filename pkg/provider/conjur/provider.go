@@ -68,4 +68,9 @@ func init() {
 	}, &esv1beta1.SecretStoreProvider{
 		Conjur: &esv1beta1.ConjurProvider{},
 	})
+	esv1beta1.Register(&Provider{
+		NewConjurProvider: newConjurProvider,
+	}, &esv1beta1.SecretStoreProvider{
+		ConjurCloud: &esv1beta1.ConjurCloudProvider{},
+	})
 }
@@ -184,6 +184,57 @@ func TestGetSecret(t *testing.T) {
 				value: "secret",
 			},
 		},
+		"CloudJwtReadSecretSuccess": {
+			reason: "Should read a secret successfully using a Conjur Cloud JWT auth secret store.",
+			args: args{
+				store: makeCloudJWTSecretStore("acme", svcAccount),
+				kube: clientfake.NewClientBuilder().
+					WithObjects().Build(),
+				namespace:  "default",
+				secretPath: "path/to/secret",
+				corev1:     utilfake.NewCreateTokenMock().WithToken(createFakeJwtToken(true)),
+			},
+			want: want{
+				err:   nil,
+				value: "secret",
+			},
+		},
+		"CloudOidcReadSecretSuccess": {
+			reason: "Should read a secret successfully using a Conjur Cloud OIDC auth secret store.",
+			args: args{
+				store: makeCloudOIDCSecretStore("acme", jwtSecretName),
+				kube: clientfake.NewClientBuilder().
+					WithObjects(&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      jwtSecretName,
+							Namespace: "default",
+						},
+						Data: map[string][]byte{
+							"token": []byte(createFakeJwtToken(true)),
+						},
+					}).Build(),
+				namespace:  "default",
+				secretPath: "path/to/secret",
+			},
+			want: want{
+				err:   nil,
+				value: "secret",
+			},
+		},
+		"CloudOidcMissingIDToken": {
+			reason: "Should fail when the ID token secret of a Conjur Cloud OIDC auth secret store is missing.",
+			args: args{
+				store: makeCloudOIDCSecretStore("acme", jwtSecretName),
+				kube: clientfake.NewClientBuilder().
+					WithObjects().Build(),
+				namespace:  "default",
+				secretPath: "path/to/secret",
+			},
+			want: want{
+				err:   fmt.Errorf(errConjurClient, fmt.Errorf(errBadOIDCToken, errors.New(`cannot get Kubernetes secret "jwt-secret": secrets "jwt-secret" not found`))),
+				value: "",
+			},
+		},
 	}
 
 	runTest := func(t *testing.T, _ string, tc testCase) {
@@ -595,6 +646,47 @@ func makeJWTSecretStore(svcURL, serviceAccountName, secretName, jwtServiceID, jw
 	return store
 }
 
+func makeCloudJWTSecretStore(subdomain, serviceAccountName string) *esv1beta1.SecretStore {
+	return &esv1beta1.SecretStore{
+		Spec: esv1beta1.SecretStoreSpec{
+			Provider: &esv1beta1.SecretStoreProvider{
+				ConjurCloud: &esv1beta1.ConjurCloudProvider{
+					Subdomain: subdomain,
+					Auth: esv1beta1.ConjurCloudAuth{
+						Jwt: &esv1beta1.ConjurCloudJWT{
+							ServiceID: jwtAuthnService,
+							ServiceAccountRef: &esmeta.ServiceAccountSelector{
+								Name:      serviceAccountName,
+								Audiences: []string{"conjur"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func makeCloudOIDCSecretStore(subdomain, idTokenSecretName string) *esv1beta1.SecretStore {
+	return &esv1beta1.SecretStore{
+		Spec: esv1beta1.SecretStoreSpec{
+			Provider: &esv1beta1.SecretStoreProvider{
+				ConjurCloud: &esv1beta1.ConjurCloudProvider{
+					Subdomain: subdomain,
+					Auth: esv1beta1.ConjurCloudAuth{
+						Oidc: &esv1beta1.ConjurCloudOIDC{
+							IDTokenRef: esmeta.SecretKeySelector{
+								Name: idTokenSecretName,
+								Key:  "token",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func makeStoreWithCA(caSource, caData string) *esv1beta1.SecretStore {
 	store := makeJWTSecretStore(svcURL, "conjur", "", jwtAuthnService, "", "myconjuraccount")
 	if caSource == "secret" {
@@ -700,6 +792,10 @@ func (c *ConjurMockAPIClient) NewClientFromJWT(_ conjurapi.Config, _, _, _ strin
 	return &fake.ConjurMockClient{}, nil
 }
 
+func (c *ConjurMockAPIClient) NewClientFromOIDC(_ conjurapi.Config, _, _ string) (SecretsClient, error) {
+	return &fake.ConjurMockClient{}, nil
+}
+
 // EquateErrors returns true if the supplied errors are of the same type and
 // produce identical strings. This mirrors the error comparison behavior of
 // https://github.com/go-test/deep, which most Crossplane tests targeted before
@@ -25,6 +25,12 @@ const (
 	errMissingStoreSpec = "store is missing spec"
 	errMissingProvider  = "storeSpec is missing provider"
 	errInvalidProvider  = "invalid provider spec. Missing Conjur field in store %s"
+
+	// ConjurCloudAccount is the account of every Conjur Cloud tenant.
+	ConjurCloudAccount = "conjur"
+	// ConjurCloudOIDCServiceID is the authn-oidc webservice trusting CyberArk Identity.
+	ConjurCloudOIDCServiceID = "cyberark"
+	conjurCloudURLFormat     = "https://%s.secretsmgr.cyberark.cloud/api"
 )
 
 // GetConjurProvider does the necessary nil checks on the generic store
@@ -41,6 +47,10 @@ func GetConjurProvider(store esv1beta1.GenericStore) (*esv1beta1.ConjurProvider,
 		return nil, fmt.Errorf(errMissingProvider)
 	}
 
+	if spec.Provider.ConjurCloud != nil {
+		return conjurCloudToConjur(spec.Provider.ConjurCloud), nil
+	}
+
 	if spec.Provider.Conjur == nil {
 		return nil, fmt.Errorf(errMissingProvider)
 	}
@@ -51,3 +61,34 @@ func GetConjurProvider(store esv1beta1.GenericStore) (*esv1beta1.ConjurProvider,
 	}
 	return prov, nil
 }
+
+// GetConjurCloudProvider returns the Conjur Cloud provider of the store, or nil if it uses another provider.
+func GetConjurCloudProvider(store esv1beta1.GenericStore) *esv1beta1.ConjurCloudProvider {
+	if store == nil || store.GetSpec() == nil || store.GetSpec().Provider == nil {
+		return nil
+	}
+	return store.GetSpec().Provider.ConjurCloud
+}
+
+// ConjurCloudURL returns the API endpoint of the Conjur Cloud tenant.
+func ConjurCloudURL(subdomain string) string {
+	return fmt.Sprintf(conjurCloudURLFormat, subdomain)
+}
+
+// conjurCloudToConjur maps a Conjur Cloud configuration to the equivalent Conjur one,
+// OIDC authentication is only available with Conjur Cloud and has no equivalent.
+func conjurCloudToConjur(cloud *esv1beta1.ConjurCloudProvider) *esv1beta1.ConjurProvider {
+	prov := &esv1beta1.ConjurProvider{
+		URL: ConjurCloudURL(cloud.Subdomain),
+	}
+	if jwt := cloud.Auth.Jwt; jwt != nil {
+		prov.Auth.Jwt = &esv1beta1.ConjurJWT{
+			Account:           ConjurCloudAccount,
+			ServiceID:         jwt.ServiceID,
+			HostID:            jwt.HostID,
+			SecretRef:         jwt.SecretRef,
+			ServiceAccountRef: jwt.ServiceAccountRef,
+		}
+	}
+	return prov
+}
@@ -17,6 +17,7 @@ package conjur
 
 import (
 	"fmt"
+	"regexp"
 
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
@@ -25,8 +26,14 @@ import (
 	"github.com/external-secrets/external-secrets/pkg/utils"
 )
 
+var subdomainRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
 // ValidateStore validates the store.
 func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnings, error) {
+	if cloud := util.GetConjurCloudProvider(store); cloud != nil {
+		return nil, validateCloudStore(store, cloud)
+	}
+
 	prov, err := util.GetConjurProvider(store)
 	if err != nil {
 		return nil, err
@@ -57,6 +64,34 @@ func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnin
 	return nil, nil
 }
 
+func validateCloudStore(store esv1beta1.GenericStore, cloud *esv1beta1.ConjurCloudProvider) error {
+	if cloud.Subdomain == "" {
+		return fmt.Errorf("conjur cloud subdomain cannot be empty")
+	}
+	if !subdomainRegex.MatchString(cloud.Subdomain) {
+		return fmt.Errorf("invalid conjur cloud subdomain %q", cloud.Subdomain)
+	}
+	if cloud.Auth.Jwt != nil {
+		// the Conjur Cloud account is implicit
+		prov, err := util.GetConjurProvider(store)
+		if err != nil {
+			return err
+		}
+		if err := validateJWTStore(store, *prov.Auth.Jwt); err != nil {
+			return err
+		}
+	}
+	if cloud.Auth.Oidc != nil {
+		if err := utils.ValidateReferentSecretSelector(store, cloud.Auth.Oidc.IDTokenRef); err != nil {
+			return fmt.Errorf("invalid Auth.Oidc.IDTokenRef: %w", err)
+		}
+	}
+	if cloud.Auth.Jwt == nil && cloud.Auth.Oidc == nil {
+		return fmt.Errorf("missing Auth.* configuration")
+	}
+	return nil
+}
+
 func validateAPIKeyStore(store esv1beta1.GenericStore, auth esv1beta1.ConjurAPIKey) error {
 	if auth.Account == "" {
 		return fmt.Errorf("missing Auth.ApiKey.Account")
@@ -78,6 +78,23 @@ func TestValidateStore(t *testing.T) {
 			store: makeNoAuthSecretStore(svcURL),
 			err:   fmt.Errorf("missing Auth.* configuration"),
 		},
+
+		{
+			store: makeCloudJWTSecretStore("acme", "conjur"),
+			err:   nil,
+		},
+		{
+			store: makeCloudOIDCSecretStore("acme", jwtSecretName),
+			err:   nil,
+		},
+		{
+			store: makeCloudJWTSecretStore("", "conjur"),
+			err:   fmt.Errorf("conjur cloud subdomain cannot be empty"),
+		},
+		{
+			store: makeCloudJWTSecretStore("acme.example.com/", "conjur"),
+			err:   fmt.Errorf(`invalid conjur cloud subdomain "acme.example.com/"`),
+		},
 	}
 	p := Provider{}
 	for _, tc := range testCases {
@@ -0,0 +1,245 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consul
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/find"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	errPropertyNotFound   = "property %q not found in key %q"
+	errPushWholeSecret    = "pushing the whole secret requires a remote property to be empty"
+	errKeyIsNotAnObject   = "value of key %q is not a JSON object"
+	errFindTagsNotAllowed = "find by tags is not supported by consul"
+)
+
+type client struct {
+	api    *api.Client
+	prefix string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	pair, err := c.get(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	value := pair.Value
+	if ref.Property == "" {
+		return value, nil
+	}
+	res := gjson.GetBytes(value, ref.Property)
+	if !res.Exists() {
+		return nil, fmt.Errorf(errPropertyNotFound, ref.Property, ref.Key)
+	}
+	return []byte(res.String()), nil
+}
+
+// GetSecretMap treats the remote key as a prefix and returns every key below it,
+// relative to the prefix.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	prefix := strings.TrimSuffix(ref.Key, "/") + "/"
+	pairs, err := c.list(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	fullPrefix := c.buildKey(prefix)
+	secretData := make(map[string][]byte, len(pairs))
+	for _, p := range pairs {
+		name := strings.TrimPrefix(p.Key, fullPrefix)
+		// folders are represented as keys with a trailing slash
+		if name == "" || strings.HasSuffix(name, "/") {
+			continue
+		}
+		secretData[name] = p.Value
+	}
+	return secretData, nil
+}
+
+func (c *client) GetAllSecrets(ctx context.Context, ref esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	if len(ref.Tags) > 0 {
+		return nil, errors.New(errFindTagsNotAllowed)
+	}
+	path := ""
+	if ref.Path != nil {
+		path = *ref.Path
+	}
+	pairs, err := c.list(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	var matcher *find.Matcher
+	if ref.Name != nil {
+		matcher, err = find.New(*ref.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	basePrefix := c.buildKey("")
+	secretData := make(map[string][]byte)
+	for _, p := range pairs {
+		name := strings.TrimPrefix(p.Key, basePrefix)
+		if strings.HasSuffix(name, "/") {
+			continue
+		}
+		if matcher != nil && !matcher.MatchName(name) {
+			continue
+		}
+		secretData[name] = p.Value
+	}
+	return utils.ConvertKeys(ref.ConversionStrategy, secretData)
+}
+
+func (c *client) PushSecret(ctx context.Context, secret *corev1.Secret, data esv1beta1.PushSecretData) error {
+	var value []byte
+	if data.GetSecretKey() == "" {
+		if data.GetProperty() != "" {
+			return errors.New(errPushWholeSecret)
+		}
+		secretStringVal := make(map[string]string, len(secret.Data))
+		for k, v := range secret.Data {
+			secretStringVal[k] = string(v)
+		}
+		var err error
+		value, err = utils.JSONMarshal(secretStringVal)
+		if err != nil {
+			return err
+		}
+	} else {
+		value = secret.Data[data.GetSecretKey()]
+	}
+
+	if data.GetProperty() != "" {
+		current, err := c.get(ctx, data.GetRemoteKey())
+		if err != nil && !errors.Is(err, esv1beta1.NoSecretError{}) {
+			return err
+		}
+		doc := "{}"
+		if err == nil && len(current.Value) > 0 {
+			if !gjson.ValidBytes(current.Value) || !gjson.ParseBytes(current.Value).IsObject() {
+				return fmt.Errorf(errKeyIsNotAnObject, data.GetRemoteKey())
+			}
+			doc = string(current.Value)
+		}
+		doc, err = sjson.Set(doc, data.GetProperty(), string(value))
+		if err != nil {
+			return err
+		}
+		value = []byte(doc)
+	}
+	return c.put(ctx, data.GetRemoteKey(), value)
+}
+
+func (c *client) DeleteSecret(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) error {
+	if remoteRef.GetProperty() == "" {
+		return c.delete(ctx, remoteRef.GetRemoteKey())
+	}
+	current, err := c.get(ctx, remoteRef.GetRemoteKey())
+	if errors.Is(err, esv1beta1.NoSecretError{}) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	doc, err := sjson.Delete(string(current.Value), remoteRef.GetProperty())
+	if err != nil {
+		return err
+	}
+	if !gjson.Parse(doc).IsObject() || len(gjson.Parse(doc).Map()) == 0 {
+		return c.delete(ctx, remoteRef.GetRemoteKey())
+	}
+	return c.put(ctx, remoteRef.GetRemoteKey(), []byte(doc))
+}
+
+func (c *client) SecretExists(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) (bool, error) {
+	pair, err := c.get(ctx, remoteRef.GetRemoteKey())
+	if errors.Is(err, esv1beta1.NoSecretError{}) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if remoteRef.GetProperty() == "" {
+		return true, nil
+	}
+	return gjson.GetBytes(pair.Value, remoteRef.GetProperty()).Exists(), nil
+}
+
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	// https://developer.hashicorp.com/consul/api-docs/status#get-raft-leader
+	if _, err := c.api.Status().Leader(); err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+func (c *client) buildKey(key string) string {
+	key = strings.TrimPrefix(key, "/")
+	if c.prefix == "" {
+		return key
+	}
+	return strings.Trim(c.prefix, "/") + "/" + key
+}
+
+// get reads a single key.
+func (c *client) get(ctx context.Context, key string) (*api.KVPair, error) {
+	pair, _, err := c.api.KV().Get(c.buildKey(key), (&api.QueryOptions{}).WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if pair == nil {
+		return nil, esv1beta1.NoSecretError{}
+	}
+	return pair, nil
+}
+
+// list reads every key below the given prefix.
+func (c *client) list(ctx context.Context, prefix string) (api.KVPairs, error) {
+	pairs, _, err := c.api.KV().List(c.buildKey(prefix), (&api.QueryOptions{}).WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if len(pairs) == 0 {
+		return nil, esv1beta1.NoSecretError{}
+	}
+	return pairs, nil
+}
+
+func (c *client) put(ctx context.Context, key string, value []byte) error {
+	_, err := c.api.KV().Put(&api.KVPair{Key: c.buildKey(key), Value: value}, (&api.WriteOptions{}).WithContext(ctx))
+	return err
+}
+
+func (c *client) delete(ctx context.Context, key string) error {
+	_, err := c.api.KV().Delete(c.buildKey(key), (&api.WriteOptions{}).WithContext(ctx))
+	return err
+}
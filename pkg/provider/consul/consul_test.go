@@ -0,0 +1,164 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consul
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/consul/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1alpha1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1alpha1"
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const testToken = "acl-token"
+
+// fakeConsul is a minimal in-memory implementation of the Consul KV HTTP API.
+type fakeConsul struct {
+	mu sync.Mutex
+	kv map[string][]byte
+}
+
+func (f *fakeConsul) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if r.Header.Get("X-Consul-Token") != testToken {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	key := strings.TrimPrefix(r.URL.Path, "/v1/kv/")
+	switch r.Method {
+	case http.MethodGet:
+		var pairs api.KVPairs
+		for k, v := range f.kv {
+			if k == key || (r.URL.Query().Has("recurse") && strings.HasPrefix(k, key)) {
+				pairs = append(pairs, &api.KVPair{Key: k, Value: v})
+			}
+		}
+		if len(pairs) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(pairs)
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		f.kv[key] = body
+		_, _ = w.Write([]byte("true"))
+	case http.MethodDelete:
+		delete(f.kv, key)
+		_, _ = w.Write([]byte("true"))
+	}
+}
+
+func newTestClient(t *testing.T, prefix string, kv map[string][]byte) (*client, *fakeConsul) {
+	t.Helper()
+	fake := &fakeConsul{kv: kv}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	consulClient, err := api.NewClient(&api.Config{
+		Address:    srv.URL,
+		Token:      testToken,
+		HttpClient: srv.Client(),
+	})
+	require.NoError(t, err)
+	return &client{
+		api:    consulClient,
+		prefix: prefix,
+	}, fake
+}
+
+func TestGetSecret(t *testing.T) {
+	c, _ := newTestClient(t, "team-a", map[string][]byte{
+		"team-a/db":    []byte(`{"user":"admin","password":"s3cr3t"}`),
+		"team-a/plain": []byte("value"),
+	})
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr error
+	}{
+		"plain value": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "plain"},
+			want: "value",
+		},
+		"property": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "db", Property: "password"},
+			want: "s3cr3t",
+		},
+		"missing key": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "missing"},
+			wantErr: esv1beta1.NoSecretError{},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestGetSecretMap(t *testing.T) {
+	c, _ := newTestClient(t, "", map[string][]byte{
+		"app/":          nil,
+		"app/user":      []byte("admin"),
+		"app/password":  []byte("s3cr3t"),
+		"other/ignored": []byte("x"),
+	})
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "app"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"user":     []byte("admin"),
+		"password": []byte("s3cr3t"),
+	}, got)
+}
+
+func TestPushAndDeleteSecret(t *testing.T) {
+	c, fake := newTestClient(t, "", map[string][]byte{})
+	secret := &corev1.Secret{Data: map[string][]byte{"token": []byte("abc")}}
+
+	err := c.PushSecret(context.Background(), secret, esv1alpha1.PushSecretData{
+		Match: esv1alpha1.PushSecretMatch{
+			SecretKey: "token",
+			RemoteRef: esv1alpha1.PushSecretRemoteRef{RemoteKey: "app/config", Property: "apiToken"},
+		},
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"apiToken":"abc"}`, string(fake.kv["app/config"]))
+
+	ref := esv1alpha1.PushSecretRemoteRef{RemoteKey: "app/config", Property: "apiToken"}
+	exists, err := c.SecretExists(context.Background(), ref)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	require.NoError(t, c.DeleteSecret(context.Background(), ref))
+	_, ok := fake.kv["app/config"]
+	assert.False(t, ok)
+}
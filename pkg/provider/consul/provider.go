@@ -0,0 +1,124 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package consul
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/consul/api"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+const (
+	errStoreIsNil                  = "store is nil"
+	errNoStoreTypeOrWrongStoreType = "no store type or wrong store type"
+	errServerIsRequired            = "server is required"
+	errInvalidServerURL            = "invalid server URL: %w"
+	errInvalidCABundle             = "failed to parse caBundle"
+	errCannotResolveToken          = "cannot resolve ACL token: %w"
+)
+
+// Provider satisfies the provider interface.
+type Provider struct{}
+
+var _ esv1beta1.Provider = &Provider{}
+
+func (p *Provider) NewClient(ctx context.Context, store esv1beta1.GenericStore, kube kclient.Client, namespace string) (esv1beta1.SecretsClient, error) {
+	cfg, err := getConfig(store)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := resolvers.SecretKeyRef(ctx, kube, store.GetKind(), namespace, &cfg.Auth.TokenSecretRef)
+	if err != nil {
+		return nil, fmt.Errorf(errCannotResolveToken, err)
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(cfg.CABundle) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(cfg.CABundle) {
+			return nil, errors.New(errInvalidCABundle)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	// the config is built from scratch instead of api.DefaultConfig,
+	// which would pick up the CONSUL_* environment variables of the controller
+	consulClient, err := api.NewClient(&api.Config{
+		Address:    cfg.Server,
+		Datacenter: cfg.Datacenter,
+		Token:      token,
+		HttpClient: &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &client{
+		api:    consulClient,
+		prefix: cfg.Prefix,
+	}, nil
+}
+
+func getConfig(store esv1beta1.GenericStore) (*esv1beta1.ConsulProvider, error) {
+	if store == nil {
+		return nil, errors.New(errStoreIsNil)
+	}
+	spec := store.GetSpec()
+	if spec == nil || spec.Provider == nil || spec.Provider.Consul == nil {
+		return nil, errors.New(errNoStoreTypeOrWrongStoreType)
+	}
+	cfg := spec.Provider.Consul
+	if cfg.Server == "" {
+		return nil, errors.New(errServerIsRequired)
+	}
+	if _, err := url.ParseRequestURI(cfg.Server); err != nil {
+		return nil, fmt.Errorf(errInvalidServerURL, err)
+	}
+	if err := utils.ValidateReferentSecretSelector(store, cfg.Auth.TokenSecretRef); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnings, error) {
+	_, err := getConfig(store)
+	return nil, err
+}
+
+func (p *Provider) Capabilities() esv1beta1.SecretStoreCapabilities {
+	return esv1beta1.SecretStoreReadWrite
+}
+
+func init() {
+	esv1beta1.Register(&Provider{}, &esv1beta1.SecretStoreProvider{
+		Consul: &esv1beta1.ConsulProvider{},
+	})
+}
@@ -0,0 +1,259 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datadog
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	// pageSize is the maximum number of keys per page.
+	pageSize = 100
+
+	errInvalidKey        = "key %q must be the name or ID of a key"
+	errInvalidProperty   = "property %q must be one of apiKey or appKey"
+	errAmbiguousName     = "%d %ss are named %q, use the ID of the key"
+	errKeyNotReturned    = "Datadog did not return the value of %s %q"
+	errUnexpectedStatus  = "unexpected status code from Datadog: %d: %s"
+	errUnmarshalResponse = "unable to unmarshal Datadog response: %w"
+	errReadOnly          = "the Datadog provider is read only"
+	errFindUnsupported   = "find is not supported by the Datadog provider"
+)
+
+// keyKind is a kind of key of the Datadog API.
+type keyKind struct {
+	// path of the collection of the keys.
+	path string
+	// property selecting the kind of key in a remote ref.
+	property string
+	name     string
+}
+
+var (
+	apiKeys = &keyKind{path: "/api/v2/api_keys", property: "apiKey", name: "API key"}
+	appKeys = &keyKind{path: "/api/v2/application_keys", property: "appKey", name: "application key"}
+
+	keyKinds = []*keyKind{apiKeys, appKeys}
+
+	idPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+)
+
+// client reads API keys and application keys with the Datadog API.
+// https://docs.datadoghq.com/api/latest/key-management/
+type client struct {
+	httpClient *http.Client
+	url        string
+	apiKey     string
+	appKey     string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+type key struct {
+	ID         string `json:"id"`
+	Attributes struct {
+		Name string `json:"name"`
+		// Key is the value of the key, it is not returned when listing keys.
+		Key string `json:"key"`
+	} `json:"attributes"`
+}
+
+type keyResponse struct {
+	Data key `json:"data"`
+}
+
+type keyList struct {
+	Data []key `json:"data"`
+}
+
+func validateKey(k string) error {
+	if k == "" || strings.Contains(k, "/") {
+		return fmt.Errorf(errInvalidKey, k)
+	}
+	return nil
+}
+
+// kindOf returns the kind of key selected by the property of a remote ref, API keys by default.
+func kindOf(property string) (*keyKind, error) {
+	switch property {
+	case "", apiKeys.property:
+		return apiKeys, nil
+	case appKeys.property:
+		return appKeys, nil
+	}
+	return nil, fmt.Errorf(errInvalidProperty, property)
+}
+
+// GetSecret returns the value of the API key with the name or ID key,
+// or of the application key if the property is appKey.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	if err := validateKey(ref.Key); err != nil {
+		return nil, err
+	}
+	kind, err := kindOf(ref.Property)
+	if err != nil {
+		return nil, err
+	}
+	k, err := c.lookup(ctx, kind, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	if k.Attributes.Key == "" {
+		return nil, fmt.Errorf(errKeyNotReturned, kind.name, ref.Key)
+	}
+	return []byte(k.Attributes.Key), nil
+}
+
+// GetSecretMap returns the values of the API key and the application key with the name or ID key
+// as apiKey and appKey, a kind of key is omitted if no key has the name.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	if err := validateKey(ref.Key); err != nil {
+		return nil, err
+	}
+	data := make(map[string][]byte, len(keyKinds))
+	for _, kind := range keyKinds {
+		k, err := c.lookup(ctx, kind, ref.Key)
+		if errors.Is(err, esv1beta1.NoSecretError{}) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if k.Attributes.Key != "" {
+			data[kind.property] = []byte(k.Attributes.Key)
+		}
+	}
+	if len(data) == 0 {
+		return nil, esv1beta1.NoSecretError{}
+	}
+	return data, nil
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindUnsupported)
+}
+
+func (c *client) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
+	return false, errors.New(errReadOnly)
+}
+
+// Validate lists the API keys to check the keys of the store.
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	if err := c.get(context.Background(), apiKeys.path+"?page%5Bsize%5D=1", &keyList{}); err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+// lookup returns the key with the ID or name, the value of a key is only returned when it is read by ID.
+func (c *client) lookup(ctx context.Context, kind *keyKind, nameOrID string) (*key, error) {
+	if idPattern.MatchString(nameOrID) {
+		k, err := c.getByID(ctx, kind, nameOrID)
+		if !errors.Is(err, esv1beta1.NoSecretError{}) {
+			return k, err
+		}
+	}
+	var matches []key
+	for page := 0; ; page++ {
+		query := url.Values{
+			"filter":       {nameOrID},
+			"page[size]":   {strconv.Itoa(pageSize)},
+			"page[number]": {strconv.Itoa(page)},
+		}
+		var list keyList
+		if err := c.get(ctx, kind.path+"?"+query.Encode(), &list); err != nil {
+			return nil, err
+		}
+		// the filter also matches keys whose name contains nameOrID
+		for _, k := range list.Data {
+			if k.Attributes.Name == nameOrID {
+				matches = append(matches, k)
+			}
+		}
+		if len(list.Data) < pageSize {
+			break
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, esv1beta1.NoSecretError{}
+	case 1:
+		return c.getByID(ctx, kind, matches[0].ID)
+	}
+	return nil, fmt.Errorf(errAmbiguousName, len(matches), kind.name, nameOrID)
+}
+
+func (c *client) getByID(ctx context.Context, kind *keyKind, id string) (*key, error) {
+	var resp keyResponse
+	if err := c.get(ctx, kind.path+"/"+url.PathEscape(id), &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Data, nil
+}
+
+func (c *client) get(ctx context.Context, path string, target any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+path, http.NoBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("DD-API-KEY", c.apiKey)
+	req.Header.Set("DD-APPLICATION-KEY", c.appKey)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return esv1beta1.NoSecretError{}
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf(errUnexpectedStatus, resp.StatusCode, body)
+	}
+	if err := json.Unmarshal(body, target); err != nil {
+		return fmt.Errorf(errUnmarshalResponse, err)
+	}
+	return nil
+}
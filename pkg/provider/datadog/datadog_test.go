@@ -0,0 +1,204 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datadog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	testAPIKey = "0123456789abcdef0123456789abcdef"
+	testAppKey = "0123456789abcdef0123456789abcdef01234567"
+
+	ordersAPIKeyID = "3a1b2c3d-0000-4000-8000-000000000001"
+	ordersAppKeyID = "3a1b2c3d-0000-4000-8000-000000000002"
+)
+
+// fakeDatadog serves the keys of an organization, the API keys named orders-<n>
+// are matched by the filter "orders" and exercise the pagination.
+type fakeDatadog struct {
+	keys map[string]map[string]key
+}
+
+func newKey(id, name, value string) key {
+	k := key{ID: id}
+	k.Attributes.Name = name
+	k.Attributes.Key = value
+	return k
+}
+
+func newTestClient(t *testing.T) (*client, *fakeDatadog) {
+	f := &fakeDatadog{keys: map[string]map[string]key{
+		apiKeys.path: {
+			ordersAPIKeyID: newKey(ordersAPIKeyID, "orders", "orders-api-key"),
+			"dup-1":        newKey("dup-1", "duplicate", "dup-api-key-1"),
+			"dup-2":        newKey("dup-2", "duplicate", "dup-api-key-2"),
+		},
+		appKeys.path: {
+			ordersAppKeyID: newKey(ordersAppKeyID, "orders", "orders-app-key"),
+			"billing":      newKey("billing", "billing", ""),
+		},
+	}}
+	for i := range 150 {
+		id := fmt.Sprintf("filler-%03d", i)
+		f.keys[apiKeys.path][id] = newKey(id, "orders-"+strconv.Itoa(i), "filler")
+	}
+	srv := httptest.NewServer(f.handler())
+	t.Cleanup(srv.Close)
+	return &client{httpClient: srv.Client(), url: srv.URL, apiKey: testAPIKey, appKey: testAppKey}, f
+}
+
+func (f *fakeDatadog) handler() http.Handler {
+	mux := http.NewServeMux()
+	for path, keys := range f.keys {
+		mux.HandleFunc("GET "+path, func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			ids := make([]string, 0, len(keys))
+			for id, k := range keys {
+				if strings.Contains(k.Attributes.Name, q.Get("filter")) {
+					ids = append(ids, id)
+				}
+			}
+			sort.Strings(ids)
+			size, _ := strconv.Atoi(q.Get("page[size]"))
+			page, _ := strconv.Atoi(q.Get("page[number]"))
+			list := keyList{Data: []key{}}
+			for i := page * size; i < len(ids) && i < (page+1)*size; i++ {
+				k := keys[ids[i]]
+				k.Attributes.Key = ""
+				list.Data = append(list.Data, k)
+			}
+			_ = json.NewEncoder(w).Encode(list)
+		})
+		mux.HandleFunc("GET "+path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+			k, ok := keys[r.PathValue("id")]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"errors":["Not found"]}`))
+				return
+			}
+			_ = json.NewEncoder(w).Encode(keyResponse{Data: k})
+		})
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("DD-API-KEY") != testAPIKey || r.Header.Get("DD-APPLICATION-KEY") != testAppKey {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["Forbidden"]}`))
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func TestGetSecret(t *testing.T) {
+	c, _ := newTestClient(t)
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"api key by name": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "orders"},
+			want: "orders-api-key",
+		},
+		"api key by id": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: ordersAPIKeyID, Property: "apiKey"},
+			want: "orders-api-key",
+		},
+		"application key by name": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "orders", Property: "appKey"},
+			want: "orders-app-key",
+		},
+		"application key by id": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: ordersAppKeyID, Property: "appKey"},
+			want: "orders-app-key",
+		},
+		"unknown id": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "3a1b2c3d-0000-4000-8000-0000000000ff"},
+			wantErr: "Secret does not exist",
+		},
+		"missing name": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "payments"},
+			wantErr: "Secret does not exist",
+		},
+		"ambiguous name": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "duplicate"},
+			wantErr: `2 API keys are named "duplicate", use the ID of the key`,
+		},
+		"value not returned": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "billing", Property: "appKey"},
+			wantErr: `Datadog did not return the value of application key "billing"`,
+		},
+		"invalid property": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "orders", Property: "name"},
+			wantErr: `property "name" must be one of apiKey or appKey`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestGetSecretMap(t *testing.T) {
+	c, _ := newTestClient(t)
+	ctx := context.Background()
+
+	got, err := c.GetSecretMap(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: "orders"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"apiKey": []byte("orders-api-key"),
+		"appKey": []byte("orders-app-key"),
+	}, got)
+
+	got, err = c.GetSecretMap(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: ordersAppKeyID})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"appKey": []byte("orders-app-key")}, got)
+
+	_, err = c.GetSecretMap(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: "payments"})
+	assert.ErrorIs(t, err, esv1beta1.NoSecretError{})
+}
+
+func TestValidate(t *testing.T) {
+	c, _ := newTestClient(t)
+	res, err := c.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+
+	c.appKey = "invalid"
+	res, err = c.Validate()
+	assert.ErrorContains(t, err, "unexpected status code from Datadog: 403")
+	assert.Equal(t, esv1beta1.ValidationResultError, res)
+}
@@ -0,0 +1,79 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datadog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+func TestValidateStore(t *testing.T) {
+	namespace := "other"
+	validAuth := esv1beta1.DatadogAuth{
+		APIKey: esmeta.SecretKeySelector{Name: "datadog", Key: "api-key"},
+		AppKey: esmeta.SecretKeySelector{Name: "datadog", Key: "app-key"},
+	}
+	tests := map[string]struct {
+		cfg     esv1beta1.DatadogProvider
+		wantErr string
+	}{
+		"valid": {
+			cfg: esv1beta1.DatadogProvider{URL: "https://api.datadoghq.eu", Auth: validAuth},
+		},
+		"invalid url": {
+			cfg:     esv1beta1.DatadogProvider{URL: "api.datadoghq.eu", Auth: validAuth},
+			wantErr: `invalid url "api.datadoghq.eu"`,
+		},
+		"secret in other namespace": {
+			cfg: esv1beta1.DatadogProvider{
+				Auth: esv1beta1.DatadogAuth{
+					APIKey: validAuth.APIKey,
+					AppKey: esmeta.SecretKeySelector{Name: "datadog", Key: "app-key", Namespace: &namespace},
+				},
+			},
+			wantErr: "namespace should either be empty or match the namespace of the SecretStore",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := esv1beta1.SecretStore{
+				Spec: esv1beta1.SecretStoreSpec{
+					Provider: &esv1beta1.SecretStoreProvider{
+						Datadog: &tc.cfg,
+					},
+				},
+			}
+			p := &Provider{}
+			_, err := p.ValidateStore(&s)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestValidateRemoteRef(t *testing.T) {
+	p := &Provider{}
+	assert.NoError(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: "orders"}))
+	assert.NoError(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: "orders", Property: "appKey"}))
+	assert.Error(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: "orders", Property: "name"}))
+	assert.Error(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: "api_keys/orders"}))
+}
@@ -40,9 +40,13 @@ const (
 	errDeleteSecrets                                   = "could not delete secrets %s: %w"
 	errPushSecrets                                     = "could not push secrets %s: %w"
 	errUnmarshalSecretMap                              = "unable to unmarshal secret %s: %w"
+	errUnsupportedProperty                             = "unsupported property %q, must be one of computed or raw"
 	secretsDownloadFileKey                             = "DOPPLER_SECRETS_FILE"
 	errDopplerTokenSecretName                          = "missing auth.secretRef.dopplerToken.name"
 	errInvalidClusterStoreMissingDopplerTokenNamespace = "missing auth.secretRef.dopplerToken.namespace"
+
+	propertyComputed = "computed"
+	propertyRaw      = "raw"
 )
 
 type Client struct {
@@ -153,10 +157,22 @@ func (c *Client) GetSecret(_ context.Context, ref esv1beta1.ExternalSecretDataRe
 		return nil, fmt.Errorf(errGetSecret, ref.Key, err)
 	}
 
-	return []byte(secret.Value), nil
+	switch ref.Property {
+	case "", propertyComputed:
+		return []byte(secret.Value), nil
+	case propertyRaw:
+		return []byte(secret.Raw), nil
+	default:
+		return nil, fmt.Errorf(errUnsupportedProperty, ref.Property)
+	}
 }
 
 func (c *Client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	// without a key the whole config is extracted
+	if ref.Key == "" {
+		return c.getSecrets(ctx)
+	}
+
 	data, err := c.GetSecret(ctx, ref)
 	if err != nil {
 		return nil, err
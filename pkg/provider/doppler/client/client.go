@@ -96,8 +96,11 @@ type secretResponseBody struct {
 }
 
 type SecretResponse struct {
-	Name  string
+	Name string
+	// Value is the computed value, with references to other secrets expanded.
 	Value string
+	// Raw is the value as entered in Doppler.
+	Raw string
 }
 
 type SecretsResponse struct {
@@ -166,7 +169,11 @@ func (c *DopplerClient) GetSecret(request SecretRequest) (*SecretResponse, error
 		return nil, &APIError{Message: fmt.Sprintf("secret '%s' not found", request.Name)}
 	}
 
-	return &SecretResponse{Name: data.Name, Value: *data.Value.Computed}, nil
+	secret := &SecretResponse{Name: data.Name, Value: *data.Value.Computed}
+	if data.Value.Raw != nil {
+		secret.Raw = *data.Value.Raw
+	}
+	return secret, nil
 }
 
 // GetSecrets should only have an ETag supplied if Secrets are cached as SecretsResponse.Secrets will be nil if 304 (not modified) returned.
@@ -210,11 +210,36 @@ func TestGetSecret(t *testing.T) {
 		pstc.apiErr = fmt.Errorf("")
 	}
 
+	setRawProperty := func(pstc *dopplerTestCase) {
+		pstc.label = "raw property"
+		pstc.response.Value = "postgres://user@db"
+		pstc.response.Raw = "postgres://${DB_USER}@db"
+		pstc.remoteRef.Property = "raw"
+		pstc.expectedSecret = "postgres://${DB_USER}@db"
+	}
+
+	setComputedProperty := func(pstc *dopplerTestCase) {
+		pstc.label = "computed property"
+		pstc.response.Value = "postgres://user@db"
+		pstc.response.Raw = "postgres://${DB_USER}@db"
+		pstc.remoteRef.Property = "computed"
+		pstc.expectedSecret = "postgres://user@db"
+	}
+
+	setUnsupportedProperty := func(pstc *dopplerTestCase) {
+		pstc.label = "unsupported property"
+		pstc.remoteRef.Property = "note"
+		pstc.expectError = "unsupported property"
+	}
+
 	testCases := []*dopplerTestCase{
 		makeValidDopplerTestCaseCustom(setSecret),
 		makeValidDopplerTestCaseCustom(setMissingSecret),
 		makeValidDopplerTestCaseCustom(setInvalidSecret),
 		makeValidDopplerTestCaseCustom(setClientError),
+		makeValidDopplerTestCaseCustom(setRawProperty),
+		makeValidDopplerTestCaseCustom(setComputedProperty),
+		makeValidDopplerTestCaseCustom(setUnsupportedProperty),
 	}
 
 	c := Client{}
@@ -279,6 +304,29 @@ func TestGetSecretMap(t *testing.T) {
 	}
 }
 
+func TestGetSecretMapWholeConfig(t *testing.T) {
+	fakeClient := &fake.DopplerClient{}
+	fakeClient.WithSecrets(client.SecretsRequest{}, &client.SecretsResponse{
+		Secrets: client.Secrets{
+			validSecretName: validSecretValue,
+			dopplerProject:  dopplerProjectVal,
+		},
+	}, nil)
+
+	d := Client{doppler: fakeClient}
+	out, err := d.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string][]byte{
+		validSecretName: []byte(validSecretValue),
+		dopplerProject:  []byte(dopplerProjectVal),
+	}
+	if !cmp.Equal(out, expected) {
+		t.Errorf("unexpected secret data: expected %#v, got %#v", expected, out)
+	}
+}
+
 func ErrorContains(out error, want string) bool {
 	if out == nil {
 		return want == ""
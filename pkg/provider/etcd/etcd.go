@@ -0,0 +1,252 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/find"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	validateTimeout = 10 * time.Second
+
+	errNoEndpointReached  = "unable to reach any etcd endpoint: %w"
+	errPropertyNotFound   = "property %q not found in key %q"
+	errPushWholeSecret    = "pushing the whole secret requires a remote property to be empty"
+	errKeyIsNotAnObject   = "value of key %q is not a JSON object"
+	errFindTagsNotAllowed = "find by tags is not supported by etcd"
+)
+
+// etcdClient is the subset of *clientv3.Client used by the provider.
+type etcdClient interface {
+	clientv3.KV
+	Status(ctx context.Context, endpoint string) (*clientv3.StatusResponse, error)
+	Endpoints() []string
+	Close() error
+}
+
+type client struct {
+	etcd   etcdClient
+	prefix string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	value, err := c.get(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Property == "" {
+		return value, nil
+	}
+	res := gjson.GetBytes(value, ref.Property)
+	if !res.Exists() {
+		return nil, fmt.Errorf(errPropertyNotFound, ref.Property, ref.Key)
+	}
+	return []byte(res.String()), nil
+}
+
+// GetSecretMap scans every key below the remote key and returns them
+// relative to it.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	prefix := strings.TrimSuffix(ref.Key, "/") + "/"
+	kvs, err := c.scan(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	if len(kvs) == 0 {
+		return nil, esv1beta1.NoSecretError{}
+	}
+	fullPrefix := c.buildKey(prefix)
+	secretData := make(map[string][]byte, len(kvs))
+	for _, kv := range kvs {
+		secretData[strings.TrimPrefix(string(kv.Key), fullPrefix)] = kv.Value
+	}
+	return secretData, nil
+}
+
+func (c *client) GetAllSecrets(ctx context.Context, ref esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	if len(ref.Tags) > 0 {
+		return nil, errors.New(errFindTagsNotAllowed)
+	}
+	path := ""
+	if ref.Path != nil {
+		path = *ref.Path
+	}
+	kvs, err := c.scan(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	var matcher *find.Matcher
+	if ref.Name != nil {
+		matcher, err = find.New(*ref.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+	basePrefix := c.buildKey("")
+	secretData := make(map[string][]byte)
+	for _, kv := range kvs {
+		name := strings.TrimPrefix(string(kv.Key), basePrefix)
+		if matcher != nil && !matcher.MatchName(name) {
+			continue
+		}
+		secretData[name] = kv.Value
+	}
+	return utils.ConvertKeys(ref.ConversionStrategy, secretData)
+}
+
+func (c *client) PushSecret(ctx context.Context, secret *corev1.Secret, data esv1beta1.PushSecretData) error {
+	var value []byte
+	if data.GetSecretKey() == "" {
+		if data.GetProperty() != "" {
+			return errors.New(errPushWholeSecret)
+		}
+		secretStringVal := make(map[string]string, len(secret.Data))
+		for k, v := range secret.Data {
+			secretStringVal[k] = string(v)
+		}
+		var err error
+		value, err = utils.JSONMarshal(secretStringVal)
+		if err != nil {
+			return err
+		}
+	} else {
+		value = secret.Data[data.GetSecretKey()]
+	}
+
+	if data.GetProperty() != "" {
+		current, err := c.get(ctx, data.GetRemoteKey())
+		if err != nil && !errors.Is(err, esv1beta1.NoSecretError{}) {
+			return err
+		}
+		doc := "{}"
+		if err == nil && len(current) > 0 {
+			if !gjson.ValidBytes(current) || !gjson.ParseBytes(current).IsObject() {
+				return fmt.Errorf(errKeyIsNotAnObject, data.GetRemoteKey())
+			}
+			doc = string(current)
+		}
+		doc, err = sjson.Set(doc, data.GetProperty(), string(value))
+		if err != nil {
+			return err
+		}
+		value = []byte(doc)
+	}
+	return c.put(ctx, data.GetRemoteKey(), value)
+}
+
+func (c *client) DeleteSecret(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) error {
+	if remoteRef.GetProperty() == "" {
+		return c.delete(ctx, remoteRef.GetRemoteKey())
+	}
+	current, err := c.get(ctx, remoteRef.GetRemoteKey())
+	if errors.Is(err, esv1beta1.NoSecretError{}) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	doc, err := sjson.Delete(string(current), remoteRef.GetProperty())
+	if err != nil {
+		return err
+	}
+	if len(gjson.Parse(doc).Map()) == 0 {
+		return c.delete(ctx, remoteRef.GetRemoteKey())
+	}
+	return c.put(ctx, remoteRef.GetRemoteKey(), []byte(doc))
+}
+
+func (c *client) SecretExists(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) (bool, error) {
+	value, err := c.get(ctx, remoteRef.GetRemoteKey())
+	if errors.Is(err, esv1beta1.NoSecretError{}) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if remoteRef.GetProperty() == "" {
+		return true, nil
+	}
+	return gjson.GetBytes(value, remoteRef.GetProperty()).Exists(), nil
+}
+
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), validateTimeout)
+	defer cancel()
+	var lastErr error
+	for _, endpoint := range c.etcd.Endpoints() {
+		if _, lastErr = c.etcd.Status(ctx, endpoint); lastErr == nil {
+			return esv1beta1.ValidationResultReady, nil
+		}
+	}
+	return esv1beta1.ValidationResultError, fmt.Errorf(errNoEndpointReached, lastErr)
+}
+
+func (c *client) Close(_ context.Context) error {
+	return c.etcd.Close()
+}
+
+func (c *client) buildKey(key string) string {
+	if c.prefix == "" {
+		return key
+	}
+	return strings.TrimSuffix(c.prefix, "/") + "/" + strings.TrimPrefix(key, "/")
+}
+
+func (c *client) get(ctx context.Context, key string) ([]byte, error) {
+	resp, err := c.etcd.Get(ctx, c.buildKey(key))
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Kvs) == 0 {
+		return nil, esv1beta1.NoSecretError{}
+	}
+	return resp.Kvs[0].Value, nil
+}
+
+// scan returns every key starting with prefix. WithPrefix scans
+// the whole keyspace, from "\x00" to "\x00", if the prefix is empty.
+func (c *client) scan(ctx context.Context, prefix string) ([]*mvccpb.KeyValue, error) {
+	resp, err := c.etcd.Get(ctx, c.buildKey(prefix), clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	return resp.Kvs, nil
+}
+
+func (c *client) put(ctx context.Context, key string, value []byte) error {
+	_, err := c.etcd.Put(ctx, c.buildKey(key), string(value))
+	return err
+}
+
+func (c *client) delete(ctx context.Context, key string) error {
+	_, err := c.etcd.Delete(ctx, c.buildKey(key))
+	return err
+}
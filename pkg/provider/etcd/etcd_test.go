@@ -0,0 +1,222 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1alpha1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1alpha1"
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+// fakeEtcd is a minimal in-memory implementation of the etcd KV API.
+type fakeEtcd struct {
+	clientv3.KV
+
+	mu        sync.Mutex
+	kv        map[string][]byte
+	endpoints []string
+	healthy   string
+}
+
+func (f *fakeEtcd) Get(_ context.Context, key string, opts ...clientv3.OpOption) (*clientv3.GetResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	op := clientv3.OpGet(key, opts...)
+	start, end := op.KeyBytes(), op.RangeBytes()
+	resp := &clientv3.GetResponse{}
+	for k, v := range f.kv {
+		key := []byte(k)
+		// a range end of "\x00" means every key from the start key on
+		inRange := end != nil && bytes.Compare(key, start) >= 0 &&
+			(bytes.Equal(end, []byte{0}) || bytes.Compare(key, end) < 0)
+		if bytes.Equal(key, start) || inRange {
+			resp.Kvs = append(resp.Kvs, &mvccpb.KeyValue{Key: key, Value: v})
+		}
+	}
+	sort.Slice(resp.Kvs, func(i, j int) bool { return bytes.Compare(resp.Kvs[i].Key, resp.Kvs[j].Key) < 0 })
+	return resp, nil
+}
+
+func (f *fakeEtcd) Put(_ context.Context, key, val string, _ ...clientv3.OpOption) (*clientv3.PutResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.kv[key] = []byte(val)
+	return &clientv3.PutResponse{}, nil
+}
+
+func (f *fakeEtcd) Delete(_ context.Context, key string, _ ...clientv3.OpOption) (*clientv3.DeleteResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.kv, key)
+	return &clientv3.DeleteResponse{}, nil
+}
+
+func (f *fakeEtcd) Status(_ context.Context, endpoint string) (*clientv3.StatusResponse, error) {
+	if endpoint != f.healthy {
+		return nil, errors.New("connection refused")
+	}
+	return &clientv3.StatusResponse{}, nil
+}
+
+func (f *fakeEtcd) Endpoints() []string {
+	return f.endpoints
+}
+
+func (f *fakeEtcd) Close() error {
+	return nil
+}
+
+func newTestClient(fake *fakeEtcd, prefix string) *client {
+	return &client{
+		etcd:   fake,
+		prefix: prefix,
+	}
+}
+
+func TestGetSecret(t *testing.T) {
+	fake := &fakeEtcd{
+		kv: map[string][]byte{
+			"/secrets/db":    []byte(`{"user":"admin","password":"s3cr3t"}`),
+			"/secrets/plain": []byte("value"),
+		},
+	}
+	c := newTestClient(fake, "/secrets")
+
+	got, err := c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "plain"})
+	require.NoError(t, err)
+	assert.Equal(t, "value", string(got))
+
+	got, err = c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "db", Property: "password"})
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t", string(got))
+
+	_, err = c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "missing"})
+	assert.ErrorIs(t, err, esv1beta1.NoSecretError{})
+}
+
+func TestGetSecretMap(t *testing.T) {
+	fake := &fakeEtcd{
+		kv: map[string][]byte{
+			"app/user":     []byte("admin"),
+			"app/password": []byte("s3cr3t"),
+			"application":  []byte("ignored"),
+		},
+	}
+	c := newTestClient(fake, "")
+
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "app"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"user":     []byte("admin"),
+		"password": []byte("s3cr3t"),
+	}, got)
+}
+
+func TestGetAllSecrets(t *testing.T) {
+	fake := &fakeEtcd{
+		kv: map[string][]byte{
+			"app/user":     []byte("admin"),
+			"app/password": []byte("s3cr3t"),
+			"db/password":  []byte("hunter2"),
+			"\x00internal": []byte("nul"),
+		},
+	}
+	c := newTestClient(fake, "")
+
+	got, err := c.GetAllSecrets(context.Background(), esv1beta1.ExternalSecretFind{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"app/user":     []byte("admin"),
+		"app/password": []byte("s3cr3t"),
+		"db/password":  []byte("hunter2"),
+		"\x00internal": []byte("nul"),
+	}, got)
+
+	path := "app/"
+	got, err = c.GetAllSecrets(context.Background(), esv1beta1.ExternalSecretFind{Path: &path})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"app/user":     []byte("admin"),
+		"app/password": []byte("s3cr3t"),
+	}, got)
+
+	got, err = c.GetAllSecrets(context.Background(), esv1beta1.ExternalSecretFind{
+		Name: &esv1beta1.FindName{RegExp: "password$"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"app/password": []byte("s3cr3t"),
+		"db/password":  []byte("hunter2"),
+	}, got)
+}
+
+func TestPushSecret(t *testing.T) {
+	fake := &fakeEtcd{kv: map[string][]byte{
+		"app/config": []byte(`{"existing":"value"}`),
+	}}
+	c := newTestClient(fake, "")
+	secret := &corev1.Secret{Data: map[string][]byte{"token": []byte("abc")}}
+
+	err := c.PushSecret(context.Background(), secret, esv1alpha1.PushSecretData{
+		Match: esv1alpha1.PushSecretMatch{
+			SecretKey: "token",
+			RemoteRef: esv1alpha1.PushSecretRemoteRef{RemoteKey: "app/config", Property: "token"},
+		},
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"existing":"value","token":"abc"}`, string(fake.kv["app/config"]))
+
+	err = c.PushSecret(context.Background(), secret, esv1alpha1.PushSecretData{
+		Match: esv1alpha1.PushSecretMatch{
+			SecretKey: "token",
+			RemoteRef: esv1alpha1.PushSecretRemoteRef{RemoteKey: "app/token"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "abc", string(fake.kv["app/token"]))
+
+	require.NoError(t, c.DeleteSecret(context.Background(), esv1alpha1.PushSecretRemoteRef{RemoteKey: "app/token"}))
+	exists, err := c.SecretExists(context.Background(), esv1alpha1.PushSecretRemoteRef{RemoteKey: "app/token"})
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestValidate(t *testing.T) {
+	fake := &fakeEtcd{
+		endpoints: []string{"https://etcd-0:2379", "https://etcd-1:2379"},
+		healthy:   "https://etcd-1:2379",
+	}
+	c := newTestClient(fake, "")
+	res, err := c.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+
+	fake.healthy = ""
+	res, err = c.Validate()
+	assert.ErrorContains(t, err, "connection refused")
+	assert.Equal(t, esv1beta1.ValidationResultError, res)
+}
@@ -0,0 +1,157 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+const (
+	errStoreIsNil                  = "store is nil"
+	errNoStoreTypeOrWrongStoreType = "no store type or wrong store type"
+	errEndpointsRequired           = "at least one endpoint is required"
+	errInvalidEndpoint             = "invalid endpoint %q: %w"
+	errInvalidCABundle             = "failed to parse caBundle"
+	errCannotResolveSecretKeyRef   = "cannot resolve secret key ref: %w"
+	errInvalidClientCert           = "invalid client certificate: %w"
+)
+
+// Provider satisfies the provider interface.
+type Provider struct{}
+
+var _ esv1beta1.Provider = &Provider{}
+
+func (p *Provider) NewClient(ctx context.Context, store esv1beta1.GenericStore, kube kclient.Client, namespace string) (esv1beta1.SecretsClient, error) {
+	cfg, err := getConfig(store)
+	if err != nil {
+		return nil, err
+	}
+	storeKind := store.GetKind()
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(cfg.CABundle) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(cfg.CABundle) {
+			return nil, errors.New(errInvalidCABundle)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if cfg.Auth.ClientTLS != nil {
+		cert, err := resolvers.SecretKeyRef(ctx, kube, storeKind, namespace, &cfg.Auth.ClientTLS.CertSecretRef)
+		if err != nil {
+			return nil, fmt.Errorf(errCannotResolveSecretKeyRef, err)
+		}
+		key, err := resolvers.SecretKeyRef(ctx, kube, storeKind, namespace, &cfg.Auth.ClientTLS.KeySecretRef)
+		if err != nil {
+			return nil, fmt.Errorf(errCannotResolveSecretKeyRef, err)
+		}
+		keyPair, err := tls.X509KeyPair([]byte(cert), []byte(key))
+		if err != nil {
+			return nil, fmt.Errorf(errInvalidClientCert, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{keyPair}
+	}
+
+	etcdCfg := clientv3.Config{
+		Endpoints:   cfg.Endpoints,
+		TLS:         tlsConfig,
+		DialTimeout: 30 * time.Second,
+	}
+	if cfg.Auth.SecretRef != nil {
+		etcdCfg.Username, err = resolvers.SecretKeyRef(ctx, kube, storeKind, namespace, &cfg.Auth.SecretRef.Username)
+		if err != nil {
+			return nil, fmt.Errorf(errCannotResolveSecretKeyRef, err)
+		}
+		etcdCfg.Password, err = resolvers.SecretKeyRef(ctx, kube, storeKind, namespace, &cfg.Auth.SecretRef.Password)
+		if err != nil {
+			return nil, fmt.Errorf(errCannotResolveSecretKeyRef, err)
+		}
+	}
+	// the connection is established lazily, unreachable endpoints
+	// are skipped by the client until one of them answers
+	cli, err := clientv3.New(etcdCfg)
+	if err != nil {
+		return nil, err
+	}
+	return &client{
+		etcd:   cli,
+		prefix: cfg.Prefix,
+	}, nil
+}
+
+func getConfig(store esv1beta1.GenericStore) (*esv1beta1.EtcdProvider, error) {
+	if store == nil {
+		return nil, errors.New(errStoreIsNil)
+	}
+	spec := store.GetSpec()
+	if spec == nil || spec.Provider == nil || spec.Provider.Etcd == nil {
+		return nil, errors.New(errNoStoreTypeOrWrongStoreType)
+	}
+	cfg := spec.Provider.Etcd
+	if len(cfg.Endpoints) == 0 {
+		return nil, errors.New(errEndpointsRequired)
+	}
+	for _, ep := range cfg.Endpoints {
+		if _, err := url.ParseRequestURI(ep); err != nil {
+			return nil, fmt.Errorf(errInvalidEndpoint, ep, err)
+		}
+	}
+	if ref := cfg.Auth.SecretRef; ref != nil {
+		if err := utils.ValidateReferentSecretSelector(store, ref.Username); err != nil {
+			return nil, err
+		}
+		if err := utils.ValidateReferentSecretSelector(store, ref.Password); err != nil {
+			return nil, err
+		}
+	}
+	if ref := cfg.Auth.ClientTLS; ref != nil {
+		if err := utils.ValidateReferentSecretSelector(store, ref.CertSecretRef); err != nil {
+			return nil, err
+		}
+		if err := utils.ValidateReferentSecretSelector(store, ref.KeySecretRef); err != nil {
+			return nil, err
+		}
+	}
+	return cfg, nil
+}
+
+func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnings, error) {
+	_, err := getConfig(store)
+	return nil, err
+}
+
+func (p *Provider) Capabilities() esv1beta1.SecretStoreCapabilities {
+	return esv1beta1.SecretStoreReadWrite
+}
+
+func init() {
+	esv1beta1.Register(&Provider{}, &esv1beta1.SecretStoreProvider{
+		Etcd: &esv1beta1.EtcdProvider{},
+	})
+}
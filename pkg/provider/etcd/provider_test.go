@@ -0,0 +1,78 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+func TestValidateStore(t *testing.T) {
+	otherNamespace := "other"
+	tests := map[string]struct {
+		cfg     esv1beta1.EtcdProvider
+		wantErr string
+	}{
+		"valid": {
+			cfg: esv1beta1.EtcdProvider{
+				Endpoints: []string{"https://etcd-0.example.com:2379"},
+			},
+		},
+		"invalid without endpoints": {
+			cfg:     esv1beta1.EtcdProvider{},
+			wantErr: errEndpointsRequired,
+		},
+		"invalid endpoint": {
+			cfg: esv1beta1.EtcdProvider{
+				Endpoints: []string{"etcd-0"},
+			},
+			wantErr: "invalid endpoint",
+		},
+		"invalid namespace in secret ref": {
+			cfg: esv1beta1.EtcdProvider{
+				Endpoints: []string{"https://etcd-0.example.com:2379"},
+				Auth: esv1beta1.EtcdAuth{
+					SecretRef: &esv1beta1.EtcdAuthSecretRef{
+						Username: esmeta.SecretKeySelector{Name: "etcd", Key: "username", Namespace: &otherNamespace},
+						Password: esmeta.SecretKeySelector{Name: "etcd", Key: "password"},
+					},
+				},
+			},
+			wantErr: "namespace should either be empty or match the namespace of the SecretStore",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := esv1beta1.SecretStore{
+				Spec: esv1beta1.SecretStoreSpec{
+					Provider: &esv1beta1.SecretStoreProvider{
+						Etcd: &tc.cfg,
+					},
+				},
+			}
+			p := &Provider{}
+			_, err := p.ValidateStore(&s)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
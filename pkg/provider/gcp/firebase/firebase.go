@@ -0,0 +1,175 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firebase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/provider/gcp/workspace"
+)
+
+const (
+	// adminSDKKey selects the service account that Firebase creates for the Admin SDK.
+	adminSDKKey    = "firebase-adminsdk"
+	adminSDKPrefix = "firebase-adminsdk-"
+
+	errListServiceAccounts = "unable to list the service accounts of project %q: %w"
+	errAdminSDKAmbiguous   = "project %q has more than one Firebase Admin SDK service account, use the email address of one of them"
+	errUnexpectedStatus    = "unexpected status code from the IAM API: %d: %s"
+)
+
+// client issues keys of the service accounts of a Firebase project. The keys are
+// issued, rotated and deleted like the keys of the Google Workspace provider, the
+// client only resolves the service account of a remoteRef to its email address.
+// https://firebase.google.com/docs/admin/setup#initialize_the_sdk_in_non-google_environments
+type client struct {
+	workspace.KeyClient
+
+	httpClient *http.Client
+	url        string
+	projectID  string
+
+	// email address of the Admin SDK service account, once it has been looked up
+	adminSDKEmail string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+var _ esv1beta1.LeaseClient = &client{}
+
+type serviceAccount struct {
+	Email    string `json:"email"`
+	Disabled bool   `json:"disabled"`
+}
+
+type serviceAccountsResponse struct {
+	Accounts      []serviceAccount `json:"accounts"`
+	NextPageToken string           `json:"nextPageToken"`
+}
+
+// GetSecret creates a key for the service account of ref.Key and returns its JSON key file,
+// or one of its fields if a property is set.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	email, err := c.email(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	ref.Key = email
+	return c.KeyClient.GetSecret(ctx, ref)
+}
+
+// GetSecretMap creates a key for the service account of ref.Key and returns the fields of its JSON key file.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	email, err := c.email(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	ref.Key = email
+	return c.KeyClient.GetSecretMap(ctx, ref)
+}
+
+// Validate lists a single service account of the project to check the credentials and the project.
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	var resp serviceAccountsResponse
+	if err := c.get(context.Background(), c.serviceAccountsPath()+"?pageSize=1", &resp); err != nil {
+		return esv1beta1.ValidationResultError, fmt.Errorf(errListServiceAccounts, c.projectID, err)
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+// email returns the email address of the service account named by key, which is
+// firebase-adminsdk, the email address or the ID of a service account of the project.
+func (c *client) email(ctx context.Context, key string) (string, error) {
+	if err := validateKey(key); err != nil {
+		return "", err
+	}
+	switch {
+	case strings.Contains(key, "@"):
+		return key, nil
+	case key == adminSDKKey:
+		return c.adminSDKServiceAccount(ctx)
+	}
+	return key + "@" + c.projectID + ".iam.gserviceaccount.com", nil
+}
+
+// adminSDKServiceAccount looks up the email address of the enabled Admin SDK service account of the project.
+func (c *client) adminSDKServiceAccount(ctx context.Context) (string, error) {
+	if c.adminSDKEmail != "" {
+		return c.adminSDKEmail, nil
+	}
+	var emails []string
+	pageToken := ""
+	for {
+		path := c.serviceAccountsPath() + "?pageSize=100"
+		if pageToken != "" {
+			path += "&pageToken=" + url.QueryEscape(pageToken)
+		}
+		var resp serviceAccountsResponse
+		if err := c.get(ctx, path, &resp); err != nil {
+			return "", fmt.Errorf(errListServiceAccounts, c.projectID, err)
+		}
+		for _, sa := range resp.Accounts {
+			if !sa.Disabled && strings.HasPrefix(sa.Email, adminSDKPrefix) {
+				emails = append(emails, sa.Email)
+			}
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+	switch len(emails) {
+	case 0:
+		return "", esv1beta1.NoSecretError{}
+	case 1:
+		c.adminSDKEmail = emails[0]
+		return c.adminSDKEmail, nil
+	}
+	return "", fmt.Errorf(errAdminSDKAmbiguous, c.projectID)
+}
+
+func (c *client) serviceAccountsPath() string {
+	return "/projects/" + url.PathEscape(c.projectID) + "/serviceAccounts"
+}
+
+func (c *client) get(ctx context.Context, path string, target any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+path, http.NoBody)
+	if err != nil {
+		return err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return esv1beta1.NoSecretError{}
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf(errUnexpectedStatus, resp.StatusCode, body)
+	}
+	return json.Unmarshal(body, target)
+}
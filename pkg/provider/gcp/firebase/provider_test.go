@@ -0,0 +1,88 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package firebase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+func TestValidateStore(t *testing.T) {
+	namespace := "other"
+	tests := map[string]struct {
+		cfg     esv1beta1.FirebaseProvider
+		wantErr string
+	}{
+		"valid": {
+			cfg: esv1beta1.FirebaseProvider{ProjectID: "acme-app"},
+		},
+		"valid rotation period": {
+			cfg: esv1beta1.FirebaseProvider{ProjectID: "acme-app", KeyRotationPeriod: &metav1.Duration{Duration: 24 * time.Hour}},
+		},
+		"missing project": {
+			cfg:     esv1beta1.FirebaseProvider{},
+			wantErr: errMissingProjectID,
+		},
+		"zero rotation period": {
+			cfg:     esv1beta1.FirebaseProvider{ProjectID: "acme-app", KeyRotationPeriod: &metav1.Duration{}},
+			wantErr: errInvalidRotationPeriod,
+		},
+		"credentials in other namespace": {
+			cfg: esv1beta1.FirebaseProvider{
+				ProjectID: "acme-app",
+				Auth: esv1beta1.GCPSMAuth{
+					SecretRef: &esv1beta1.GCPSMAuthSecretRef{
+						SecretAccessKey: esmeta.SecretKeySelector{Name: "gcp", Key: "credentials", Namespace: &namespace},
+					},
+				},
+			},
+			wantErr: "invalid auth.secretRef",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := esv1beta1.SecretStore{
+				Spec: esv1beta1.SecretStoreSpec{
+					Provider: &esv1beta1.SecretStoreProvider{
+						Firebase: &tc.cfg,
+					},
+				},
+			}
+			p := &Provider{}
+			_, err := p.ValidateStore(&s)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestValidateRemoteRef(t *testing.T) {
+	p := &Provider{}
+	for _, key := range []string{"firebase-adminsdk", "functions-runner", "functions-runner@acme-app.iam.gserviceaccount.com"} {
+		assert.NoError(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: key}), key)
+	}
+	for _, key := range []string{"", "@acme-app.iam.gserviceaccount.com", "functions-runner@", "a@b/keys"} {
+		assert.Error(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: key}), key)
+	}
+}
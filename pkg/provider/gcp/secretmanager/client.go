@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -92,13 +93,39 @@ type GoogleSecretManagerClient interface {
 	Close() error
 	GetSecret(ctx context.Context, req *secretmanagerpb.GetSecretRequest, opts ...gax.CallOption) (*secretmanagerpb.Secret, error)
 	UpdateSecret(context.Context, *secretmanagerpb.UpdateSecretRequest, ...gax.CallOption) (*secretmanagerpb.Secret, error)
+	ListSecretVersions(ctx context.Context, req *secretmanagerpb.ListSecretVersionsRequest, opts ...gax.CallOption) *secretmanager.SecretVersionIterator
+	DisableSecretVersion(ctx context.Context, req *secretmanagerpb.DisableSecretVersionRequest, opts ...gax.CallOption) (*secretmanagerpb.SecretVersion, error)
+}
+
+// PermissionDeniedError is returned when the credentials of the store
+// lack the IAM permissions to read or write a secret.
+type PermissionDeniedError struct {
+	Name string
+	Err  error
+}
+
+func (e *PermissionDeniedError) Error() string {
+	return fmt.Sprintf("permission denied on secret %s: %v", e.Name, e.Err)
+}
+
+func (e *PermissionDeniedError) Unwrap() error {
+	return e.Err
+}
+
+// wrapPermissionError wraps err in a PermissionDeniedError if GCP denied access to name.
+func wrapPermissionError(name string, err error) error {
+	if err != nil && status.Code(err) == codes.PermissionDenied {
+		return &PermissionDeniedError{Name: name, Err: err}
+	}
+	return err
 }
 
 var log = ctrl.Log.WithName("provider").WithName("gcp").WithName("secretsmanager")
 
 func (c *Client) DeleteSecret(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) error {
+	secretName := fmt.Sprintf("projects/%s/secrets/%s", c.store.ProjectID, remoteRef.GetRemoteKey())
 	gcpSecret, err := c.smClient.GetSecret(ctx, &secretmanagerpb.GetSecretRequest{
-		Name: fmt.Sprintf("projects/%s/secrets/%s", c.store.ProjectID, remoteRef.GetRemoteKey()),
+		Name: secretName,
 	})
 	metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMGetSecret, err)
 	if err != nil {
@@ -106,20 +133,21 @@ func (c *Client) DeleteSecret(ctx context.Context, remoteRef esv1beta1.PushSecre
 			return nil
 		}
 
-		return err
+		return wrapPermissionError(secretName, err)
 	}
 
 	if manager, ok := gcpSecret.Labels[managedByKey]; !ok || manager != managedByValue {
 		return nil
 	}
 
+	// deleting the secret resource destroys all of its versions
 	deleteSecretVersionReq := &secretmanagerpb.DeleteSecretRequest{
-		Name: fmt.Sprintf("projects/%s/secrets/%s", c.store.ProjectID, remoteRef.GetRemoteKey()),
+		Name: secretName,
 		Etag: gcpSecret.Etag,
 	}
 	err = c.smClient.DeleteSecret(ctx, deleteSecretVersionReq)
 	metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMDeleteSecret, err)
-	return err
+	return wrapPermissionError(secretName, err)
 }
 
 func parseError(err error) error {
@@ -130,8 +158,19 @@ func parseError(err error) error {
 	return err
 }
 
-func (c *Client) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
-	return false, fmt.Errorf("not implemented")
+func (c *Client) SecretExists(ctx context.Context, ref esv1beta1.PushSecretRemoteRef) (bool, error) {
+	secretName := fmt.Sprintf("projects/%s/secrets/%s", c.store.ProjectID, ref.GetRemoteKey())
+	_, err := c.smClient.GetSecret(ctx, &secretmanagerpb.GetSecretRequest{
+		Name: secretName,
+	})
+	metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMGetSecret, err)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return false, nil
+		}
+		return false, wrapPermissionError(secretName, err)
+	}
+	return true, nil
 }
 
 // PushSecret pushes a kubernetes secret key into gcp provider Secret.
@@ -161,7 +200,7 @@ func (c *Client) PushSecret(ctx context.Context, secret *corev1.Secret, pushSecr
 
 	if err != nil {
 		if status.Code(err) != codes.NotFound {
-			return err
+			return wrapPermissionError(secretName, err)
 		}
 
 		var replication = &secretmanagerpb.Replication{
@@ -196,7 +235,7 @@ func (c *Client) PushSecret(ctx context.Context, secret *corev1.Secret, pushSecr
 		})
 		metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMCreateSecret, err)
 		if err != nil {
-			return err
+			return wrapPermissionError(secretName, err)
 		}
 	}
 
@@ -240,7 +279,7 @@ func (c *Client) PushSecret(ctx context.Context, secret *corev1.Secret, pushSecr
 		})
 		metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMUpdateSecret, err)
 		if err != nil {
-			return err
+			return wrapPermissionError(secretName, err)
 		}
 	}
 
@@ -256,7 +295,7 @@ func (c *Client) PushSecret(ctx context.Context, secret *corev1.Secret, pushSecr
 	metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMAccessSecretVersion, err)
 
 	if err != nil && status.Code(err) != codes.NotFound {
-		return err
+		return wrapPermissionError(secretName, err)
 	}
 
 	if gcpVersion != nil && gcpVersion.Payload != nil && !builder.needUpdate(gcpVersion.Payload.Data) {
@@ -274,7 +313,7 @@ func (c *Client) PushSecret(ctx context.Context, secret *corev1.Secret, pushSecr
 	}
 
 	addSecretVersionReq := &secretmanagerpb.AddSecretVersionRequest{
-		Parent: fmt.Sprintf("projects/%s/secrets/%s", c.store.ProjectID, pushSecretData.GetRemoteKey()),
+		Parent: secretName,
 		Payload: &secretmanagerpb.SecretPayload{
 			Data: data,
 		},
@@ -282,7 +321,55 @@ func (c *Client) PushSecret(ctx context.Context, secret *corev1.Secret, pushSecr
 
 	_, err = c.smClient.AddSecretVersion(ctx, addSecretVersionReq)
 	metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMAddSecretVersion, err)
-	return err
+	if err != nil {
+		return wrapPermissionError(secretName, err)
+	}
+
+	return c.disableOldVersions(ctx, secretName)
+}
+
+// disableOldVersions disables the enabled versions of a secret
+// that exceed the VersionsToKeep limit of the store, oldest first.
+func (c *Client) disableOldVersions(ctx context.Context, secretName string) error {
+	if c.store.VersionsToKeep <= 0 {
+		return nil
+	}
+
+	var versions []*secretmanagerpb.SecretVersion
+	it := c.smClient.ListSecretVersions(ctx, &secretmanagerpb.ListSecretVersionsRequest{
+		Parent: secretName,
+		Filter: "state:ENABLED",
+	})
+	for {
+		version, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMListSecretVersions, err)
+		if err != nil {
+			return wrapPermissionError(secretName, err)
+		}
+		versions = append(versions, version)
+	}
+
+	if len(versions) <= int(c.store.VersionsToKeep) {
+		return nil
+	}
+
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].GetCreateTime().AsTime().After(versions[j].GetCreateTime().AsTime())
+	})
+	for _, version := range versions[c.store.VersionsToKeep:] {
+		_, err := c.smClient.DisableSecretVersion(ctx, &secretmanagerpb.DisableSecretVersionRequest{
+			Name: version.GetName(),
+			Etag: version.GetEtag(),
+		})
+		metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMDisableSecretVersion, err)
+		if err != nil {
+			return wrapPermissionError(secretName, err)
+		}
+	}
+	return nil
 }
 
 // GetAllSecrets syncs multiple secrets from gcp provider into a single Kubernetes Secret.
@@ -524,6 +611,26 @@ func (c *Client) getSecretMetadata(ctx context.Context, ref esv1beta1.ExternalSe
 	return j, nil
 }
 
+// GetSecretMetadata returns the labels and annotations of the secret,
+// annotations take precedence over labels with the same key.
+func (c *Client) GetSecretMetadata(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string]string, error) {
+	if c.smClient == nil || c.store.ProjectID == "" {
+		return nil, fmt.Errorf(errUninitalizedGCPProvider)
+	}
+	secret, err := c.smClient.GetSecret(ctx, &secretmanagerpb.GetSecretRequest{
+		Name: fmt.Sprintf("projects/%s/secrets/%s", c.store.ProjectID, ref.Key),
+	})
+	metrics.ObserveAPICall(constants.ProviderGCPSM, constants.CallGCPSMGetSecret, err)
+	err = parseError(err)
+	if err != nil {
+		return nil, err
+	}
+	metadata := make(map[string]string, len(secret.GetLabels())+len(secret.GetAnnotations()))
+	maps.Copy(metadata, secret.GetLabels())
+	maps.Copy(metadata, secret.GetAnnotations())
+	return metadata, nil
+}
+
 // GetSecretMap returns multiple k/v pairs from the provider.
 func (c *Client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
 	if c.smClient == nil || c.store.ProjectID == "" {
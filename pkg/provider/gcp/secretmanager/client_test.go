@@ -1108,6 +1108,60 @@ func TestGetSecretMap(t *testing.T) {
 	}
 }
 
+func TestGetSecretMetadata(t *testing.T) {
+	fErr := status.Error(codes.NotFound, "failed")
+	notFoundError, _ := apierror.FromError(fErr)
+	tests := map[string]struct {
+		getSecretOutput fakesm.SecretMockReturn
+		want            map[string]string
+		wantErr         error
+	}{
+		"labels and annotations": {
+			getSecretOutput: fakesm.SecretMockReturn{
+				Secret: &secretmanagerpb.Secret{
+					Name:        "projects/foo/secret/bar",
+					Labels:      map[string]string{"team": "payments", "env": "dev"},
+					Annotations: map[string]string{"description": "database password", "env": "prod"},
+				},
+			},
+			want: map[string]string{
+				"team":        "payments",
+				"env":         "prod",
+				"description": "database password",
+			},
+		},
+		"no metadata": {
+			getSecretOutput: fakesm.SecretMockReturn{
+				Secret: &secretmanagerpb.Secret{Name: "projects/foo/secret/bar"},
+			},
+			want: map[string]string{},
+		},
+		"secret not found": {
+			getSecretOutput: fakesm.SecretMockReturn{Err: notFoundError},
+			wantErr:         esv1beta1.NoSecretErr,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			smClient := fakesm.MockSMClient{}
+			smClient.NewGetSecretFn(tc.getSecretOutput)
+			client := Client{
+				smClient: &smClient,
+				store: &esv1beta1.GCPSMProvider{
+					ProjectID: "foo",
+				},
+			}
+			got, err := client.GetSecretMetadata(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "bar"})
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("unexpected error: got %v, want %v", err, tc.wantErr)
+			}
+			if tc.wantErr == nil && !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("unexpected metadata: expected %#v, got %#v", tc.want, got)
+			}
+		})
+	}
+}
+
 func ErrorContains(out error, want string) bool {
 	if out == nil {
 		return want == ""
@@ -1179,3 +1233,141 @@ func TestValidateStore(t *testing.T) {
 		})
 	}
 }
+
+func TestPushSecretVersions(t *testing.T) {
+	const secretName = "projects/foo/secrets/bar"
+	type want struct {
+		enabled  []string
+		disabled []string
+		err      bool
+		denied   bool
+	}
+	tests := map[string]struct {
+		versionsToKeep int32
+		denied         bool
+		pushes         []string
+		want           want
+	}{
+		"creates the secret with a first version": {
+			versionsToKeep: 2,
+			pushes:         []string{"a"},
+			want:           want{enabled: []string{"1"}},
+		},
+		"keeps all versions without a limit": {
+			pushes: []string{"a", "b", "c"},
+			want:   want{enabled: []string{"1", "2", "3"}},
+		},
+		"disables versions beyond the limit": {
+			versionsToKeep: 2,
+			pushes:         []string{"a", "b", "c", "d"},
+			want:           want{enabled: []string{"3", "4"}, disabled: []string{"1", "2"}},
+		},
+		"does not add a version for an unchanged value": {
+			versionsToKeep: 1,
+			pushes:         []string{"a", "a"},
+			want:           want{enabled: []string{"1"}},
+		},
+		"returns a permission error": {
+			denied: true,
+			pushes: []string{"a"},
+			want:   want{err: true, denied: true},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := fakesm.NewServer()
+			if tc.denied {
+				srv.Denied[secretName] = true
+			}
+			smClient, stop, err := srv.Client(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer stop()
+			client := Client{
+				smClient: smClient,
+				store: &esv1beta1.GCPSMProvider{
+					ProjectID:      "foo",
+					VersionsToKeep: tc.versionsToKeep,
+				},
+			}
+
+			for _, value := range tc.pushes {
+				secret := &corev1.Secret{Data: map[string][]byte{"key": []byte(value)}}
+				err = client.PushSecret(context.Background(), secret, testingfake.PushSecretData{SecretKey: "key", RemoteKey: "bar"})
+				if err != nil {
+					break
+				}
+			}
+			if (err != nil) != tc.want.err {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var permErr *PermissionDeniedError
+			if errors.As(err, &permErr) != tc.want.denied {
+				t.Fatalf("expected permission error %v, got %v", tc.want.denied, err)
+			}
+			if tc.want.err {
+				return
+			}
+
+			if got := srv.Secret(secretName).GetLabels()[managedByKey]; got != managedByValue {
+				t.Errorf("expected secret to be managed by external secrets, got label %q", got)
+			}
+			var enabled, disabled []string
+			for _, version := range srv.Versions(secretName) {
+				id := version.GetName()[strings.LastIndex(version.GetName(), "/")+1:]
+				if version.GetState() == secretmanagerpb.SecretVersion_ENABLED {
+					enabled = append(enabled, id)
+				} else {
+					disabled = append(disabled, id)
+				}
+			}
+			if !reflect.DeepEqual(enabled, tc.want.enabled) || !reflect.DeepEqual(disabled, tc.want.disabled) {
+				t.Errorf("unexpected versions: enabled %v disabled %v, want enabled %v disabled %v", enabled, disabled, tc.want.enabled, tc.want.disabled)
+			}
+		})
+	}
+}
+
+func TestDeleteSecretAndExists(t *testing.T) {
+	srv := fakesm.NewServer()
+	smClient, stop, err := srv.Client(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stop()
+	client := Client{
+		smClient: smClient,
+		store:    &esv1beta1.GCPSMProvider{ProjectID: "foo"},
+	}
+	ref := testingfake.PushSecretData{SecretKey: "key", RemoteKey: "bar"}
+
+	for _, value := range []string{"a", "b"} {
+		secret := &corev1.Secret{Data: map[string][]byte{"key": []byte(value)}}
+		if err := client.PushSecret(context.Background(), secret, ref); err != nil {
+			t.Fatal(err)
+		}
+	}
+	exists, err := client.SecretExists(context.Background(), ref)
+	if err != nil || !exists {
+		t.Fatalf("expected secret to exist, got %v, %v", exists, err)
+	}
+
+	if err := client.DeleteSecret(context.Background(), ref); err != nil {
+		t.Fatal(err)
+	}
+	if srv.Secret("projects/foo/secrets/bar") != nil || len(srv.Versions("projects/foo/secrets/bar")) != 0 {
+		t.Error("expected secret and its versions to be deleted")
+	}
+	exists, err = client.SecretExists(context.Background(), ref)
+	if err != nil || exists {
+		t.Fatalf("expected secret not to exist, got %v, %v", exists, err)
+	}
+
+	srv.Denied["projects/foo/secrets/bar"] = true
+	_, err = client.SecretExists(context.Background(), ref)
+	var permErr *PermissionDeniedError
+	if !errors.As(err, &permErr) {
+		t.Fatalf("expected permission error, got %v", err)
+	}
+}
@@ -0,0 +1,288 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	secretmanager "cloud.google.com/go/secretmanager/apiv1"
+	"cloud.google.com/go/secretmanager/apiv1/secretmanagerpb"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Server is an in-memory GCP Secret Manager gRPC server.
+// It implements the calls used by the provider to push and delete secrets.
+type Server struct {
+	secretmanagerpb.UnimplementedSecretManagerServiceServer
+
+	mu       sync.Mutex
+	secrets  map[string]*secretmanagerpb.Secret
+	versions map[string][]*secretmanagerpb.SecretVersion
+	payloads map[string][]byte
+	// Denied holds the names of secrets the caller is not allowed to access.
+	Denied map[string]bool
+}
+
+func NewServer() *Server {
+	return &Server{
+		secrets:  map[string]*secretmanagerpb.Secret{},
+		versions: map[string][]*secretmanagerpb.SecretVersion{},
+		payloads: map[string][]byte{},
+		Denied:   map[string]bool{},
+	}
+}
+
+// Client starts the server on an in-memory listener and returns a Secret Manager client connected to it.
+// The returned function stops the client and the server.
+func (s *Server) Client(ctx context.Context) (*secretmanager.Client, func(), error) {
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	secretmanagerpb.RegisterSecretManagerServiceServer(srv, s)
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		srv.Stop()
+		return nil, nil, err
+	}
+	client, err := secretmanager.NewClient(ctx, option.WithGRPCConn(conn))
+	if err != nil {
+		_ = conn.Close()
+		srv.Stop()
+		return nil, nil, err
+	}
+	return client, func() {
+		_ = client.Close()
+		srv.Stop()
+	}, nil
+}
+
+// Secret returns the secret with the given name or nil if it does not exist.
+func (s *Server) Secret(name string) *secretmanagerpb.Secret {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.secrets[name]
+}
+
+// Versions returns the versions of a secret, oldest first.
+func (s *Server) Versions(name string) []*secretmanagerpb.SecretVersion {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	versions := make([]*secretmanagerpb.SecretVersion, 0, len(s.versions[name]))
+	for _, version := range s.versions[name] {
+		versions = append(versions, proto.Clone(version).(*secretmanagerpb.SecretVersion))
+	}
+	return versions
+}
+
+// Payload returns the data of a secret version.
+func (s *Server) Payload(versionName string) []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.payloads[versionName]
+}
+
+func (s *Server) GetSecret(_ context.Context, req *secretmanagerpb.GetSecretRequest) (*secretmanagerpb.Secret, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkAccess(req.GetName()); err != nil {
+		return nil, err
+	}
+	secret, ok := s.secrets[req.GetName()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "secret %s not found", req.GetName())
+	}
+	return proto.Clone(secret).(*secretmanagerpb.Secret), nil
+}
+
+func (s *Server) CreateSecret(_ context.Context, req *secretmanagerpb.CreateSecretRequest) (*secretmanagerpb.Secret, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	name := fmt.Sprintf("%s/secrets/%s", req.GetParent(), req.GetSecretId())
+	if err := s.checkAccess(name); err != nil {
+		return nil, err
+	}
+	if _, ok := s.secrets[name]; ok {
+		return nil, status.Errorf(codes.AlreadyExists, "secret %s already exists", name)
+	}
+	secret := proto.Clone(req.GetSecret()).(*secretmanagerpb.Secret)
+	secret.Name = name
+	secret.Etag = "1"
+	s.secrets[name] = secret
+	return proto.Clone(secret).(*secretmanagerpb.Secret), nil
+}
+
+func (s *Server) UpdateSecret(_ context.Context, req *secretmanagerpb.UpdateSecretRequest) (*secretmanagerpb.Secret, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	name := req.GetSecret().GetName()
+	if err := s.checkAccess(name); err != nil {
+		return nil, err
+	}
+	secret, ok := s.secrets[name]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "secret %s not found", name)
+	}
+	secret.Labels = req.GetSecret().GetLabels()
+	secret.Annotations = req.GetSecret().GetAnnotations()
+	secret.Etag = bumpEtag(secret.Etag)
+	return proto.Clone(secret).(*secretmanagerpb.Secret), nil
+}
+
+func (s *Server) DeleteSecret(_ context.Context, req *secretmanagerpb.DeleteSecretRequest) (*emptypb.Empty, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkAccess(req.GetName()); err != nil {
+		return nil, err
+	}
+	secret, ok := s.secrets[req.GetName()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "secret %s not found", req.GetName())
+	}
+	if req.GetEtag() != "" && req.GetEtag() != secret.Etag {
+		return nil, status.Errorf(codes.Aborted, "etag mismatch for secret %s", req.GetName())
+	}
+	for _, version := range s.versions[req.GetName()] {
+		delete(s.payloads, version.Name)
+	}
+	delete(s.versions, req.GetName())
+	delete(s.secrets, req.GetName())
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) AddSecretVersion(_ context.Context, req *secretmanagerpb.AddSecretVersionRequest) (*secretmanagerpb.SecretVersion, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkAccess(req.GetParent()); err != nil {
+		return nil, err
+	}
+	if _, ok := s.secrets[req.GetParent()]; !ok {
+		return nil, status.Errorf(codes.NotFound, "secret %s not found", req.GetParent())
+	}
+	n := len(s.versions[req.GetParent()]) + 1
+	version := &secretmanagerpb.SecretVersion{
+		Name: fmt.Sprintf("%s/versions/%d", req.GetParent(), n),
+		// versions are created one second apart to keep their order stable
+		CreateTime: timestamppb.New(time.Unix(int64(n), 0)),
+		State:      secretmanagerpb.SecretVersion_ENABLED,
+		Etag:       "1",
+	}
+	s.versions[req.GetParent()] = append(s.versions[req.GetParent()], version)
+	s.payloads[version.Name] = req.GetPayload().GetData()
+	return proto.Clone(version).(*secretmanagerpb.SecretVersion), nil
+}
+
+func (s *Server) AccessSecretVersion(_ context.Context, req *secretmanagerpb.AccessSecretVersionRequest) (*secretmanagerpb.AccessSecretVersionResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	secretName, id, _ := strings.Cut(req.GetName(), "/versions/")
+	if err := s.checkAccess(secretName); err != nil {
+		return nil, err
+	}
+	versions := s.versions[secretName]
+	for i := len(versions) - 1; i >= 0; i-- {
+		version := versions[i]
+		if id == "latest" && version.State != secretmanagerpb.SecretVersion_ENABLED {
+			continue
+		}
+		if id != "latest" && version.Name != req.GetName() {
+			continue
+		}
+		if version.State != secretmanagerpb.SecretVersion_ENABLED {
+			return nil, status.Errorf(codes.FailedPrecondition, "version %s is disabled", version.Name)
+		}
+		return &secretmanagerpb.AccessSecretVersionResponse{
+			Name:    version.Name,
+			Payload: &secretmanagerpb.SecretPayload{Data: s.payloads[version.Name]},
+		}, nil
+	}
+	return nil, status.Errorf(codes.NotFound, "version %s not found", req.GetName())
+}
+
+// ListSecretVersions returns the versions of a secret newest first,
+// the only supported filter is state:ENABLED.
+func (s *Server) ListSecretVersions(_ context.Context, req *secretmanagerpb.ListSecretVersionsRequest) (*secretmanagerpb.ListSecretVersionsResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.checkAccess(req.GetParent()); err != nil {
+		return nil, err
+	}
+	if _, ok := s.secrets[req.GetParent()]; !ok {
+		return nil, status.Errorf(codes.NotFound, "secret %s not found", req.GetParent())
+	}
+	res := &secretmanagerpb.ListSecretVersionsResponse{}
+	versions := s.versions[req.GetParent()]
+	for i := len(versions) - 1; i >= 0; i-- {
+		if req.GetFilter() == "state:ENABLED" && versions[i].State != secretmanagerpb.SecretVersion_ENABLED {
+			continue
+		}
+		res.Versions = append(res.Versions, proto.Clone(versions[i]).(*secretmanagerpb.SecretVersion))
+	}
+	res.TotalSize = int32(len(res.Versions))
+	return res, nil
+}
+
+func (s *Server) DisableSecretVersion(_ context.Context, req *secretmanagerpb.DisableSecretVersionRequest) (*secretmanagerpb.SecretVersion, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	secretName, _, _ := strings.Cut(req.GetName(), "/versions/")
+	if err := s.checkAccess(secretName); err != nil {
+		return nil, err
+	}
+	for _, version := range s.versions[secretName] {
+		if version.Name != req.GetName() {
+			continue
+		}
+		if req.GetEtag() != "" && req.GetEtag() != version.Etag {
+			return nil, status.Errorf(codes.Aborted, "etag mismatch for version %s", req.GetName())
+		}
+		version.State = secretmanagerpb.SecretVersion_DISABLED
+		version.Etag = bumpEtag(version.Etag)
+		return proto.Clone(version).(*secretmanagerpb.SecretVersion), nil
+	}
+	return nil, status.Errorf(codes.NotFound, "version %s not found", req.GetName())
+}
+
+func (s *Server) checkAccess(name string) error {
+	if s.Denied[name] {
+		return status.Errorf(codes.PermissionDenied, "permission denied on %s", name)
+	}
+	return nil
+}
+
+func bumpEtag(etag string) string {
+	n, _ := strconv.Atoi(etag)
+	return strconv.Itoa(n + 1)
+}
@@ -35,6 +35,7 @@ type Provider struct{}
 
 // https://github.com/external-secrets/external-secrets/issues/644
 var _ esv1beta1.SecretsClient = &Client{}
+var _ esv1beta1.MetadataClient = &Client{}
 var _ esv1beta1.Provider = &Provider{}
 
 func init() {
@@ -0,0 +1,126 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/provider/gcp/secretmanager"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	defaultURL               = "https://iam.googleapis.com/v1"
+	defaultKeyRotationPeriod = 90 * 24 * time.Hour
+
+	errStoreIsNil                  = "store is nil"
+	errNoStoreTypeOrWrongStoreType = "no store type or wrong store type"
+	errInvalidRotationPeriod       = "keyRotationPeriod must be positive"
+	errInvalidAuthSecretRef        = "invalid auth.secretRef: %w"
+	errInvalidWISARef              = "invalid auth.workloadIdentity.serviceAccountRef: %w"
+	errUnableCreateTokenSource     = "unable to create token source: %w"
+	errInvalidKey                  = "invalid key %q: must be the email address of a service account"
+)
+
+// Provider satisfies the provider interface.
+type Provider struct{}
+
+var _ esv1beta1.Provider = &Provider{}
+
+func (p *Provider) NewClient(ctx context.Context, store esv1beta1.GenericStore, kube kclient.Client, namespace string) (esv1beta1.SecretsClient, error) {
+	cfg, err := getConfig(store)
+	if err != nil {
+		return nil, err
+	}
+	projectID := cfg.ProjectID
+	if cfg.Auth.WorkloadIdentity != nil && cfg.Auth.WorkloadIdentity.ClusterProjectID != "" {
+		projectID = cfg.Auth.WorkloadIdentity.ClusterProjectID
+	}
+	ts, err := secretmanager.NewTokenSource(ctx, cfg.Auth, projectID, store.GetKind(), kube, namespace)
+	if err != nil {
+		return nil, fmt.Errorf(errUnableCreateTokenSource, err)
+	}
+	httpClient := oauth2.NewClient(context.Background(), ts)
+	httpClient.Timeout = 30 * time.Second
+	return newClient(httpClient, defaultURL, keyRotationPeriod(cfg)), nil
+}
+
+func keyRotationPeriod(cfg *esv1beta1.GoogleWorkspaceProvider) time.Duration {
+	if cfg.KeyRotationPeriod == nil {
+		return defaultKeyRotationPeriod
+	}
+	return cfg.KeyRotationPeriod.Duration
+}
+
+func getConfig(store esv1beta1.GenericStore) (*esv1beta1.GoogleWorkspaceProvider, error) {
+	if store == nil {
+		return nil, errors.New(errStoreIsNil)
+	}
+	spec := store.GetSpec()
+	if spec == nil || spec.Provider == nil || spec.Provider.GoogleWorkspace == nil {
+		return nil, errors.New(errNoStoreTypeOrWrongStoreType)
+	}
+	cfg := spec.Provider.GoogleWorkspace
+	if keyRotationPeriod(cfg) <= 0 {
+		return nil, errors.New(errInvalidRotationPeriod)
+	}
+	if cfg.Auth.SecretRef != nil {
+		if err := utils.ValidateReferentSecretSelector(store, cfg.Auth.SecretRef.SecretAccessKey); err != nil {
+			return nil, fmt.Errorf(errInvalidAuthSecretRef, err)
+		}
+	}
+	if cfg.Auth.WorkloadIdentity != nil {
+		if err := utils.ValidateReferentServiceAccountSelector(store, cfg.Auth.WorkloadIdentity.ServiceAccountRef); err != nil {
+			return nil, fmt.Errorf(errInvalidWISARef, err)
+		}
+	}
+	return cfg, nil
+}
+
+func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnings, error) {
+	_, err := getConfig(store)
+	return nil, err
+}
+
+// ValidateRemoteRef checks that the key is the email address of a service account.
+func (p *Provider) ValidateRemoteRef(_ esv1beta1.GenericStore, ref esv1beta1.ExternalSecretDataRemoteRef) error {
+	return validateEmail(ref.Key)
+}
+
+func validateEmail(email string) error {
+	if i := strings.Index(email, "@"); i <= 0 || i == len(email)-1 || strings.ContainsAny(email, "/?#") {
+		return fmt.Errorf(errInvalidKey, email)
+	}
+	return nil
+}
+
+func (p *Provider) Capabilities() esv1beta1.SecretStoreCapabilities {
+	return esv1beta1.SecretStoreReadOnly
+}
+
+func init() {
+	esv1beta1.Register(&Provider{}, &esv1beta1.SecretStoreProvider{
+		GoogleWorkspace: &esv1beta1.GoogleWorkspaceProvider{},
+	})
+}
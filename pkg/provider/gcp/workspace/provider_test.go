@@ -0,0 +1,81 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+func TestValidateStore(t *testing.T) {
+	namespace := "other"
+	tests := map[string]struct {
+		cfg     esv1beta1.GoogleWorkspaceProvider
+		wantErr string
+	}{
+		"valid": {
+			cfg: esv1beta1.GoogleWorkspaceProvider{},
+		},
+		"valid rotation period": {
+			cfg: esv1beta1.GoogleWorkspaceProvider{KeyRotationPeriod: &metav1.Duration{Duration: 24 * time.Hour}},
+		},
+		"zero rotation period": {
+			cfg:     esv1beta1.GoogleWorkspaceProvider{KeyRotationPeriod: &metav1.Duration{}},
+			wantErr: errInvalidRotationPeriod,
+		},
+		"credentials in other namespace": {
+			cfg: esv1beta1.GoogleWorkspaceProvider{
+				Auth: esv1beta1.GCPSMAuth{
+					SecretRef: &esv1beta1.GCPSMAuthSecretRef{
+						SecretAccessKey: esmeta.SecretKeySelector{Name: "gcp", Key: "credentials", Namespace: &namespace},
+					},
+				},
+			},
+			wantErr: "invalid auth.secretRef",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := esv1beta1.SecretStore{
+				Spec: esv1beta1.SecretStoreSpec{
+					Provider: &esv1beta1.SecretStoreProvider{
+						GoogleWorkspace: &tc.cfg,
+					},
+				},
+			}
+			p := &Provider{}
+			_, err := p.ValidateStore(&s)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestValidateRemoteRef(t *testing.T) {
+	p := &Provider{}
+	assert.NoError(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: "directory-sync@acme-admin.iam.gserviceaccount.com"}))
+	for _, key := range []string{"", "directory-sync", "@acme-admin.iam.gserviceaccount.com", "directory-sync@", "a@b/keys"} {
+		assert.Error(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: key}), key)
+	}
+}
@@ -0,0 +1,276 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workspace
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	errReadOnly         = "the Google Workspace provider can not push secrets"
+	errFindAll          = "the Google Workspace provider can not find secrets"
+	errUnexpectedStatus = "unexpected status code from the IAM API: %d: %s"
+	errCreateKey        = "unable to create a key for service account %q: %w"
+	errDecodeKey        = "unable to decode the key of service account %q: %w"
+	errGetKey           = "unable to get key %s: %w"
+	errKeyDisabled      = "key %s is disabled"
+	errDeleteKey        = "unable to delete key %s: %w"
+	errPropertyNotFound = "the key of service account %q has no field %q"
+)
+
+// client issues service account keys with the IAM API. The private key of a service
+// account key can only be read when it is created, so every sync creates a key that
+// is recorded as a lease. It is rotated once it is older than the rotation period.
+// https://cloud.google.com/iam/docs/reference/rest/v1/projects.serviceAccounts.keys
+type client struct {
+	httpClient     *http.Client
+	url            string
+	rotationPeriod time.Duration
+	now            func() time.Time
+
+	// key files created by the client, by service account email, so that
+	// the values of separate remoteRefs belong to the same key
+	keys map[string][]byte
+	// names of the keys created since the last call to IssuedLeases
+	leases []string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+var _ esv1beta1.LeaseClient = &client{}
+
+// KeyClient issues keys of service accounts and records them as leases.
+type KeyClient interface {
+	esv1beta1.SecretsClient
+	esv1beta1.LeaseClient
+}
+
+// NewKeyClient returns a client that issues keys of service accounts with the IAM API at apiURL,
+// for providers of other Google products that authenticate with service account keys.
+func NewKeyClient(httpClient *http.Client, apiURL string, rotationPeriod time.Duration) KeyClient {
+	return newClient(httpClient, apiURL, rotationPeriod)
+}
+
+func newClient(httpClient *http.Client, apiURL string, rotationPeriod time.Duration) *client {
+	return &client{
+		httpClient:     httpClient,
+		url:            apiURL,
+		rotationPeriod: rotationPeriod,
+		now:            time.Now,
+	}
+}
+
+type serviceAccountKey struct {
+	Name            string    `json:"name"`
+	PrivateKeyData  string    `json:"privateKeyData"`
+	ValidAfterTime  time.Time `json:"validAfterTime"`
+	ValidBeforeTime time.Time `json:"validBeforeTime"`
+	Disabled        bool      `json:"disabled"`
+}
+
+// GetSecret creates a key for the service account ref.Key and returns its JSON key file,
+// or one of its fields if a property is set.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	keyFile, err := c.keyFile(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Property == "" {
+		return keyFile, nil
+	}
+	fields, err := keyFields(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf(errDecodeKey, ref.Key, err)
+	}
+	value, ok := fields[ref.Property]
+	if !ok {
+		return nil, fmt.Errorf(errPropertyNotFound, ref.Key, ref.Property)
+	}
+	return value, nil
+}
+
+// GetSecretMap creates a key for the service account ref.Key and returns the fields of its JSON key file.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	keyFile, err := c.keyFile(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	fields, err := keyFields(keyFile)
+	if err != nil {
+		return nil, fmt.Errorf(errDecodeKey, ref.Key, err)
+	}
+	return fields, nil
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindAll)
+}
+
+func (c *client) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
+	return false, errors.New(errReadOnly)
+}
+
+// Validate is not implemented: the permissions can only be checked on a service account.
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	return esv1beta1.ValidationResultUnknown, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+// IssuedLeases returns the names of the keys created since the last call.
+func (c *client) IssuedLeases() []string {
+	leases := c.leases
+	c.leases = nil
+	return leases
+}
+
+// RenewLease checks that a key is still enabled and returns the time until it is due for rotation,
+// which is the rotation period after its create time or its expiry, whichever comes first.
+func (c *client) RenewLease(ctx context.Context, leaseID string, _ time.Duration) (time.Duration, error) {
+	var key serviceAccountKey
+	if err := c.do(ctx, http.MethodGet, "/"+leaseID, nil, &key); err != nil {
+		return 0, fmt.Errorf(errGetKey, leaseID, err)
+	}
+	if key.Disabled {
+		return 0, fmt.Errorf(errKeyDisabled, leaseID)
+	}
+	rotateAt := key.ValidAfterTime.Add(c.rotationPeriod)
+	if !key.ValidBeforeTime.IsZero() && key.ValidBeforeTime.Before(rotateAt) {
+		rotateAt = key.ValidBeforeTime
+	}
+	return rotateAt.Sub(c.now()), nil
+}
+
+// RevokeLease deletes a key, a key which has already been deleted is not an error.
+func (c *client) RevokeLease(ctx context.Context, leaseID string) error {
+	err := c.do(ctx, http.MethodDelete, "/"+leaseID, nil, nil)
+	if err != nil && !errors.Is(err, esv1beta1.NoSecretError{}) {
+		return fmt.Errorf(errDeleteKey, leaseID, err)
+	}
+	return nil
+}
+
+// keyFile returns the JSON key file of a key of the service account, creating the key on the first call.
+func (c *client) keyFile(ctx context.Context, email string) ([]byte, error) {
+	if keyFile, ok := c.keys[email]; ok {
+		return keyFile, nil
+	}
+	if err := validateEmail(email); err != nil {
+		return nil, err
+	}
+	var key serviceAccountKey
+	err := c.do(ctx, http.MethodPost, "/projects/-/serviceAccounts/"+url.PathEscape(email)+"/keys", map[string]string{
+		"privateKeyType": "TYPE_GOOGLE_CREDENTIALS_FILE",
+		"keyAlgorithm":   "KEY_ALG_RSA_2048",
+	}, &key)
+	if errors.Is(err, esv1beta1.NoSecretError{}) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf(errCreateKey, email, err)
+	}
+	keyFile, err := base64.StdEncoding.DecodeString(key.PrivateKeyData)
+	if err != nil {
+		// the key is not recorded as a lease yet, do not leave it behind
+		if derr := c.RevokeLease(ctx, key.Name); derr != nil {
+			err = errors.Join(err, derr)
+		}
+		return nil, fmt.Errorf(errDecodeKey, email, err)
+	}
+	if c.keys == nil {
+		c.keys = make(map[string][]byte)
+	}
+	c.keys[email] = keyFile
+	c.leases = append(c.leases, key.Name)
+	return keyFile, nil
+}
+
+// keyFields returns the fields of a JSON key file.
+func keyFields(keyFile []byte) (map[string][]byte, error) {
+	var fields map[string]any
+	if err := json.Unmarshal(keyFile, &fields); err != nil {
+		return nil, err
+	}
+	out := make(map[string][]byte, len(fields))
+	for k, v := range fields {
+		b, err := utils.GetByteValue(v)
+		if err != nil {
+			return nil, err
+		}
+		out[k] = b
+	}
+	return out, nil
+}
+
+func (c *client) do(ctx context.Context, method, path string, body, target any) error {
+	var reqBody io.Reader = http.NoBody
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, reqBody)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return esv1beta1.NoSecretError{}
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf(errUnexpectedStatus, resp.StatusCode, respBody)
+	}
+	if target == nil {
+		return nil
+	}
+	return json.Unmarshal(respBody, target)
+}
@@ -0,0 +1,272 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package githubsecrets
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/crypto/nacl/box"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	errWriteOnly          = "GitHub Actions secrets are write only, their values can not be read back: use a PushSecret to write them"
+	errUnexpectedStatus   = "unexpected status code from GitHub: %d: %s"
+	errUnmarshalResponse  = "unable to unmarshal GitHub response: %w"
+	errInstallationToken  = "unable to create an installation access token: %w"
+	errInvalidKey         = "key %q must have the format <org>/<secret name> or <owner>/<repo>/<secret name>"
+	errInvalidSecretName  = "%q is not a valid secret name: it may only contain alphanumeric characters and underscores, must not start with a number or GITHUB_"
+	errPropertyNotAllowed = "property is not supported, GitHub Actions secrets hold a single value"
+	errInvalidPublicKey   = "invalid public key %q returned by GitHub"
+)
+
+var secretNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// client writes Actions secrets with the GitHub REST API, authenticated as an installation of a GitHub App.
+// https://docs.github.com/en/rest/actions/secrets
+type client struct {
+	httpClient     *http.Client
+	url            string
+	appID          string
+	installationID string
+	privateKey     *rsa.PrivateKey
+	visibility     string
+	// token is the installation access token, it is requested with the first call.
+	token string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+type publicKey struct {
+	KeyID string `json:"key_id"`
+	Key   string `json:"key"`
+}
+
+// secretPath returns the path of the secrets of an organization or repository
+// and the name of the secret from a key.
+func secretPath(key string) (string, string, error) {
+	parts := strings.Split(key, "/")
+	for _, p := range parts {
+		if p == "" {
+			return "", "", fmt.Errorf(errInvalidKey, key)
+		}
+	}
+	var scope string
+	switch len(parts) {
+	case 2:
+		scope = "/orgs/" + url.PathEscape(parts[0])
+	case 3:
+		scope = "/repos/" + url.PathEscape(parts[0]) + "/" + url.PathEscape(parts[1])
+	default:
+		return "", "", fmt.Errorf(errInvalidKey, key)
+	}
+	name := parts[len(parts)-1]
+	if !secretNameRegexp.MatchString(name) || strings.HasPrefix(strings.ToUpper(name), "GITHUB_") {
+		return "", "", fmt.Errorf(errInvalidSecretName, name)
+	}
+	return scope + "/actions/secrets", name, nil
+}
+
+// PushSecret encrypts the value with the public key of the organization or
+// repository and creates or updates the secret.
+func (c *client) PushSecret(ctx context.Context, secret *corev1.Secret, data esv1beta1.PushSecretData) error {
+	if data.GetProperty() != "" {
+		return errors.New(errPropertyNotAllowed)
+	}
+	path, name, err := secretPath(data.GetRemoteKey())
+	if err != nil {
+		return err
+	}
+	value, err := utils.PushSecretValue(secret, data.GetSecretKey())
+	if err != nil {
+		return err
+	}
+
+	var key publicKey
+	if err := c.do(ctx, http.MethodGet, path+"/public-key", nil, &key); err != nil {
+		return err
+	}
+	encrypted, err := seal(key.Key, value)
+	if err != nil {
+		return err
+	}
+	body := map[string]string{
+		"encrypted_value": encrypted,
+		"key_id":          key.KeyID,
+	}
+	if strings.HasPrefix(path, "/orgs/") {
+		body["visibility"] = c.visibility
+	}
+	return c.do(ctx, http.MethodPut, path+"/"+name, body, nil)
+}
+
+// DeleteSecret deletes the secret, a missing secret is not an error.
+func (c *client) DeleteSecret(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) error {
+	path, name, err := secretPath(remoteRef.GetRemoteKey())
+	if err != nil {
+		return err
+	}
+	err = c.do(ctx, http.MethodDelete, path+"/"+name, nil, nil)
+	if errors.Is(err, esv1beta1.NoSecretError{}) {
+		return nil
+	}
+	return err
+}
+
+// SecretExists checks if the secret exists, its value can not be compared.
+func (c *client) SecretExists(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) (bool, error) {
+	path, name, err := secretPath(remoteRef.GetRemoteKey())
+	if err != nil {
+		return false, err
+	}
+	err = c.do(ctx, http.MethodGet, path+"/"+name, nil, nil)
+	if errors.Is(err, esv1beta1.NoSecretError{}) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (c *client) GetSecret(_ context.Context, _ esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	return nil, errors.New(errWriteOnly)
+}
+
+func (c *client) GetSecretMap(_ context.Context, _ esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	return nil, errors.New(errWriteOnly)
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errWriteOnly)
+}
+
+// Validate creates an installation access token.
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	if err := c.authenticate(context.Background()); err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+// seal encrypts the value into a libsodium sealed box for the base64 encoded public key.
+func seal(key string, value []byte) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil || len(raw) != 32 {
+		return "", fmt.Errorf(errInvalidPublicKey, key)
+	}
+	var recipient [32]byte
+	copy(recipient[:], raw)
+	sealed, err := box.SealAnonymous(nil, value, &recipient, rand.Reader)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// authenticate exchanges a JWT signed with the private key of the App for an installation access token.
+func (c *client) authenticate(ctx context.Context) error {
+	now := time.Now()
+	appToken, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.RegisteredClaims{
+		Issuer: c.appID,
+		// allow for clock drift with GitHub
+		IssuedAt:  jwt.NewNumericDate(now.Add(-time.Minute)),
+		ExpiresAt: jwt.NewNumericDate(now.Add(5 * time.Minute)),
+	}).SignedString(c.privateKey)
+	if err != nil {
+		return fmt.Errorf(errInstallationToken, err)
+	}
+	var out struct {
+		Token string `json:"token"`
+	}
+	path := "/app/installations/" + url.PathEscape(c.installationID) + "/access_tokens"
+	if err := c.request(ctx, http.MethodPost, path, appToken, nil, &out); err != nil {
+		return fmt.Errorf(errInstallationToken, err)
+	}
+	c.token = out.Token
+	return nil
+}
+
+// do sends a request authenticated with the installation access token.
+func (c *client) do(ctx context.Context, method, path string, body, target any) error {
+	if c.token == "" {
+		if err := c.authenticate(ctx); err != nil {
+			return err
+		}
+	}
+	return c.request(ctx, method, path, c.token, body, target)
+}
+
+func (c *client) request(ctx context.Context, method, path, token string, body, target any) error {
+	var reqBody io.Reader = http.NoBody
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(c.url, "/")+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return esv1beta1.NoSecretError{}
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf(errUnexpectedStatus, resp.StatusCode, respBody)
+	}
+	if target == nil {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, target); err != nil {
+		return fmt.Errorf(errUnmarshalResponse, err)
+	}
+	return nil
+}
@@ -0,0 +1,239 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package githubsecrets
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/nacl/box"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1alpha1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1alpha1"
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	testAppID          = "123"
+	testInstallationID = "456"
+	testToken          = "ghs_installation"
+)
+
+// fakeGitHub implements the endpoints of the GitHub REST API used by the client.
+type fakeGitHub struct {
+	t          *testing.T
+	appKey     *rsa.PublicKey
+	publicKey  *[32]byte
+	privateKey *[32]byte
+
+	mu sync.Mutex
+	// secrets holds the request bodies of the created secrets by path.
+	secrets map[string]map[string]string
+}
+
+func newFakeGitHub(t *testing.T, appKey *rsa.PublicKey) *fakeGitHub {
+	pub, priv, err := box.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return &fakeGitHub{t: t, appKey: appKey, publicKey: pub, privateKey: priv, secrets: map[string]map[string]string{}}
+}
+
+func (f *fakeGitHub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	auth := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+
+	if r.URL.Path == "/app/installations/"+testInstallationID+"/access_tokens" {
+		claims := &jwt.RegisteredClaims{}
+		_, err := jwt.ParseWithClaims(auth, claims, func(*jwt.Token) (any, error) { return f.appKey, nil })
+		if err != nil || claims.Issuer != testAppID || r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"token":"` + testToken + `"}`))
+		return
+	}
+	if auth != testToken {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if strings.HasSuffix(r.URL.Path, "/actions/secrets/public-key") {
+		_ = json.NewEncoder(w).Encode(publicKey{KeyID: "key-1", Key: base64.StdEncoding.EncodeToString(f.publicKey[:])})
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		if _, ok := f.secrets[r.URL.Path]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"name":"x"}`))
+	case http.MethodPut:
+		body := map[string]string{}
+		require.NoError(f.t, json.NewDecoder(r.Body).Decode(&body))
+		f.secrets[r.URL.Path] = body
+		w.WriteHeader(http.StatusCreated)
+	case http.MethodDelete:
+		if _, ok := f.secrets[r.URL.Path]; !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		delete(f.secrets, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// open decrypts the value of a secret pushed to path.
+func (f *fakeGitHub) open(path string) (string, map[string]string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	body, ok := f.secrets[path]
+	require.True(f.t, ok, "secret %s not found", path)
+	sealed, err := base64.StdEncoding.DecodeString(body["encrypted_value"])
+	require.NoError(f.t, err)
+	plain, ok := box.OpenAnonymous(nil, sealed, f.publicKey, f.privateKey)
+	require.True(f.t, ok, "unable to decrypt secret %s", path)
+	return string(plain), body
+}
+
+func newTestClient(t *testing.T) (*client, *fakeGitHub) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	fake := newFakeGitHub(t, &key.PublicKey)
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	return &client{
+		httpClient:     srv.Client(),
+		url:            srv.URL,
+		appID:          testAppID,
+		installationID: testInstallationID,
+		privateKey:     key,
+		visibility:     "private",
+	}, fake
+}
+
+func pushData(secretKey, remoteKey string) esv1alpha1.PushSecretData {
+	return esv1alpha1.PushSecretData{
+		Match: esv1alpha1.PushSecretMatch{
+			SecretKey: secretKey,
+			RemoteRef: esv1alpha1.PushSecretRemoteRef{RemoteKey: remoteKey},
+		},
+	}
+}
+
+func TestPushSecret(t *testing.T) {
+	c, fake := newTestClient(t)
+	secret := &corev1.Secret{Data: map[string][]byte{"token": []byte("abc"), "user": []byte("deploy")}}
+
+	require.NoError(t, c.PushSecret(context.Background(), secret, pushData("token", "acme/api/DEPLOY_TOKEN")))
+	value, body := fake.open("/repos/acme/api/actions/secrets/DEPLOY_TOKEN")
+	assert.Equal(t, "abc", value)
+	assert.Equal(t, "key-1", body["key_id"])
+	assert.NotContains(t, body, "visibility")
+
+	require.NoError(t, c.PushSecret(context.Background(), secret, pushData("", "acme/DEPLOY")))
+	value, body = fake.open("/orgs/acme/actions/secrets/DEPLOY")
+	assert.JSONEq(t, `{"token":"abc","user":"deploy"}`, value)
+	assert.Equal(t, "private", body["visibility"])
+
+	err := c.PushSecret(context.Background(), secret, esv1alpha1.PushSecretData{
+		Match: esv1alpha1.PushSecretMatch{
+			SecretKey: "token",
+			RemoteRef: esv1alpha1.PushSecretRemoteRef{RemoteKey: "acme/DEPLOY", Property: "token"},
+		},
+	})
+	assert.ErrorContains(t, err, "property is not supported")
+
+	err = c.PushSecret(context.Background(), secret, pushData("password", "acme/api/DEPLOY_TOKEN"))
+	assert.ErrorContains(t, err, `key "password" not found in secret`)
+	value, _ = fake.open("/repos/acme/api/actions/secrets/DEPLOY_TOKEN")
+	assert.Equal(t, "abc", value)
+}
+
+func TestPushSecretInvalidKey(t *testing.T) {
+	c, _ := newTestClient(t)
+	secret := &corev1.Secret{Data: map[string][]byte{"token": []byte("abc")}}
+	tests := map[string]string{
+		"no scope":        "DEPLOY_TOKEN",
+		"too many parts":  "acme/api/env/DEPLOY_TOKEN",
+		"empty part":      "acme//DEPLOY_TOKEN",
+		"reserved prefix": "acme/github_token",
+		"leading digit":   "acme/1TOKEN",
+		"dash":            "acme/DEPLOY-TOKEN",
+	}
+	for name, key := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, c.PushSecret(context.Background(), secret, pushData("token", key)))
+		})
+	}
+}
+
+func TestSecretExistsAndDelete(t *testing.T) {
+	c, _ := newTestClient(t)
+	secret := &corev1.Secret{Data: map[string][]byte{"token": []byte("abc")}}
+	ref := esv1alpha1.PushSecretRemoteRef{RemoteKey: "acme/api/DEPLOY_TOKEN"}
+
+	exists, err := c.SecretExists(context.Background(), ref)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	require.NoError(t, c.PushSecret(context.Background(), secret, pushData("token", ref.RemoteKey)))
+	exists, err = c.SecretExists(context.Background(), ref)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	require.NoError(t, c.DeleteSecret(context.Background(), ref))
+	exists, err = c.SecretExists(context.Background(), ref)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// deleting a missing secret succeeds
+	require.NoError(t, c.DeleteSecret(context.Background(), ref))
+}
+
+func TestGetSecretIsNotSupported(t *testing.T) {
+	c, _ := newTestClient(t)
+	_, err := c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "acme/api/DEPLOY_TOKEN"})
+	assert.ErrorContains(t, err, "write only")
+	_, err = c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "acme/api/DEPLOY_TOKEN"})
+	assert.ErrorContains(t, err, "write only")
+	_, err = c.GetAllSecrets(context.Background(), esv1beta1.ExternalSecretFind{})
+	assert.ErrorContains(t, err, "write only")
+}
+
+func TestValidate(t *testing.T) {
+	c, _ := newTestClient(t)
+	res, err := c.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	c.privateKey = other
+	res, err = c.Validate()
+	assert.ErrorContains(t, err, "unable to create an installation access token")
+	assert.Equal(t, esv1beta1.ValidationResultError, res)
+}
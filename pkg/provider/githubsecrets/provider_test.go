@@ -0,0 +1,79 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package githubsecrets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+func TestValidateStore(t *testing.T) {
+	namespace := "other"
+	validAuth := esv1beta1.GitHubSecretsAuth{
+		PrivateKey: esmeta.SecretKeySelector{Name: "github-app", Key: "private-key"},
+	}
+	tests := map[string]struct {
+		cfg     esv1beta1.GitHubSecretsProvider
+		wantErr string
+	}{
+		"valid": {
+			cfg: esv1beta1.GitHubSecretsProvider{AppID: "123", InstallationID: "456", Auth: validAuth},
+		},
+		"invalid url": {
+			cfg:     esv1beta1.GitHubSecretsProvider{URL: "api.github.com", AppID: "123", InstallationID: "456", Auth: validAuth},
+			wantErr: `invalid url "api.github.com"`,
+		},
+		"missing app id": {
+			cfg:     esv1beta1.GitHubSecretsProvider{InstallationID: "456", Auth: validAuth},
+			wantErr: errAppIDRequired,
+		},
+		"missing installation id": {
+			cfg:     esv1beta1.GitHubSecretsProvider{AppID: "123", Auth: validAuth},
+			wantErr: errInstallationIDRequired,
+		},
+		"private key in other namespace": {
+			cfg: esv1beta1.GitHubSecretsProvider{
+				AppID:          "123",
+				InstallationID: "456",
+				Auth: esv1beta1.GitHubSecretsAuth{
+					PrivateKey: esmeta.SecretKeySelector{Name: "github-app", Key: "private-key", Namespace: &namespace},
+				},
+			},
+			wantErr: "namespace should either be empty or match the namespace of the SecretStore",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := esv1beta1.SecretStore{
+				Spec: esv1beta1.SecretStoreSpec{
+					Provider: &esv1beta1.SecretStoreProvider{
+						GitHubSecrets: &tc.cfg,
+					},
+				},
+			}
+			p := &Provider{}
+			_, err := p.ValidateStore(&s)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
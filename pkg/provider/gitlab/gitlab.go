@@ -50,6 +50,7 @@ const (
 	errPathNotImplemented                     = "'find.path' is not implemented in the GitLab provider"
 	errJSONSecretUnmarshal                    = "unable to unmarshal secret: %w"
 	errNotImplemented                         = "not implemented"
+	errInvalidVariablePath                    = "key %q must have the format <namespace>/<project>/<variable> or <group>/<variable>"
 )
 
 // https://github.com/external-secrets/external-secrets/issues/644
@@ -205,6 +206,11 @@ func (g *gitlabBase) GetSecret(_ context.Context, ref esv1beta1.ExternalSecretDa
 		return nil, fmt.Errorf(errUninitializedGitlabProvider)
 	}
 
+	// variable keys can't contain slashes, a key with a path addresses a project or group
+	if strings.Contains(ref.Key, "/") {
+		return g.getVariableByPath(ref)
+	}
+
 	// Need to replace hyphens with underscores to work with GitLab API
 	ref.Key = strings.ReplaceAll(ref.Key, "-", "_")
 	// Retrieves a gitlab variable in the form
@@ -265,6 +271,50 @@ func (g *gitlabBase) GetSecret(_ context.Context, ref esv1beta1.ExternalSecretDa
 	return nil, err
 }
 
+// getVariableByPath returns a variable of the project or group addressed by the key, i.e.
+// <namespace>/<project>/<variable> for a project or <group>/<variable> for a group.
+// Projects and groups share their paths, so the project is looked up first and a
+// missing project variable falls back to the group with the same path.
+func (g *gitlabBase) getVariableByPath(ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	i := strings.LastIndex(ref.Key, "/")
+	path, key := ref.Key[:i], strings.ReplaceAll(ref.Key[i+1:], "-", "_")
+	if path == "" || key == "" {
+		return nil, fmt.Errorf(errInvalidVariablePath, ref.Key)
+	}
+
+	var vopts *gitlab.GetProjectVariableOptions
+	if g.store.Environment != "" {
+		vopts = &gitlab.GetProjectVariableOptions{Filter: &gitlab.VariableFilter{EnvironmentScope: g.store.Environment}}
+	}
+	data, resp, err := g.projectVariablesClient.GetVariable(path, key, vopts)
+	metrics.ObserveAPICall(constants.ProviderGitLab, constants.CallGitLabProjectVariableGet, err)
+	if !isEmptyOrWildcard(g.store.Environment) && isNotFound(resp) {
+		vopts.Filter.EnvironmentScope = "*"
+		data, resp, err = g.projectVariablesClient.GetVariable(path, key, vopts)
+		metrics.ObserveAPICall(constants.ProviderGitLab, constants.CallGitLabProjectVariableGet, err)
+	}
+	if !isNotFound(resp) {
+		if err != nil {
+			return nil, err
+		}
+		return extractVariable(ref, data.Value)
+	}
+
+	groupVar, resp, err := g.groupVariablesClient.GetVariable(path, key)
+	metrics.ObserveAPICall(constants.ProviderGitLab, constants.CallGitLabGroupGetVariable, err)
+	if isNotFound(resp) {
+		return nil, esv1beta1.NoSecretError{}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return extractVariable(ref, groupVar.Value)
+}
+
+func isNotFound(resp *gitlab.Response) bool {
+	return resp != nil && resp.Response != nil && resp.StatusCode == http.StatusNotFound
+}
+
 func extractVariable(ref esv1beta1.ExternalSecretDataRemoteRef, value string) ([]byte, error) {
 	if ref.Property == "" {
 		if value != "" {
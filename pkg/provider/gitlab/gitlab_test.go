@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"slices"
 	"strings"
 	"testing"
 
@@ -451,6 +452,84 @@ func TestGetSecret(t *testing.T) {
 	}
 }
 
+// pathVariablesClient serves the variables of projects and groups by their full path.
+type pathVariablesClient struct {
+	variables map[string]string
+	requested []string
+}
+
+func (c *pathVariablesClient) get(id any, key string) (string, *gitlab.Response) {
+	c.requested = append(c.requested, fmt.Sprintf("%v/%s", id, key))
+	v, ok := c.variables[fmt.Sprintf("%v/%s", id, key)]
+	if !ok {
+		return "", &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+	}
+	return v, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusOK}}
+}
+
+func (c *pathVariablesClient) GetVariable(pid any, key string, _ *gitlab.GetProjectVariableOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.ProjectVariable, *gitlab.Response, error) {
+	v, resp := c.get(pid, key)
+	return &gitlab.ProjectVariable{Key: key, Value: v}, resp, nil
+}
+
+func (c *pathVariablesClient) ListVariables(_ any, _ *gitlab.ListProjectVariablesOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.ProjectVariable, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+type pathGroupVariablesClient struct {
+	*pathVariablesClient
+}
+
+func (c pathGroupVariablesClient) GetVariable(gid any, key string, _ ...gitlab.RequestOptionFunc) (*gitlab.GroupVariable, *gitlab.Response, error) {
+	v, resp := c.get(gid, key)
+	return &gitlab.GroupVariable{Key: key, Value: v}, resp, nil
+}
+
+func (c pathGroupVariablesClient) ListVariables(_ any, _ *gitlab.ListGroupVariablesOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.GroupVariable, *gitlab.Response, error) {
+	return nil, nil, nil
+}
+
+func TestGetSecretByPath(t *testing.T) {
+	projects := &pathVariablesClient{variables: map[string]string{
+		"acme/shop/DB_PASSWORD": "project-secret",
+	}}
+	groups := &pathVariablesClient{variables: map[string]string{
+		"acme/DB_PASSWORD":      "group-secret",
+		"acme/infra/DB_CONFIG":  `{"user":"admin"}`,
+		"acme/shop/DB_PASSWORD": "never read, projects take precedence",
+	}}
+	sm := gitlabBase{
+		store:                  &esv1beta1.GitlabProvider{ProjectID: project},
+		projectVariablesClient: projects,
+		groupVariablesClient:   pathGroupVariablesClient{groups},
+	}
+	tests := []struct {
+		ref         esv1beta1.ExternalSecretDataRemoteRef
+		want        string
+		expectError string
+	}{
+		{ref: esv1beta1.ExternalSecretDataRemoteRef{Key: "acme/shop/DB_PASSWORD"}, want: "project-secret"},
+		{ref: esv1beta1.ExternalSecretDataRemoteRef{Key: "acme/shop/DB-PASSWORD"}, want: "project-secret"},
+		{ref: esv1beta1.ExternalSecretDataRemoteRef{Key: "acme/DB_PASSWORD"}, want: "group-secret"},
+		{ref: esv1beta1.ExternalSecretDataRemoteRef{Key: "acme/infra/DB_CONFIG", Property: "user"}, want: "admin"},
+		{ref: esv1beta1.ExternalSecretDataRemoteRef{Key: "acme/shop/API_TOKEN"}, expectError: esv1beta1.NoSecretError{}.Error()},
+		{ref: esv1beta1.ExternalSecretDataRemoteRef{Key: "acme/"}, expectError: "must have the format"},
+		{ref: esv1beta1.ExternalSecretDataRemoteRef{Key: "/DB_PASSWORD"}, expectError: "must have the format"},
+	}
+	for k, tc := range tests {
+		out, err := sm.GetSecret(context.Background(), tc.ref)
+		if !ErrorContains(err, tc.expectError) {
+			t.Errorf(defaultErrorMessage, k, err, tc.expectError)
+		}
+		if string(out) != tc.want {
+			t.Errorf("[%d] unexpected secret: [%s], expected [%s]", k, string(out), tc.want)
+		}
+	}
+	if slices.Contains(groups.requested, "acme/shop/DB_PASSWORD") {
+		t.Errorf("group variables must only be read when the project has no such variable, got requests %v", groups.requested)
+	}
+}
+
 func TestResolveGroupIds(t *testing.T) {
 	v := makeValidSecretManagerTestCaseCustom()
 	sm := gitlabBase{}
@@ -0,0 +1,388 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hiera
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/tidwall/gjson"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/find"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	errReadOnly             = "the Hiera provider is read only"
+	errFindTagsUnsupported  = "find by tags is not supported by the Hiera provider"
+	errGetConfigMap         = "unable to get ConfigMap %s: %w"
+	errParseDataFile        = "unable to parse data file %s: %w"
+	errNoNamespace          = "the namespace of the ConfigMap is required"
+	errPropertyNotFound     = "property %s does not exist in key %s"
+	errNotAMap              = "key %s is not a hash"
+	errInterpolationCycle   = "interpolation of key %s is recursive"
+	errAliasNotAlone        = "alias of key %s must be the only content of the string"
+	errInterpolateNonString = "unable to interpolate key %s, it is not a string"
+	errEncryptedValue       = "key %s is encrypted with hiera-eyaml, which is not supported"
+	errUnknownFunction      = "unknown interpolation function %s"
+)
+
+var (
+	interpolationRe = regexp.MustCompile(`%\{([^}]*)\}`)
+	functionRe      = regexp.MustCompile(`^(alias|lookup|hiera|literal|scope)\(\s*(?:'([^']*)'|"([^"]*)")\s*\)$`)
+)
+
+// client looks up keys in the Hiera data files of a ConfigMap.
+// https://www.puppet.com/docs/puppet/latest/hiera_intro
+type client struct {
+	kube      kclient.Client
+	name      string
+	namespace string
+	hierarchy []string
+	variables map[string]string
+
+	// the data files are read once per client, which is created for every reconcile
+	mu     sync.Mutex
+	levels []map[string]any
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+// GetSecret looks up key, property selects a value of a hash, e.g. "db.password".
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	v, err := c.lookup(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Property == "" {
+		return utils.GetByteValue(v)
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	res := gjson.GetBytes(raw, ref.Property)
+	if !res.Exists() {
+		return nil, fmt.Errorf(errPropertyNotFound, ref.Property, ref.Key)
+	}
+	if res.Type == gjson.String {
+		return []byte(res.Str), nil
+	}
+	return []byte(res.Raw), nil
+}
+
+// GetSecretMap returns the keys of the hash key.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	v, err := c.lookup(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf(errNotAMap, ref.Key)
+	}
+	data := make(map[string][]byte, len(m))
+	for k, v := range m {
+		b, err := utils.GetByteValue(v)
+		if err != nil {
+			return nil, err
+		}
+		data[k] = b
+	}
+	return data, nil
+}
+
+// GetAllSecrets returns the keys of all data files whose name matches.
+func (c *client) GetAllSecrets(ctx context.Context, ref esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	if len(ref.Tags) > 0 {
+		return nil, errors.New(errFindTagsUnsupported)
+	}
+	var matcher *find.Matcher
+	if ref.Name != nil {
+		m, err := find.New(*ref.Name)
+		if err != nil {
+			return nil, err
+		}
+		matcher = m
+	}
+	levels, err := c.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data := make(map[string][]byte)
+	for _, level := range levels {
+		for k := range level {
+			if _, ok := data[k]; ok {
+				continue
+			}
+			if ref.Path != nil && !strings.HasPrefix(k, *ref.Path) {
+				continue
+			}
+			if matcher != nil && !matcher.MatchName(k) {
+				continue
+			}
+			v, err := c.lookup(ctx, k)
+			if err != nil {
+				return nil, err
+			}
+			b, err := utils.GetByteValue(v)
+			if err != nil {
+				return nil, err
+			}
+			data[k] = b
+		}
+	}
+	return utils.ConvertKeys(ref.ConversionStrategy, data)
+}
+
+func (c *client) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
+	return false, errors.New(errReadOnly)
+}
+
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	// a ClusterSecretStore without namespace reads the ConfigMap of every ExternalSecret namespace
+	if c.namespace == "" {
+		return esv1beta1.ValidationResultUnknown, nil
+	}
+	if _, err := c.load(context.Background()); err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+// load reads the data files of the hierarchy from the ConfigMap,
+// files which do not exist are skipped like in Hiera.
+func (c *client) load(ctx context.Context) ([]map[string]any, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.levels != nil {
+		return c.levels, nil
+	}
+	if c.namespace == "" {
+		return nil, errors.New(errNoNamespace)
+	}
+	cm := &corev1.ConfigMap{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: c.name, Namespace: c.namespace}, cm); err != nil {
+		return nil, fmt.Errorf(errGetConfigMap, c.name, err)
+	}
+	levels := make([]map[string]any, 0, len(c.hierarchy))
+	for _, entry := range c.hierarchy {
+		file := c.interpolateVariables(entry)
+		content, ok := cm.Data[file]
+		if !ok {
+			continue
+		}
+		level, err := parseDataFile(content)
+		if err != nil {
+			return nil, fmt.Errorf(errParseDataFile, file, err)
+		}
+		levels = append(levels, level)
+	}
+	c.levels = levels
+	return levels, nil
+}
+
+func parseDataFile(content string) (map[string]any, error) {
+	raw, err := yaml.YAMLToJSON([]byte(content))
+	if err != nil {
+		return nil, err
+	}
+	level := make(map[string]any)
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	if err := dec.Decode(&level); err != nil {
+		return nil, err
+	}
+	return level, nil
+}
+
+func (c *client) lookup(ctx context.Context, key string) (any, error) {
+	levels, err := c.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.resolve(levels, key, map[string]bool{})
+}
+
+// resolve returns the interpolated value of the first level defining key.
+// Keys which are not defined are split on dots to dig into hashes, e.g. "db.password".
+func (c *client) resolve(levels []map[string]any, key string, seen map[string]bool) (any, error) {
+	if seen[key] {
+		return nil, fmt.Errorf(errInterpolationCycle, key)
+	}
+	seen[key] = true
+	defer delete(seen, key)
+	for _, level := range levels {
+		v, ok := level[key]
+		if !ok {
+			v, ok = dig(level, key)
+		}
+		if ok {
+			return c.interpolate(levels, key, v, seen)
+		}
+	}
+	return nil, esv1beta1.NoSecretError{}
+}
+
+func dig(level map[string]any, key string) (any, bool) {
+	segments := strings.Split(key, ".")
+	if len(segments) < 2 {
+		return nil, false
+	}
+	var v any = level
+	for _, s := range segments {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if v, ok = m[s]; !ok {
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+// interpolate resolves the interpolations of every string of v.
+func (c *client) interpolate(levels []map[string]any, key string, v any, seen map[string]bool) (any, error) {
+	switch t := v.(type) {
+	case string:
+		return c.interpolateString(levels, key, t, seen)
+	case map[string]any:
+		m := make(map[string]any, len(t))
+		for k, item := range t {
+			r, err := c.interpolate(levels, key, item, seen)
+			if err != nil {
+				return nil, err
+			}
+			m[k] = r
+		}
+		return m, nil
+	case []any:
+		s := make([]any, len(t))
+		for i, item := range t {
+			r, err := c.interpolate(levels, key, item, seen)
+			if err != nil {
+				return nil, err
+			}
+			s[i] = r
+		}
+		return s, nil
+	default:
+		return v, nil
+	}
+}
+
+// interpolateString resolves variables, e.g. "%{environment}", and the functions
+// alias, lookup, hiera, literal and scope, e.g. "%{lookup('db::host')}".
+func (c *client) interpolateString(levels []map[string]any, key, s string, seen map[string]bool) (any, error) {
+	if strings.HasPrefix(s, "ENC[") {
+		return nil, fmt.Errorf(errEncryptedValue, key)
+	}
+	matches := interpolationRe.FindAllStringSubmatchIndex(s, -1)
+	if len(matches) == 0 {
+		return s, nil
+	}
+	var sb strings.Builder
+	last := 0
+	for _, m := range matches {
+		sb.WriteString(s[last:m[0]])
+		last = m[1]
+		expr := strings.TrimSpace(s[m[2]:m[3]])
+		fn := functionRe.FindStringSubmatch(expr)
+		if fn == nil {
+			if strings.Contains(expr, "(") {
+				return nil, fmt.Errorf(errUnknownFunction, expr)
+			}
+			sb.WriteString(c.variable(expr))
+			continue
+		}
+		arg := fn[2] + fn[3]
+		switch fn[1] {
+		case "literal":
+			sb.WriteString(arg)
+		case "scope":
+			sb.WriteString(c.variable(arg))
+		case "alias":
+			if m[0] != 0 || m[1] != len(s) {
+				return nil, fmt.Errorf(errAliasNotAlone, key)
+			}
+			return c.resolve(levels, arg, seen)
+		default:
+			v, err := c.resolve(levels, arg, seen)
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := v.(map[string]any); ok {
+				return nil, fmt.Errorf(errInterpolateNonString, arg)
+			}
+			if _, ok := v.([]any); ok {
+				return nil, fmt.Errorf(errInterpolateNonString, arg)
+			}
+			b, err := utils.GetByteValue(v)
+			if err != nil {
+				return nil, err
+			}
+			sb.Write(b)
+		}
+	}
+	sb.WriteString(s[last:])
+	return sb.String(), nil
+}
+
+// interpolateVariables resolves the variables of a hierarchy entry.
+func (c *client) interpolateVariables(s string) string {
+	return interpolationRe.ReplaceAllStringFunc(s, func(m string) string {
+		expr := strings.TrimSpace(m[2 : len(m)-1])
+		if fn := functionRe.FindStringSubmatch(expr); fn != nil {
+			switch fn[1] {
+			case "literal":
+				return fn[2] + fn[3]
+			case "scope":
+				return c.variable(fn[2] + fn[3])
+			}
+			return ""
+		}
+		return c.variable(expr)
+	})
+}
+
+// variable returns the value of a variable, top scope variables may be prefixed with "::".
+// Variables which are not defined are interpolated as an empty string like in Hiera.
+func (c *client) variable(name string) string {
+	return c.variables[strings.TrimPrefix(name, "::")]
+}
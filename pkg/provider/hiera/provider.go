@@ -0,0 +1,100 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hiera
+
+import (
+	"context"
+	"errors"
+
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	defaultDataFile = "common.yaml"
+
+	errStoreIsNil                  = "store is nil"
+	errNoStoreTypeOrWrongStoreType = "no store type or wrong store type"
+	errConfigMapNameRequired       = "configMap.name is required"
+	errNamespaceNotAllowed         = "configMap.namespace can only be defined in a ClusterSecretStore"
+	errEmptyHierarchyEntry         = "hierarchy entries must not be empty"
+)
+
+// Provider satisfies the provider interface.
+type Provider struct{}
+
+var _ esv1beta1.Provider = &Provider{}
+
+func (p *Provider) NewClient(_ context.Context, store esv1beta1.GenericStore, kube kclient.Client, namespace string) (esv1beta1.SecretsClient, error) {
+	cfg, err := getConfig(store)
+	if err != nil {
+		return nil, err
+	}
+	c := &client{
+		kube:      kube,
+		name:      cfg.ConfigMap.Name,
+		namespace: namespace,
+		hierarchy: cfg.Hierarchy,
+		variables: cfg.Variables,
+	}
+	if store.GetKind() == esv1beta1.ClusterSecretStoreKind && cfg.ConfigMap.Namespace != nil {
+		c.namespace = *cfg.ConfigMap.Namespace
+	}
+	if len(c.hierarchy) == 0 {
+		c.hierarchy = []string{defaultDataFile}
+	}
+	return c, nil
+}
+
+func getConfig(store esv1beta1.GenericStore) (*esv1beta1.HieraProvider, error) {
+	if store == nil {
+		return nil, errors.New(errStoreIsNil)
+	}
+	spec := store.GetSpec()
+	if spec == nil || spec.Provider == nil || spec.Provider.Hiera == nil {
+		return nil, errors.New(errNoStoreTypeOrWrongStoreType)
+	}
+	cfg := spec.Provider.Hiera
+	if cfg.ConfigMap.Name == "" {
+		return nil, errors.New(errConfigMapNameRequired)
+	}
+	ns := cfg.ConfigMap.Namespace
+	if store.GetKind() != esv1beta1.ClusterSecretStoreKind && ns != nil && *ns != store.GetNamespace() {
+		return nil, errors.New(errNamespaceNotAllowed)
+	}
+	for _, entry := range cfg.Hierarchy {
+		if entry == "" {
+			return nil, errors.New(errEmptyHierarchyEntry)
+		}
+	}
+	return cfg, nil
+}
+
+func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnings, error) {
+	_, err := getConfig(store)
+	return nil, err
+}
+
+func (p *Provider) Capabilities() esv1beta1.SecretStoreCapabilities {
+	return esv1beta1.SecretStoreReadOnly
+}
+
+func init() {
+	esv1beta1.Register(&Provider{}, &esv1beta1.SecretStoreProvider{
+		Hiera: &esv1beta1.HieraProvider{},
+	})
+}
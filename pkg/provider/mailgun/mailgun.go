@@ -0,0 +1,191 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mailgun
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	// credentialSMTPLogin, credentialSMTPPassword and credentialAPIKey are the credential types of a domain.
+	credentialSMTPLogin    = "smtpLogin"
+	credentialSMTPPassword = "smtpPassword"
+	credentialAPIKey       = "apiKey"
+
+	errInvalidCredential   = "invalid credential type %q, must be smtpLogin, smtpPassword or apiKey"
+	errNoCredentialType    = "key %q must end with the credential type smtpLogin, smtpPassword or apiKey"
+	errPropertyUnsupported = "the Mailgun provider does not support properties, set the credential type in the key"
+	errAPIKeyUnreadable    = "the api key of domain %q can not be read, Mailgun returns a sending api key only when it is created"
+	errNoSMTPPassword      = "Mailgun did not return the smtp password of domain %q"
+	errUnexpectedStatus    = "unexpected status code from Mailgun: %d: %s"
+	errUnmarshalResponse   = "unable to unmarshal Mailgun response: %w"
+	errReadOnly            = "the Mailgun provider is read only"
+	errFindUnsupported     = "find is not supported by the Mailgun provider"
+)
+
+// client reads the SMTP credentials of sending domains with the Mailgun API.
+// https://documentation.mailgun.com/docs/mailgun/api-reference/
+type client struct {
+	httpClient *http.Client
+	url        string
+	apiKey     string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+type domain struct {
+	Name         string `json:"name"`
+	SMTPLogin    string `json:"smtp_login"`
+	SMTPPassword string `json:"smtp_password"`
+}
+
+type domainResponse struct {
+	Domain domain `json:"domain"`
+}
+
+// GetSecret returns a credential of a domain, the key is the domain followed by the credential type,
+// e.g. mg.example.com/smtpPassword.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	if ref.Property != "" {
+		return nil, errors.New(errPropertyUnsupported)
+	}
+	name, credential, err := parseKey(ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	if credential == "" {
+		return nil, fmt.Errorf(errNoCredentialType, ref.Key)
+	}
+	if credential == credentialAPIKey {
+		return nil, fmt.Errorf(errAPIKeyUnreadable, name)
+	}
+	data, err := c.smtpCredentials(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return data[credential], nil
+}
+
+// GetSecretMap returns the SMTP login and password of the domain named by the key.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	if ref.Property != "" {
+		return nil, errors.New(errPropertyUnsupported)
+	}
+	name, credential, err := parseKey(ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	if credential == credentialAPIKey {
+		return nil, fmt.Errorf(errAPIKeyUnreadable, name)
+	}
+	return c.smtpCredentials(ctx, name)
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindUnsupported)
+}
+
+func (c *client) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
+	return false, errors.New(errReadOnly)
+}
+
+// Validate lists a single domain to check the api key.
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	var resp map[string]any
+	if err := c.get(context.Background(), "/v3/domains?limit=1", &resp); err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+// smtpCredentials returns the default SMTP credentials of a domain.
+func (c *client) smtpCredentials(ctx context.Context, name string) (map[string][]byte, error) {
+	var resp domainResponse
+	if err := c.get(ctx, "/v3/domains/"+url.PathEscape(name), &resp); err != nil {
+		return nil, err
+	}
+	if resp.Domain.SMTPPassword == "" {
+		return nil, fmt.Errorf(errNoSMTPPassword, name)
+	}
+	return map[string][]byte{
+		credentialSMTPLogin:    []byte(resp.Domain.SMTPLogin),
+		credentialSMTPPassword: []byte(resp.Domain.SMTPPassword),
+	}, nil
+}
+
+// parseKey splits a key into the domain and the optional credential type.
+func parseKey(key string) (string, string, error) {
+	name, credential, _ := strings.Cut(key, "/")
+	if name == "" {
+		return "", "", errors.New(errEmptyKey)
+	}
+	switch credential {
+	case "", credentialSMTPLogin, credentialSMTPPassword, credentialAPIKey:
+		return name, credential, nil
+	}
+	return "", "", fmt.Errorf(errInvalidCredential, credential)
+}
+
+func (c *client) get(ctx context.Context, path string, target any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+path, http.NoBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth("api", c.apiKey)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return esv1beta1.NoSecretError{}
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf(errUnexpectedStatus, resp.StatusCode, body)
+	}
+	if err := json.Unmarshal(body, target); err != nil {
+		return fmt.Errorf(errUnmarshalResponse, err)
+	}
+	return nil
+}
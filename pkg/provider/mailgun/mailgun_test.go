@@ -0,0 +1,156 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mailgun
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	testAPIKey       = "key-0123456789abcdef0123456789abcdef"
+	testSMTPPassword = "4rtqo4p6rrx9"
+)
+
+// newTestClient serves the domain mg.example.com, and legacy.example.com without an SMTP password.
+func newTestClient(t *testing.T) *client {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v3/domains", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"total_count": 2,
+			"items":       []domain{{Name: "mg.example.com", SMTPLogin: "postmaster@mg.example.com"}},
+		})
+	})
+	mux.HandleFunc("GET /v3/domains/{name}", func(w http.ResponseWriter, r *http.Request) {
+		resp := domainResponse{}
+		switch r.PathValue("name") {
+		case "mg.example.com":
+			resp.Domain = domain{Name: "mg.example.com", SMTPLogin: "postmaster@mg.example.com", SMTPPassword: testSMTPPassword}
+		case "legacy.example.com":
+			resp.Domain = domain{Name: "legacy.example.com", SMTPLogin: "postmaster@legacy.example.com"}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Domain not found"}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		if !ok || user != "api" || pass != testAPIKey {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`Forbidden`))
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return &client{
+		httpClient: srv.Client(),
+		url:        srv.URL,
+		apiKey:     testAPIKey,
+	}
+}
+
+func TestGetSecret(t *testing.T) {
+	c := newTestClient(t)
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"smtp login": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "mg.example.com/smtpLogin"},
+			want: "postmaster@mg.example.com",
+		},
+		"smtp password": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "mg.example.com/smtpPassword"},
+			want: testSMTPPassword,
+		},
+		"api key": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "mg.example.com/apiKey"},
+			wantErr: `the api key of domain "mg.example.com" can not be read`,
+		},
+		"no credential type": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "mg.example.com"},
+			wantErr: `key "mg.example.com" must end with the credential type`,
+		},
+		"invalid credential type": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "mg.example.com/password"},
+			wantErr: `invalid credential type "password"`,
+		},
+		"property": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "mg.example.com/smtpLogin", Property: "login"},
+			wantErr: "does not support properties",
+		},
+		"no smtp password": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "legacy.example.com/smtpPassword"},
+			wantErr: `Mailgun did not return the smtp password of domain "legacy.example.com"`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+
+	_, err := c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "missing.example.com/smtpLogin"})
+	assert.True(t, errors.Is(err, esv1beta1.NoSecretError{}))
+}
+
+func TestGetSecretMap(t *testing.T) {
+	c := newTestClient(t)
+	want := map[string][]byte{
+		"smtpLogin":    []byte("postmaster@mg.example.com"),
+		"smtpPassword": []byte(testSMTPPassword),
+	}
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "mg.example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	got, err = c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "mg.example.com/smtpLogin"})
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	_, err = c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "mg.example.com/apiKey"})
+	assert.ErrorContains(t, err, "can not be read")
+}
+
+func TestValidate(t *testing.T) {
+	c := newTestClient(t)
+	res, err := c.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+
+	c.apiKey = "invalid"
+	res, err = c.Validate()
+	assert.ErrorContains(t, err, "unexpected status code from Mailgun: 401")
+	assert.Equal(t, esv1beta1.ValidationResultError, res)
+}
@@ -0,0 +1,118 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mongodbatlas
+
+import (
+	"crypto/md5" //nolint:gosec // MD5 is the default algorithm of HTTP digest authentication
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"strings"
+)
+
+// digestChallenge is the challenge of a WWW-Authenticate: Digest header, see RFC 7616.
+type digestChallenge struct {
+	realm     string
+	nonce     string
+	opaque    string
+	algorithm string
+	qop       string
+}
+
+// parseDigestChallenge parses the parameters of a WWW-Authenticate: Digest header.
+func parseDigestChallenge(header string) (*digestChallenge, error) {
+	scheme, params, _ := strings.Cut(header, " ")
+	if !strings.EqualFold(scheme, "Digest") {
+		return nil, fmt.Errorf("unsupported authentication scheme %q", scheme)
+	}
+	c := &digestChallenge{algorithm: "MD5"}
+	for params != "" {
+		var key, value string
+		key, params, _ = strings.Cut(strings.TrimLeft(params, " ,"), "=")
+		if strings.HasPrefix(params, `"`) {
+			end := strings.Index(params[1:], `"`)
+			if end < 0 {
+				return nil, errors.New("unterminated quoted string in digest challenge")
+			}
+			value, params = params[1:end+1], params[end+2:]
+		} else {
+			value, params, _ = strings.Cut(params, ",")
+		}
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "realm":
+			c.realm = value
+		case "nonce":
+			c.nonce = value
+		case "opaque":
+			c.opaque = value
+		case "algorithm":
+			c.algorithm = strings.TrimSpace(value)
+		case "qop":
+			c.qop = value
+		}
+	}
+	if c.nonce == "" {
+		return nil, errors.New("digest challenge without nonce")
+	}
+	return c, nil
+}
+
+// authorization returns the Authorization header answering the challenge for a request.
+func (c *digestChallenge) authorization(username, password, method, uri string) (string, error) {
+	var h func() hash.Hash
+	switch strings.ToUpper(c.algorithm) {
+	case "MD5":
+		h = md5.New
+	case "SHA-256":
+		h = sha256.New
+	default:
+		return "", fmt.Errorf("unsupported digest algorithm %q", c.algorithm)
+	}
+	hexHash := func(parts ...string) string {
+		d := h()
+		d.Write([]byte(strings.Join(parts, ":")))
+		return hex.EncodeToString(d.Sum(nil))
+	}
+	ha1 := hexHash(username, c.realm, password)
+	ha2 := hexHash(method, uri)
+	header := fmt.Sprintf(`Digest username=%q, realm=%q, nonce=%q, uri=%q, algorithm=%s`, username, c.realm, c.nonce, uri, c.algorithm)
+	if c.qopAuth() {
+		b := make([]byte, 16)
+		if _, err := rand.Read(b); err != nil {
+			return "", err
+		}
+		cnonce, nc := hex.EncodeToString(b), "00000001"
+		header += fmt.Sprintf(`, qop=auth, nc=%s, cnonce=%q, response=%q`, nc, cnonce, hexHash(ha1, c.nonce, nc, cnonce, "auth", ha2))
+	} else {
+		header += fmt.Sprintf(`, response=%q`, hexHash(ha1, c.nonce, ha2))
+	}
+	if c.opaque != "" {
+		header += fmt.Sprintf(`, opaque=%q`, c.opaque)
+	}
+	return header, nil
+}
+
+// qopAuth returns true if the server offers the auth quality of protection.
+func (c *digestChallenge) qopAuth() bool {
+	for _, qop := range strings.Split(c.qop, ",") {
+		if strings.TrimSpace(qop) == "auth" {
+			return true
+		}
+	}
+	return false
+}
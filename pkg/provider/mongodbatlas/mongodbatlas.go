@@ -0,0 +1,335 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mongodbatlas
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	// secret keys of an API key.
+	keyPublicKey  = "publicKey"
+	keyPrivateKey = "privateKey"
+
+	// mediaType selects the version of the Atlas Administration API.
+	mediaType = "application/vnd.atlas.2023-01-01+json"
+	// pageSize is the maximum number of items per page.
+	pageSize = 500
+
+	errInvalidKey           = "key %q must be the ID of an API key"
+	errInvalidProperty      = "property %q must be one of publicKey or privateKey"
+	errPrivateKeyRedacted   = "MongoDB Atlas only returns the private key of API key %q when it is created"
+	errRemoteKeyRequired    = "remoteKey must be the description of the API key"
+	errRolesRequired        = "secretKey must name the key of the Secret holding the comma separated roles of the API key"
+	errKeyNotCreated        = "no API key is described %q, create it in MongoDB Atlas as the private key is only returned in the response to its creation"
+	errUnexpectedStatus     = "unexpected status code from MongoDB Atlas: %d: %s"
+	errUnmarshalResponse    = "unable to unmarshal MongoDB Atlas response: %w"
+	errDigestAuthentication = "unable to authenticate with MongoDB Atlas: %w"
+	errFindUnsupported      = "find is not supported by the MongoDB Atlas provider"
+)
+
+// client reads the programmatic API keys of an organization and updates their roles with the Atlas Administration API.
+// https://www.mongodb.com/docs/atlas/reference/api-resources-spec/v2/#tag/Programmatic-API-Keys
+type client struct {
+	httpClient *http.Client
+	url        string
+	orgID      string
+	publicKey  string
+	privateKey string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+type role struct {
+	RoleName string `json:"roleName"`
+	OrgID    string `json:"orgId,omitempty"`
+}
+
+type apiKey struct {
+	ID          string `json:"id"`
+	Description string `json:"desc"`
+	PublicKey   string `json:"publicKey"`
+	// PrivateKey is redacted except in the response to the creation of the key.
+	PrivateKey string `json:"privateKey"`
+	Roles      []role `json:"roles"`
+}
+
+type apiKeyRequest struct {
+	Description string   `json:"desc"`
+	Roles       []string `json:"roles"`
+}
+
+type apiKeyList struct {
+	Results    []apiKey `json:"results"`
+	TotalCount int      `json:"totalCount"`
+}
+
+func validateKeyID(key string) error {
+	if key == "" || strings.Contains(key, "/") {
+		return fmt.Errorf(errInvalidKey, key)
+	}
+	return nil
+}
+
+func validateProperty(property string) error {
+	switch property {
+	case "", keyPublicKey, keyPrivateKey:
+		return nil
+	}
+	return fmt.Errorf(errInvalidProperty, property)
+}
+
+// orgRoles returns the sorted roles of the key in the organization.
+func (k apiKey) orgRoles(orgID string) []string {
+	roles := make([]string, 0, len(k.Roles))
+	for _, r := range k.Roles {
+		if r.OrgID == "" || r.OrgID == orgID {
+			roles = append(roles, r.RoleName)
+		}
+	}
+	slices.Sort(roles)
+	return roles
+}
+
+// GetSecret returns a field of the API key, or all fields as JSON if no property is given.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	if err := validateProperty(ref.Property); err != nil {
+		return nil, err
+	}
+	data, err := c.GetSecretMap(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Property == "" {
+		values := make(map[string]string, len(data))
+		for k, v := range data {
+			values[k] = string(v)
+		}
+		return utils.JSONMarshal(values)
+	}
+	value, ok := data[ref.Property]
+	if !ok {
+		return nil, fmt.Errorf(errPrivateKeyRedacted, ref.Key)
+	}
+	return value, nil
+}
+
+// GetSecretMap returns the public key of the API key, and its private key if Atlas does not redact it.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	if err := validateKeyID(ref.Key); err != nil {
+		return nil, err
+	}
+	var key apiKey
+	if err := c.do(ctx, http.MethodGet, c.apiKeysPath()+"/"+url.PathEscape(ref.Key), nil, &key); err != nil {
+		return nil, err
+	}
+	data := map[string][]byte{
+		keyPublicKey: []byte(key.PublicKey),
+	}
+	// a redacted key looks like ********-****-****-c4e26334754f
+	if key.PrivateKey != "" && !strings.Contains(key.PrivateKey, "*") {
+		data[keyPrivateKey] = []byte(key.PrivateKey)
+	}
+	return data, nil
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindUnsupported)
+}
+
+// PushSecret updates the roles of the API keys with the remote key as description to the roles in the Secret.
+// API keys are not created, as their private key would be lost: Atlas only returns it in the response to the creation.
+func (c *client) PushSecret(ctx context.Context, secret *corev1.Secret, data esv1beta1.PushSecretData) error {
+	desc := data.GetRemoteKey()
+	if desc == "" {
+		return errors.New(errRemoteKeyRequired)
+	}
+	var roles []string
+	if data.GetSecretKey() != "" {
+		for _, r := range strings.Split(string(secret.Data[data.GetSecretKey()]), ",") {
+			if r = strings.TrimSpace(r); r != "" {
+				roles = append(roles, r)
+			}
+		}
+	}
+	if len(roles) == 0 {
+		return errors.New(errRolesRequired)
+	}
+	slices.Sort(roles)
+	keys, err := c.keysByDescription(ctx, desc)
+	if err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf(errKeyNotCreated, desc)
+	}
+	req := apiKeyRequest{Description: desc, Roles: roles}
+	for _, key := range keys {
+		if slices.Equal(key.orgRoles(c.orgID), roles) {
+			continue
+		}
+		if err := c.do(ctx, http.MethodPatch, c.apiKeysPath()+"/"+url.PathEscape(key.ID), req, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteSecret deletes the API keys with the description.
+func (c *client) DeleteSecret(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) error {
+	keys, err := c.keysByDescription(ctx, remoteRef.GetRemoteKey())
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		err := c.do(ctx, http.MethodDelete, c.apiKeysPath()+"/"+url.PathEscape(key.ID), nil, nil)
+		if err != nil && !errors.Is(err, esv1beta1.NoSecretError{}) {
+			return err
+		}
+	}
+	return nil
+}
+
+// SecretExists checks if an API key with the description exists.
+func (c *client) SecretExists(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) (bool, error) {
+	keys, err := c.keysByDescription(ctx, remoteRef.GetRemoteKey())
+	if err != nil {
+		return false, err
+	}
+	return len(keys) > 0, nil
+}
+
+// Validate lists the API keys of the organization to check the credentials.
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	err := c.do(context.Background(), http.MethodGet, c.apiKeysPath()+"?itemsPerPage=1", nil, nil)
+	if err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+func (c *client) apiKeysPath() string {
+	return "/api/atlas/v2/orgs/" + url.PathEscape(c.orgID) + "/apiKeys"
+}
+
+// keysByDescription returns the API keys of the organization with the description.
+func (c *client) keysByDescription(ctx context.Context, desc string) ([]apiKey, error) {
+	var keys []apiKey
+	for page, seen := 1, 0; ; page++ {
+		query := url.Values{
+			"itemsPerPage": {strconv.Itoa(pageSize)},
+			"pageNum":      {strconv.Itoa(page)},
+		}
+		var list apiKeyList
+		if err := c.do(ctx, http.MethodGet, c.apiKeysPath()+"?"+query.Encode(), nil, &list); err != nil {
+			return nil, err
+		}
+		for _, key := range list.Results {
+			if key.Description == desc {
+				keys = append(keys, key)
+			}
+		}
+		seen += len(list.Results)
+		if len(list.Results) == 0 || seen >= list.TotalCount {
+			return keys, nil
+		}
+	}
+}
+
+// do sends the request, and sends it again with digest authentication when Atlas answers with a challenge.
+func (c *client) do(ctx context.Context, method, path string, body, target any) error {
+	var b []byte
+	if body != nil {
+		var err error
+		if b, err = json.Marshal(body); err != nil {
+			return err
+		}
+	}
+	resp, err := c.send(ctx, method, path, b, "")
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode == http.StatusUnauthorized && resp.Header.Get("WWW-Authenticate") != "" {
+		resp.Body.Close()
+		challenge, err := parseDigestChallenge(resp.Header.Get("WWW-Authenticate"))
+		if err != nil {
+			return fmt.Errorf(errDigestAuthentication, err)
+		}
+		authorization, err := challenge.authorization(c.publicKey, c.privateKey, method, path)
+		if err != nil {
+			return fmt.Errorf(errDigestAuthentication, err)
+		}
+		if resp, err = c.send(ctx, method, path, b, authorization); err != nil {
+			return err
+		}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return esv1beta1.NoSecretError{}
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf(errUnexpectedStatus, resp.StatusCode, respBody)
+	}
+	if target == nil {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, target); err != nil {
+		return fmt.Errorf(errUnmarshalResponse, err)
+	}
+	return nil
+}
+
+func (c *client) send(ctx context.Context, method, path string, body []byte, authorization string) (*http.Response, error) {
+	var reqBody io.Reader = http.NoBody
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", mediaType)
+	if body != nil {
+		req.Header.Set("Content-Type", mediaType)
+	}
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	return c.httpClient.Do(req)
+}
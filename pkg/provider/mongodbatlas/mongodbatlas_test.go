@@ -0,0 +1,270 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mongodbatlas
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec // MD5 is the default algorithm of HTTP digest authentication
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1alpha1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1alpha1"
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	testOrg        = "5980cfdf0b6d97029d82f86e"
+	testPublicKey  = "atlaspub"
+	testPrivateKey = "f4b9d0a2-22a4-4c4d-a9ef-3c5b2f6b0d55"
+	testRealm      = "MMS Public API"
+	testNonce      = "n0nc3"
+)
+
+var digestParam = regexp.MustCompile(`(\w+)=(?:"([^"]*)"|([^,\s]*))`)
+
+// fakeAtlas serves the API keys of testOrg, listing one key per page to exercise the pagination.
+type fakeAtlas struct {
+	mu      sync.Mutex
+	keys    map[string]apiKey
+	patched int
+}
+
+func newTestClient(t *testing.T) (*client, *fakeAtlas) {
+	f := &fakeAtlas{keys: map[string]apiKey{
+		"64a1b2c3d4e5f6a7b8c9d0e1": {
+			ID: "64a1b2c3d4e5f6a7b8c9d0e1", Description: "orders", PublicKey: "orderspub",
+			PrivateKey: "********-****-****-3c5b2f6b0d55", Roles: []role{{RoleName: "ORG_READ_ONLY", OrgID: testOrg}},
+		},
+		"64a1b2c3d4e5f6a7b8c9d0e2": {
+			ID: "64a1b2c3d4e5f6a7b8c9d0e2", Description: "billing", PublicKey: "billingpub",
+			PrivateKey: "********-****-****-7a1e0c9d2b44", Roles: []role{{RoleName: "ORG_BILLING_ADMIN", OrgID: testOrg}},
+		},
+	}}
+	srv := httptest.NewServer(f.handler())
+	t.Cleanup(srv.Close)
+	return &client{httpClient: srv.Client(), url: srv.URL, orgID: testOrg, publicKey: testPublicKey, privateKey: testPrivateKey}, f
+}
+
+func md5Hex(parts ...string) string {
+	sum := md5.Sum([]byte(strings.Join(parts, ":"))) //nolint:gosec // see import
+	return hex.EncodeToString(sum[:])
+}
+
+// authorized verifies the digest response with qop=auth.
+func authorized(r *http.Request) bool {
+	scheme, header, _ := strings.Cut(r.Header.Get("Authorization"), " ")
+	if scheme != "Digest" {
+		return false
+	}
+	params := map[string]string{}
+	for _, m := range digestParam.FindAllStringSubmatch(header, -1) {
+		params[m[1]] = m[2] + m[3]
+	}
+	if params["username"] != testPublicKey || params["nonce"] != testNonce || params["qop"] != "auth" || params["uri"] != r.URL.RequestURI() {
+		return false
+	}
+	ha1 := md5Hex(testPublicKey, testRealm, testPrivateKey)
+	ha2 := md5Hex(r.Method, params["uri"])
+	return params["response"] == md5Hex(ha1, testNonce, params["nc"], params["cnonce"], "auth", ha2)
+}
+
+func (f *fakeAtlas) handler() http.Handler {
+	path := "/api/atlas/v2/orgs/" + testOrg + "/apiKeys"
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+path, func(w http.ResponseWriter, r *http.Request) {
+		ids := make([]string, 0, len(f.keys))
+		for id := range f.keys {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		list := apiKeyList{TotalCount: len(ids), Results: []apiKey{}}
+		page, _ := strconv.Atoi(r.URL.Query().Get("pageNum"))
+		if page >= 1 && page <= len(ids) {
+			list.Results = append(list.Results, f.keys[ids[page-1]])
+		}
+		_ = json.NewEncoder(w).Encode(list)
+	})
+	mux.HandleFunc(path+"/{id}", func(w http.ResponseWriter, r *http.Request) {
+		key, ok := f.keys[r.PathValue("id")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":404,"errorCode":"API_KEY_NOT_FOUND"}`))
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			_ = json.NewEncoder(w).Encode(key)
+		case http.MethodPatch:
+			var req apiKeyRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			f.patched++
+			key.Roles = nil
+			for _, r := range req.Roles {
+				key.Roles = append(key.Roles, role{RoleName: r, OrgID: testOrg})
+			}
+			f.keys[key.ID] = key
+			_ = json.NewEncoder(w).Encode(key)
+		case http.MethodDelete:
+			delete(f.keys, key.ID)
+			w.WriteHeader(http.StatusNoContent)
+		}
+	})
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept") != mediaType {
+			w.WriteHeader(http.StatusNotAcceptable)
+			return
+		}
+		if !authorized(r) {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Digest realm=%q, domain="", nonce=%q, algorithm=MD5, qop="auth", stale=false`, testRealm, testNonce))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func TestGetSecret(t *testing.T) {
+	c, _ := newTestClient(t)
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"all fields": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "64a1b2c3d4e5f6a7b8c9d0e1"},
+			want: `{"publicKey":"orderspub"}`,
+		},
+		"public key": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "64a1b2c3d4e5f6a7b8c9d0e1", Property: keyPublicKey},
+			want: "orderspub",
+		},
+		"redacted private key": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "64a1b2c3d4e5f6a7b8c9d0e1", Property: keyPrivateKey},
+			wantErr: `MongoDB Atlas only returns the private key of API key "64a1b2c3d4e5f6a7b8c9d0e1" when it is created`,
+		},
+		"missing key": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "64a1b2c3d4e5f6a7b8c9d0ff"},
+			wantErr: "Secret does not exist",
+		},
+		"invalid property": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "64a1b2c3d4e5f6a7b8c9d0e1", Property: "roles"},
+			wantErr: `property "roles" must be one of publicKey or privateKey`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestGetSecretMap(t *testing.T) {
+	c, fake := newTestClient(t)
+	key := fake.keys["64a1b2c3d4e5f6a7b8c9d0e1"]
+	key.PrivateKey = "orders-private-key"
+	fake.keys[key.ID] = key
+
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: key.ID})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		keyPublicKey:  []byte("orderspub"),
+		keyPrivateKey: []byte("orders-private-key"),
+	}, got)
+}
+
+func pushData(secretKey, remoteKey string) esv1alpha1.PushSecretData {
+	return esv1alpha1.PushSecretData{
+		Match: esv1alpha1.PushSecretMatch{
+			SecretKey: secretKey,
+			RemoteRef: esv1alpha1.PushSecretRemoteRef{RemoteKey: remoteKey},
+		},
+	}
+}
+
+func TestPushSecret(t *testing.T) {
+	c, fake := newTestClient(t)
+	ctx := context.Background()
+	secret := &corev1.Secret{Data: map[string][]byte{
+		"readonly": []byte("ORG_READ_ONLY"),
+		"members":  []byte("ORG_MEMBER, ORG_READ_ONLY"),
+	}}
+
+	// the key already exists with the roles
+	require.NoError(t, c.PushSecret(ctx, secret, pushData("readonly", "orders")))
+	assert.Zero(t, fake.patched)
+
+	require.NoError(t, c.PushSecret(ctx, secret, pushData("members", "orders")))
+	assert.Equal(t, 1, fake.patched)
+	assert.Equal(t, []string{"ORG_MEMBER", "ORG_READ_ONLY"}, fake.keys["64a1b2c3d4e5f6a7b8c9d0e1"].orgRoles(testOrg))
+
+	// keys are not created, their private key would be lost
+	err := c.PushSecret(ctx, secret, pushData("readonly", "payments"))
+	assert.ErrorContains(t, err, `no API key is described "payments"`)
+	assert.Len(t, fake.keys, 2)
+
+	assert.EqualError(t, c.PushSecret(ctx, secret, pushData("", "orders")), errRolesRequired)
+	assert.EqualError(t, c.PushSecret(ctx, secret, pushData("readonly", "")), errRemoteKeyRequired)
+}
+
+func TestSecretExistsAndDelete(t *testing.T) {
+	c, fake := newTestClient(t)
+	ctx := context.Background()
+
+	exists, err := c.SecretExists(ctx, esv1alpha1.PushSecretRemoteRef{RemoteKey: "orders"})
+	require.NoError(t, err)
+	assert.True(t, exists)
+	require.NoError(t, c.DeleteSecret(ctx, esv1alpha1.PushSecretRemoteRef{RemoteKey: "orders"}))
+	assert.NotContains(t, fake.keys, "64a1b2c3d4e5f6a7b8c9d0e1")
+	assert.Contains(t, fake.keys, "64a1b2c3d4e5f6a7b8c9d0e2")
+	exists, err = c.SecretExists(ctx, esv1alpha1.PushSecretRemoteRef{RemoteKey: "orders"})
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestValidate(t *testing.T) {
+	c, _ := newTestClient(t)
+	res, err := c.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+
+	c.privateKey = "invalid"
+	res, err = c.Validate()
+	assert.ErrorContains(t, err, "unexpected status code from MongoDB Atlas: 401")
+	assert.Equal(t, esv1beta1.ValidationResultError, res)
+}
@@ -0,0 +1,84 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mongodbatlas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+func TestValidateStore(t *testing.T) {
+	namespace := "other"
+	validAuth := esv1beta1.MongoDBAtlasAuth{
+		PublicKey:  esmeta.SecretKeySelector{Name: "atlas", Key: "public-key"},
+		PrivateKey: esmeta.SecretKeySelector{Name: "atlas", Key: "private-key"},
+	}
+	tests := map[string]struct {
+		cfg     esv1beta1.MongoDBAtlasProvider
+		wantErr string
+	}{
+		"valid": {
+			cfg: esv1beta1.MongoDBAtlasProvider{OrganizationID: "5980cfdf0b6d97029d82f86e", Auth: validAuth},
+		},
+		"missing organization": {
+			cfg:     esv1beta1.MongoDBAtlasProvider{Auth: validAuth},
+			wantErr: errOrganizationIDRequired,
+		},
+		"invalid url": {
+			cfg:     esv1beta1.MongoDBAtlasProvider{URL: "cloud.mongodb.com", OrganizationID: "5980cfdf0b6d97029d82f86e", Auth: validAuth},
+			wantErr: `invalid url "cloud.mongodb.com"`,
+		},
+		"secret in other namespace": {
+			cfg: esv1beta1.MongoDBAtlasProvider{
+				OrganizationID: "5980cfdf0b6d97029d82f86e",
+				Auth: esv1beta1.MongoDBAtlasAuth{
+					PublicKey:  validAuth.PublicKey,
+					PrivateKey: esmeta.SecretKeySelector{Name: "atlas", Key: "private-key", Namespace: &namespace},
+				},
+			},
+			wantErr: "namespace should either be empty or match the namespace of the SecretStore",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := esv1beta1.SecretStore{
+				Spec: esv1beta1.SecretStoreSpec{
+					Provider: &esv1beta1.SecretStoreProvider{
+						MongoDBAtlas: &tc.cfg,
+					},
+				},
+			}
+			p := &Provider{}
+			_, err := p.ValidateStore(&s)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestValidateRemoteRef(t *testing.T) {
+	p := &Provider{}
+	assert.NoError(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: "64a1b2c3d4e5f6a7b8c9d0e1"}))
+	assert.NoError(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: "64a1b2c3d4e5f6a7b8c9d0e1", Property: "privateKey"}))
+	assert.Error(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: "64a1b2c3d4e5f6a7b8c9d0e1", Property: "roles"}))
+	assert.Error(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: "orgs/64a1b2c3d4e5f6a7b8c9d0e1"}))
+}
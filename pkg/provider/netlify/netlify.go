@@ -0,0 +1,286 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package netlify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	// contextProduction is the deploy context of variables without a version.
+	contextProduction = "production"
+	// contextAll is the deploy context of values used in every context without a value of its own.
+	contextAll = "all"
+
+	errInvalidKey         = "key %q must have the format <variable> or <site id>/<variable>"
+	errInvalidContext     = "version %q must be one of the deploy contexts %v"
+	errUnexpectedStatus   = "unexpected status code from Netlify: %d: %s"
+	errUnmarshalResponse  = "unable to unmarshal Netlify response: %w"
+	errSecretVariable     = "variable %q contains secret values, Netlify does not return them"
+	errPropertyNotAllowed = "property is not supported, Netlify variables hold a single value"
+	errFindUnsupported    = "find is not supported by the Netlify provider"
+)
+
+var deployContexts = []string{"production", "deploy-preview", "branch-deploy", "dev"}
+
+// client reads and writes environment variables of a team and its sites with the Netlify API.
+// https://open-api.netlify.com/#tag/environmentVariables
+type client struct {
+	httpClient *http.Client
+	url        string
+	accountID  string
+	// scopes of pushed variables, all scopes if empty
+	scopes []string
+	token  string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+type envVar struct {
+	Key      string     `json:"key"`
+	Scopes   []string   `json:"scopes,omitempty"`
+	Values   []envValue `json:"values"`
+	IsSecret bool       `json:"is_secret"`
+}
+
+type envValue struct {
+	Value   string `json:"value"`
+	Context string `json:"context"`
+}
+
+// parseKey returns the site of a site variable, empty for a team variable, and the key of the variable.
+func parseKey(key string) (string, string, error) {
+	parts := strings.Split(key, "/")
+	for _, p := range parts {
+		if p == "" {
+			return "", "", fmt.Errorf(errInvalidKey, key)
+		}
+	}
+	switch len(parts) {
+	case 1:
+		return "", parts[0], nil
+	case 2:
+		return parts[0], parts[1], nil
+	default:
+		return "", "", fmt.Errorf(errInvalidKey, key)
+	}
+}
+
+// parseContext returns the deploy context of the version, production if it is empty.
+func parseContext(version string) (string, error) {
+	if version == "" {
+		return contextProduction, nil
+	}
+	if !slices.Contains(deployContexts, version) {
+		return "", fmt.Errorf(errInvalidContext, version, deployContexts)
+	}
+	return version, nil
+}
+
+// GetSecret returns the value of the variable in the deploy context given by the version,
+// or its value for all contexts.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	if ref.Property != "" {
+		return nil, errors.New(errPropertyNotAllowed)
+	}
+	site, name, err := parseKey(ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	deployContext, err := parseContext(ref.Version)
+	if err != nil {
+		return nil, err
+	}
+	var v envVar
+	if err := c.do(ctx, http.MethodGet, c.envPath(name), site, nil, &v); err != nil {
+		return nil, err
+	}
+	if v.IsSecret {
+		return nil, fmt.Errorf(errSecretVariable, ref.Key)
+	}
+	for _, want := range []string{deployContext, contextAll} {
+		for _, value := range v.Values {
+			if value.Context == want {
+				return []byte(value.Value), nil
+			}
+		}
+	}
+	return nil, esv1beta1.NoSecretError{}
+}
+
+// GetSecretMap decodes the value of the variable as a JSON object.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	data, err := c.GetSecret(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	kv := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &kv); err != nil {
+		return nil, fmt.Errorf(errUnmarshalResponse, err)
+	}
+	secretData := make(map[string][]byte, len(kv))
+	for k, v := range kv {
+		var s string
+		if err := json.Unmarshal(v, &s); err == nil {
+			secretData[k] = []byte(s)
+			continue
+		}
+		secretData[k] = v
+	}
+	return secretData, nil
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindUnsupported)
+}
+
+// PushSecret creates or replaces the variable with a single value for all deploy contexts,
+// available in the scopes of the store.
+func (c *client) PushSecret(ctx context.Context, secret *corev1.Secret, data esv1beta1.PushSecretData) error {
+	if data.GetProperty() != "" {
+		return errors.New(errPropertyNotAllowed)
+	}
+	site, name, err := parseKey(data.GetRemoteKey())
+	if err != nil {
+		return err
+	}
+	value, err := utils.PushSecretValue(secret, data.GetSecretKey())
+	if err != nil {
+		return err
+	}
+	v := envVar{
+		Key:    name,
+		Scopes: c.scopes,
+		Values: []envValue{{Value: string(value), Context: contextAll}},
+	}
+	err = c.do(ctx, http.MethodGet, c.envPath(name), site, nil, nil)
+	if errors.Is(err, esv1beta1.NoSecretError{}) {
+		return c.do(ctx, http.MethodPost, c.envPath(""), site, []envVar{v}, nil)
+	}
+	if err != nil {
+		return err
+	}
+	return c.do(ctx, http.MethodPut, c.envPath(name), site, v, nil)
+}
+
+// DeleteSecret deletes the variable, a missing variable is not an error.
+func (c *client) DeleteSecret(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) error {
+	site, name, err := parseKey(remoteRef.GetRemoteKey())
+	if err != nil {
+		return err
+	}
+	err = c.do(ctx, http.MethodDelete, c.envPath(name), site, nil, nil)
+	if errors.Is(err, esv1beta1.NoSecretError{}) {
+		return nil
+	}
+	return err
+}
+
+// SecretExists checks if the variable exists.
+func (c *client) SecretExists(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) (bool, error) {
+	site, name, err := parseKey(remoteRef.GetRemoteKey())
+	if err != nil {
+		return false, err
+	}
+	err = c.do(ctx, http.MethodGet, c.envPath(name), site, nil, nil)
+	if errors.Is(err, esv1beta1.NoSecretError{}) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Validate requests the team to check the token and its membership.
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	if err := c.do(context.Background(), http.MethodGet, "/api/v1/accounts/"+url.PathEscape(c.accountID), "", nil, nil); err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+// envPath returns the path of the variable name of the team, or of the variables if name is empty.
+func (c *client) envPath(name string) string {
+	path := "/api/v1/accounts/" + url.PathEscape(c.accountID) + "/env"
+	if name != "" {
+		path += "/" + url.PathEscape(name)
+	}
+	return path
+}
+
+// do sends a request to path, the variables of a site are addressed by the site_id parameter.
+func (c *client) do(ctx context.Context, method, path, site string, body, target any) error {
+	reqURL := c.url + path
+	if site != "" {
+		reqURL += "?" + url.Values{"site_id": {site}}.Encode()
+	}
+	var reqBody io.Reader = http.NoBody
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// a missing team, site or variable
+	if resp.StatusCode == http.StatusNotFound {
+		return esv1beta1.NoSecretError{}
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf(errUnexpectedStatus, resp.StatusCode, respBody)
+	}
+	if target == nil {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, target); err != nil {
+		return fmt.Errorf(errUnmarshalResponse, err)
+	}
+	return nil
+}
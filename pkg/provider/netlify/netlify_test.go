@@ -0,0 +1,251 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package netlify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1alpha1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1alpha1"
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	testToken   = "token"
+	testAccount = "acme"
+	testSite    = "5b6f4a1c-7d9c-4e2b-9a3f-0c1d2e3f4a5b"
+)
+
+// fakeNetlify serves the variables of the team acme, keyed by site id and variable key.
+// The variables of the team have an empty site id.
+type fakeNetlify struct {
+	mu   sync.Mutex
+	vars map[string]map[string]envVar
+}
+
+func newTestClient(t *testing.T) (*client, *fakeNetlify) {
+	f := &fakeNetlify{vars: map[string]map[string]envVar{
+		"": {
+			"REGISTRY": {Key: "REGISTRY", Values: []envValue{{Value: "registry.example.com", Context: "all"}}},
+		},
+		testSite: {
+			"API_URL": {Key: "API_URL", Scopes: []string{"builds", "functions"}, Values: []envValue{
+				{Value: "https://api.example.com", Context: "production"},
+				{Value: "https://staging.example.com", Context: "deploy-preview"},
+			}},
+			"DB_CONFIG": {Key: "DB_CONFIG", Values: []envValue{{Value: `{"user":"admin","port":5432}`, Context: "all"}}},
+			"DB_PASSWORD": {Key: "DB_PASSWORD", IsSecret: true, Values: []envValue{
+				{Value: "************", Context: "production"},
+			}},
+		},
+	}}
+	srv := httptest.NewServer(f.handler())
+	t.Cleanup(srv.Close)
+	return &client{httpClient: srv.Client(), url: srv.URL, accountID: testAccount, token: testToken}, f
+}
+
+func (f *fakeNetlify) handler() http.Handler {
+	mux := http.NewServeMux()
+	prefix := "/api/v1/accounts/" + testAccount
+	mux.HandleFunc("GET "+prefix, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"5f1e2d3c","slug":"acme","name":"Acme"}`))
+	})
+	mux.HandleFunc("POST "+prefix+"/env", func(w http.ResponseWriter, r *http.Request) {
+		var created []envVar
+		if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		site := r.URL.Query().Get("site_id")
+		for _, v := range created {
+			if f.vars[site] == nil {
+				f.vars[site] = map[string]envVar{}
+			}
+			f.vars[site][v.Key] = v
+		}
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(created)
+	})
+	mux.HandleFunc(prefix+"/env/{key}", func(w http.ResponseWriter, r *http.Request) {
+		site, key := r.URL.Query().Get("site_id"), r.PathValue("key")
+		v, ok := f.vars[site][key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":404,"message":"Environment variable not found"}`))
+			return
+		}
+		switch r.Method {
+		case http.MethodPut:
+			if err := json.NewDecoder(r.Body).Decode(&v); err != nil || v.Key != key {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			f.vars[site][key] = v
+		case http.MethodDelete:
+			delete(f.vars[site], key)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(v)
+	})
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"code":401,"message":"Access Denied"}`))
+			return
+		}
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func TestGetSecret(t *testing.T) {
+	c, _ := newTestClient(t)
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"team variable": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "REGISTRY"},
+			want: "registry.example.com",
+		},
+		"production by default": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: testSite + "/API_URL"},
+			want: "https://api.example.com",
+		},
+		"deploy preview": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: testSite + "/API_URL", Version: "deploy-preview"},
+			want: "https://staging.example.com",
+		},
+		"value for all contexts": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: testSite + "/DB_CONFIG", Version: "dev"},
+			want: `{"user":"admin","port":5432}`,
+		},
+		"no value for the context": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: testSite + "/API_URL", Version: "dev"},
+			wantErr: "Secret does not exist",
+		},
+		"secret variable": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: testSite + "/DB_PASSWORD"},
+			wantErr: `variable "` + testSite + `/DB_PASSWORD" contains secret values, Netlify does not return them`,
+		},
+		"missing variable": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "API_URL"},
+			wantErr: "Secret does not exist",
+		},
+		"invalid context": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: testSite + "/API_URL", Version: "staging"},
+			wantErr: `version "staging" must be one of the deploy contexts`,
+		},
+		"invalid key": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: testSite + "/api/API_URL"},
+			wantErr: "must have the format <variable> or <site id>/<variable>",
+		},
+		"property": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "REGISTRY", Property: "host"},
+			wantErr: errPropertyNotAllowed,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestGetSecretMap(t *testing.T) {
+	c, _ := newTestClient(t)
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: testSite + "/DB_CONFIG"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"user": []byte("admin"), "port": []byte("5432")}, got)
+}
+
+func pushData(secretKey, remoteKey string) esv1alpha1.PushSecretData {
+	return esv1alpha1.PushSecretData{
+		Match: esv1alpha1.PushSecretMatch{
+			SecretKey: secretKey,
+			RemoteRef: esv1alpha1.PushSecretRemoteRef{RemoteKey: remoteKey},
+		},
+	}
+}
+
+func TestPushSecret(t *testing.T) {
+	c, fake := newTestClient(t)
+	c.scopes = []string{"functions", "runtime"}
+	secret := &corev1.Secret{Data: map[string][]byte{"token": []byte("abc"), "user": []byte("deploy")}}
+
+	require.NoError(t, c.PushSecret(context.Background(), secret, pushData("token", testSite+"/DEPLOY_TOKEN")))
+	assert.Equal(t, envVar{
+		Key:    "DEPLOY_TOKEN",
+		Scopes: []string{"functions", "runtime"},
+		Values: []envValue{{Value: "abc", Context: "all"}},
+	}, fake.vars[testSite]["DEPLOY_TOKEN"])
+
+	// the values of all contexts are replaced
+	require.NoError(t, c.PushSecret(context.Background(), secret, pushData("", testSite+"/API_URL")))
+	got, err := c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: testSite + "/API_URL", Version: "deploy-preview"})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"token":"abc","user":"deploy"}`, string(got))
+
+	require.NoError(t, c.PushSecret(context.Background(), secret, pushData("user", "DEPLOY_USER")))
+	assert.Equal(t, "deploy", fake.vars[""]["DEPLOY_USER"].Values[0].Value)
+
+	assert.ErrorContains(t, c.PushSecret(context.Background(), secret, pushData("token", "/DEPLOY_TOKEN")), "must have the format")
+}
+
+func TestSecretExistsAndDelete(t *testing.T) {
+	c, _ := newTestClient(t)
+	ctx := context.Background()
+	ref := esv1alpha1.PushSecretRemoteRef{RemoteKey: testSite + "/API_URL"}
+
+	exists, err := c.SecretExists(ctx, ref)
+	require.NoError(t, err)
+	assert.True(t, exists)
+	require.NoError(t, c.DeleteSecret(ctx, ref))
+	exists, err = c.SecretExists(ctx, ref)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// deleting a missing variable succeeds
+	require.NoError(t, c.DeleteSecret(ctx, ref))
+}
+
+func TestValidate(t *testing.T) {
+	c, _ := newTestClient(t)
+	res, err := c.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+
+	c.token = "invalid"
+	res, err = c.Validate()
+	assert.ErrorContains(t, err, "unexpected status code from Netlify: 401")
+	assert.Equal(t, esv1beta1.ValidationResultError, res)
+}
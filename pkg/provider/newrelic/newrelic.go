@@ -0,0 +1,278 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package newrelic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	errInvalidKey        = "key %q must have the format <account id>/<key type>, the key type is one of ingestLicenseKey, browserKey or userApiKey"
+	errAmbiguousKey      = "account %d has %d keys of type %s, set property to the name or ID of the key"
+	errDuplicateName     = "account %d has several keys of type %s named %q"
+	errUnexpectedStatus  = "unexpected status code from New Relic: %d: %s"
+	errUnmarshalResponse = "unable to unmarshal New Relic response: %w"
+	errGraphQL           = "New Relic returned an error: %s"
+	errReadOnly          = "the New Relic provider is read only"
+	errFindUnsupported   = "find is not supported by the New Relic provider"
+)
+
+const (
+	queryKeys = `query keys($query: ApiAccessKeySearchQuery!, $cursor: String) {
+  actor {
+    apiAccess {
+      keySearch(query: $query, cursor: $cursor) {
+        keys { id name key }
+        nextCursor
+      }
+    }
+  }
+}`
+	queryUser = `query { actor { user { id } } }`
+)
+
+// keyType is a type of key of NerdGraph.
+type keyType struct {
+	// keySearchType is the type of the key in a key search.
+	keySearchType string
+	// ingestType is the type of an ingest key.
+	ingestType string
+}
+
+var keyTypes = map[string]keyType{
+	"ingestLicenseKey": {keySearchType: "INGEST", ingestType: "LICENSE"},
+	"browserKey":       {keySearchType: "INGEST", ingestType: "BROWSER"},
+	"userApiKey":       {keySearchType: "USER"},
+}
+
+// client reads the keys of New Relic accounts with the NerdGraph API.
+// https://docs.newrelic.com/docs/apis/nerdgraph/examples/use-nerdgraph-manage-license-keys-user-keys/
+type client struct {
+	httpClient *http.Client
+	url        string
+	apiKey     string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+type graphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+type apiAccessKey struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Key  string `json:"key"`
+}
+
+type keySearchResponse struct {
+	Actor struct {
+		APIAccess struct {
+			KeySearch struct {
+				Keys       []apiAccessKey `json:"keys"`
+				NextCursor *string        `json:"nextCursor"`
+			} `json:"keySearch"`
+		} `json:"apiAccess"`
+	} `json:"actor"`
+}
+
+// parseKey parses a key with the format <account id>/<key type>.
+func parseKey(key string) (int, string, error) {
+	account, typ, ok := strings.Cut(key, "/")
+	if !ok {
+		return 0, "", fmt.Errorf(errInvalidKey, key)
+	}
+	accountID, err := strconv.Atoi(account)
+	if err != nil || accountID <= 0 {
+		return 0, "", fmt.Errorf(errInvalidKey, key)
+	}
+	if _, ok := keyTypes[typ]; !ok {
+		return 0, "", fmt.Errorf(errInvalidKey, key)
+	}
+	return accountID, typ, nil
+}
+
+// GetSecret returns the key of the type of the account, the property selects a key by name or ID
+// and is required if the account has several keys of the type.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	accountID, typ, err := parseKey(ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := c.keys(ctx, accountID, typ)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Property != "" {
+		for _, k := range keys {
+			if k.ID == ref.Property || k.Name == ref.Property {
+				return []byte(k.Key), nil
+			}
+		}
+		return nil, esv1beta1.NoSecretError{}
+	}
+	switch len(keys) {
+	case 0:
+		return nil, esv1beta1.NoSecretError{}
+	case 1:
+		return []byte(keys[0].Key), nil
+	}
+	return nil, fmt.Errorf(errAmbiguousKey, accountID, len(keys), typ)
+}
+
+// GetSecretMap returns the keys of the type of the account by name.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	accountID, typ, err := parseKey(ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := c.keys(ctx, accountID, typ)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, esv1beta1.NoSecretError{}
+	}
+	data := make(map[string][]byte, len(keys))
+	for _, k := range keys {
+		if _, ok := data[k.Name]; ok {
+			return nil, fmt.Errorf(errDuplicateName, accountID, typ, k.Name)
+		}
+		data[k.Name] = []byte(k.Key)
+	}
+	return data, nil
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindUnsupported)
+}
+
+func (c *client) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
+	return false, errors.New(errReadOnly)
+}
+
+// Validate reads the user of the API key.
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	if err := c.do(context.Background(), queryUser, nil, nil); err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+// keys returns the keys of the type of the account, following the cursor of the key search.
+func (c *client) keys(ctx context.Context, accountID int, typ string) ([]apiAccessKey, error) {
+	kt := keyTypes[typ]
+	scope := map[string]any{"accountIds": []int{accountID}}
+	if kt.ingestType != "" {
+		scope["ingestTypes"] = []string{kt.ingestType}
+	}
+	variables := map[string]any{
+		"query": map[string]any{
+			"types": []string{kt.keySearchType},
+			"scope": scope,
+		},
+	}
+	var keys []apiAccessKey
+	for {
+		var resp keySearchResponse
+		if err := c.do(ctx, queryKeys, variables, &resp); err != nil {
+			return nil, err
+		}
+		search := resp.Actor.APIAccess.KeySearch
+		keys = append(keys, search.Keys...)
+		if search.NextCursor == nil || *search.NextCursor == "" {
+			return keys, nil
+		}
+		variables["cursor"] = *search.NextCursor
+	}
+}
+
+func (c *client) do(ctx context.Context, query string, variables map[string]any, target any) error {
+	b, err := json.Marshal(graphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("API-Key", c.apiKey)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf(errUnexpectedStatus, resp.StatusCode, respBody)
+	}
+	var gqlResp graphQLResponse
+	if err := json.Unmarshal(respBody, &gqlResp); err != nil {
+		return fmt.Errorf(errUnmarshalResponse, err)
+	}
+	// NerdGraph reports errors, e.g. an account the user can not access, with a successful status code
+	if len(gqlResp.Errors) > 0 {
+		msgs := make([]string, 0, len(gqlResp.Errors))
+		for _, e := range gqlResp.Errors {
+			msgs = append(msgs, e.Message)
+		}
+		return fmt.Errorf(errGraphQL, strings.Join(msgs, "; "))
+	}
+	if target == nil {
+		return nil
+	}
+	if err := json.Unmarshal(gqlResp.Data, target); err != nil {
+		return fmt.Errorf(errUnmarshalResponse, err)
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package newrelic
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const testAPIKey = "NRAK-TESTKEY"
+
+// keySearchRequest is the key search of a NerdGraph request.
+type keySearchRequest struct {
+	Query     string `json:"query"`
+	Variables struct {
+		Query struct {
+			Types []string `json:"types"`
+			Scope struct {
+				AccountIDs  []int    `json:"accountIds"`
+				IngestTypes []string `json:"ingestTypes"`
+			} `json:"scope"`
+		} `json:"query"`
+		Cursor string `json:"cursor"`
+	} `json:"variables"`
+}
+
+// newTestClient serves the keys of account 1234567, the license keys are
+// returned on two pages to exercise the cursor.
+func newTestClient(t *testing.T) *client {
+	keys := map[string][]apiAccessKey{
+		"LICENSE": {
+			{ID: "LIC1", Name: "Original account license key", Key: "license-key-1"},
+			{ID: "LIC2", Name: "staging", Key: "license-key-2"},
+		},
+		"BROWSER": {
+			{ID: "BRW1", Name: "Browser key", Key: "browser-key"},
+		},
+		"USER": {},
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("API-Key") != testAPIKey {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"errors":[{"message":"Invalid API key"}]}`))
+			return
+		}
+		var req keySearchRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if req.Query == queryUser {
+			_, _ = w.Write([]byte(`{"data":{"actor":{"user":{"id":1}}}}`))
+			return
+		}
+		scope := req.Variables.Query.Scope
+		if len(scope.AccountIDs) != 1 || scope.AccountIDs[0] != 1234567 {
+			_, _ = w.Write([]byte(`{"data":null,"errors":[{"message":"Access denied to account"}]}`))
+			return
+		}
+		typ := req.Variables.Query.Types[0]
+		if typ == "INGEST" {
+			typ = scope.IngestTypes[0]
+		}
+		var resp keySearchResponse
+		search := &resp.Actor.APIAccess.KeySearch
+		search.Keys = keys[typ]
+		if typ == "LICENSE" {
+			if req.Variables.Cursor == "" {
+				search.Keys = keys[typ][:1]
+				next := "page2"
+				search.NextCursor = &next
+			} else {
+				search.Keys = keys[typ][1:]
+			}
+		}
+		data, _ := json.Marshal(resp)
+		_ = json.NewEncoder(w).Encode(graphQLResponse{Data: data})
+	}))
+	t.Cleanup(srv.Close)
+	return &client{httpClient: srv.Client(), url: srv.URL, apiKey: testAPIKey}
+}
+
+func TestGetSecret(t *testing.T) {
+	c := newTestClient(t)
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"single browser key": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "1234567/browserKey"},
+			want: "browser-key",
+		},
+		"license key by name": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "1234567/ingestLicenseKey", Property: "staging"},
+			want: "license-key-2",
+		},
+		"license key by id": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "1234567/ingestLicenseKey", Property: "LIC1"},
+			want: "license-key-1",
+		},
+		"several license keys": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "1234567/ingestLicenseKey"},
+			wantErr: "account 1234567 has 2 keys of type ingestLicenseKey, set property to the name or ID of the key",
+		},
+		"missing key name": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "1234567/ingestLicenseKey", Property: "production"},
+			wantErr: "Secret does not exist",
+		},
+		"no user keys": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "1234567/userApiKey"},
+			wantErr: "Secret does not exist",
+		},
+		"other account": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "7654321/browserKey"},
+			wantErr: "New Relic returned an error: Access denied to account",
+		},
+		"invalid key type": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "1234567/insightsKey"},
+			wantErr: `key "1234567/insightsKey" must have the format <account id>/<key type>`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestGetSecretMap(t *testing.T) {
+	c := newTestClient(t)
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "1234567/ingestLicenseKey"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"Original account license key": []byte("license-key-1"),
+		"staging":                      []byte("license-key-2"),
+	}, got)
+
+	_, err = c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "1234567/userApiKey"})
+	assert.ErrorIs(t, err, esv1beta1.NoSecretError{})
+}
+
+func TestValidate(t *testing.T) {
+	c := newTestClient(t)
+	res, err := c.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+
+	c.apiKey = "invalid"
+	res, err = c.Validate()
+	assert.ErrorContains(t, err, "unexpected status code from New Relic: 401")
+	assert.Equal(t, esv1beta1.ValidationResultError, res)
+}
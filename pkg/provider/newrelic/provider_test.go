@@ -0,0 +1,79 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package newrelic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+func TestValidateStore(t *testing.T) {
+	namespace := "other"
+	tests := map[string]struct {
+		cfg     esv1beta1.NewRelicProvider
+		wantErr string
+	}{
+		"valid": {
+			cfg: esv1beta1.NewRelicProvider{
+				URL:  "https://api.eu.newrelic.com/graphql",
+				Auth: esv1beta1.NewRelicAuth{APIKey: esmeta.SecretKeySelector{Name: "newrelic", Key: "api-key"}},
+			},
+		},
+		"invalid url": {
+			cfg: esv1beta1.NewRelicProvider{
+				URL:  "api.newrelic.com",
+				Auth: esv1beta1.NewRelicAuth{APIKey: esmeta.SecretKeySelector{Name: "newrelic", Key: "api-key"}},
+			},
+			wantErr: `invalid url "api.newrelic.com"`,
+		},
+		"secret in other namespace": {
+			cfg: esv1beta1.NewRelicProvider{
+				Auth: esv1beta1.NewRelicAuth{APIKey: esmeta.SecretKeySelector{Name: "newrelic", Key: "api-key", Namespace: &namespace}},
+			},
+			wantErr: "namespace should either be empty or match the namespace of the SecretStore",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := esv1beta1.SecretStore{
+				Spec: esv1beta1.SecretStoreSpec{
+					Provider: &esv1beta1.SecretStoreProvider{
+						NewRelic: &tc.cfg,
+					},
+				},
+			}
+			p := &Provider{}
+			_, err := p.ValidateStore(&s)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestValidateRemoteRef(t *testing.T) {
+	p := &Provider{}
+	assert.NoError(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: "1234567/ingestLicenseKey"}))
+	assert.NoError(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: "1234567/userApiKey", Property: "ci"}))
+	assert.Error(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: "1234567"}))
+	assert.Error(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: "production/browserKey"}))
+	assert.Error(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: "1234567/insertKey"}))
+}
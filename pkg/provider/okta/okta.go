@@ -0,0 +1,194 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package okta
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	propertyClientID     = "clientId"
+	propertyClientSecret = "clientSecret"
+
+	statusActive = "ACTIVE"
+
+	errUnexpectedStatus  = "unexpected status code from Okta: %d: %s"
+	errUnmarshalResponse = "unable to unmarshal Okta response: %w"
+	errNotOAuthClient    = "application %q is not an OAuth 2.0 client"
+	errNoActiveSecret    = "application %q has no active client secret"
+	errReadOnly          = "the Okta provider is read only"
+	errFindUnsupported   = "find is not supported by the Okta provider"
+)
+
+// client reads the credentials of OAuth 2.0 client applications with the Okta Applications API.
+// https://developer.okta.com/docs/api/openapi/okta-management/management/tag/Application/
+type client struct {
+	httpClient *http.Client
+	url        string
+	token      string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+type application struct {
+	ID          string `json:"id"`
+	Status      string `json:"status"`
+	Credentials struct {
+		OAuthClient *struct {
+			ClientID string `json:"client_id"`
+		} `json:"oauthClient"`
+	} `json:"credentials"`
+}
+
+type clientSecret struct {
+	ID           string `json:"id"`
+	Status       string `json:"status"`
+	ClientSecret string `json:"client_secret"`
+	Created      string `json:"created"`
+}
+
+// GetSecret returns the property of the credentials of the application with the client ID key,
+// or both credentials as JSON if no property is given.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	creds, err := c.credentials(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	switch ref.Property {
+	case "":
+		return utils.JSONMarshal(creds)
+	case propertyClientID, propertyClientSecret:
+		return []byte(creds[ref.Property]), nil
+	}
+	return nil, fmt.Errorf(errInvalidProperty, ref.Property, propertyClientID, propertyClientSecret)
+}
+
+// GetSecretMap returns the client ID and client secret of the application with the client ID key.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	creds, err := c.credentials(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	secretMap := make(map[string][]byte, len(creds))
+	for k, v := range creds {
+		secretMap[k] = []byte(v)
+	}
+	return secretMap, nil
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindUnsupported)
+}
+
+func (c *client) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
+	return false, errors.New(errReadOnly)
+}
+
+// Validate requests the user of the API token.
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	if err := c.get(context.Background(), "/api/v1/users/me", nil); err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+// credentials returns the client ID and the newest active client secret of an application.
+// The ID of an OAuth 2.0 client application is its client ID.
+func (c *client) credentials(ctx context.Context, clientID string) (map[string]string, error) {
+	if clientID == "" {
+		return nil, errors.New(errKeyRequired)
+	}
+	var app application
+	if err := c.get(ctx, "/api/v1/apps/"+url.PathEscape(clientID), &app); err != nil {
+		return nil, err
+	}
+	if app.Credentials.OAuthClient == nil {
+		return nil, fmt.Errorf(errNotOAuthClient, clientID)
+	}
+	var secrets []clientSecret
+	if err := c.get(ctx, "/api/v1/apps/"+url.PathEscape(app.ID)+"/credentials/secrets", &secrets); err != nil {
+		return nil, err
+	}
+	var newest *clientSecret
+	for i := range secrets {
+		s := &secrets[i]
+		// created is an RFC 3339 timestamp in UTC, which sorts lexically.
+		if s.Status == statusActive && s.ClientSecret != "" && (newest == nil || s.Created > newest.Created) {
+			newest = s
+		}
+	}
+	if newest == nil {
+		return nil, fmt.Errorf(errNoActiveSecret, clientID)
+	}
+	return map[string]string{
+		propertyClientID:     app.Credentials.OAuthClient.ClientID,
+		propertyClientSecret: newest.ClientSecret,
+	}, nil
+}
+
+func (c *client) get(ctx context.Context, path string, target any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+path, http.NoBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "SSWS "+c.token)
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return esv1beta1.NoSecretError{}
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf(errUnexpectedStatus, resp.StatusCode, respBody)
+	}
+	if target == nil {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, target); err != nil {
+		return fmt.Errorf(errUnmarshalResponse, err)
+	}
+	return nil
+}
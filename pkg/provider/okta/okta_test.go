@@ -0,0 +1,155 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package okta
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	testToken = "00QCjAl4MlV-WPXM-ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+	serviceApp  = "0oa1gjh63g214q0Hq0g4"
+	bookmarkApp = "0oa1gjh7ivT7Xq2Bw0g4"
+)
+
+// newFakeOkta serves the Applications API responses recorded in testdata.
+func newFakeOkta(t *testing.T) *httptest.Server {
+	t.Helper()
+	fixture := func(name string) http.HandlerFunc {
+		body, err := os.ReadFile("testdata/" + name)
+		require.NoError(t, err)
+		return func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write(body)
+		}
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/apps/"+serviceApp, fixture("app.json"))
+	mux.HandleFunc("GET /api/v1/apps/"+serviceApp+"/credentials/secrets", fixture("secrets.json"))
+	mux.HandleFunc("GET /api/v1/apps/"+bookmarkApp, fixture("bookmark_app.json"))
+	mux.HandleFunc("GET /api/v1/users/me", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"00u1gjh5ykKq3Q2tV0g4","status":"ACTIVE"}`))
+	})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "SSWS "+testToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"errorCode":"E0000011","errorSummary":"Invalid token provided"}`))
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newTestClient(url, token string) *client {
+	return &client{
+		httpClient: &http.Client{Timeout: time.Second},
+		url:        url,
+		token:      token,
+	}
+}
+
+func TestGetSecret(t *testing.T) {
+	c := newTestClient(newFakeOkta(t).URL, testToken)
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"client id": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: serviceApp, Property: "clientId"},
+			want: serviceApp,
+		},
+		"newest active client secret": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: serviceApp, Property: "clientSecret"},
+			want: "Wt3zQ8xkP5mR2vLc7HbNa9JdYf4sUeGq1oTiK6Xw",
+		},
+		"all credentials": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: serviceApp},
+			want: `{"clientId":"0oa1gjh63g214q0Hq0g4","clientSecret":"Wt3zQ8xkP5mR2vLc7HbNa9JdYf4sUeGq1oTiK6Xw"}`,
+		},
+		"invalid property": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: serviceApp, Property: "secret"},
+			wantErr: `invalid property "secret": must be "clientId" or "clientSecret"`,
+		},
+		"not an oauth client": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: bookmarkApp},
+			wantErr: `application "` + bookmarkApp + `" is not an OAuth 2.0 client`,
+		},
+		"missing application": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "0oa1gjh9zzzzzzzzz0g4"},
+			wantErr: esv1beta1.NoSecretError{}.Error(),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestGetSecretMap(t *testing.T) {
+	c := newTestClient(newFakeOkta(t).URL, testToken)
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: serviceApp})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"clientId":     []byte(serviceApp),
+		"clientSecret": []byte("Wt3zQ8xkP5mR2vLc7HbNa9JdYf4sUeGq1oTiK6Xw"),
+	}, got)
+}
+
+func TestNoActiveClientSecret(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/apps/"+serviceApp, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"id":"` + serviceApp + `","credentials":{"oauthClient":{"client_id":"` + serviceApp + `"}}}`))
+	})
+	mux.HandleFunc("GET /api/v1/apps/"+serviceApp+"/credentials/secrets", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`[{"id":"ocs2f4zrZbs8nUa7p0g4","status":"INACTIVE","client_secret":"old"}]`))
+	})
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	_, err := newTestClient(srv.URL, testToken).GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: serviceApp})
+	assert.EqualError(t, err, `application "`+serviceApp+`" has no active client secret`)
+}
+
+func TestValidate(t *testing.T) {
+	srv := newFakeOkta(t)
+	result, err := newTestClient(srv.URL, testToken).Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, result)
+
+	result, err = newTestClient(srv.URL, "wrong").Validate()
+	assert.ErrorContains(t, err, "unexpected status code from Okta: 401")
+	assert.Equal(t, esv1beta1.ValidationResultError, result)
+}
@@ -0,0 +1,111 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package okta
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+const (
+	errStoreIsNil                  = "store is nil"
+	errNoStoreTypeOrWrongStoreType = "no store type or wrong store type"
+	errOrgURLRequired              = "orgURL is required"
+	errInvalidURL                  = "invalid url %q: %w"
+	errCannotResolveAPIToken       = "cannot resolve api token: %w"
+	errKeyRequired                 = "key is required, it is the client ID of the application"
+	errInvalidProperty             = "invalid property %q: must be %q or %q"
+)
+
+// Provider satisfies the provider interface.
+type Provider struct{}
+
+var _ esv1beta1.Provider = &Provider{}
+
+func (p *Provider) NewClient(ctx context.Context, store esv1beta1.GenericStore, kube kclient.Client, namespace string) (esv1beta1.SecretsClient, error) {
+	cfg, err := getConfig(store)
+	if err != nil {
+		return nil, err
+	}
+	token, err := resolvers.SecretKeyRef(ctx, kube, store.GetKind(), namespace, &cfg.Auth.APIToken)
+	if err != nil {
+		return nil, fmt.Errorf(errCannotResolveAPIToken, err)
+	}
+	return &client{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		url:        strings.TrimSuffix(cfg.OrgURL, "/"),
+		token:      token,
+	}, nil
+}
+
+func getConfig(store esv1beta1.GenericStore) (*esv1beta1.OktaProvider, error) {
+	if store == nil {
+		return nil, errors.New(errStoreIsNil)
+	}
+	spec := store.GetSpec()
+	if spec == nil || spec.Provider == nil || spec.Provider.Okta == nil {
+		return nil, errors.New(errNoStoreTypeOrWrongStoreType)
+	}
+	cfg := spec.Provider.Okta
+	if cfg.OrgURL == "" {
+		return nil, errors.New(errOrgURLRequired)
+	}
+	if _, err := url.ParseRequestURI(cfg.OrgURL); err != nil {
+		return nil, fmt.Errorf(errInvalidURL, cfg.OrgURL, err)
+	}
+	if err := utils.ValidateReferentSecretSelector(store, cfg.Auth.APIToken); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnings, error) {
+	_, err := getConfig(store)
+	return nil, err
+}
+
+// ValidateRemoteRef checks that the key is set and the property is one of the credentials.
+func (p *Provider) ValidateRemoteRef(_ esv1beta1.GenericStore, ref esv1beta1.ExternalSecretDataRemoteRef) error {
+	if ref.Key == "" {
+		return errors.New(errKeyRequired)
+	}
+	switch ref.Property {
+	case "", propertyClientID, propertyClientSecret:
+		return nil
+	}
+	return fmt.Errorf(errInvalidProperty, ref.Property, propertyClientID, propertyClientSecret)
+}
+
+func (p *Provider) Capabilities() esv1beta1.SecretStoreCapabilities {
+	return esv1beta1.SecretStoreReadOnly
+}
+
+func init() {
+	esv1beta1.Register(&Provider{}, &esv1beta1.SecretStoreProvider{
+		Okta: &esv1beta1.OktaProvider{},
+	})
+}
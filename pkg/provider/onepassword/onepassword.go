@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net/url"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/1Password/connect-sdk-go/connect"
@@ -55,6 +56,7 @@ const (
 	errVersionNotImplemented = "'remoteRef.version' is not implemented in the 1Password provider"
 	errCreateItem            = "error creating 1Password Item: %w"
 	errDeleteItem            = "error deleting 1Password Item: %w"
+	errNoTOTP                = "1Password ItemField '%s' in '%s' has no TOTP code"
 	// custom error messages.
 	errKeyNotFoundMsg       = "key not found in 1Password Vaults"
 	errNoVaultsMsg          = "no vaults found"
@@ -63,6 +65,8 @@ const (
 	errExpectedOneFieldMsgF = "%w: '%s' in '%s', got %d"
 
 	documentCategory = "DOCUMENT"
+	// totpSuffix selects the current TOTP code of an OTP field instead of its secret.
+	totpSuffix = ".totp"
 )
 
 // Custom Errors //.
@@ -442,6 +446,12 @@ func (provider *ProviderOnePassword) getField(item *onepassword.Item, property s
 		fieldLabel = property
 	}
 
+	totp := false
+	if isTOTPProperty(fieldLabel, item.Fields) {
+		fieldLabel = strings.TrimSuffix(fieldLabel, totpSuffix)
+		totp = true
+	}
+
 	if length := countFieldsWithLabel(fieldLabel, item.Fields); length != 1 {
 		return nil, fmt.Errorf("%w: '%s' in '%s', got %d", ErrExpectedOneField, fieldLabel, item.Title, length)
 	}
@@ -449,16 +459,32 @@ func (provider *ProviderOnePassword) getField(item *onepassword.Item, property s
 	// caution: do not use client.GetValue here because it has undesirable behavior on keys with a dot in them
 	value := ""
 	for _, field := range item.Fields {
-		if field.Label == fieldLabel {
-			value = field.Value
-			break
+		if field.Label != fieldLabel {
+			continue
 		}
+		value = field.Value
+		if totp {
+			if !hasTOTP(field) {
+				return nil, fmt.Errorf(errNoTOTP, fieldLabel, item.Title)
+			}
+			value = field.TOTP
+		}
+		break
 	}
 
 	return []byte(value), nil
 }
 
 func (provider *ProviderOnePassword) getFields(item *onepassword.Item, property string) (map[string][]byte, error) {
+	// the TOTP code of an OTP field is only returned when it is requested explicitly
+	if isTOTPProperty(property, item.Fields) {
+		value, err := provider.getField(item, property)
+		if err != nil {
+			return nil, err
+		}
+		return map[string][]byte{property: value}, nil
+	}
+
 	secretData := make(map[string][]byte)
 	for _, field := range item.Fields {
 		if property != "" && field.Label != property {
@@ -638,6 +664,17 @@ func (provider *ProviderOnePassword) waitForLabelToBeUpdated(title, label string
 	}
 }
 
+// hasTOTP reports whether 1Password Connect computed a TOTP code for the field.
+func hasTOTP(field *onepassword.ItemField) bool {
+	return field.Type == onepassword.FieldTypeOTP && field.TOTP != ""
+}
+
+// isTOTPProperty reports whether property requests the TOTP code of a field,
+// a field labeled with the suffix takes precedence.
+func isTOTPProperty(property string, fields []*onepassword.ItemField) bool {
+	return strings.HasSuffix(property, totpSuffix) && countFieldsWithLabel(property, fields) == 0
+}
+
 func countFieldsWithLabel(fieldLabel string, fields []*onepassword.ItemField) int {
 	count := 0
 	for _, field := range fields {
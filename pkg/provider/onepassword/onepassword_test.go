@@ -58,6 +58,7 @@ const (
 	myOtherContents                          = "my-other-contents"
 	nonMatchingFilePNG, nonMatchingFilePNGID = "non-matching-file.png", "non-matching-file-id"
 	nonMatchingContents                      = "non-matching-contents"
+	otpLabel, otpSecret, otpCode             = "otp", "otpauth://totp/my-item?secret=JBSWY3DPEHPK3PXP", "123456"
 
 	// other.
 	mySecret, token, password = "my-secret", "token", "password"
@@ -720,6 +721,47 @@ func TestGetSecret(t *testing.T) {
 				},
 			},
 		},
+		{
+			setupNote: "one vault, one item, one OTP field",
+			provider: &ProviderOnePassword{
+				vaults: map[string]int{myVault: 1},
+				client: fake.NewMockClient().
+					AddPredictableVault(myVault).
+					AddPredictableItemWithField(myVault, myItem, key1, value1).
+					AppendItemField(myVaultID, myItemID, onepassword.ItemField{
+						Label: otpLabel,
+						Type:  onepassword.FieldTypeOTP,
+						Value: otpSecret,
+						TOTP:  otpCode,
+					}),
+			},
+			checks: []check{
+				{
+					checkNote: "OTP secret",
+					ref: esv1beta1.ExternalSecretDataRemoteRef{
+						Key:      myItem,
+						Property: otpLabel,
+					},
+					expectedValue: otpSecret,
+				},
+				{
+					checkNote: "current TOTP code",
+					ref: esv1beta1.ExternalSecretDataRemoteRef{
+						Key:      myItem,
+						Property: otpLabel + totpSuffix,
+					},
+					expectedValue: otpCode,
+				},
+				{
+					checkNote: "no TOTP code on a regular field",
+					ref: esv1beta1.ExternalSecretDataRemoteRef{
+						Key:      myItem,
+						Property: key1 + totpSuffix,
+					},
+					expectedErr: fmt.Errorf(errNoTOTP, key1, myItem),
+				},
+			},
+		},
 		{
 			setupNote: "files are loaded",
 			provider: &ProviderOnePassword{
@@ -885,6 +927,43 @@ func TestGetSecretMap(t *testing.T) {
 				},
 			},
 		},
+		{
+			setupNote: "one vault, one item, one OTP field",
+			provider: &ProviderOnePassword{
+				vaults: map[string]int{myVault: 1},
+				client: fake.NewMockClient().
+					AddPredictableVault(myVault).
+					AddPredictableItemWithField(myVault, myItem, key1, value1).
+					AppendItemField(myVaultID, myItemID, onepassword.ItemField{
+						Label: otpLabel,
+						Type:  onepassword.FieldTypeOTP,
+						Value: otpSecret,
+						TOTP:  otpCode,
+					}),
+			},
+			checks: []check{
+				{
+					checkNote: "all Properties without TOTP code",
+					ref: esv1beta1.ExternalSecretDataRemoteRef{
+						Key: myItem,
+					},
+					expectedMap: map[string][]byte{
+						key1:     []byte(value1),
+						otpLabel: []byte(otpSecret),
+					},
+				},
+				{
+					checkNote: "limit by TOTP Property",
+					ref: esv1beta1.ExternalSecretDataRemoteRef{
+						Key:      myItem,
+						Property: otpLabel + totpSuffix,
+					},
+					expectedMap: map[string][]byte{
+						otpLabel + totpSuffix: []byte(otpCode),
+					},
+				},
+			},
+		},
 		{
 			setupNote: "files",
 			provider: &ProviderOnePassword{
@@ -1298,6 +1377,34 @@ func TestGetAllSecrets(t *testing.T) {
 				},
 			},
 		},
+		{
+			setupNote: "one vault, one item, one OTP field",
+			provider: &ProviderOnePassword{
+				vaults: map[string]int{myVault: 1},
+				client: fake.NewMockClient().
+					AddPredictableVault(myVault).
+					AddPredictableItemWithField(myVault, myItem, key1, value1).
+					AppendItemField(myVaultID, myItemID, onepassword.ItemField{
+						Label: otpLabel,
+						Type:  onepassword.FieldTypeOTP,
+						Value: otpSecret,
+						TOTP:  otpCode,
+					}),
+			},
+			checks: []check{
+				{
+					checkNote: "find returns the OTP secret without TOTP code",
+					ref: esv1beta1.ExternalSecretFind{
+						Name: &esv1beta1.FindName{
+							RegExp: "^otp",
+						},
+					},
+					expectedMap: map[string][]byte{
+						otpLabel: []byte(otpSecret),
+					},
+				},
+			},
+		},
 	}
 
 	// run the tests
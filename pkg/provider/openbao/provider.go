@@ -0,0 +1,31 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package openbao registers the Vault provider for OpenBao stores.
+// OpenBao keeps the HTTP API of Vault, the Vault client is used to talk to it.
+package openbao
+
+import (
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/provider/vault"
+)
+
+func init() {
+	esv1beta1.Register(&vault.Provider{
+		NewVaultClient: vault.NewVaultClient,
+		OpenBao:        true,
+	}, &esv1beta1.SecretStoreProvider{
+		OpenBao: &esv1beta1.OpenBaoProvider{},
+	})
+}
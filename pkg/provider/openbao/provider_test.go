@@ -0,0 +1,115 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package openbao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+func TestProviderRegistered(t *testing.T) {
+	store := &esv1beta1.SecretStore{
+		Spec: esv1beta1.SecretStoreSpec{
+			Provider: &esv1beta1.SecretStoreProvider{
+				OpenBao: &esv1beta1.OpenBaoProvider{},
+			},
+		},
+	}
+	p, err := esv1beta1.GetProvider(store)
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.SecretStoreReadWrite, p.Capabilities())
+}
+
+func TestValidateStore(t *testing.T) {
+	otherNamespace := "other"
+	tests := map[string]struct {
+		cfg          esv1beta1.VaultProvider
+		wantWarnings bool
+		wantErr      string
+	}{
+		"valid": {
+			cfg: esv1beta1.VaultProvider{
+				Server: "https://openbao.example.com:8200",
+				Auth: esv1beta1.VaultAuth{
+					TokenSecretRef: &esmeta.SecretKeySelector{Name: "openbao-token", Key: "token"},
+				},
+			},
+		},
+		"token in other namespace": {
+			cfg: esv1beta1.VaultProvider{
+				Server: "https://openbao.example.com:8200",
+				Auth: esv1beta1.VaultAuth{
+					TokenSecretRef: &esmeta.SecretKeySelector{Name: "openbao-token", Key: "token", Namespace: &otherNamespace},
+				},
+			},
+			wantErr: "namespace should either be empty or match the namespace of the SecretStore",
+		},
+		"read your writes": {
+			cfg: esv1beta1.VaultProvider{
+				Server:         "https://openbao.example.com:8200",
+				ReadYourWrites: true,
+			},
+			wantWarnings: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			store := &esv1beta1.SecretStore{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default"},
+				Spec: esv1beta1.SecretStoreSpec{
+					Provider: &esv1beta1.SecretStoreProvider{
+						OpenBao: &esv1beta1.OpenBaoProvider{VaultProvider: tc.cfg},
+					},
+				},
+			}
+			p, err := esv1beta1.GetProvider(store)
+			require.NoError(t, err)
+			warnings, err := p.ValidateStore(store)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantWarnings, len(warnings) > 0)
+		})
+	}
+}
+
+func TestVaultStoreRejected(t *testing.T) {
+	store := &esv1beta1.SecretStore{
+		Spec: esv1beta1.SecretStoreSpec{
+			Provider: &esv1beta1.SecretStoreProvider{
+				OpenBao: &esv1beta1.OpenBaoProvider{},
+			},
+		},
+	}
+	p, err := esv1beta1.GetProvider(store)
+	require.NoError(t, err)
+	vaultStore := &esv1beta1.SecretStore{
+		Spec: esv1beta1.SecretStoreSpec{
+			Provider: &esv1beta1.SecretStoreProvider{
+				Vault: &esv1beta1.VaultProvider{},
+			},
+		},
+	}
+	_, err = p.ValidateStore(vaultStore)
+	assert.ErrorContains(t, err, "invalid vault provider")
+}
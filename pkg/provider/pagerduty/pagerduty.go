@@ -0,0 +1,207 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	// defaultIntegrationType is the integration type of a remote ref without property.
+	defaultIntegrationType = "events_api_v2"
+	// integrationTypeSuffix is the suffix of the integration types of the API, it may be omitted in a property.
+	integrationTypeSuffix = "_inbound_integration"
+
+	// mediaType selects the version 2 of the REST API.
+	mediaType = "application/vnd.pagerduty+json;version=2"
+
+	errInvalidKey          = "key %q must be the ID of a service"
+	errIntegrationNotFound = "service %q has no integration %q"
+	errAmbiguousType       = "service %q has %d integrations of type %q, set property to the ID of the integration"
+	errUnexpectedStatus    = "unexpected status code from PagerDuty: %d: %s"
+	errUnmarshalResponse   = "unable to unmarshal PagerDuty response: %w"
+	errReadOnly            = "the PagerDuty provider is read only"
+	errFindUnsupported     = "find is not supported by the PagerDuty provider"
+)
+
+// client reads the integration keys of services with the PagerDuty REST API.
+// https://developer.pagerduty.com/api-reference/
+type client struct {
+	httpClient *http.Client
+	url        string
+	apiToken   string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+type integration struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Summary string `json:"summary"`
+	// IntegrationKey is the routing key of the events API integrations.
+	IntegrationKey string `json:"integration_key"`
+	// IntegrationEmail is the address of the email integrations.
+	IntegrationEmail string `json:"integration_email"`
+}
+
+type serviceResponse struct {
+	Service struct {
+		ID           string        `json:"id"`
+		Integrations []integration `json:"integrations"`
+	} `json:"service"`
+}
+
+func validateServiceID(key string) error {
+	if key == "" || strings.Contains(key, "/") {
+		return fmt.Errorf(errInvalidKey, key)
+	}
+	return nil
+}
+
+// value returns the integration key, or the address of an email integration.
+func (i *integration) value() string {
+	if i.IntegrationKey != "" {
+		return i.IntegrationKey
+	}
+	return i.IntegrationEmail
+}
+
+// GetSecret returns the key of the integration of the service with the ID key. The property is the
+// ID or the type of the integration, e.g. events_api_v2 or generic_email, events_api_v2 by default.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	integrations, err := c.integrations(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	property := ref.Property
+	if property == "" {
+		property = defaultIntegrationType
+	}
+	var matches []integration
+	for _, i := range integrations {
+		if i.ID == property {
+			return []byte(i.value()), nil
+		}
+		if strings.TrimSuffix(i.Type, integrationTypeSuffix) == strings.TrimSuffix(property, integrationTypeSuffix) {
+			matches = append(matches, i)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf(errIntegrationNotFound, ref.Key, property)
+	case 1:
+		return []byte(matches[0].value()), nil
+	}
+	return nil, fmt.Errorf(errAmbiguousType, ref.Key, len(matches), property)
+}
+
+// GetSecretMap returns the keys of the integrations of the service by integration ID.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	integrations, err := c.integrations(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	data := make(map[string][]byte, len(integrations))
+	for _, i := range integrations {
+		if v := i.value(); v != "" {
+			data[i.ID] = []byte(v)
+		}
+	}
+	return data, nil
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindUnsupported)
+}
+
+func (c *client) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
+	return false, errors.New(errReadOnly)
+}
+
+// Validate reads the abilities of the account to check the API token.
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	if err := c.get(context.Background(), "/abilities", nil); err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+// integrations returns the integrations of a service, including their keys.
+func (c *client) integrations(ctx context.Context, serviceID string) ([]integration, error) {
+	if err := validateServiceID(serviceID); err != nil {
+		return nil, err
+	}
+	var resp serviceResponse
+	query := url.Values{"include[]": {"integrations"}}
+	if err := c.get(ctx, "/services/"+url.PathEscape(serviceID)+"?"+query.Encode(), &resp); err != nil {
+		return nil, err
+	}
+	return resp.Service.Integrations, nil
+}
+
+func (c *client) get(ctx context.Context, path string, target any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+path, http.NoBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", mediaType)
+	req.Header.Set("Authorization", "Token token="+c.apiToken)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return esv1beta1.NoSecretError{}
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf(errUnexpectedStatus, resp.StatusCode, body)
+	}
+	if target == nil {
+		return nil
+	}
+	if err := json.Unmarshal(body, target); err != nil {
+		return fmt.Errorf(errUnmarshalResponse, err)
+	}
+	return nil
+}
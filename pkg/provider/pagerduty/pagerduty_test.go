@@ -0,0 +1,135 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pagerduty
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const testToken = "u+testtoken"
+
+// newTestClient serves the service PXPGF42 from the recorded response in testdata.
+func newTestClient(t *testing.T) *client {
+	service, err := os.ReadFile("testdata/service.json")
+	require.NoError(t, err)
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /services/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if r.PathValue("id") != "PXPGF42" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"message":"Not Found","code":2100}}`))
+			return
+		}
+		if r.URL.Query().Get("include[]") != "integrations" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = w.Write(service)
+	})
+	mux.HandleFunc("GET /abilities", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"abilities":["teams","urgencies"]}`))
+	})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Token token="+testToken || r.Header.Get("Accept") != mediaType {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":{"message":"Authentication failed","code":2006}}`))
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return &client{httpClient: srv.Client(), url: srv.URL, apiToken: testToken}
+}
+
+func TestGetSecret(t *testing.T) {
+	c := newTestClient(t)
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"events api v2 by default": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "PXPGF42"},
+			want: "f9e3c4a1b2d84f6c9a0e7b5d3c1a2b4e",
+		},
+		"full type": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "PXPGF42", Property: "events_api_v2_inbound_integration"},
+			want: "f9e3c4a1b2d84f6c9a0e7b5d3c1a2b4e",
+		},
+		"email": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "PXPGF42", Property: "generic_email"},
+			want: "checkout-api@acme.pagerduty.com",
+		},
+		"integration id": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "PXPGF42", Property: "P3MZ5RT"},
+			want: "5c8e2a0f7d1b4c96b3e9a4d2f6c0b8e1",
+		},
+		"several integrations of the type": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "PXPGF42", Property: "generic_events_api"},
+			wantErr: `service "PXPGF42" has 2 integrations of type "generic_events_api", set property to the ID of the integration`,
+		},
+		"missing integration": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "PXPGF42", Property: "aws_cloudwatch"},
+			wantErr: `service "PXPGF42" has no integration "aws_cloudwatch"`,
+		},
+		"missing service": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "PMISSING"},
+			wantErr: "Secret does not exist",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestGetSecretMap(t *testing.T) {
+	c := newTestClient(t)
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "PXPGF42"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"PE1U9CH": []byte("f9e3c4a1b2d84f6c9a0e7b5d3c1a2b4e"),
+		"PQ2X8ZL": []byte("checkout-api@acme.pagerduty.com"),
+		"P7KD0QA": []byte("0a7d1c5e9b3f4e28a6c2d8b0f1e3a5c7"),
+		"P3MZ5RT": []byte("5c8e2a0f7d1b4c96b3e9a4d2f6c0b8e1"),
+	}, got)
+}
+
+func TestValidate(t *testing.T) {
+	c := newTestClient(t)
+	res, err := c.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+
+	c.apiToken = "invalid"
+	res, err = c.Validate()
+	assert.ErrorContains(t, err, "unexpected status code from PagerDuty: 401")
+	assert.Equal(t, esv1beta1.ValidationResultError, res)
+}
@@ -0,0 +1,78 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pagerduty
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+func TestValidateStore(t *testing.T) {
+	namespace := "other"
+	tests := map[string]struct {
+		cfg     esv1beta1.PagerDutyProvider
+		wantErr string
+	}{
+		"valid": {
+			cfg: esv1beta1.PagerDutyProvider{
+				URL:  "https://api.eu.pagerduty.com",
+				Auth: esv1beta1.PagerDutyAuth{APIToken: esmeta.SecretKeySelector{Name: "pagerduty", Key: "api-token"}},
+			},
+		},
+		"invalid url": {
+			cfg: esv1beta1.PagerDutyProvider{
+				URL:  "api.pagerduty.com",
+				Auth: esv1beta1.PagerDutyAuth{APIToken: esmeta.SecretKeySelector{Name: "pagerduty", Key: "api-token"}},
+			},
+			wantErr: `invalid url "api.pagerduty.com"`,
+		},
+		"secret in other namespace": {
+			cfg: esv1beta1.PagerDutyProvider{
+				Auth: esv1beta1.PagerDutyAuth{APIToken: esmeta.SecretKeySelector{Name: "pagerduty", Key: "api-token", Namespace: &namespace}},
+			},
+			wantErr: "namespace should either be empty or match the namespace of the SecretStore",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := esv1beta1.SecretStore{
+				Spec: esv1beta1.SecretStoreSpec{
+					Provider: &esv1beta1.SecretStoreProvider{
+						PagerDuty: &tc.cfg,
+					},
+				},
+			}
+			p := &Provider{}
+			_, err := p.ValidateStore(&s)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestValidateRemoteRef(t *testing.T) {
+	p := &Provider{}
+	assert.NoError(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: "PXPGF42"}))
+	assert.NoError(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: "PXPGF42", Property: "generic_email"}))
+	assert.Error(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: ""}))
+	assert.Error(t, p.ValidateRemoteRef(nil, esv1beta1.ExternalSecretDataRemoteRef{Key: "services/PXPGF42"}))
+}
@@ -0,0 +1,208 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package passbolt
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/gopenpgp/v2/helper"
+	"github.com/passbolt/go-passbolt/api"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	fixturePassphrase        = "passbolt-test"
+	fixtureServerFingerprint = "3B50 0552 8DD2 2780 3CF1 4D2F DA29 146C 7CF9 9173"
+)
+
+// interaction is a recorded response of the Passbolt API.
+type interaction struct {
+	Method       string              `json:"method"`
+	Path         string              `json:"path"`
+	BodyContains string              `json:"bodyContains,omitempty"`
+	Headers      map[string][]string `json:"headers,omitempty"`
+	Response     json.RawMessage     `json:"response"`
+}
+
+func (i interaction) statusCode() int {
+	var res api.APIResponse
+	if err := json.Unmarshal(i.Response, &res); err != nil || res.Header.Code == 0 {
+		return http.StatusOK
+	}
+	return res.Header.Code
+}
+
+func readFixture(t *testing.T, name string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+	return string(data)
+}
+
+// newFixtureServer replays testdata/api.json. The server verification
+// challenge can not be recorded, so it is answered with the server key.
+func newFixtureServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	var interactions []interaction
+	if err := json.Unmarshal([]byte(readFixture(t, "api.json")), &interactions); err != nil {
+		t.Fatalf("failed to parse fixture: %v", err)
+	}
+	serverKey := readFixture(t, "server_private_key.asc")
+
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method == http.MethodPost && r.URL.Path == "/auth/verify.json" {
+			var req api.GPGVerifyContainer
+			if err := json.Unmarshal(body, &req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			token, err := helper.DecryptMessageArmored(serverKey, nil, req.Req.Token)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			w.Header().Set("X-GPGAuth-Verify-Response", token)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"header":{"status":"success","code":200},"body":null}`))
+			return
+		}
+		for _, i := range interactions {
+			if i.Method != r.Method || i.Path != r.URL.Path || !strings.Contains(string(body), i.BodyContains) {
+				continue
+			}
+			for k, v := range i.Headers {
+				w.Header()[k] = v
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(i.statusCode())
+			_, _ = w.Write(i.Response)
+			return
+		}
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		http.NotFound(w, r)
+	}))
+}
+
+func newFixtureProvider(t *testing.T, serverFingerprint string) *ProviderPassbolt {
+	t.Helper()
+	srv := newFixtureServer(t)
+	t.Cleanup(srv.Close)
+	client, err := api.NewClient(srv.Client(), "", srv.URL, readFixture(t, "user_private_key.asc"), fixturePassphrase)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	return &ProviderPassbolt{client: client, serverFingerprint: normalizeFingerprint(serverFingerprint)}
+}
+
+func TestFixtureGetSecret(t *testing.T) {
+	tbl := []struct {
+		name     string
+		key      string
+		property string
+		expValue string
+		expErr   string
+	}{
+		{
+			name:     "password and description by id",
+			key:      "8e3874ae-4b40-590b-968a-418f704b9d9a",
+			property: "description",
+			expValue: "production database",
+		},
+		{
+			name:     "password and description by name",
+			key:      "database",
+			property: "password",
+			expValue: "s3cr3t",
+		},
+		{
+			name:     "password string by name",
+			key:      "legacy",
+			expValue: `{"name":"legacy","username":"admin","password":"hunter2","uri":"https://legacy.example.com","description":"legacy admin"}`,
+		},
+		{
+			name:   "unknown name",
+			key:    "missing",
+			expErr: esv1beta1.NoSecretError{}.Error(),
+		},
+		{
+			name:   "ambiguous name",
+			key:    "shared",
+			expErr: `found 2 resources named "shared"`,
+		},
+	}
+
+	for _, row := range tbl {
+		t.Run(row.name, func(t *testing.T) {
+			p := newFixtureProvider(t, fixtureServerFingerprint)
+			out, err := p.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: row.key, Property: row.property})
+			if row.expErr != "" {
+				if err == nil || !strings.Contains(err.Error(), row.expErr) {
+					t.Fatalf("expected error containing %q, got %v", row.expErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(out) != row.expValue {
+				t.Errorf("expected %q, got %q", row.expValue, out)
+			}
+		})
+	}
+}
+
+func TestFixtureGetSecretMap(t *testing.T) {
+	p := newFixtureProvider(t, fixtureServerFingerprint)
+	out, err := p.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "database"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]string{
+		"password":    "s3cr3t",
+		"username":    "app",
+		"description": "production database",
+	}
+	if len(out) != len(expected) {
+		t.Fatalf("expected %d keys, got %v", len(expected), out)
+	}
+	for k, v := range expected {
+		if string(out[k]) != v {
+			t.Errorf("expected %s to be %q, got %q", k, v, out[k])
+		}
+	}
+	if err := p.Close(context.Background()); err != nil {
+		t.Errorf("unexpected error on close: %v", err)
+	}
+}
+
+func TestFixtureServerFingerprintMismatch(t *testing.T) {
+	p := newFixtureProvider(t, "0000000000000000000000000000000000000000")
+	_, err := p.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "database"})
+	if err == nil || !strings.Contains(err.Error(), "does not match the configured serverFingerprint") {
+		t.Fatalf("expected fingerprint mismatch, got %v", err)
+	}
+}
@@ -21,7 +21,11 @@ import (
 	"fmt"
 	"net/url"
 	"regexp"
+	"strings"
 
+	"github.com/ProtonMail/gopenpgp/v2/crypto"
+	"github.com/ProtonMail/gopenpgp/v2/helper"
+	"github.com/google/uuid"
 	"github.com/passbolt/go-passbolt/api"
 	corev1 "k8s.io/api/core/v1"
 	kclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -40,12 +44,25 @@ const (
 	errPassboltStoreMissingHost                    = "missing: spec.provider.passbolt.host"
 	errPassboltExternalSecretMissingFindNameRegExp = "missing: find.name.regexp"
 	errPassboltStoreHostSchemeNotHTTPS             = "host Url has to be https scheme"
+	errPassboltStoreInvalidServerFingerprint       = "serverFingerprint must be a hex encoded OpenPGP fingerprint"
 	errPassboltSecretPropertyInvalid               = "property must be one of name, username, uri, password or description"
+	errPassboltServerFingerprintMismatch           = "server key fingerprint %s does not match the configured serverFingerprint"
+	errPassboltServerVerification                  = "unable to verify passbolt server: %w"
+	errPassboltAmbiguousResourceName               = "found %d resources named %q, use the resource ID instead"
 	errNotImplemented                              = "not implemented"
+
+	// gpgAuthTokenFormat is the challenge format of the GPGAuth protocol.
+	gpgAuthTokenFormat = "gpgauthv1.3.0|36|%s|gpgauthv1.3.0"
 )
 
+// secretMapKeys are the properties returned by GetSecretMap.
+var secretMapKeys = []string{"password", "username", "description"}
+
+var fingerprintRegexp = regexp.MustCompile(`^([0-9a-fA-F]{40}|[0-9a-fA-F]{64})$`)
+
 type ProviderPassbolt struct {
-	client Client
+	client            Client
+	serverFingerprint string
 }
 
 func (provider *ProviderPassbolt) Capabilities() esv1beta1.SecretStoreCapabilities {
@@ -61,6 +78,8 @@ type Client interface {
 	GetResourceType(ctx context.Context, typeID string) (*api.ResourceType, error)
 	DecryptMessage(message string) (string, error)
 	GetSecret(ctx context.Context, resourceID string) (*api.Secret, error)
+	GetPublicKey(ctx context.Context) (string, string, error)
+	VerifyServer(ctx context.Context, token, encToken string) error
 }
 
 func (provider *ProviderPassbolt) NewClient(ctx context.Context, store esv1beta1.GenericStore, kube kclient.Client, namespace string) (esv1beta1.SecretsClient, error) {
@@ -93,8 +112,10 @@ func (provider *ProviderPassbolt) NewClient(ctx context.Context, store esv1beta1
 		return nil, err
 	}
 
-	provider.client = client
-	return provider, nil
+	return &ProviderPassbolt{
+		client:            client,
+		serverFingerprint: normalizeFingerprint(config.ServerFingerprint),
+	}, nil
 }
 
 func (provider *ProviderPassbolt) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
@@ -102,11 +123,16 @@ func (provider *ProviderPassbolt) SecretExists(_ context.Context, _ esv1beta1.Pu
 }
 
 func (provider *ProviderPassbolt) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
-	if err := assureLoggedIn(ctx, provider.client); err != nil {
+	if err := provider.assureLoggedIn(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := provider.resolveResourceID(ctx, ref.Key)
+	if err != nil {
 		return nil, err
 	}
 
-	secret, err := provider.getPassboltSecret(ctx, ref.Key)
+	secret, err := provider.getPassboltSecret(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -130,8 +156,30 @@ func (provider *ProviderPassbolt) Validate() (esv1beta1.ValidationResult, error)
 	return esv1beta1.ValidationResultUnknown, nil
 }
 
-func (provider *ProviderPassbolt) GetSecretMap(_ context.Context, _ esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
-	return nil, fmt.Errorf(errNotImplemented)
+func (provider *ProviderPassbolt) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	if err := provider.assureLoggedIn(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := provider.resolveResourceID(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := provider.getPassboltSecret(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string][]byte, len(secretMapKeys))
+	for _, key := range secretMapKeys {
+		res[key], err = secret.GetProp(key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
 }
 
 func (provider *ProviderPassbolt) GetAllSecrets(ctx context.Context, ref esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
@@ -141,7 +189,7 @@ func (provider *ProviderPassbolt) GetAllSecrets(ctx context.Context, ref esv1bet
 		return res, errors.New(errPassboltExternalSecretMissingFindNameRegExp)
 	}
 
-	if err := assureLoggedIn(ctx, provider.client); err != nil {
+	if err := provider.assureLoggedIn(ctx); err != nil {
 		return nil, err
 	}
 
@@ -208,6 +256,10 @@ func (provider *ProviderPassbolt) ValidateStore(store esv1beta1.GenericStore) (a
 		return nil, errors.New(errPassboltStoreHostSchemeNotHTTPS)
 	}
 
+	if config.ServerFingerprint != "" && !fingerprintRegexp.MatchString(normalizeFingerprint(config.ServerFingerprint)) {
+		return nil, errors.New(errPassboltStoreInvalidServerFingerprint)
+	}
+
 	return nil, nil
 }
 
@@ -287,10 +339,77 @@ func (provider *ProviderPassbolt) getPassboltSecret(ctx context.Context, id stri
 	return &res, nil
 }
 
-func assureLoggedIn(ctx context.Context, client Client) error {
-	if client.CheckSession(ctx) {
+// resolveResourceID maps a remote key to a resource ID. Keys that are not
+// UUIDs are looked up by resource name, which has to be unique.
+func (provider *ProviderPassbolt) resolveResourceID(ctx context.Context, key string) (string, error) {
+	if _, err := uuid.Parse(key); err == nil {
+		return key, nil
+	}
+
+	resources, err := provider.client.GetResources(ctx, &api.GetResourcesOptions{})
+	if err != nil {
+		return "", err
+	}
+
+	var ids []string
+	for _, resource := range resources {
+		if resource.Name == key {
+			ids = append(ids, resource.ID)
+		}
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", esv1beta1.NoSecretError{}
+	case 1:
+		return ids[0], nil
+	default:
+		return "", fmt.Errorf(errPassboltAmbiguousResourceName, len(ids), key)
+	}
+}
+
+func (provider *ProviderPassbolt) assureLoggedIn(ctx context.Context) error {
+	if provider.client.CheckSession(ctx) {
 		return nil
 	}
 
-	return client.Login(ctx)
+	if provider.serverFingerprint != "" {
+		if err := provider.verifyServer(ctx); err != nil {
+			return fmt.Errorf(errPassboltServerVerification, err)
+		}
+	}
+
+	return provider.client.Login(ctx)
+}
+
+// verifyServer checks the fingerprint of the server key and lets the server
+// decrypt a challenge with it, so it has to hold the matching private key.
+func (provider *ProviderPassbolt) verifyServer(ctx context.Context) error {
+	serverKey, _, err := provider.client.GetPublicKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	key, err := crypto.NewKeyFromArmored(serverKey)
+	if err != nil {
+		return err
+	}
+
+	// the fingerprint returned by the client is the one of the user key, so
+	// compute it from the server key instead
+	if fingerprint := key.GetFingerprint(); !strings.EqualFold(fingerprint, provider.serverFingerprint) {
+		return fmt.Errorf(errPassboltServerFingerprintMismatch, fingerprint)
+	}
+
+	token := fmt.Sprintf(gpgAuthTokenFormat, uuid.NewString())
+	encToken, err := helper.EncryptMessageArmored(serverKey, token)
+	if err != nil {
+		return err
+	}
+
+	return provider.client.VerifyServer(ctx, token, encToken)
+}
+
+func normalizeFingerprint(fingerprint string) string {
+	return strings.ReplaceAll(fingerprint, " ", "")
 }
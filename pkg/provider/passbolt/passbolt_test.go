@@ -29,6 +29,12 @@ import (
 	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
 )
 
+const (
+	someKey1 = "3b8c8ad5-9d1a-4b5e-a0c4-2f1e6d7c8b9a"
+	someKey2 = "7e6d5c4b-3a2f-4e1d-8c9b-0a1f2e3d4c5b"
+	someKey3 = "c1d2e3f4-a5b6-4c7d-8e9f-0a1b2c3d4e5f"
+)
+
 type PassboltClientMock struct {
 }
 
@@ -43,8 +49,8 @@ func (p *PassboltClientMock) Logout(_ context.Context) error {
 }
 func (p *PassboltClientMock) GetResource(_ context.Context, resourceID string) (*api.Resource, error) {
 	resmap := map[string]api.Resource{
-		"some-key1": {ID: "some-key1", Name: "some-name1", URI: "some-uri1"},
-		"some-key2": {ID: "some-key2", Name: "some-name2", URI: "some-uri2"},
+		someKey1: {ID: someKey1, Name: "some-name1", URI: "some-uri1"},
+		someKey2: {ID: someKey2, Name: "some-name2", URI: "some-uri2"},
 	}
 
 	if res, ok := resmap[resourceID]; ok {
@@ -56,8 +62,9 @@ func (p *PassboltClientMock) GetResource(_ context.Context, resourceID string) (
 
 func (p *PassboltClientMock) GetResources(_ context.Context, _ *api.GetResourcesOptions) ([]api.Resource, error) {
 	res := []api.Resource{
-		{ID: "some-key1", Name: "some-name1", URI: "some-uri1"},
-		{ID: "some-key2", Name: "some-name2", URI: "some-uri2"},
+		{ID: someKey1, Name: "some-name1", URI: "some-uri1"},
+		{ID: someKey2, Name: "some-name2", URI: "some-uri2"},
+		{ID: someKey3, Name: "some-name2", URI: "some-uri3"},
 	}
 	return res, nil
 }
@@ -73,8 +80,8 @@ func (p *PassboltClientMock) DecryptMessage(message string) (string, error) {
 
 func (p *PassboltClientMock) GetSecret(_ context.Context, resourceID string) (*api.Secret, error) {
 	resmap := map[string]api.Secret{
-		"some-key1": {Data: `{"password": "some-password1", "description": "some-description1"}`},
-		"some-key2": {Data: `{"password": "some-password2", "description": "some-description2"}`},
+		someKey1: {Data: `{"password": "some-password1", "description": "some-description1"}`},
+		someKey2: {Data: `{"password": "some-password2", "description": "some-description2"}`},
 	}
 
 	if res, ok := resmap[resourceID]; ok {
@@ -84,6 +91,14 @@ func (p *PassboltClientMock) GetSecret(_ context.Context, resourceID string) (*a
 	return nil, errors.New("ID not found")
 }
 
+func (p *PassboltClientMock) GetPublicKey(_ context.Context) (string, string, error) {
+	return "", "", errors.New("not implemented")
+}
+
+func (p *PassboltClientMock) VerifyServer(_ context.Context, _, _ string) error {
+	return errors.New("not implemented")
+}
+
 var clientMock = &PassboltClientMock{}
 
 func TestValidateStore(t *testing.T) {
@@ -131,8 +146,14 @@ func TestValidateStore(t *testing.T) {
 	_, err = p.ValidateStore(store)
 	g.Expect(err).To(g.BeEquivalentTo(fmt.Errorf(errPassboltStoreHostSchemeNotHTTPS)))
 
-	// spec ok
+	// invalid server fingerprint
 	store.Spec.Provider.Passbolt.Host = "https://passbolt.test"
+	store.Spec.Provider.Passbolt.ServerFingerprint = "not-a-fingerprint"
+	_, err = p.ValidateStore(store)
+	g.Expect(err).To(g.BeEquivalentTo(fmt.Errorf(errPassboltStoreInvalidServerFingerprint)))
+
+	// spec ok
+	store.Spec.Provider.Passbolt.ServerFingerprint = "3B50 0552 8DD2 2780 3CF1 4D2F DA29 146C 7CF9 9173"
 	_, err = p.ValidateStore(store)
 	g.Expect(err).To(g.BeNil())
 }
@@ -164,12 +185,11 @@ func TestGetAllSecrets(t *testing.T) {
 			desc: "matches",
 			ref: esv1beta1.ExternalSecretFind{
 				Name: &esv1beta1.FindName{
-					RegExp: "some-name.*",
+					RegExp: "some-name1",
 				},
 			},
 			expected: map[string][]byte{
-				"some-key1": []byte(`{"name":"some-name1","username":"","password":"some-password1","uri":"some-uri1","description":"some-description1"}`),
-				"some-key2": []byte(`{"name":"some-name2","username":"","password":"some-password2","uri":"some-uri2","description":"some-description2"}`),
+				someKey1: []byte(`{"name":"some-name1","username":"","password":"some-password1","uri":"some-uri1","description":"some-description1"}`),
 			},
 		},
 		{
@@ -228,14 +248,36 @@ func TestGetSecret(t *testing.T) {
 		{
 			name: "return err when not found",
 			request: esv1beta1.ExternalSecretDataRemoteRef{
-				Key: "nonexistent",
+				Key: "0d9c8b7a-6f5e-4d3c-2b1a-0f9e8d7c6b5a",
 			},
 			expErr: "ID not found",
 		},
+		{
+			name: "return err when name not found",
+			request: esv1beta1.ExternalSecretDataRemoteRef{
+				Key: "nonexistent",
+			},
+			expErr: esv1beta1.NoSecretError{}.Error(),
+		},
+		{
+			name: "return err when name is ambiguous",
+			request: esv1beta1.ExternalSecretDataRemoteRef{
+				Key: "some-name2",
+			},
+			expErr: fmt.Sprintf(errPassboltAmbiguousResourceName, 2, "some-name2"),
+		},
+		{
+			name: "get property from secret by name",
+			request: esv1beta1.ExternalSecretDataRemoteRef{
+				Key:      "some-name1",
+				Property: "description",
+			},
+			expValue: "some-description1",
+		},
 		{
 			name: "get property from secret",
 			request: esv1beta1.ExternalSecretDataRemoteRef{
-				Key:      "some-key1",
+				Key:      someKey1,
 				Property: "password",
 			},
 			expValue: "some-password1",
@@ -243,14 +285,14 @@ func TestGetSecret(t *testing.T) {
 		{
 			name: "get full secret",
 			request: esv1beta1.ExternalSecretDataRemoteRef{
-				Key: "some-key1",
+				Key: someKey1,
 			},
 			expValue: `{"name":"some-name1","username":"","password":"some-password1","uri":"some-uri1","description":"some-description1"}`,
 		},
 		{
 			name: "return err when using invalid property",
 			request: esv1beta1.ExternalSecretDataRemoteRef{
-				Key:      "some-key1",
+				Key:      someKey1,
 				Property: "invalid",
 			},
 			expErr: errPassboltSecretPropertyInvalid,
@@ -293,6 +335,11 @@ func TestDeleteSecret(t *testing.T) {
 func TestGetSecretMap(t *testing.T) {
 	p := &ProviderPassbolt{client: clientMock}
 	g.RegisterTestingT(t)
-	_, err := p.GetSecretMap(context.TODO(), esv1beta1.ExternalSecretDataRemoteRef{})
-	g.Expect(err).To(g.BeEquivalentTo(fmt.Errorf(errNotImplemented)))
+	out, err := p.GetSecretMap(context.TODO(), esv1beta1.ExternalSecretDataRemoteRef{Key: someKey1})
+	g.Expect(err).ToNot(g.HaveOccurred())
+	g.Expect(out).To(g.Equal(map[string][]byte{
+		"password":    []byte("some-password1"),
+		"username":    []byte(""),
+		"description": []byte("some-description1"),
+	}))
 }
@@ -0,0 +1,100 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command example is a skeleton of a provider plugin keeping secrets in memory.
+// Replace the in-memory map with calls to your secret backend.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"net"
+	"os"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/external-secrets/external-secrets/pkg/provider/plugin"
+)
+
+type server struct {
+	plugin.UnimplementedProviderPluginServer
+
+	mu      sync.RWMutex
+	secrets map[string][]byte
+}
+
+func (s *server) GetSecret(_ context.Context, req *plugin.GetSecretRequest) (*plugin.GetSecretResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	value, ok := s.secrets[req.Ref.Key]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "secret %q not found", req.Ref.Key)
+	}
+	return &plugin.GetSecretResponse{Value: value}, nil
+}
+
+func (s *server) GetSecretMap(_ context.Context, req *plugin.GetSecretMapRequest) (*plugin.GetSecretMapResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	prefix := strings.TrimSuffix(req.Ref.Key, "/") + "/"
+	data := make(map[string][]byte)
+	for k, v := range s.secrets {
+		if strings.HasPrefix(k, prefix) {
+			data[strings.TrimPrefix(k, prefix)] = v
+		}
+	}
+	if len(data) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no secret below %q", prefix)
+	}
+	return &plugin.GetSecretMapResponse{Data: data}, nil
+}
+
+func (s *server) PushSecret(_ context.Context, req *plugin.PushSecretRequest) (*plugin.PushSecretResponse, error) {
+	if req.Property != "" {
+		return nil, status.Error(codes.InvalidArgument, "properties are not supported")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.secrets[req.RemoteKey] = req.Value
+	return &plugin.PushSecretResponse{}, nil
+}
+
+func (s *server) DeleteSecret(_ context.Context, req *plugin.DeleteSecretRequest) (*plugin.DeleteSecretResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.secrets, req.RemoteKey)
+	return &plugin.DeleteSecretResponse{}, nil
+}
+
+func main() {
+	socket := flag.String("socket", "/var/run/plugin/plugin.sock", "unix socket to listen on")
+	flag.Parse()
+
+	_ = os.Remove(*socket)
+	lis, err := net.Listen("unix", *socket)
+	if err != nil {
+		log.Fatalf("unable to listen: %v", err)
+	}
+	s := grpc.NewServer()
+	plugin.RegisterProviderPluginServer(s, &server{secrets: map[string][]byte{}})
+	log.Printf("serving on %s", *socket)
+	if err := s.Serve(lis); err != nil {
+		log.Fatalf("unable to serve: %v", err)
+	}
+}
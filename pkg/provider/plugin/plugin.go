@@ -0,0 +1,167 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	validateTimeout = 10 * time.Second
+
+	errFindNotSupported = "find is not supported by the plugin provider"
+	errPluginCall       = "plugin call %s failed: %w"
+	errPluginInvalid    = "plugin is not ready: %s"
+)
+
+// GRPCProvider is the SecretsClient proxying every call to a plugin
+// implementing the ProviderPlugin service.
+type GRPCProvider struct {
+	conn   *grpc.ClientConn
+	client ProviderPluginClient
+}
+
+var _ esv1beta1.SecretsClient = &GRPCProvider{}
+
+// NewGRPCProvider returns a client using the given connection.
+func NewGRPCProvider(conn *grpc.ClientConn) *GRPCProvider {
+	return &GRPCProvider{conn: conn, client: NewProviderPluginClient(conn)}
+}
+
+func (c *GRPCProvider) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	resp, err := c.client.GetSecret(ctx, &GetSecretRequest{Ref: toRemoteRef(ref)})
+	if err != nil {
+		return nil, callError("GetSecret", err)
+	}
+	return resp.GetValue(), nil
+}
+
+func (c *GRPCProvider) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	resp, err := c.client.GetSecretMap(ctx, &GetSecretMapRequest{Ref: toRemoteRef(ref)})
+	if err != nil {
+		return nil, callError("GetSecretMap", err)
+	}
+	return resp.GetData(), nil
+}
+
+func (c *GRPCProvider) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindNotSupported)
+}
+
+func (c *GRPCProvider) PushSecret(ctx context.Context, secret *corev1.Secret, data esv1beta1.PushSecretData) error {
+	var value []byte
+	if data.GetSecretKey() == "" {
+		secretStringVal := make(map[string]string, len(secret.Data))
+		for k, v := range secret.Data {
+			secretStringVal[k] = string(v)
+		}
+		var err error
+		value, err = utils.JSONMarshal(secretStringVal)
+		if err != nil {
+			return err
+		}
+	} else {
+		value = secret.Data[data.GetSecretKey()]
+	}
+	req := &PushSecretRequest{
+		Value:     value,
+		SecretKey: data.GetSecretKey(),
+		RemoteKey: data.GetRemoteKey(),
+		Property:  data.GetProperty(),
+	}
+	if _, err := c.client.PushSecret(ctx, req); err != nil {
+		return callError("PushSecret", err)
+	}
+	return nil
+}
+
+func (c *GRPCProvider) DeleteSecret(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) error {
+	req := &DeleteSecretRequest{
+		RemoteKey: remoteRef.GetRemoteKey(),
+		Property:  remoteRef.GetProperty(),
+	}
+	_, err := c.client.DeleteSecret(ctx, req)
+	if err == nil || status.Code(err) == codes.NotFound {
+		return nil
+	}
+	return callError("DeleteSecret", err)
+}
+
+func (c *GRPCProvider) SecretExists(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) (bool, error) {
+	ref := &RemoteRef{
+		Key:      remoteRef.GetRemoteKey(),
+		Property: remoteRef.GetProperty(),
+	}
+	_, err := c.client.GetSecret(ctx, &GetSecretRequest{Ref: ref})
+	if status.Code(err) == codes.NotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, callError("GetSecret", err)
+	}
+	return true, nil
+}
+
+func (c *GRPCProvider) Validate() (esv1beta1.ValidationResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), validateTimeout)
+	defer cancel()
+	resp, err := c.client.Validate(ctx, &ValidateRequest{})
+	// plugins without a Validate method are assumed to be ready
+	if status.Code(err) == codes.Unimplemented {
+		return esv1beta1.ValidationResultReady, nil
+	}
+	if err != nil {
+		return esv1beta1.ValidationResultError, callError("Validate", err)
+	}
+	switch resp.GetResult() {
+	case ValidateResponse_READY:
+		return esv1beta1.ValidationResultReady, nil
+	case ValidateResponse_UNKNOWN:
+		return esv1beta1.ValidationResultUnknown, nil
+	default:
+		return esv1beta1.ValidationResultError, fmt.Errorf(errPluginInvalid, resp.GetMessage())
+	}
+}
+
+func (c *GRPCProvider) Close(_ context.Context) error {
+	return c.conn.Close()
+}
+
+// callError maps codes.NotFound to NoSecretError and wraps every other error.
+func callError(method string, err error) error {
+	if status.Code(err) == codes.NotFound {
+		return esv1beta1.NoSecretError{}
+	}
+	return fmt.Errorf(errPluginCall, method, err)
+}
+
+func toRemoteRef(ref esv1beta1.ExternalSecretDataRemoteRef) *RemoteRef {
+	return &RemoteRef{
+		Key:      ref.Key,
+		Property: ref.Property,
+		Version:  ref.Version,
+	}
+}
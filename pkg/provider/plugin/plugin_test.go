@@ -0,0 +1,141 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1alpha1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1alpha1"
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+type fakePlugin struct {
+	UnimplementedProviderPluginServer
+
+	mu      sync.Mutex
+	secrets map[string][]byte
+	pushed  *PushSecretRequest
+}
+
+func (f *fakePlugin) GetSecret(_ context.Context, req *GetSecretRequest) (*GetSecretResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	v, ok := f.secrets[req.Ref.Key]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return &GetSecretResponse{Value: v}, nil
+}
+
+func (f *fakePlugin) GetSecretMap(_ context.Context, _ *GetSecretMapRequest) (*GetSecretMapResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return &GetSecretMapResponse{Data: f.secrets}, nil
+}
+
+func (f *fakePlugin) PushSecret(_ context.Context, req *PushSecretRequest) (*PushSecretResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.pushed = req
+	f.secrets[req.RemoteKey] = req.Value
+	return &PushSecretResponse{}, nil
+}
+
+func (f *fakePlugin) Validate(_ context.Context, _ *ValidateRequest) (*ValidateResponse, error) {
+	return &ValidateResponse{Result: ValidateResponse_ERROR, Message: "backend unreachable"}, nil
+}
+
+func newTestProvider(t *testing.T, srv ProviderPluginServer) *GRPCProvider {
+	t.Helper()
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	RegisterProviderPluginServer(s, srv)
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	p := NewGRPCProvider(conn)
+	t.Cleanup(func() {
+		_ = p.Close(context.Background())
+	})
+	return p
+}
+
+func TestGRPCProvider(t *testing.T) {
+	fake := &fakePlugin{secrets: map[string][]byte{"db": []byte("s3cr3t")}}
+	p := newTestProvider(t, fake)
+	ctx := context.Background()
+
+	got, err := p.GetSecret(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: "db"})
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t", string(got))
+
+	_, err = p.GetSecret(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: "missing"})
+	assert.ErrorIs(t, err, esv1beta1.NoSecretError{})
+
+	secret := &corev1.Secret{Data: map[string][]byte{"token": []byte("abc")}}
+	err = p.PushSecret(ctx, secret, esv1alpha1.PushSecretData{
+		Match: esv1alpha1.PushSecretMatch{
+			SecretKey: "token",
+			RemoteRef: esv1alpha1.PushSecretRemoteRef{RemoteKey: "app/token", Property: "value"},
+		},
+	})
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(&PushSecretRequest{Value: []byte("abc"), SecretKey: "token", RemoteKey: "app/token", Property: "value"}, fake.pushed))
+
+	exists, err := p.SecretExists(ctx, esv1alpha1.PushSecretRemoteRef{RemoteKey: "app/token"})
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	data, err := p.GetSecretMap(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: "app"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"db": []byte("s3cr3t"), "app/token": []byte("abc")}, data)
+
+	// DeleteSecret is not implemented by the fake plugin
+	err = p.DeleteSecret(ctx, esv1alpha1.PushSecretRemoteRef{RemoteKey: "app/token"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	res, err := p.Validate()
+	assert.Equal(t, esv1beta1.ValidationResultError, res)
+	assert.ErrorContains(t, err, "backend unreachable")
+}
+
+func TestGRPCProviderValidateUnimplemented(t *testing.T) {
+	p := newTestProvider(t, &UnimplementedProviderPluginServer{})
+	res, err := p.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+}
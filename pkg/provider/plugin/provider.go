@@ -0,0 +1,137 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+//go:generate protoc -I proto --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative plugin.proto
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"net/url"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	errStoreIsNil                  = "store is nil"
+	errNoStoreTypeOrWrongStoreType = "no store type or wrong store type"
+	errEndpointRequired            = "endpoint is required"
+	errInvalidCABundle             = "failed to parse caBundle"
+)
+
+// Provider satisfies the provider interface.
+type Provider struct{}
+
+var _ esv1beta1.Provider = &Provider{}
+
+func (p *Provider) NewClient(_ context.Context, store esv1beta1.GenericStore, _ kclient.Client, _ string) (esv1beta1.SecretsClient, error) {
+	cfg, err := getConfig(store)
+	if err != nil {
+		return nil, err
+	}
+	creds, err := transportCredentials(cfg)
+	if err != nil {
+		return nil, err
+	}
+	// the connection is established lazily on the first call
+	conn, err := grpc.NewClient(cfg.Endpoint,
+		grpc.WithTransportCredentials(creds),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return NewGRPCProvider(conn), nil
+}
+
+// transportCredentials returns TLS credentials unless the plugin is local or insecure is set.
+func transportCredentials(cfg *esv1beta1.PluginProvider) (credentials.TransportCredentials, error) {
+	if len(cfg.CABundle) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(cfg.CABundle) {
+			return nil, errors.New(errInvalidCABundle)
+		}
+		return credentials.NewTLS(&tls.Config{
+			MinVersion: tls.VersionTLS12,
+			RootCAs:    pool,
+		}), nil
+	}
+	if cfg.Insecure || isLocalEndpoint(cfg.Endpoint) {
+		return insecure.NewCredentials(), nil
+	}
+	// the plugin certificate is verified with the system CAs
+	return credentials.NewTLS(&tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}), nil
+}
+
+// isLocalEndpoint reports whether the gRPC target is a unix socket or a loopback address.
+func isLocalEndpoint(endpoint string) bool {
+	if strings.HasPrefix(endpoint, "unix:") || strings.HasPrefix(endpoint, "unix-abstract:") {
+		return true
+	}
+	host := endpoint
+	// targets with a scheme, e.g. dns:///localhost:9090 or passthrough:///127.0.0.1:9090
+	if u, err := url.Parse(endpoint); err == nil && u.Scheme != "" && u.Opaque == "" {
+		host = strings.TrimPrefix(u.Path, "/")
+	}
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func getConfig(store esv1beta1.GenericStore) (*esv1beta1.PluginProvider, error) {
+	if store == nil {
+		return nil, errors.New(errStoreIsNil)
+	}
+	spec := store.GetSpec()
+	if spec == nil || spec.Provider == nil || spec.Provider.Plugin == nil {
+		return nil, errors.New(errNoStoreTypeOrWrongStoreType)
+	}
+	cfg := spec.Provider.Plugin
+	if cfg.Endpoint == "" {
+		return nil, errors.New(errEndpointRequired)
+	}
+	return cfg, nil
+}
+
+func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnings, error) {
+	_, err := getConfig(store)
+	return nil, err
+}
+
+func (p *Provider) Capabilities() esv1beta1.SecretStoreCapabilities {
+	return esv1beta1.SecretStoreReadWrite
+}
+
+func init() {
+	esv1beta1.Register(&Provider{}, &esv1beta1.SecretStoreProvider{
+		Plugin: &esv1beta1.PluginProvider{},
+	})
+}
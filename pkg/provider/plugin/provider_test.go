@@ -0,0 +1,119 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+func TestValidateStore(t *testing.T) {
+	tests := map[string]struct {
+		cfg     esv1beta1.PluginProvider
+		wantErr string
+	}{
+		"valid": {
+			cfg: esv1beta1.PluginProvider{
+				Endpoint: "unix:///var/run/plugin/plugin.sock",
+			},
+		},
+		"invalid without endpoint": {
+			cfg:     esv1beta1.PluginProvider{},
+			wantErr: errEndpointRequired,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := esv1beta1.SecretStore{
+				Spec: esv1beta1.SecretStoreSpec{
+					Provider: &esv1beta1.SecretStoreProvider{
+						Plugin: &tc.cfg,
+					},
+				},
+			}
+			p := &Provider{}
+			_, err := p.ValidateStore(&s)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestNewClientInvalidCABundle(t *testing.T) {
+	s := esv1beta1.SecretStore{
+		Spec: esv1beta1.SecretStoreSpec{
+			Provider: &esv1beta1.SecretStoreProvider{
+				Plugin: &esv1beta1.PluginProvider{
+					Endpoint: "dns:///plugin:9090",
+					CABundle: []byte("not a cert"),
+				},
+			},
+		},
+	}
+	p := &Provider{}
+	_, err := p.NewClient(context.Background(), &s, nil, "default")
+	assert.EqualError(t, err, errInvalidCABundle)
+}
+
+func TestTransportCredentials(t *testing.T) {
+	tests := map[string]struct {
+		cfg  esv1beta1.PluginProvider
+		want string
+	}{
+		"unix socket": {
+			cfg:  esv1beta1.PluginProvider{Endpoint: "unix:///var/run/plugin/plugin.sock"},
+			want: "insecure",
+		},
+		"loopback address": {
+			cfg:  esv1beta1.PluginProvider{Endpoint: "127.0.0.1:9090"},
+			want: "insecure",
+		},
+		"loopback address with scheme": {
+			cfg:  esv1beta1.PluginProvider{Endpoint: "passthrough:///[::1]:9090"},
+			want: "insecure",
+		},
+		"localhost": {
+			cfg:  esv1beta1.PluginProvider{Endpoint: "dns:///localhost:9090"},
+			want: "insecure",
+		},
+		"remote address": {
+			cfg:  esv1beta1.PluginProvider{Endpoint: "dns:///plugin.plugins.svc:9090"},
+			want: "tls",
+		},
+		"remote address without scheme": {
+			cfg:  esv1beta1.PluginProvider{Endpoint: "10.0.0.1:9090"},
+			want: "tls",
+		},
+		"remote address with insecure": {
+			cfg:  esv1beta1.PluginProvider{Endpoint: "dns:///plugin.plugins.svc:9090", Insecure: true},
+			want: "insecure",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			creds, err := transportCredentials(&tc.cfg)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, creds.Info().SecurityProtocol)
+		})
+	}
+}
@@ -0,0 +1,282 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package railway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	// sharedVariables is the key of GetSecretMap for the shared variables of the environment.
+	sharedVariables = "shared"
+
+	errInvalidKey         = "key %q must have the format <variable> or <service id>/<variable>"
+	errInvalidMapKey      = "key %q must be a service id or %q"
+	errUnexpectedStatus   = "unexpected status code from Railway: %d: %s"
+	errUnmarshalResponse  = "unable to unmarshal Railway response: %w"
+	errGraphQL            = "Railway returned an error: %s"
+	errPropertyNotAllowed = "property is not supported, Railway variables hold a single value"
+	errFindUnsupported    = "find is not supported by the Railway provider"
+)
+
+const (
+	queryVariables = `query variables($projectId: String!, $environmentId: String!, $serviceId: String) {
+  variables(projectId: $projectId, environmentId: $environmentId, serviceId: $serviceId)
+}`
+	mutationUpsert = `mutation variableUpsert($input: VariableUpsertInput!) {
+  variableUpsert(input: $input)
+}`
+	mutationDelete = `mutation variableDelete($input: VariableDeleteInput!) {
+  variableDelete(input: $input)
+}`
+	queryProject = `query project($id: String!) {
+  project(id: $id) { id }
+}`
+)
+
+// client reads and writes variables of a project environment with the Railway GraphQL API.
+// https://docs.railway.com/guides/manage-variables
+type client struct {
+	httpClient    *http.Client
+	url           string
+	token         string
+	projectID     string
+	environmentID string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+type graphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables"`
+}
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// variableInput identifies a variable in the mutations, an empty service is a shared variable.
+type variableInput struct {
+	ProjectID     string `json:"projectId"`
+	EnvironmentID string `json:"environmentId"`
+	ServiceID     string `json:"serviceId,omitempty"`
+	Name          string `json:"name"`
+	Value         string `json:"value,omitempty"`
+}
+
+// parseKey returns the service id, which is empty for a shared variable, and the name of the variable.
+func parseKey(key string) (string, string, error) {
+	service, name, ok := strings.Cut(key, "/")
+	if !ok {
+		service, name = "", key
+	}
+	if name == "" || (ok && service == "") || strings.Contains(name, "/") {
+		return "", "", fmt.Errorf(errInvalidKey, key)
+	}
+	return service, name, nil
+}
+
+// GetSecret returns the rendered value of the variable.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	if ref.Property != "" {
+		return nil, errors.New(errPropertyNotAllowed)
+	}
+	service, name, err := parseKey(ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	vars, err := c.variables(ctx, service)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := vars[name]
+	if !ok {
+		return nil, esv1beta1.NoSecretError{}
+	}
+	return []byte(value), nil
+}
+
+// GetSecretMap returns all variables of a service, or the shared variables of the environment.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	if ref.Property != "" {
+		return nil, errors.New(errPropertyNotAllowed)
+	}
+	if ref.Key == "" || strings.Contains(ref.Key, "/") {
+		return nil, fmt.Errorf(errInvalidMapKey, ref.Key, sharedVariables)
+	}
+	service := ref.Key
+	if service == sharedVariables {
+		service = ""
+	}
+	vars, err := c.variables(ctx, service)
+	if err != nil {
+		return nil, err
+	}
+	secretData := make(map[string][]byte, len(vars))
+	for k, v := range vars {
+		secretData[k] = []byte(v)
+	}
+	return secretData, nil
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindUnsupported)
+}
+
+// PushSecret creates or updates the variable, Railway redeploys the service with the changed variable.
+func (c *client) PushSecret(ctx context.Context, secret *corev1.Secret, data esv1beta1.PushSecretData) error {
+	if data.GetProperty() != "" {
+		return errors.New(errPropertyNotAllowed)
+	}
+	service, name, err := parseKey(data.GetRemoteKey())
+	if err != nil {
+		return err
+	}
+	value, err := utils.PushSecretValue(secret, data.GetSecretKey())
+	if err != nil {
+		return err
+	}
+	input := c.variableInput(service, name)
+	input.Value = string(value)
+	return c.do(ctx, mutationUpsert, map[string]any{"input": input}, nil)
+}
+
+// DeleteSecret deletes the variable, a missing variable is not an error.
+func (c *client) DeleteSecret(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) error {
+	exists, err := c.SecretExists(ctx, remoteRef)
+	if err != nil || !exists {
+		return err
+	}
+	service, name, err := parseKey(remoteRef.GetRemoteKey())
+	if err != nil {
+		return err
+	}
+	return c.do(ctx, mutationDelete, map[string]any{"input": c.variableInput(service, name)}, nil)
+}
+
+// SecretExists checks if the variable exists.
+func (c *client) SecretExists(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) (bool, error) {
+	service, name, err := parseKey(remoteRef.GetRemoteKey())
+	if err != nil {
+		return false, err
+	}
+	vars, err := c.variables(ctx, service)
+	if err != nil {
+		return false, err
+	}
+	_, ok := vars[name]
+	return ok, nil
+}
+
+// Validate reads the project of the store.
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	err := c.do(context.Background(), queryProject, map[string]any{"id": c.projectID}, nil)
+	if err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+func (c *client) variableInput(service, name string) variableInput {
+	return variableInput{
+		ProjectID:     c.projectID,
+		EnvironmentID: c.environmentID,
+		ServiceID:     service,
+		Name:          name,
+	}
+}
+
+// variables returns the variables of the service, or the shared variables if service is empty.
+func (c *client) variables(ctx context.Context, service string) (map[string]string, error) {
+	vars := map[string]any{
+		"projectId":     c.projectID,
+		"environmentId": c.environmentID,
+	}
+	if service != "" {
+		vars["serviceId"] = service
+	}
+	var data struct {
+		Variables map[string]string `json:"variables"`
+	}
+	if err := c.do(ctx, queryVariables, vars, &data); err != nil {
+		return nil, err
+	}
+	return data.Variables, nil
+}
+
+func (c *client) do(ctx context.Context, query string, variables map[string]any, target any) error {
+	b, err := json.Marshal(graphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf(errUnexpectedStatus, resp.StatusCode, respBody)
+	}
+	var gqlResp graphQLResponse
+	if err := json.Unmarshal(respBody, &gqlResp); err != nil {
+		return fmt.Errorf(errUnmarshalResponse, err)
+	}
+	// GraphQL reports errors, e.g. a missing service, with a successful status code
+	if len(gqlResp.Errors) > 0 {
+		msgs := make([]string, 0, len(gqlResp.Errors))
+		for _, e := range gqlResp.Errors {
+			msgs = append(msgs, e.Message)
+		}
+		return fmt.Errorf(errGraphQL, strings.Join(msgs, "; "))
+	}
+	if target == nil {
+		return nil
+	}
+	if err := json.Unmarshal(gqlResp.Data, target); err != nil {
+		return fmt.Errorf(errUnmarshalResponse, err)
+	}
+	return nil
+}
@@ -0,0 +1,250 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package railway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1alpha1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1alpha1"
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	testToken       = "railway-token"
+	testProject     = "5a1e0c2b-4e0b-4d7a-9b0e-6f1f2a3b4c5d"
+	testEnvironment = "9c8b7a6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d"
+	testService     = "0f1e2d3c-4b5a-4968-8776-a5b4c3d2e1f0"
+)
+
+// fakeRailway serves the variables of testProject and testEnvironment by service id,
+// the shared variables have an empty service id.
+type fakeRailway struct {
+	mu   sync.Mutex
+	vars map[string]map[string]string
+}
+
+func newTestClient(t *testing.T) (*client, *fakeRailway) {
+	f := &fakeRailway{vars: map[string]map[string]string{
+		"": {"REGION": "us-west"},
+		testService: {
+			"DB_HOST":     "db.example.com",
+			"DB_PASSWORD": "s3cr3t",
+		},
+	}}
+	srv := httptest.NewServer(f.handler())
+	t.Cleanup(srv.Close)
+	return &client{
+		httpClient:    srv.Client(),
+		url:           srv.URL,
+		token:         testToken,
+		projectID:     testProject,
+		environmentID: testEnvironment,
+	}, f
+}
+
+func (f *fakeRailway) handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"errors":[{"message":"Not Authorized"}]}`))
+			return
+		}
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		var req struct {
+			Query     string `json:"query"`
+			Variables struct {
+				ID            string        `json:"id"`
+				ProjectID     string        `json:"projectId"`
+				EnvironmentID string        `json:"environmentId"`
+				ServiceID     string        `json:"serviceId"`
+				Input         variableInput `json:"input"`
+			} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		vars := req.Variables
+		input := vars.Input
+		switch {
+		case strings.Contains(req.Query, "variableUpsert"):
+			if input.ProjectID != testProject || input.EnvironmentID != testEnvironment {
+				writeError(w, "Project not found")
+				return
+			}
+			if f.vars[input.ServiceID] == nil {
+				f.vars[input.ServiceID] = make(map[string]string)
+			}
+			f.vars[input.ServiceID][input.Name] = input.Value
+			_, _ = w.Write([]byte(`{"data":{"variableUpsert":true}}`))
+		case strings.Contains(req.Query, "variableDelete"):
+			if _, ok := f.vars[input.ServiceID][input.Name]; !ok {
+				writeError(w, "Variable not found")
+				return
+			}
+			delete(f.vars[input.ServiceID], input.Name)
+			_, _ = w.Write([]byte(`{"data":{"variableDelete":true}}`))
+		case strings.Contains(req.Query, "variables("):
+			service, ok := f.vars[vars.ServiceID]
+			if vars.ProjectID != testProject || vars.EnvironmentID != testEnvironment || !ok {
+				writeError(w, "Service not found")
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"variables": service}})
+		case strings.Contains(req.Query, "project("):
+			if vars.ID != testProject {
+				writeError(w, "Project not found")
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":{"project":{"id":"` + testProject + `"}}}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+}
+
+func writeError(w http.ResponseWriter, msg string) {
+	_, _ = w.Write([]byte(`{"data":null,"errors":[{"message":"` + msg + `"}]}`))
+}
+
+func TestGetSecret(t *testing.T) {
+	c, _ := newTestClient(t)
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"service variable": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: testService + "/DB_HOST"},
+			want: "db.example.com",
+		},
+		"shared variable": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "REGION"},
+			want: "us-west",
+		},
+		"missing variable": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: testService + "/DB_USER"},
+			wantErr: "Secret does not exist",
+		},
+		"missing service": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "other/DB_HOST"},
+			wantErr: "Railway returned an error: Service not found",
+		},
+		"invalid key": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: testService + "/db/DB_HOST"},
+			wantErr: `key "` + testService + `/db/DB_HOST" must have the format <variable> or <service id>/<variable>`,
+		},
+		"property": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: testService + "/DB_HOST", Property: "host"},
+			wantErr: errPropertyNotAllowed,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestGetSecretMap(t *testing.T) {
+	c, _ := newTestClient(t)
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: testService})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"DB_HOST": []byte("db.example.com"), "DB_PASSWORD": []byte("s3cr3t")}, got)
+
+	got, err = c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "shared"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"REGION": []byte("us-west")}, got)
+
+	_, err = c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: testService + "/DB_HOST"})
+	assert.ErrorContains(t, err, `must be a service id or "shared"`)
+}
+
+func pushData(secretKey, remoteKey string) esv1alpha1.PushSecretData {
+	return esv1alpha1.PushSecretData{
+		Match: esv1alpha1.PushSecretMatch{
+			SecretKey: secretKey,
+			RemoteRef: esv1alpha1.PushSecretRemoteRef{RemoteKey: remoteKey},
+		},
+	}
+}
+
+func TestPushSecret(t *testing.T) {
+	c, fake := newTestClient(t)
+	secret := &corev1.Secret{Data: map[string][]byte{"token": []byte("abc"), "user": []byte("deploy")}}
+
+	require.NoError(t, c.PushSecret(context.Background(), secret, pushData("token", testService+"/DEPLOY_TOKEN")))
+	assert.Equal(t, "abc", fake.vars[testService]["DEPLOY_TOKEN"])
+
+	require.NoError(t, c.PushSecret(context.Background(), secret, pushData("", "DEPLOY")))
+	assert.JSONEq(t, `{"token":"abc","user":"deploy"}`, fake.vars[""]["DEPLOY"])
+
+	assert.EqualError(t, c.PushSecret(context.Background(), secret, pushData("token", "/DEPLOY_TOKEN")),
+		`key "/DEPLOY_TOKEN" must have the format <variable> or <service id>/<variable>`)
+}
+
+func TestSecretExistsAndDelete(t *testing.T) {
+	c, _ := newTestClient(t)
+	ctx := context.Background()
+	ref := esv1alpha1.PushSecretRemoteRef{RemoteKey: testService + "/DB_PASSWORD"}
+
+	exists, err := c.SecretExists(ctx, ref)
+	require.NoError(t, err)
+	assert.True(t, exists)
+	require.NoError(t, c.DeleteSecret(ctx, ref))
+	exists, err = c.SecretExists(ctx, ref)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// deleting a missing variable succeeds
+	require.NoError(t, c.DeleteSecret(ctx, ref))
+}
+
+func TestValidate(t *testing.T) {
+	c, _ := newTestClient(t)
+	res, err := c.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+
+	c.projectID = "other"
+	res, err = c.Validate()
+	assert.EqualError(t, err, "Railway returned an error: Project not found")
+	assert.Equal(t, esv1beta1.ValidationResultError, res)
+
+	c.token = "invalid"
+	_, err = c.Validate()
+	assert.ErrorContains(t, err, "unexpected status code from Railway: 401")
+}
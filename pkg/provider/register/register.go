@@ -19,32 +19,74 @@ package register
 import (
 	_ "github.com/external-secrets/external-secrets/pkg/provider/akeyless"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/alibaba"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/ansiblevault"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/auth0"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/aws"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/aws/appregistry"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/aws/iot"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/azure/devops"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/azure/keyvault"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/bitbucket"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/bitwarden"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/chef"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/chefvault"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/circleci"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/cloudflare"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/cloudinary"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/confluent"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/conjur"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/consul"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/datadog"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/delinea"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/device42"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/doppler"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/etcd"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/fake"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/fortanix"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/gcp/firebase"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/gcp/secretmanager"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/gcp/workspace"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/githubsecrets"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/gitlab"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/hiera"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/ibm"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/infisical"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/keepersecurity"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/kubernetes"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/mailgun"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/mongodbatlas"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/netlify"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/newrelic"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/okta"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/onboardbase"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/onepassword"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/openbao"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/oracle"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/pagerduty"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/passbolt"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/passworddepot"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/plugin"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/pulumi"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/railway"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/render"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/scaleway"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/sds"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/sendgrid"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/senhasegura"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/snowflake"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/sops"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/splunkkv"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/springconfig"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/supabase"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/teleport"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/tenable"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/terraformcloud"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/twilio"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/vault"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/venafi"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/vercel"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/webhook"
+	_ "github.com/external-secrets/external-secrets/pkg/provider/wiz"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/yandex/certificatemanager"
 	_ "github.com/external-secrets/external-secrets/pkg/provider/yandex/lockbox"
 )
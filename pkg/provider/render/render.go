@@ -0,0 +1,213 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	errInvalidKey         = "key %q must have the format <service id>/<variable>"
+	errUnexpectedStatus   = "unexpected status code from Render: %d: %s"
+	errUnmarshalResponse  = "unable to unmarshal Render response: %w"
+	errUnreadableVariable = "Render did not return the value of variable %q, it can only be written with a PushSecret"
+	errPropertyNotAllowed = "property is not supported, Render variables hold a single value"
+	errFindUnsupported    = "find is not supported by the Render provider"
+)
+
+// client reads and writes environment variables of services with the Render API.
+// https://api-docs.render.com/reference/get-env-var
+type client struct {
+	httpClient *http.Client
+	url        string
+	apiKey     string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+type envVar struct {
+	Key   string  `json:"key,omitempty"`
+	Value *string `json:"value"`
+}
+
+// parseKey returns the service id and the key of the variable.
+func parseKey(key string) (string, string, error) {
+	service, name, ok := strings.Cut(key, "/")
+	if !ok || service == "" || name == "" || strings.Contains(name, "/") {
+		return "", "", fmt.Errorf(errInvalidKey, key)
+	}
+	return service, name, nil
+}
+
+func envVarPath(service, name string) string {
+	return "/services/" + url.PathEscape(service) + "/env-vars/" + url.PathEscape(name)
+}
+
+// GetSecret returns the value of the variable, which fails if Render does not return it.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	if ref.Property != "" {
+		return nil, errors.New(errPropertyNotAllowed)
+	}
+	service, name, err := parseKey(ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	var v envVar
+	if err := c.do(ctx, http.MethodGet, envVarPath(service, name), nil, &v); err != nil {
+		return nil, err
+	}
+	if v.Value == nil {
+		return nil, fmt.Errorf(errUnreadableVariable, ref.Key)
+	}
+	return []byte(*v.Value), nil
+}
+
+// GetSecretMap decodes the value of the variable as a JSON object.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	data, err := c.GetSecret(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	kv := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &kv); err != nil {
+		return nil, fmt.Errorf(errUnmarshalResponse, err)
+	}
+	secretData := make(map[string][]byte, len(kv))
+	for k, v := range kv {
+		var s string
+		if err := json.Unmarshal(v, &s); err == nil {
+			secretData[k] = []byte(s)
+			continue
+		}
+		secretData[k] = v
+	}
+	return secretData, nil
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindUnsupported)
+}
+
+// PushSecret creates or updates the variable, the service uses it from its next deploy.
+func (c *client) PushSecret(ctx context.Context, secret *corev1.Secret, data esv1beta1.PushSecretData) error {
+	if data.GetProperty() != "" {
+		return errors.New(errPropertyNotAllowed)
+	}
+	service, name, err := parseKey(data.GetRemoteKey())
+	if err != nil {
+		return err
+	}
+	value, err := utils.PushSecretValue(secret, data.GetSecretKey())
+	if err != nil {
+		return err
+	}
+	s := string(value)
+	return c.do(ctx, http.MethodPut, envVarPath(service, name), envVar{Value: &s}, nil)
+}
+
+// DeleteSecret deletes the variable, a missing variable is not an error.
+func (c *client) DeleteSecret(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) error {
+	service, name, err := parseKey(remoteRef.GetRemoteKey())
+	if err != nil {
+		return err
+	}
+	err = c.do(ctx, http.MethodDelete, envVarPath(service, name), nil, nil)
+	if errors.Is(err, esv1beta1.NoSecretError{}) {
+		return nil
+	}
+	return err
+}
+
+// SecretExists checks if the variable exists.
+func (c *client) SecretExists(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) (bool, error) {
+	service, name, err := parseKey(remoteRef.GetRemoteKey())
+	if err != nil {
+		return false, err
+	}
+	err = c.do(ctx, http.MethodGet, envVarPath(service, name), nil, nil)
+	if errors.Is(err, esv1beta1.NoSecretError{}) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Validate lists a workspace of the API key.
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	if err := c.do(context.Background(), http.MethodGet, "/owners?limit=1", nil, nil); err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+func (c *client) do(ctx context.Context, method, path string, body, target any) error {
+	var reqBody io.Reader = http.NoBody
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// a missing service or variable
+	if resp.StatusCode == http.StatusNotFound {
+		return esv1beta1.NoSecretError{}
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf(errUnexpectedStatus, resp.StatusCode, respBody)
+	}
+	if target == nil {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, target); err != nil {
+		return fmt.Errorf(errUnmarshalResponse, err)
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package render
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1alpha1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1alpha1"
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	testAPIKey  = "rnd_key"
+	testService = "srv-cq1h2b3v4n5m6k7j8l9a"
+)
+
+// fakeRender serves the variables of testService, a nil value is not returned.
+type fakeRender struct {
+	mu   sync.Mutex
+	vars map[string]*string
+}
+
+func newTestClient(t *testing.T) (*client, *fakeRender) {
+	host, config := "db.example.com", `{"user":"admin","port":5432}`
+	f := &fakeRender{vars: map[string]*string{
+		"DB_HOST":     &host,
+		"DB_CONFIG":   &config,
+		"DB_PASSWORD": nil,
+	}}
+	srv := httptest.NewServer(f.handler())
+	t.Cleanup(srv.Close)
+	return &client{httpClient: srv.Client(), url: srv.URL, apiKey: testAPIKey}, f
+}
+
+func (f *fakeRender) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /owners", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`[{"owner":{"id":"tea-1","name":"acme","type":"team"},"cursor":"a"}]`))
+	})
+	mux.HandleFunc("/services/"+testService+"/env-vars/{key}", func(w http.ResponseWriter, r *http.Request) {
+		key := r.PathValue("key")
+		value, ok := f.vars[key]
+		switch r.Method {
+		case http.MethodPut:
+			var v envVar
+			if err := json.NewDecoder(r.Body).Decode(&v); err != nil || v.Value == nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			f.vars[key] = v.Value
+			_ = json.NewEncoder(w).Encode(envVar{Key: key, Value: v.Value})
+			return
+		case http.MethodGet, http.MethodDelete:
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				_, _ = w.Write([]byte(`{"id":"not-found","message":"not found"}`))
+				return
+			}
+		}
+		if r.Method == http.MethodDelete {
+			delete(f.vars, key)
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if value == nil {
+			_, _ = w.Write([]byte(`{"key":"` + key + `"}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(envVar{Key: key, Value: value})
+	})
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testAPIKey {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message":"unauthorized"}`))
+			return
+		}
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func TestGetSecret(t *testing.T) {
+	c, _ := newTestClient(t)
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"variable": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: testService + "/DB_HOST"},
+			want: "db.example.com",
+		},
+		"unreadable variable": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: testService + "/DB_PASSWORD"},
+			wantErr: "Render did not return the value of variable",
+		},
+		"missing variable": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: testService + "/DB_USER"},
+			wantErr: "Secret does not exist",
+		},
+		"missing service": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "srv-other/DB_HOST"},
+			wantErr: "Secret does not exist",
+		},
+		"invalid key": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "DB_HOST"},
+			wantErr: `key "DB_HOST" must have the format <service id>/<variable>`,
+		},
+		"property": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: testService + "/DB_HOST", Property: "host"},
+			wantErr: errPropertyNotAllowed,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestGetSecretMap(t *testing.T) {
+	c, _ := newTestClient(t)
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: testService + "/DB_CONFIG"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"user": []byte("admin"), "port": []byte("5432")}, got)
+}
+
+func pushData(secretKey, remoteKey string) esv1alpha1.PushSecretData {
+	return esv1alpha1.PushSecretData{
+		Match: esv1alpha1.PushSecretMatch{
+			SecretKey: secretKey,
+			RemoteRef: esv1alpha1.PushSecretRemoteRef{RemoteKey: remoteKey},
+		},
+	}
+}
+
+func TestPushSecret(t *testing.T) {
+	c, fake := newTestClient(t)
+	secret := &corev1.Secret{Data: map[string][]byte{"token": []byte("abc"), "user": []byte("deploy")}}
+
+	require.NoError(t, c.PushSecret(context.Background(), secret, pushData("token", testService+"/DEPLOY_TOKEN")))
+	assert.Equal(t, "abc", *fake.vars["DEPLOY_TOKEN"])
+
+	// a variable which can not be read can be written
+	require.NoError(t, c.PushSecret(context.Background(), secret, pushData("", testService+"/DB_PASSWORD")))
+	assert.JSONEq(t, `{"token":"abc","user":"deploy"}`, *fake.vars["DB_PASSWORD"])
+
+	assert.EqualError(t, c.PushSecret(context.Background(), secret, pushData("token", testService+"/DEPLOY/TOKEN")),
+		`key "`+testService+`/DEPLOY/TOKEN" must have the format <service id>/<variable>`)
+}
+
+func TestSecretExistsAndDelete(t *testing.T) {
+	c, _ := newTestClient(t)
+	ctx := context.Background()
+	ref := esv1alpha1.PushSecretRemoteRef{RemoteKey: testService + "/DB_PASSWORD"}
+
+	exists, err := c.SecretExists(ctx, ref)
+	require.NoError(t, err)
+	assert.True(t, exists)
+	require.NoError(t, c.DeleteSecret(ctx, ref))
+	exists, err = c.SecretExists(ctx, ref)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// deleting a missing variable succeeds
+	require.NoError(t, c.DeleteSecret(ctx, ref))
+}
+
+func TestValidate(t *testing.T) {
+	c, _ := newTestClient(t)
+	res, err := c.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+
+	c.apiKey = "invalid"
+	res, err = c.Validate()
+	assert.ErrorContains(t, err, "unexpected status code from Render: 401")
+	assert.Equal(t, esv1beta1.ValidationResultError, res)
+}
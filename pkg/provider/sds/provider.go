@@ -0,0 +1,113 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sds
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	defaultNodeID = "external-secrets"
+
+	errStoreIsNil                  = "store is nil"
+	errNoStoreTypeOrWrongStoreType = "no store type or wrong store type"
+	errEndpointRequired            = "endpoint is required"
+	errInvalidCABundle             = "failed to parse caBundle"
+)
+
+// Provider satisfies the provider interface.
+type Provider struct{}
+
+var _ esv1beta1.Provider = &Provider{}
+
+func (p *Provider) NewClient(_ context.Context, store esv1beta1.GenericStore, _ kclient.Client, _ string) (esv1beta1.SecretsClient, error) {
+	cfg, err := getConfig(store)
+	if err != nil {
+		return nil, err
+	}
+	nodeID := cfg.NodeID
+	if nodeID == "" {
+		nodeID = defaultNodeID
+	}
+	return &client{
+		cache: subscriptions,
+		cfg: connConfig{
+			endpoint: cfg.Endpoint,
+			caBundle: string(cfg.CABundle),
+			nodeID:   nodeID,
+			cluster:  cfg.Cluster,
+		},
+	}, nil
+}
+
+// dial creates the connection of a subscription, it is established lazily by the stream.
+func dial(cfg connConfig) (*grpc.ClientConn, error) {
+	creds := insecure.NewCredentials()
+	if cfg.caBundle != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(cfg.caBundle)) {
+			return nil, errors.New(errInvalidCABundle)
+		}
+		creds = credentials.NewTLS(&tls.Config{
+			MinVersion: tls.VersionTLS12,
+			RootCAs:    pool,
+		})
+	}
+	return grpc.NewClient(cfg.endpoint, grpc.WithTransportCredentials(creds))
+}
+
+func getConfig(store esv1beta1.GenericStore) (*esv1beta1.SDSProvider, error) {
+	if store == nil {
+		return nil, errors.New(errStoreIsNil)
+	}
+	spec := store.GetSpec()
+	if spec == nil || spec.Provider == nil || spec.Provider.SDS == nil {
+		return nil, errors.New(errNoStoreTypeOrWrongStoreType)
+	}
+	cfg := spec.Provider.SDS
+	if cfg.Endpoint == "" {
+		return nil, errors.New(errEndpointRequired)
+	}
+	if len(cfg.CABundle) > 0 && !x509.NewCertPool().AppendCertsFromPEM(cfg.CABundle) {
+		return nil, errors.New(errInvalidCABundle)
+	}
+	return cfg, nil
+}
+
+func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnings, error) {
+	_, err := getConfig(store)
+	return nil, err
+}
+
+func (p *Provider) Capabilities() esv1beta1.SecretStoreCapabilities {
+	return esv1beta1.SecretStoreReadOnly
+}
+
+func init() {
+	esv1beta1.Register(&Provider{}, &esv1beta1.SecretStoreProvider{
+		SDS: &esv1beta1.SDSProvider{},
+	})
+}
@@ -0,0 +1,65 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sds
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+func TestValidateStore(t *testing.T) {
+	tests := map[string]struct {
+		cfg     esv1beta1.SDSProvider
+		wantErr string
+	}{
+		"valid": {
+			cfg: esv1beta1.SDSProvider{
+				Endpoint: "unix:///var/run/sds/sds.sock",
+			},
+		},
+		"invalid without endpoint": {
+			cfg:     esv1beta1.SDSProvider{},
+			wantErr: errEndpointRequired,
+		},
+		"invalid ca bundle": {
+			cfg: esv1beta1.SDSProvider{
+				Endpoint: "dns:///sds:15012",
+				CABundle: []byte("not a cert"),
+			},
+			wantErr: errInvalidCABundle,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := esv1beta1.SecretStore{
+				Spec: esv1beta1.SecretStoreSpec{
+					Provider: &esv1beta1.SecretStoreProvider{
+						SDS: &tc.cfg,
+					},
+				},
+			}
+			p := &Provider{}
+			_, err := p.ValidateStore(&s)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
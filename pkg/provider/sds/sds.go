@@ -0,0 +1,411 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	discoveryv3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	secretv3 "github.com/envoyproxy/go-control-plane/envoy/service/secret/v3"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	secretTypeURL   = "type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.Secret"
+	resourceTypeURL = "type.googleapis.com/envoy.service.discovery.v3.Resource"
+
+	// waitTimeout bounds the wait for the first response of a new subscription.
+	waitTimeout = 10 * time.Second
+	// idleTimeout is the time after which subscriptions that were not read are closed.
+	idleTimeout = time.Hour
+	maxBackoff  = time.Minute
+
+	keyCertificate = "tls.crt"
+	keyPrivateKey  = "tls.key"
+	keyTrustedCA   = "ca.crt"
+	keyGeneric     = "secret"
+
+	errReadOnly        = "the SDS provider is read only"
+	errFindUnsupported = "find is not supported by the SDS provider"
+	errNotReceived     = "secret %q was not received from the SDS server: %w"
+	errNoResponse      = "no response yet"
+	errUnknownProperty = "unknown property %q, must be one of tls.crt, tls.key, ca.crt or secret"
+	errEmptySecret     = "secret %q has no certificate, validation context or generic secret"
+	errUnexpectedType  = "unexpected resource type %s"
+	errNotInline       = "data source of the SDS server is not inline, only inline data is supported"
+)
+
+var log = ctrl.Log.WithName("provider").WithName("sds")
+
+// subscriptions is shared by every client so that a secret is streamed once
+// and outlives the clients, which are created for every reconcile.
+var subscriptions = newSubscriptionCache()
+
+type client struct {
+	cache *subscriptionCache
+	cfg   connConfig
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+// GetSecret returns a single key of the secret named key, property defaults
+// to the certificate chain, or to the trusted CA for validation contexts.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	data, err := c.GetSecretMap(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	property := ref.Property
+	if property == "" {
+		for _, k := range []string{keyCertificate, keyTrustedCA, keyGeneric} {
+			if _, ok := data[k]; ok {
+				property = k
+				break
+			}
+		}
+	}
+	switch property {
+	case keyCertificate, keyPrivateKey, keyTrustedCA, keyGeneric:
+	default:
+		return nil, fmt.Errorf(errUnknownProperty, property)
+	}
+	value, ok := data[property]
+	if !ok {
+		return nil, esv1beta1.NoSecretError{}
+	}
+	return value, nil
+}
+
+// GetSecretMap returns the latest version of the secret named key pushed by the SDS server.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	sub, err := c.cache.get(c.cfg, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	return sub.wait(ctx)
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindUnsupported)
+}
+
+func (c *client) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
+	return false, errors.New(errReadOnly)
+}
+
+// Validate can not check the connection without subscribing to a secret.
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	return esv1beta1.ValidationResultUnknown, nil
+}
+
+// Close does not close the subscriptions, they are shared and closed once idle.
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+// connConfig identifies the SDS server and how the operator presents itself to it.
+type connConfig struct {
+	endpoint string
+	caBundle string
+	nodeID   string
+	cluster  string
+}
+
+type subscriptionKey struct {
+	connConfig
+	name string
+}
+
+type subscriptionCache struct {
+	mu   sync.Mutex
+	subs map[subscriptionKey]*subscription
+	dial func(cfg connConfig) (*grpc.ClientConn, error)
+}
+
+func newSubscriptionCache() *subscriptionCache {
+	return &subscriptionCache{
+		subs: make(map[subscriptionKey]*subscription),
+		dial: dial,
+	}
+}
+
+// get returns the subscription to the secret name, starting it if needed.
+// Subscriptions that were not read for idleTimeout are closed.
+func (c *subscriptionCache) get(cfg connConfig, name string) (*subscription, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	for key, sub := range c.subs {
+		if now.Sub(sub.lastUsed()) > idleTimeout {
+			log.V(1).Info("closing idle subscription", "endpoint", key.endpoint, "secret", key.name)
+			sub.stop()
+			delete(c.subs, key)
+		}
+	}
+	key := subscriptionKey{connConfig: cfg, name: name}
+	if sub, ok := c.subs[key]; ok {
+		sub.touch()
+		return sub, nil
+	}
+	conn, err := c.dial(cfg)
+	if err != nil {
+		return nil, err
+	}
+	sub := newSubscription(conn, cfg, name)
+	c.subs[key] = sub
+	go sub.run()
+	return sub, nil
+}
+
+// subscription streams a single secret and keeps the latest version pushed by the server.
+type subscription struct {
+	conn   *grpc.ClientConn
+	cfg    connConfig
+	name   string
+	ctx    context.Context
+	cancel context.CancelFunc
+	ready  chan struct{}
+
+	mu      sync.Mutex
+	data    map[string][]byte
+	version string
+	err     error
+	used    time.Time
+}
+
+func newSubscription(conn *grpc.ClientConn, cfg connConfig, name string) *subscription {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &subscription{
+		conn:   conn,
+		cfg:    cfg,
+		name:   name,
+		ctx:    ctx,
+		cancel: cancel,
+		ready:  make(chan struct{}),
+		err:    errors.New(errNoResponse),
+		used:   time.Now(),
+	}
+}
+
+// wait returns the latest version of the secret, waiting for the first response if needed.
+func (s *subscription) wait(ctx context.Context) (map[string][]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, waitTimeout)
+	defer cancel()
+	select {
+	case <-s.ready:
+	case <-ctx.Done():
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.data == nil {
+		return nil, fmt.Errorf(errNotReceived, s.name, s.err)
+	}
+	data := make(map[string][]byte, len(s.data))
+	for k, v := range s.data {
+		data[k] = v
+	}
+	return data, nil
+}
+
+func (s *subscription) touch() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.used = time.Now()
+}
+
+func (s *subscription) lastUsed() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.used
+}
+
+func (s *subscription) stop() {
+	s.cancel()
+	_ = s.conn.Close()
+}
+
+// run streams the secret until the subscription is stopped, reconnecting with a backoff.
+// The latest version is kept when the stream fails, so that secrets can still be read
+// while the SDS server is unavailable.
+func (s *subscription) run() {
+	backoff := time.Second
+	for {
+		err := s.stream()
+		if s.ctx.Err() != nil {
+			return
+		}
+		s.mu.Lock()
+		s.err = err
+		s.mu.Unlock()
+		log.Error(err, "SDS stream failed, reconnecting", "endpoint", s.cfg.endpoint, "secret", s.name, "backoff", backoff)
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, maxBackoff)
+	}
+}
+
+// stream subscribes to the secret and acknowledges every response, responses
+// that can not be decoded are rejected and the previous version is kept.
+func (s *subscription) stream() error {
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+	st, err := secretv3.NewSecretDiscoveryServiceClient(s.conn).StreamSecrets(ctx)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	version := s.version
+	s.mu.Unlock()
+	req := &discoveryv3.DiscoveryRequest{
+		VersionInfo:   version,
+		Node:          &corev3.Node{Id: s.cfg.nodeID, Cluster: s.cfg.cluster},
+		ResourceNames: []string{s.name},
+		TypeUrl:       secretTypeURL,
+	}
+	if err := st.Send(req); err != nil {
+		return err
+	}
+	for {
+		resp, err := st.Recv()
+		if err != nil {
+			return err
+		}
+		req.ResponseNonce = resp.GetNonce()
+		req.ErrorDetail = nil
+		data, found, err := decodeSecret(resp, s.name)
+		switch {
+		case err != nil:
+			log.Error(err, "rejecting SDS response", "endpoint", s.cfg.endpoint, "secret", s.name, "version", resp.GetVersionInfo())
+			req.ErrorDetail = &status.Status{Code: int32(codes.InvalidArgument), Message: err.Error()}
+		case found:
+			req.VersionInfo = resp.GetVersionInfo()
+			s.update(data, resp.GetVersionInfo())
+		default:
+			req.VersionInfo = resp.GetVersionInfo()
+		}
+		if err := st.Send(req); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *subscription) update(data map[string][]byte, version string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.data != nil {
+		log.Info("secret rotated by the SDS server", "endpoint", s.cfg.endpoint, "secret", s.name, "version", version)
+	}
+	s.data = data
+	s.version = version
+	s.err = nil
+	select {
+	case <-s.ready:
+	default:
+		close(s.ready)
+	}
+}
+
+// decodeSecret returns the keys of the secret name if the response contains it.
+func decodeSecret(resp *discoveryv3.DiscoveryResponse, name string) (map[string][]byte, bool, error) {
+	for _, r := range resp.GetResources() {
+		if r.GetTypeUrl() == resourceTypeURL {
+			wrapped := &discoveryv3.Resource{}
+			if err := r.UnmarshalTo(wrapped); err != nil {
+				return nil, false, err
+			}
+			if wrapped.GetResource() == nil {
+				continue
+			}
+			r = wrapped.GetResource()
+		}
+		if r.GetTypeUrl() != secretTypeURL {
+			return nil, false, fmt.Errorf(errUnexpectedType, r.GetTypeUrl())
+		}
+		secret := &tlsv3.Secret{}
+		if err := r.UnmarshalTo(secret); err != nil {
+			return nil, false, err
+		}
+		if secret.GetName() != name {
+			continue
+		}
+		data, err := secretData(secret)
+		return data, err == nil, err
+	}
+	return nil, false, nil
+}
+
+// secretData decodes the certificate, the validation context and generic secrets.
+func secretData(secret *tlsv3.Secret) (map[string][]byte, error) {
+	data := make(map[string][]byte)
+	for key, source := range map[string]*corev3.DataSource{
+		keyCertificate: secret.GetTlsCertificate().GetCertificateChain(),
+		keyPrivateKey:  secret.GetTlsCertificate().GetPrivateKey(),
+		keyTrustedCA:   secret.GetValidationContext().GetTrustedCa(),
+		keyGeneric:     secret.GetGenericSecret().GetSecret(),
+	} {
+		if source == nil {
+			continue
+		}
+		value, err := dataSourceValue(source)
+		if err != nil {
+			return nil, err
+		}
+		if len(value) > 0 {
+			data[key] = value
+		}
+	}
+	if len(data) == 0 {
+		return nil, fmt.Errorf(errEmptySecret, secret.GetName())
+	}
+	return data, nil
+}
+
+// dataSourceValue returns the inline content of the data source, files and environment
+// variables are local to the SDS server and can not be read by the operator.
+func dataSourceValue(source *corev3.DataSource) ([]byte, error) {
+	switch s := source.GetSpecifier().(type) {
+	case *corev3.DataSource_InlineBytes:
+		return s.InlineBytes, nil
+	case *corev3.DataSource_InlineString:
+		return []byte(s.InlineString), nil
+	case nil:
+		return nil, nil
+	default:
+		return nil, errors.New(errNotInline)
+	}
+}
@@ -0,0 +1,220 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sds
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	discoveryv3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	secretv3 "github.com/envoyproxy/go-control-plane/envoy/service/secret/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+// fakeSDS pushes the responses written to push and records the requests it receives.
+type fakeSDS struct {
+	secretv3.UnimplementedSecretDiscoveryServiceServer
+	push     chan *discoveryv3.DiscoveryResponse
+	requests chan *discoveryv3.DiscoveryRequest
+}
+
+func (f *fakeSDS) StreamSecrets(stream secretv3.SecretDiscoveryService_StreamSecretsServer) error {
+	errs := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				errs <- err
+				return
+			}
+			f.requests <- req
+		}
+	}()
+	for {
+		select {
+		case resp := <-f.push:
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		case err := <-errs:
+			return err
+		}
+	}
+}
+
+func newTestClient(t *testing.T) (*client, *fakeSDS) {
+	t.Helper()
+	fake := &fakeSDS{
+		push:     make(chan *discoveryv3.DiscoveryResponse, 10),
+		requests: make(chan *discoveryv3.DiscoveryRequest, 10),
+	}
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	secretv3.RegisterSecretDiscoveryServiceServer(s, fake)
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	t.Cleanup(s.Stop)
+
+	cache := newSubscriptionCache()
+	cache.dial = func(_ connConfig) (*grpc.ClientConn, error) {
+		return grpc.NewClient("passthrough:///bufnet",
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return lis.DialContext(ctx)
+			}),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+	}
+	t.Cleanup(func() {
+		for _, sub := range cache.subs {
+			sub.stop()
+		}
+	})
+	return &client{cache: cache, cfg: connConfig{endpoint: "bufnet", nodeID: defaultNodeID}}, fake
+}
+
+func secretResponse(t *testing.T, version string, secret *tlsv3.Secret) *discoveryv3.DiscoveryResponse {
+	t.Helper()
+	resource, err := anypb.New(secret)
+	require.NoError(t, err)
+	return &discoveryv3.DiscoveryResponse{
+		VersionInfo: version,
+		Nonce:       "nonce-" + version,
+		TypeUrl:     secretTypeURL,
+		Resources:   []*anypb.Any{resource},
+	}
+}
+
+func tlsCertificate(name string, chain, key *corev3.DataSource) *tlsv3.Secret {
+	return &tlsv3.Secret{
+		Name: name,
+		Type: &tlsv3.Secret_TlsCertificate{TlsCertificate: &tlsv3.TlsCertificate{CertificateChain: chain, PrivateKey: key}},
+	}
+}
+
+func inlineBytes(b string) *corev3.DataSource {
+	return &corev3.DataSource{Specifier: &corev3.DataSource_InlineBytes{InlineBytes: []byte(b)}}
+}
+
+func inlineString(s string) *corev3.DataSource {
+	return &corev3.DataSource{Specifier: &corev3.DataSource_InlineString{InlineString: s}}
+}
+
+func receive(t *testing.T, fake *fakeSDS) *discoveryv3.DiscoveryRequest {
+	t.Helper()
+	select {
+	case req := <-fake.requests:
+		return req
+	case <-time.After(5 * time.Second):
+		t.Fatal("no request received")
+		return nil
+	}
+}
+
+func TestSubscription(t *testing.T) {
+	c, fake := newTestClient(t)
+	ctx := context.Background()
+	ref := esv1beta1.ExternalSecretDataRemoteRef{Key: "default"}
+
+	fake.push <- secretResponse(t, "1", tlsCertificate("default", inlineBytes("cert-1"), inlineString("key-1")))
+	data, err := c.GetSecretMap(ctx, ref)
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"tls.crt": []byte("cert-1"), "tls.key": []byte("key-1")}, data)
+
+	sub := receive(t, fake)
+	assert.Equal(t, []string{"default"}, sub.GetResourceNames())
+	assert.Equal(t, secretTypeURL, sub.GetTypeUrl())
+	assert.Equal(t, defaultNodeID, sub.GetNode().GetId())
+	ack := receive(t, fake)
+	assert.Equal(t, "1", ack.GetVersionInfo())
+	assert.Equal(t, "nonce-1", ack.GetResponseNonce())
+	assert.Nil(t, ack.GetErrorDetail())
+
+	// a rotation pushed by the server replaces the cached version
+	fake.push <- secretResponse(t, "2", tlsCertificate("default", inlineBytes("cert-2"), inlineBytes("key-2")))
+	assert.Equal(t, "2", receive(t, fake).GetVersionInfo())
+	got, err := c.GetSecret(ctx, ref)
+	require.NoError(t, err)
+	assert.Equal(t, "cert-2", string(got))
+	ref.Property = "tls.key"
+	got, err = c.GetSecret(ctx, ref)
+	require.NoError(t, err)
+	assert.Equal(t, "key-2", string(got))
+
+	// invalid responses are rejected and the previous version is kept
+	fake.push <- secretResponse(t, "3", tlsCertificate("default",
+		&corev3.DataSource{Specifier: &corev3.DataSource_Filename{Filename: "/etc/certs/cert.pem"}}, nil))
+	nack := receive(t, fake)
+	assert.Equal(t, "2", nack.GetVersionInfo())
+	assert.Equal(t, "nonce-3", nack.GetResponseNonce())
+	require.NotNil(t, nack.GetErrorDetail())
+	assert.Contains(t, nack.GetErrorDetail().GetMessage(), "only inline data is supported")
+	got, err = c.GetSecret(ctx, ref)
+	require.NoError(t, err)
+	assert.Equal(t, "key-2", string(got))
+
+	ref.Property = "ca.crt"
+	_, err = c.GetSecret(ctx, ref)
+	assert.ErrorIs(t, err, esv1beta1.NoSecretError{})
+	ref.Property = "tls.pem"
+	_, err = c.GetSecret(ctx, ref)
+	assert.ErrorContains(t, err, "unknown property")
+}
+
+func TestSubscriptionValidationContext(t *testing.T) {
+	c, fake := newTestClient(t)
+	secret, err := anypb.New(&tlsv3.Secret{
+		Name: "ROOTCA",
+		Type: &tlsv3.Secret_ValidationContext{ValidationContext: &tlsv3.CertificateValidationContext{TrustedCa: inlineBytes("ca")}},
+	})
+	require.NoError(t, err)
+	// the secret is wrapped in a Resource, as sent by some SDS servers
+	wrapped, err := anypb.New(&discoveryv3.Resource{Name: "ROOTCA", Resource: secret})
+	require.NoError(t, err)
+	fake.push <- &discoveryv3.DiscoveryResponse{
+		VersionInfo: "1",
+		Nonce:       "a",
+		Resources:   []*anypb.Any{wrapped},
+	}
+	got, err := c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "ROOTCA"})
+	require.NoError(t, err)
+	assert.Equal(t, "ca", string(got))
+}
+
+func TestSubscriptionIsShared(t *testing.T) {
+	c, fake := newTestClient(t)
+	fake.push <- secretResponse(t, "1", &tlsv3.Secret{
+		Name: "default",
+		Type: &tlsv3.Secret_GenericSecret{GenericSecret: &tlsv3.GenericSecret{Secret: inlineString("token")}},
+	})
+	ctx := context.Background()
+	for range 2 {
+		got, err := c.GetSecret(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: "default"})
+		require.NoError(t, err)
+		assert.Equal(t, "token", string(got))
+	}
+	assert.Len(t, c.cache.subs, 1)
+}
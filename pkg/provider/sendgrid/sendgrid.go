@@ -0,0 +1,219 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sendgrid
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	// properties of an API key.
+	propertyID     = "id"
+	propertyName   = "name"
+	propertyScopes = "scopes"
+	propertyAPIKey = "apiKey"
+
+	errEmptyKey          = "key must be the name or the ID of an API key"
+	errInvalidProperty   = "invalid property %q of api key %q, must be one of id, name or scopes"
+	errAPIKeyUnreadable  = "SendGrid only returns api key %q when it is created"
+	errKeyNotFound       = "api key %q not found"
+	errAmbiguousKey      = "%d api keys are named %q, use the ID of the key instead"
+	errReadOnly          = "the SendGrid provider is read only"
+	errUnexpectedStatus  = "unexpected status code from SendGrid: %d: %s"
+	errUnmarshalResponse = "unable to unmarshal SendGrid response: %w"
+	errFindUnsupported   = "find is not supported by the SendGrid provider"
+)
+
+// client reads the API keys of an account with the SendGrid v3 API.
+// https://www.twilio.com/docs/sendgrid/api-reference/api-keys
+type client struct {
+	httpClient *http.Client
+	url        string
+	subuser    string
+	apiKey     string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+type apiKey struct {
+	ID     string   `json:"api_key_id"`
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+type apiKeyList struct {
+	Result []apiKey `json:"result"`
+}
+
+func validateProperty(key, property string) error {
+	switch property {
+	case "", propertyID, propertyName, propertyScopes:
+		return nil
+	case propertyAPIKey:
+		return fmt.Errorf(errAPIKeyUnreadable, key)
+	}
+	return fmt.Errorf(errInvalidProperty, property, key)
+}
+
+// GetSecret returns a field of the API key with the name or ID key, its ID by default.
+// The scopes are returned comma separated.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	if err := validateProperty(ref.Key, ref.Property); err != nil {
+		return nil, err
+	}
+	key, err := c.findKey(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	switch ref.Property {
+	case propertyName:
+		return []byte(key.Name), nil
+	case propertyScopes:
+		return []byte(strings.Join(key.Scopes, ",")), nil
+	}
+	return []byte(key.ID), nil
+}
+
+// GetSecretMap returns the ID, the name and the scopes of the API key.
+// The API key itself is only returned by SendGrid when it is created.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	key, err := c.findKey(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	return map[string][]byte{
+		propertyID:     []byte(key.ID),
+		propertyName:   []byte(key.Name),
+		propertyScopes: []byte(strings.Join(key.Scopes, ",")),
+	}, nil
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindUnsupported)
+}
+
+func (c *client) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
+	return false, errors.New(errReadOnly)
+}
+
+// Validate reads the scopes of the API key of the store to check it.
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	if err := c.do(context.Background(), http.MethodGet, "/v3/scopes", nil, nil); err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+// findKey returns the API key with the ID or the name nameOrID, including its scopes.
+func (c *client) findKey(ctx context.Context, nameOrID string) (*apiKey, error) {
+	if nameOrID == "" {
+		return nil, errors.New(errEmptyKey)
+	}
+	var list apiKeyList
+	if err := c.do(ctx, http.MethodGet, "/v3/api_keys", nil, &list); err != nil {
+		return nil, err
+	}
+	var matches []apiKey
+	for _, key := range list.Result {
+		if key.ID == nameOrID || key.Name == nameOrID {
+			matches = append(matches, key)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf(errKeyNotFound, nameOrID)
+	case 1:
+	default:
+		return nil, fmt.Errorf(errAmbiguousKey, len(matches), nameOrID)
+	}
+	// the list does not include the scopes of the keys
+	var key apiKey
+	if err := c.do(ctx, http.MethodGet, "/v3/api_keys/"+url.PathEscape(matches[0].ID), nil, &key); err != nil {
+		return nil, err
+	}
+	slices.Sort(key.Scopes)
+	return &key, nil
+}
+
+func (c *client) do(ctx context.Context, method, path string, body, target any) error {
+	var reqBody io.Reader = http.NoBody
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.subuser != "" {
+		req.Header.Set("On-Behalf-Of", c.subuser)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return esv1beta1.NoSecretError{}
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf(errUnexpectedStatus, resp.StatusCode, respBody)
+	}
+	if target == nil {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, target); err != nil {
+		return fmt.Errorf(errUnmarshalResponse, err)
+	}
+	return nil
+}
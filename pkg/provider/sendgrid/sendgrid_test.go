@@ -0,0 +1,167 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	testingfake "github.com/external-secrets/external-secrets/pkg/provider/testing/fake"
+)
+
+const testAPIKey = "SG.test.key"
+
+// fakeSendGrid keeps the API keys of an account in memory.
+type fakeSendGrid struct {
+	mu   sync.Mutex
+	keys []apiKey
+}
+
+// newTestClient serves the API keys of the account with the SendGrid v3 API.
+func newTestClient(t *testing.T) (*client, *fakeSendGrid) {
+	fake := &fakeSendGrid{keys: []apiKey{
+		{ID: "k1", Name: "mail", Scopes: []string{"mail.send", "alerts.read"}},
+		{ID: "k2", Name: "stats", Scopes: []string{"stats.read"}},
+		{ID: "k3", Name: "stats", Scopes: []string{"stats.global.read"}},
+	}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v3/api_keys", func(w http.ResponseWriter, _ *http.Request) {
+		fake.mu.Lock()
+		defer fake.mu.Unlock()
+		// the list does not include the scopes
+		list := apiKeyList{Result: []apiKey{}}
+		for _, k := range fake.keys {
+			list.Result = append(list.Result, apiKey{ID: k.ID, Name: k.Name})
+		}
+		_ = json.NewEncoder(w).Encode(list)
+	})
+	mux.HandleFunc("GET /v3/api_keys/{id}", func(w http.ResponseWriter, r *http.Request) {
+		fake.mu.Lock()
+		defer fake.mu.Unlock()
+		for _, k := range fake.keys {
+			if k.ID == r.PathValue("id") {
+				_ = json.NewEncoder(w).Encode(k)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors":[{"field":null,"message":"unable to get api key"}]}`))
+	})
+	mux.HandleFunc("GET /v3/scopes", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"scopes":["api_keys.create","api_keys.read","api_keys.delete"]}`))
+	})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testAPIKey {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"errors":[{"field":null,"message":"authorization required"}]}`))
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return &client{httpClient: srv.Client(), url: srv.URL, apiKey: testAPIKey}, fake
+}
+
+func TestGetSecret(t *testing.T) {
+	c, _ := newTestClient(t)
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"id by name": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "mail"},
+			want: "k1",
+		},
+		"name by id": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "k2", Property: "name"},
+			want: "stats",
+		},
+		"sorted scopes": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "mail", Property: "scopes"},
+			want: "alerts.read,mail.send",
+		},
+		"api key": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "mail", Property: "apiKey"},
+			wantErr: `SendGrid only returns api key "mail" when it is created`,
+		},
+		"invalid property": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "mail", Property: "secret"},
+			wantErr: `invalid property "secret"`,
+		},
+		"ambiguous name": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "stats"},
+			wantErr: `2 api keys are named "stats"`,
+		},
+		"missing key": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "marketing"},
+			wantErr: `api key "marketing" not found`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestGetSecretMap(t *testing.T) {
+	c, _ := newTestClient(t)
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "mail"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"id":     []byte("k1"),
+		"name":   []byte("mail"),
+		"scopes": []byte("alerts.read,mail.send"),
+	}, got)
+}
+
+func TestPushSecretIsNotSupported(t *testing.T) {
+	c, _ := newTestClient(t)
+	ctx := context.Background()
+	ref := testingfake.PushSecretData{SecretKey: "scopes", RemoteKey: "ci"}
+
+	assert.EqualError(t, c.PushSecret(ctx, &corev1.Secret{}, ref), errReadOnly)
+	assert.EqualError(t, c.DeleteSecret(ctx, ref), errReadOnly)
+	_, err := c.SecretExists(ctx, ref)
+	assert.EqualError(t, err, errReadOnly)
+}
+
+func TestValidate(t *testing.T) {
+	c, _ := newTestClient(t)
+	res, err := c.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+
+	c.apiKey = "invalid"
+	res, err = c.Validate()
+	assert.ErrorContains(t, err, "unexpected status code from SendGrid: 401")
+	assert.Equal(t, esv1beta1.ValidationResultError, res)
+}
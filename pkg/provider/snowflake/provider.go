@@ -0,0 +1,135 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snowflake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+const (
+	errStoreIsNil                  = "store is nil"
+	errNoStoreTypeOrWrongStoreType = "no store type or wrong store type"
+	errInvalidURL                  = "invalid url %q: %w"
+	errAccountRequired             = "account is required"
+	errUsernameRequired            = "auth.username is required"
+	errCannotResolvePrivateKey     = "cannot resolve private key: %w"
+	errCannotResolvePassphrase     = "cannot resolve passphrase: %w"
+)
+
+// Provider satisfies the provider interface.
+type Provider struct{}
+
+var _ esv1beta1.Provider = &Provider{}
+
+func (p *Provider) NewClient(ctx context.Context, store esv1beta1.GenericStore, kube kclient.Client, namespace string) (esv1beta1.SecretsClient, error) {
+	cfg, err := getConfig(store)
+	if err != nil {
+		return nil, err
+	}
+	keyPEM, err := resolvers.SecretKeyRef(ctx, kube, store.GetKind(), namespace, &cfg.Auth.PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf(errCannotResolvePrivateKey, err)
+	}
+	var passphrase string
+	if cfg.Auth.Passphrase != nil {
+		passphrase, err = resolvers.SecretKeyRef(ctx, kube, store.GetKind(), namespace, cfg.Auth.Passphrase)
+		if err != nil {
+			return nil, fmt.Errorf(errCannotResolvePassphrase, err)
+		}
+	}
+	key, err := parsePrivateKey([]byte(keyPEM), passphrase)
+	if err != nil {
+		return nil, err
+	}
+	apiURL := cfg.URL
+	if apiURL == "" {
+		apiURL = fmt.Sprintf("https://%s.snowflakecomputing.com", strings.ToLower(cfg.Account))
+	}
+	return &client{
+		httpClient: &http.Client{Timeout: 90 * time.Second},
+		url:        strings.TrimSuffix(apiURL, "/"),
+		account:    cfg.Account,
+		username:   cfg.Auth.Username,
+		role:       cfg.Role,
+		key:        key,
+	}, nil
+}
+
+func getConfig(store esv1beta1.GenericStore) (*esv1beta1.SnowflakeProvider, error) {
+	if store == nil {
+		return nil, errors.New(errStoreIsNil)
+	}
+	spec := store.GetSpec()
+	if spec == nil || spec.Provider == nil || spec.Provider.Snowflake == nil {
+		return nil, errors.New(errNoStoreTypeOrWrongStoreType)
+	}
+	cfg := spec.Provider.Snowflake
+	if cfg.Account == "" {
+		return nil, errors.New(errAccountRequired)
+	}
+	if cfg.URL != "" {
+		if _, err := url.ParseRequestURI(cfg.URL); err != nil {
+			return nil, fmt.Errorf(errInvalidURL, cfg.URL, err)
+		}
+	}
+	if cfg.Auth.Username == "" {
+		return nil, errors.New(errUsernameRequired)
+	}
+	if err := utils.ValidateReferentSecretSelector(store, cfg.Auth.PrivateKey); err != nil {
+		return nil, err
+	}
+	if cfg.Auth.Passphrase != nil {
+		if err := utils.ValidateReferentSecretSelector(store, *cfg.Auth.Passphrase); err != nil {
+			return nil, err
+		}
+	}
+	return cfg, nil
+}
+
+func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnings, error) {
+	_, err := getConfig(store)
+	return nil, err
+}
+
+// ValidateRemoteRef checks that the key is a user name and the property a field of the user.
+func (p *Provider) ValidateRemoteRef(_ esv1beta1.GenericStore, ref esv1beta1.ExternalSecretDataRemoteRef) error {
+	if ref.Key == "" {
+		return errors.New(errUserRequired)
+	}
+	return validateProperty(ref.Property)
+}
+
+func (p *Provider) Capabilities() esv1beta1.SecretStoreCapabilities {
+	return esv1beta1.SecretStoreReadWrite
+}
+
+func init() {
+	esv1beta1.Register(&Provider{}, &esv1beta1.SecretStoreProvider{
+		Snowflake: &esv1beta1.SnowflakeProvider{},
+	})
+}
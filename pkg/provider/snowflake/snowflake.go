@@ -0,0 +1,385 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snowflake
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/youmark/pkcs8"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	// secret keys of a user.
+	keyAccount      = "account"
+	keyUsername     = "username"
+	keyFingerprint  = "publicKeyFingerprint"
+	keyFingerprint2 = "publicKey2Fingerprint"
+
+	// keys of a pushed Secret.
+	keyPrivateKey = "privateKey"
+	keyPassphrase = "passphrase"
+
+	// properties of DESC USER.
+	propPublicKey    = "RSA_PUBLIC_KEY"
+	propFingerprint  = "RSA_PUBLIC_KEY_FP"
+	propFingerprint2 = "RSA_PUBLIC_KEY_2_FP"
+
+	statementsPath = "/api/v2/statements"
+	// codeDoesNotExist is returned for a missing user, or one the role may not see.
+	codeDoesNotExist = "002003"
+
+	errUserRequired       = "key must be the name of a user"
+	errInvalidProperty    = "property %q must be one of account, username, publicKeyFingerprint or publicKey2Fingerprint"
+	errPropertyNotSet     = "user %q has no %s"
+	errPrivateKeyRequired = "the Secret must contain a PEM encoded private key in %q"
+	errDecodePrivateKey   = "unable to decode private key: no PEM block found"
+	errParsePrivateKey    = "unable to parse private key: %w"
+	errNotRSAKey          = "Snowflake key pairs must be RSA keys, got %T"
+	errInvalidPublicKey   = "user %q has an invalid public key"
+	errStatementRunning   = "statement did not finish in time: %s"
+	errStatement          = "Snowflake returned an error: %s: %s"
+	errUnexpectedStatus   = "unexpected status code from Snowflake: %d: %s"
+	errUnmarshalResponse  = "unable to unmarshal Snowflake response: %w"
+	errMissingColumns     = "unexpected result of DESC USER, property or value column is missing"
+	errFindUnsupported    = "find is not supported by the Snowflake provider"
+)
+
+// unquotedIdentifier matches the identifiers which can be used without quotes, they are case-insensitive.
+var unquotedIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_$]*$`)
+
+// client manages the public keys of users with the Snowflake SQL API.
+// https://docs.snowflake.com/en/developer-guide/sql-api/index
+type client struct {
+	httpClient *http.Client
+	url        string
+	account    string
+	username   string
+	role       string
+	key        *rsa.PrivateKey
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+type statementRequest struct {
+	Statement string `json:"statement"`
+	Timeout   int    `json:"timeout"`
+	Role      string `json:"role,omitempty"`
+}
+
+type statementResponse struct {
+	Code              string `json:"code"`
+	Message           string `json:"message"`
+	StatementHandle   string `json:"statementHandle"`
+	ResultSetMetaData struct {
+		RowType []struct {
+			Name string `json:"name"`
+		} `json:"rowType"`
+	} `json:"resultSetMetaData"`
+	Data [][]*string `json:"data"`
+}
+
+func validateProperty(property string) error {
+	switch property {
+	case "", keyAccount, keyUsername, keyFingerprint, keyFingerprint2:
+		return nil
+	}
+	return fmt.Errorf(errInvalidProperty, property)
+}
+
+// GetSecret returns a field of the user, or all fields as JSON if no property is given.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	if err := validateProperty(ref.Property); err != nil {
+		return nil, err
+	}
+	data, err := c.GetSecretMap(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Property == "" {
+		values := make(map[string]string, len(data))
+		for k, v := range data {
+			values[k] = string(v)
+		}
+		return utils.JSONMarshal(values)
+	}
+	value, ok := data[ref.Property]
+	if !ok {
+		return nil, fmt.Errorf(errPropertyNotSet, ref.Key, ref.Property)
+	}
+	return value, nil
+}
+
+// GetSecretMap returns the account, the name and the fingerprints of the public keys of the user.
+// Snowflake only stores public keys, the private key never leaves the Secret it was pushed from.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	if ref.Key == "" {
+		return nil, errors.New(errUserRequired)
+	}
+	props, err := c.describeUser(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	data := map[string][]byte{
+		keyAccount:  []byte(c.account),
+		keyUsername: []byte(ref.Key),
+	}
+	if fp := props[propFingerprint]; fp != "" {
+		data[keyFingerprint] = []byte(fp)
+	}
+	if fp := props[propFingerprint2]; fp != "" {
+		data[keyFingerprint2] = []byte(fp)
+	}
+	return data, nil
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindUnsupported)
+}
+
+// PushSecret sets the public key of the private key in the Secret as RSA_PUBLIC_KEY of the user.
+// The previous RSA_PUBLIC_KEY is kept as RSA_PUBLIC_KEY_2, so clients can switch to the new key.
+// Nothing is done if the fingerprint of the key matches one of the public keys of the user.
+func (c *client) PushSecret(ctx context.Context, secret *corev1.Secret, data esv1beta1.PushSecretData) error {
+	name := data.GetRemoteKey()
+	if name == "" {
+		return errors.New(errUserRequired)
+	}
+	secretKey := data.GetSecretKey()
+	if secretKey == "" {
+		secretKey = keyPrivateKey
+	}
+	if len(secret.Data[secretKey]) == 0 {
+		return fmt.Errorf(errPrivateKeyRequired, secretKey)
+	}
+	key, err := parsePrivateKey(secret.Data[secretKey], string(secret.Data[keyPassphrase]))
+	if err != nil {
+		return err
+	}
+	publicKey, fingerprint, err := encodePublicKey(&key.PublicKey)
+	if err != nil {
+		return err
+	}
+	props, err := c.describeUser(ctx, name)
+	if err != nil {
+		return err
+	}
+	if fingerprint == props[propFingerprint] || fingerprint == props[propFingerprint2] {
+		return nil
+	}
+	stmt := fmt.Sprintf("ALTER USER %s SET RSA_PUBLIC_KEY='%s'", identifier(name), publicKey)
+	if previous := strings.Join(strings.Fields(props[propPublicKey]), ""); previous != "" {
+		// the key is embedded in the statement, so it must be plain base64
+		if _, err := base64.StdEncoding.DecodeString(previous); err != nil {
+			return fmt.Errorf(errInvalidPublicKey, name)
+		}
+		stmt += fmt.Sprintf(" RSA_PUBLIC_KEY_2='%s'", previous)
+	}
+	_, err = c.execute(ctx, stmt)
+	return err
+}
+
+// DeleteSecret unsets both public keys of the user, a missing user is not an error.
+func (c *client) DeleteSecret(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) error {
+	exists, err := c.SecretExists(ctx, remoteRef)
+	if err != nil || !exists {
+		return err
+	}
+	_, err = c.execute(ctx, fmt.Sprintf("ALTER USER %s UNSET RSA_PUBLIC_KEY, RSA_PUBLIC_KEY_2", identifier(remoteRef.GetRemoteKey())))
+	return err
+}
+
+// SecretExists checks if the user has a public key.
+func (c *client) SecretExists(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) (bool, error) {
+	props, err := c.describeUser(ctx, remoteRef.GetRemoteKey())
+	if errors.Is(err, esv1beta1.NoSecretError{}) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return props[propFingerprint] != "" || props[propFingerprint2] != "", nil
+}
+
+// Validate runs a statement to check the key pair of the store.
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	if _, err := c.execute(context.Background(), "SELECT CURRENT_USER()"); err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+// describeUser returns the properties of the user, unset properties are omitted.
+func (c *client) describeUser(ctx context.Context, name string) (map[string]string, error) {
+	resp, err := c.execute(ctx, "DESC USER "+identifier(name))
+	if err != nil {
+		return nil, err
+	}
+	propCol, valueCol := -1, -1
+	for i, col := range resp.ResultSetMetaData.RowType {
+		switch strings.ToLower(col.Name) {
+		case "property":
+			propCol = i
+		case "value":
+			valueCol = i
+		}
+	}
+	if propCol < 0 || valueCol < 0 {
+		return nil, errors.New(errMissingColumns)
+	}
+	props := make(map[string]string, len(resp.Data))
+	for _, row := range resp.Data {
+		if len(row) <= propCol || len(row) <= valueCol || row[propCol] == nil || row[valueCol] == nil {
+			continue
+		}
+		if value := *row[valueCol]; value != "" && value != "null" {
+			props[strings.ToUpper(*row[propCol])] = value
+		}
+	}
+	return props, nil
+}
+
+// identifier returns name as an identifier of a statement, names which need quotes are case-sensitive.
+func identifier(name string) string {
+	if unquotedIdentifier.MatchString(name) {
+		return name
+	}
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// parsePrivateKey parses a PEM encoded RSA key in PKCS#1 or PKCS#8 format, which may be encrypted.
+func parsePrivateKey(keyPEM []byte, passphrase string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New(errDecodePrivateKey)
+	}
+	var key any
+	var err error
+	switch block.Type {
+	case "ENCRYPTED PRIVATE KEY":
+		key, err = pkcs8.ParsePKCS8PrivateKey(block.Bytes, []byte(passphrase))
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf(errParsePrivateKey, err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf(errNotRSAKey, key)
+	}
+	return rsaKey, nil
+}
+
+// encodePublicKey returns the base64 encoded DER of the public key and its fingerprint as shown by DESC USER.
+func encodePublicKey(pub *rsa.PublicKey) (string, string, error) {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return "", "", err
+	}
+	sum := sha256.Sum256(der)
+	return base64.StdEncoding.EncodeToString(der), "SHA256:" + base64.StdEncoding.EncodeToString(sum[:]), nil
+}
+
+// token returns a JWT for key pair authentication.
+// https://docs.snowflake.com/en/developer-guide/sql-api/authenticating#using-key-pair-authentication
+func (c *client) token() (string, error) {
+	_, fingerprint, err := encodePublicKey(&c.key.PublicKey)
+	if err != nil {
+		return "", err
+	}
+	// the account locator may contain the region, e.g. xy12345.us-east-2.aws
+	account, _, _ := strings.Cut(strings.ToUpper(c.account), ".")
+	qualifiedName := account + "." + strings.ToUpper(c.username)
+	now := time.Now()
+	claims := jwt.RegisteredClaims{
+		Issuer:    qualifiedName + "." + fingerprint,
+		Subject:   qualifiedName,
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodRS256, claims).SignedString(c.key)
+}
+
+func (c *client) execute(ctx context.Context, statement string) (*statementResponse, error) {
+	b, err := json.Marshal(statementRequest{Statement: statement, Timeout: 60, Role: c.role})
+	if err != nil {
+		return nil, err
+	}
+	token, err := c.token()
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url+statementsPath, bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("X-Snowflake-Authorization-Token-Type", "KEYPAIR_JWT")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	// a failed statement is reported with 422 and the error in the body
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusUnprocessableEntity {
+		return nil, fmt.Errorf(errUnexpectedStatus, resp.StatusCode, respBody)
+	}
+	var stmtResp statementResponse
+	if err := json.Unmarshal(respBody, &stmtResp); err != nil {
+		return nil, fmt.Errorf(errUnmarshalResponse, err)
+	}
+	switch resp.StatusCode {
+	case http.StatusAccepted:
+		return nil, fmt.Errorf(errStatementRunning, stmtResp.StatementHandle)
+	case http.StatusUnprocessableEntity:
+		if stmtResp.Code == codeDoesNotExist {
+			return nil, esv1beta1.NoSecretError{}
+		}
+		return nil, fmt.Errorf(errStatement, stmtResp.Code, stmtResp.Message)
+	}
+	return &stmtResp, nil
+}
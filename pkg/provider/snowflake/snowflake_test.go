@@ -0,0 +1,304 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package snowflake
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/youmark/pkcs8"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1alpha1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1alpha1"
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	testAccount = "myorg-myaccount"
+	testUser    = "eso"
+)
+
+var (
+	userStmt   = regexp.MustCompile(`^(?:DESC|ALTER) USER (\S+)`)
+	descUser   = regexp.MustCompile(`^DESC USER (\S+)$`)
+	alterSet   = regexp.MustCompile(`^ALTER USER (\S+) SET RSA_PUBLIC_KEY='([^']+)'(?: RSA_PUBLIC_KEY_2='([^']+)')?$`)
+	alterUnset = regexp.MustCompile(`^ALTER USER (\S+) UNSET RSA_PUBLIC_KEY, RSA_PUBLIC_KEY_2$`)
+)
+
+type fakeUser struct {
+	publicKey  string
+	publicKey2 string
+}
+
+// fakeSnowflake runs the statements of the provider against the public keys of the users,
+// the requests must be signed by the key of the store.
+type fakeSnowflake struct {
+	mu         sync.Mutex
+	storeKey   *rsa.PublicKey
+	users      map[string]*fakeUser
+	statements []string
+}
+
+func newTestKey(t *testing.T) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return key
+}
+
+func publicKeyOf(t *testing.T, key *rsa.PrivateKey) string {
+	pub, _, err := encodePublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	return pub
+}
+
+func newTestClient(t *testing.T) (*client, *fakeSnowflake) {
+	storeKey := newTestKey(t)
+	f := &fakeSnowflake{
+		storeKey: &storeKey.PublicKey,
+		users: map[string]*fakeUser{
+			"ESO":          {publicKey: publicKeyOf(t, storeKey)},
+			"SVC_ORDERS":   {},
+			"svc.payments": {},
+		},
+	}
+	srv := httptest.NewServer(f.handler())
+	t.Cleanup(srv.Close)
+	return &client{httpClient: srv.Client(), url: srv.URL, account: testAccount, username: testUser, key: storeKey}, f
+}
+
+func fingerprint(publicKey string) string {
+	if publicKey == "" {
+		return "null"
+	}
+	der, _ := base64.StdEncoding.DecodeString(publicKey)
+	sum := sha256.Sum256(der)
+	return "SHA256:" + base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// user returns the user of an identifier, unquoted identifiers are case-insensitive.
+func (f *fakeSnowflake) user(ident string) *fakeUser {
+	if strings.HasPrefix(ident, `"`) {
+		return f.users[strings.ReplaceAll(strings.Trim(ident, `"`), `""`, `"`)]
+	}
+	return f.users[strings.ToUpper(ident)]
+}
+
+func (f *fakeSnowflake) handler() http.Handler {
+	_, storeFingerprint, _ := encodePublicKey(f.storeKey)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := jwt.Parse(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "), func(*jwt.Token) (any, error) {
+			return f.storeKey, nil
+		}, jwt.WithIssuer("MYORG-MYACCOUNT.ESO."+storeFingerprint), jwt.WithSubject("MYORG-MYACCOUNT.ESO"))
+		if err != nil || r.Header.Get("X-Snowflake-Authorization-Token-Type") != "KEYPAIR_JWT" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"code":"390144","message":"JWT token is invalid."}`))
+			return
+		}
+		var req statementRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		f.statements = append(f.statements, req.Statement)
+
+		writeError := func(code, msg string) {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_ = json.NewEncoder(w).Encode(map[string]string{"code": code, "message": msg})
+		}
+		var user *fakeUser
+		if m := userStmt.FindStringSubmatch(req.Statement); m != nil {
+			if user = f.user(m[1]); user == nil {
+				writeError(codeDoesNotExist, "SQL compilation error: User '"+m[1]+"' does not exist or not authorized.")
+				return
+			}
+		}
+		resp := map[string]any{"code": "090001", "message": "Statement executed successfully."}
+		switch {
+		case descUser.MatchString(req.Statement):
+			resp["resultSetMetaData"] = map[string]any{"rowType": []map[string]string{
+				{"name": "property"}, {"name": "value"}, {"name": "default"}, {"name": "description"},
+			}}
+			resp["data"] = [][]*string{
+				{ptr("NAME"), ptr("X"), nil, ptr("Name")},
+				{ptr(propPublicKey), ptr(nullIfEmpty(user.publicKey)), ptr("null"), ptr("RSA public key of the user")},
+				{ptr(propFingerprint), ptr(fingerprint(user.publicKey)), ptr("null"), ptr("Fingerprint of user's RSA public key.")},
+				{ptr("RSA_PUBLIC_KEY_2"), ptr(nullIfEmpty(user.publicKey2)), ptr("null"), ptr("Second RSA public key of the user")},
+				{ptr(propFingerprint2), ptr(fingerprint(user.publicKey2)), ptr("null"), ptr("Fingerprint of user's second RSA public key.")},
+			}
+		case alterSet.MatchString(req.Statement):
+			m := alterSet.FindStringSubmatch(req.Statement)
+			user.publicKey = m[2]
+			if m[3] != "" {
+				user.publicKey2 = m[3]
+			}
+		case alterUnset.MatchString(req.Statement):
+			user.publicKey, user.publicKey2 = "", ""
+		case req.Statement == "SELECT CURRENT_USER()":
+			resp["data"] = [][]*string{{ptr("ESO")}}
+		default:
+			writeError("001003", "SQL compilation error: syntax error")
+			return
+		}
+		_ = json.NewEncoder(w).Encode(resp)
+	})
+}
+
+func ptr(s string) *string {
+	return &s
+}
+
+func nullIfEmpty(s string) string {
+	if s == "" {
+		return "null"
+	}
+	return s
+}
+
+func TestGetSecret(t *testing.T) {
+	c, fake := newTestClient(t)
+	storeFingerprint := fingerprint(fake.users["ESO"].publicKey)
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"all fields": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "eso"},
+			want: `{"account":"` + testAccount + `","publicKeyFingerprint":"` + storeFingerprint + `","username":"eso"}`,
+		},
+		"fingerprint": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "eso", Property: keyFingerprint},
+			want: storeFingerprint,
+		},
+		"unset fingerprint": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "eso", Property: keyFingerprint2},
+			wantErr: `user "eso" has no publicKey2Fingerprint`,
+		},
+		"missing user": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "missing"},
+			wantErr: "Secret does not exist",
+		},
+		"invalid property": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "eso", Property: "privateKey"},
+			wantErr: `property "privateKey" must be one of`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func pushData(secretKey, remoteKey string) esv1alpha1.PushSecretData {
+	return esv1alpha1.PushSecretData{
+		Match: esv1alpha1.PushSecretMatch{
+			SecretKey: secretKey,
+			RemoteRef: esv1alpha1.PushSecretRemoteRef{RemoteKey: remoteKey},
+		},
+	}
+}
+
+func TestPushSecretRotation(t *testing.T) {
+	c, fake := newTestClient(t)
+	ctx := context.Background()
+	first, second := newTestKey(t), newTestKey(t)
+	firstPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(first)})
+	der, err := pkcs8.MarshalPrivateKey(second, []byte("s3cret"), nil)
+	require.NoError(t, err)
+	secondPEM := pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED PRIVATE KEY", Bytes: der})
+
+	secret := &corev1.Secret{Data: map[string][]byte{keyPrivateKey: firstPEM}}
+	require.NoError(t, c.PushSecret(ctx, secret, pushData("", "svc_orders")))
+	assert.Equal(t, publicKeyOf(t, first), fake.users["SVC_ORDERS"].publicKey)
+	assert.Empty(t, fake.users["SVC_ORDERS"].publicKey2)
+
+	// an unchanged key is not set again
+	statements := len(fake.statements)
+	require.NoError(t, c.PushSecret(ctx, secret, pushData("", "svc_orders")))
+	assert.Len(t, fake.statements, statements+1)
+
+	// the previous key stays valid as second key
+	secret = &corev1.Secret{Data: map[string][]byte{"key": secondPEM, keyPassphrase: []byte("s3cret")}}
+	require.NoError(t, c.PushSecret(ctx, secret, pushData("key", "svc_orders")))
+	assert.Equal(t, publicKeyOf(t, second), fake.users["SVC_ORDERS"].publicKey)
+	assert.Equal(t, publicKeyOf(t, first), fake.users["SVC_ORDERS"].publicKey2)
+
+	got, err := c.GetSecretMap(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: "svc_orders"})
+	require.NoError(t, err)
+	assert.Equal(t, fingerprint(publicKeyOf(t, second)), string(got[keyFingerprint]))
+	assert.Equal(t, fingerprint(publicKeyOf(t, first)), string(got[keyFingerprint2]))
+
+	// names which are not plain identifiers are quoted
+	require.NoError(t, c.PushSecret(ctx, &corev1.Secret{Data: map[string][]byte{keyPrivateKey: firstPEM}}, pushData("", "svc.payments")))
+	assert.Equal(t, publicKeyOf(t, first), fake.users["svc.payments"].publicKey)
+
+	secret.Data[keyPassphrase] = []byte("wrong")
+	assert.ErrorContains(t, c.PushSecret(ctx, secret, pushData("key", "svc_orders")), "unable to parse private key")
+	assert.EqualError(t, c.PushSecret(ctx, secret, pushData("", "svc_orders")), `the Secret must contain a PEM encoded private key in "privateKey"`)
+}
+
+func TestSecretExistsAndDelete(t *testing.T) {
+	c, fake := newTestClient(t)
+	ctx := context.Background()
+	fake.users["SVC_ORDERS"].publicKey = publicKeyOf(t, newTestKey(t))
+	ref := esv1alpha1.PushSecretRemoteRef{RemoteKey: "svc_orders"}
+
+	exists, err := c.SecretExists(ctx, ref)
+	require.NoError(t, err)
+	assert.True(t, exists)
+	require.NoError(t, c.DeleteSecret(ctx, ref))
+	exists, err = c.SecretExists(ctx, ref)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// deleting the keys of a missing user succeeds
+	require.NoError(t, c.DeleteSecret(ctx, esv1alpha1.PushSecretRemoteRef{RemoteKey: "missing"}))
+}
+
+func TestValidate(t *testing.T) {
+	c, _ := newTestClient(t)
+	res, err := c.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+
+	c.key = newTestKey(t)
+	res, err = c.Validate()
+	assert.ErrorContains(t, err, "unexpected status code from Snowflake: 401")
+	assert.Equal(t, esv1beta1.ValidationResultError, res)
+}
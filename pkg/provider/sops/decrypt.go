@@ -0,0 +1,158 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sops
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/getsops/sops/v3"
+	"github.com/getsops/sops/v3/aes"
+	"github.com/getsops/sops/v3/keyservice"
+	sopsjson "github.com/getsops/sops/v3/stores/json"
+	sopsyaml "github.com/getsops/sops/v3/stores/yaml"
+	"google.golang.org/grpc"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	errParseFile        = "unable to parse SOPS file: %w"
+	errKMSKeyNotAllowed = "KMS key %s is not listed in kms.keyARNs of the SecretStore"
+	errDecryptDataKey   = "unable to decrypt the data key: %w"
+	errDecryptTree      = "unable to decrypt the file: %w"
+	errMACMismatch      = "MAC mismatch, the file has been modified after it was encrypted"
+	errNotAMap          = "the decrypted document must be a map: %w"
+)
+
+// decrypter decrypts SOPS files. The data key of KMS master keys is decrypted with
+// the credentials of the SecretStore, the other master keys, e.g. age or PGP, are
+// decrypted by the local key service of SOPS with the environment of the operator.
+type decrypter struct {
+	// kms returns the client for the region of a key.
+	kms     func(region string) kmsiface.KMSAPI
+	keyARNs []string
+}
+
+// decrypt returns the decrypted content of a SOPS file. Files ending in .json are
+// read as JSON, the others as YAML. The MAC of the file is verified.
+func (d *decrypter) decrypt(ctx context.Context, key string, content []byte) (map[string]any, error) {
+	var store sops.Store = &sopsyaml.Store{}
+	if strings.HasSuffix(key, ".json") {
+		store = &sopsjson.Store{}
+	}
+	tree, err := store.LoadEncryptedFile(content)
+	if err != nil {
+		return nil, fmt.Errorf(errParseFile, err)
+	}
+	svc := &keyService{ctx: ctx, decrypter: d, local: keyservice.NewLocalClient()}
+	dataKey, err := tree.Metadata.GetDataKeyWithKeyServices([]keyservice.KeyServiceClient{svc})
+	if err != nil {
+		// the error of SOPS only counts the key groups, the errors of the KMS calls explain why
+		return nil, fmt.Errorf(errDecryptDataKey, errors.Join(append([]error{err}, svc.errs...)...))
+	}
+	cipher := aes.NewCipher()
+	mac, err := tree.Decrypt(dataKey, cipher)
+	if err != nil {
+		return nil, fmt.Errorf(errDecryptTree, err)
+	}
+	originalMAC, err := cipher.Decrypt(tree.Metadata.MessageAuthenticationCode, dataKey, tree.Metadata.LastModified.Format(time.RFC3339))
+	if err != nil {
+		return nil, fmt.Errorf(errDecryptTree, err)
+	}
+	if originalMAC != mac {
+		return nil, errors.New(errMACMismatch)
+	}
+	plain, err := store.EmitPlainFile(tree.Branches)
+	if err != nil {
+		return nil, fmt.Errorf(errDecryptTree, err)
+	}
+	// JSON is valid YAML, both are decoded into the same types
+	var out map[string]any
+	if err := yaml.Unmarshal(plain, &out); err != nil {
+		return nil, fmt.Errorf(errNotAMap, err)
+	}
+	return out, nil
+}
+
+// keyService is a SOPS key service decrypting the data key of KMS master keys with
+// the KMS client of the store, roles and profiles in the metadata of the files are ignored.
+type keyService struct {
+	ctx       context.Context
+	decrypter *decrypter
+	local     keyservice.KeyServiceClient
+	errs      []error
+}
+
+func (s *keyService) Encrypt(ctx context.Context, req *keyservice.EncryptRequest, opts ...grpc.CallOption) (*keyservice.EncryptResponse, error) {
+	return s.local.Encrypt(ctx, req, opts...)
+}
+
+func (s *keyService) Decrypt(ctx context.Context, req *keyservice.DecryptRequest, opts ...grpc.CallOption) (*keyservice.DecryptResponse, error) {
+	key := req.GetKey().GetKmsKey()
+	if key == nil {
+		resp, err := s.local.Decrypt(ctx, req, opts...)
+		if err != nil {
+			s.errs = append(s.errs, err)
+		}
+		return resp, err
+	}
+	plaintext, err := s.decrypter.decryptDataKey(s.ctx, key, req.GetCiphertext())
+	if err != nil {
+		err = fmt.Errorf("%s: %w", key.GetArn(), err)
+		s.errs = append(s.errs, err)
+		return nil, err
+	}
+	return &keyservice.DecryptResponse{Plaintext: plaintext}, nil
+}
+
+// decryptDataKey decrypts the base64 encoded data key of a KMS master key in the region of the key.
+func (d *decrypter) decryptDataKey(ctx context.Context, key *keyservice.KmsKey, ciphertext []byte) ([]byte, error) {
+	if len(d.keyARNs) > 0 && !slices.Contains(d.keyARNs, key.GetArn()) {
+		return nil, fmt.Errorf(errKMSKeyNotAllowed, key.GetArn())
+	}
+	keyARN, err := arn.Parse(key.GetArn())
+	if err != nil {
+		return nil, err
+	}
+	blob, err := base64.StdEncoding.DecodeString(string(ciphertext))
+	if err != nil {
+		return nil, err
+	}
+	var encryptionContext map[string]*string
+	if len(key.GetContext()) > 0 {
+		encryptionContext = make(map[string]*string, len(key.GetContext()))
+		for k, v := range key.GetContext() {
+			encryptionContext[k] = aws.String(v)
+		}
+	}
+	out, err := d.kms(keyARN.Region).DecryptWithContext(ctx, &kms.DecryptInput{
+		CiphertextBlob:    blob,
+		EncryptionContext: encryptionContext,
+		KeyId:             aws.String(key.GetArn()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.Plaintext, nil
+}
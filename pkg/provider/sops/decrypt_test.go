@@ -0,0 +1,271 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sops
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"filippo.io/age"
+	"github.com/ProtonMail/gopenpgp/v2/crypto"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/getsops/sops/v3"
+	"github.com/getsops/sops/v3/aes"
+	sopsage "github.com/getsops/sops/v3/age"
+	"github.com/getsops/sops/v3/keys"
+	sopskms "github.com/getsops/sops/v3/kms"
+	"github.com/getsops/sops/v3/pgp"
+	sopsjson "github.com/getsops/sops/v3/stores/json"
+	sopsyaml "github.com/getsops/sops/v3/stores/yaml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testKeyARN = "arn:aws:kms:eu-west-1:111122223333:key/1234abcd-12ab-34cd-56ef-1234567890ab"
+
+var testDataKey = []byte("0123456789abcdef0123456789abcdef")
+
+type fakeKMS struct {
+	kmsiface.KMSAPI
+	calls int
+	err   error
+}
+
+func (f *fakeKMS) DecryptWithContext(_ aws.Context, in *kms.DecryptInput, _ ...request.Option) (*kms.DecryptOutput, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	if string(in.CiphertextBlob) != "encrypted-data-key" || aws.StringValue(in.EncryptionContext["app"]) != "test" {
+		return nil, fmt.Errorf("invalid ciphertext")
+	}
+	return &kms.DecryptOutput{Plaintext: testDataKey}, nil
+}
+
+const testYAML = `database:
+    user: admin
+    password: s3cr3t
+    port: 5432
+api_key: abc
+hosts:
+    - a.example.com
+    - b.example.com
+region_unencrypted: eu-west-1
+`
+
+const testJSON = `{
+	"database": {"user": "admin", "password": "s3cr3t", "port": 5432},
+	"api_key": "abc",
+	"hosts": ["a.example.com", "b.example.com"],
+	"enabled_unencrypted": true
+}`
+
+// testKMSKey is a KMS master key whose data key is decrypted by fakeKMS.
+func testKMSKey() *sopskms.MasterKey {
+	return &sopskms.MasterKey{
+		Arn:               testKeyARN,
+		EncryptedKey:      base64.StdEncoding.EncodeToString([]byte("encrypted-data-key")),
+		EncryptionContext: map[string]*string{"app": aws.String("test")},
+	}
+}
+
+// encryptFile encrypts plain with the test data key like sops --encrypt does, the
+// master keys must already hold the encrypted data key.
+func encryptFile(t *testing.T, store sops.Store, plain string, masterKeys ...keys.MasterKey) string {
+	t.Helper()
+	branches, err := store.LoadPlainFile([]byte(plain))
+	require.NoError(t, err)
+	tree := sops.Tree{
+		Branches: branches,
+		Metadata: sops.Metadata{
+			KeyGroups:         []sops.KeyGroup{masterKeys},
+			UnencryptedSuffix: "_unencrypted",
+			Version:           "3.8.1",
+		},
+	}
+	cipher := aes.NewCipher()
+	mac, err := tree.Encrypt(testDataKey, cipher)
+	require.NoError(t, err)
+	tree.Metadata.LastModified = time.Now().UTC()
+	tree.Metadata.MessageAuthenticationCode, err = cipher.Encrypt(mac, testDataKey, tree.Metadata.LastModified.Format(time.RFC3339))
+	require.NoError(t, err)
+	out, err := store.EmitEncryptedFile(tree)
+	require.NoError(t, err)
+	return string(out)
+}
+
+func testYAMLFile(t *testing.T) string {
+	return encryptFile(t, &sopsyaml.Store{}, testYAML, testKMSKey())
+}
+
+// testAgeFile returns a file encrypted with a new age key, the key is set in
+// SOPS_AGE_KEY like it would be in the environment of the operator.
+func testAgeFile(t *testing.T) string {
+	t.Helper()
+	identity, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+	t.Setenv("SOPS_AGE_KEY", identity.String())
+	key, err := sopsage.MasterKeyFromRecipient(identity.Recipient().String())
+	require.NoError(t, err)
+	require.NoError(t, key.Encrypt(testDataKey))
+	return encryptFile(t, &sopsyaml.Store{}, testYAML, key)
+}
+
+// testPGPFile returns a file encrypted with a new PGP key, the keyrings are written
+// to GNUPGHOME like they would be in the environment of the operator.
+func testPGPFile(t *testing.T) string {
+	t.Helper()
+	privateKey, err := crypto.GenerateKey("test", "test@example.com", "x25519", 0)
+	require.NoError(t, err)
+	secring, err := privateKey.Serialize()
+	require.NoError(t, err)
+	pubring, err := privateKey.GetPublicKey()
+	require.NoError(t, err)
+	home := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(home, "secring.gpg"), secring, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "pubring.gpg"), pubring, 0o600))
+	t.Setenv("GNUPGHOME", home)
+	key := pgp.NewMasterKeyFromFingerprint(strings.ToUpper(privateKey.GetFingerprint()))
+	require.NoError(t, key.Encrypt(testDataKey))
+	return encryptFile(t, &sopsyaml.Store{}, testYAML, key)
+}
+
+func testDecrypter(k *fakeKMS, keyARNs ...string) *decrypter {
+	return &decrypter{
+		kms: func(region string) kmsiface.KMSAPI {
+			if region != "eu-west-1" {
+				panic("unexpected region " + region)
+			}
+			return k
+		},
+		keyARNs: keyARNs,
+	}
+}
+
+func TestDecrypt(t *testing.T) {
+	yamlWant := map[string]any{
+		"database":           map[string]any{"user": "admin", "password": "s3cr3t", "port": 5432},
+		"api_key":            "abc",
+		"hosts":              []any{"a.example.com", "b.example.com"},
+		"region_unencrypted": "eu-west-1",
+	}
+	tests := map[string]struct {
+		key     string
+		content func(t *testing.T) string
+		want    map[string]any
+	}{
+		"yaml with kms": {
+			key:     "web.yaml",
+			content: testYAMLFile,
+			want:    yamlWant,
+		},
+		"json with kms": {
+			key: "web.json",
+			content: func(t *testing.T) string {
+				return encryptFile(t, &sopsjson.Store{}, testJSON, testKMSKey())
+			},
+			want: map[string]any{
+				"database":            map[string]any{"user": "admin", "password": "s3cr3t", "port": 5432},
+				"api_key":             "abc",
+				"hosts":               []any{"a.example.com", "b.example.com"},
+				"enabled_unencrypted": true,
+			},
+		},
+		"yaml with age": {
+			key:     "web.yaml",
+			content: testAgeFile,
+			want:    yamlWant,
+		},
+		"yaml with pgp": {
+			key:     "web.yaml",
+			content: testPGPFile,
+			want:    yamlWant,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := testDecrypter(&fakeKMS{}).decrypt(context.Background(), tc.key, []byte(tc.content(t)))
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestDecryptUnencryptedSuffix(t *testing.T) {
+	content := testYAMLFile(t)
+	assert.Contains(t, content, "region_unencrypted: eu-west-1\n")
+	assert.NotContains(t, content, "s3cr3t")
+}
+
+func TestDecryptErrors(t *testing.T) {
+	tests := map[string]struct {
+		content string
+		kms     *fakeKMS
+		keyARNs []string
+		wantErr string
+	}{
+		"modified unencrypted value": {
+			content: strings.Replace(testYAMLFile(t), "eu-west-1\n", "us-east-1\n", 1),
+			kms:     &fakeKMS{},
+			wantErr: errMACMismatch,
+		},
+		"kms key not allowed": {
+			content: testYAMLFile(t),
+			kms:     &fakeKMS{},
+			keyARNs: []string{"arn:aws:kms:eu-west-1:111122223333:key/other"},
+			wantErr: "is not listed in kms.keyARNs",
+		},
+		"kms error": {
+			content: testYAMLFile(t),
+			kms:     &fakeKMS{err: fmt.Errorf("AccessDeniedException")},
+			wantErr: "AccessDeniedException",
+		},
+		"not a sops file": {
+			content: "key: value\n",
+			kms:     &fakeKMS{},
+			wantErr: sops.MetadataNotFound.Error(),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := testDecrypter(tc.kms, tc.keyARNs...).decrypt(context.Background(), "web.yaml", []byte(tc.content))
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestDecryptAgeWithoutIdentity(t *testing.T) {
+	content := testAgeFile(t)
+	t.Setenv("SOPS_AGE_KEY", "")
+	t.Setenv("SOPS_AGE_KEY_FILE", t.TempDir()+"/keys.txt")
+	_, err := testDecrypter(&fakeKMS{}).decrypt(context.Background(), "web.yaml", []byte(content))
+	assert.ErrorContains(t, err, "unable to decrypt the data key")
+}
+
+func TestDecryptPGPWithoutKey(t *testing.T) {
+	content := testPGPFile(t)
+	t.Setenv("GNUPGHOME", t.TempDir())
+	_, err := testDecrypter(&fakeKMS{}).decrypt(context.Background(), "web.yaml", []byte(content))
+	assert.ErrorContains(t, err, "unable to decrypt the data key")
+}
@@ -0,0 +1,147 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/aws/aws-sdk-go/service/s3"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/cache"
+	awsauth "github.com/external-secrets/external-secrets/pkg/provider/aws/auth"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	errStoreIsNil                  = "store is nil"
+	errNoStoreTypeOrWrongStoreType = "no store type or wrong store type"
+	errBucketRequired              = "s3.bucket is required"
+	errRegionRequired              = "s3.region is required"
+	errInvalidKeyARN               = "invalid kms.keyARNs entry %q: %w"
+	errUnableCreateSession         = "unable to create session: %w"
+)
+
+// Provider satisfies the provider interface.
+type Provider struct{}
+
+var _ esv1beta1.Provider = &Provider{}
+
+func (p *Provider) NewClient(ctx context.Context, store esv1beta1.GenericStore, kube kclient.Client, namespace string) (esv1beta1.SecretsClient, error) {
+	cfg, err := getConfig(store)
+	if err != nil {
+		return nil, err
+	}
+	sess, err := awsauth.NewStoreSession(ctx, cfg.S3.Auth, cfg.S3.Role, cfg.S3.Region, store.GetKind(), kube, namespace, awsauth.DefaultSTSProvider, awsauth.DefaultJWTProvider)
+	if err != nil {
+		return nil, fmt.Errorf(errUnableCreateSession, err)
+	}
+	kmsSess := sess
+	if cfg.KMS.Role != "" {
+		kmsSess = sess.Copy()
+		kmsSess.Config.WithCredentials(stscreds.NewCredentials(sess, cfg.KMS.Role))
+	}
+	return &client{
+		s3:     s3.New(sess),
+		bucket: cfg.S3.Bucket,
+		prefix: cfg.S3.Prefix,
+		decrypter: &decrypter{
+			kms:     kmsClientFactory(kmsSess),
+			keyARNs: cfg.KMS.KeyARNs,
+		},
+		cacheKey: cache.Key{
+			Name:      store.GetName(),
+			Namespace: store.GetNamespace(),
+			Kind:      store.GetKind(),
+		},
+		storeVersion: store.GetObjectMeta().ResourceVersion,
+	}, nil
+}
+
+// kmsClientFactory returns a function creating KMS clients in the region of a master key.
+func kmsClientFactory(sess *session.Session) func(region string) kmsiface.KMSAPI {
+	return func(region string) kmsiface.KMSAPI {
+		return kms.New(sess, aws.NewConfig().WithRegion(region))
+	}
+}
+
+func getConfig(store esv1beta1.GenericStore) (*esv1beta1.SOPSProvider, error) {
+	if store == nil {
+		return nil, errors.New(errStoreIsNil)
+	}
+	spec := store.GetSpec()
+	if spec == nil || spec.Provider == nil || spec.Provider.SOPS == nil {
+		return nil, errors.New(errNoStoreTypeOrWrongStoreType)
+	}
+	cfg := spec.Provider.SOPS
+	if cfg.S3.Bucket == "" {
+		return nil, errors.New(errBucketRequired)
+	}
+	if cfg.S3.Region == "" {
+		return nil, errors.New(errRegionRequired)
+	}
+	for _, keyARN := range cfg.KMS.KeyARNs {
+		if _, err := arn.Parse(keyARN); err != nil {
+			return nil, fmt.Errorf(errInvalidKeyARN, keyARN, err)
+		}
+	}
+	return cfg, nil
+}
+
+func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnings, error) {
+	cfg, err := getConfig(store)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.S3.Auth.SecretRef != nil {
+		if err := utils.ValidateReferentSecretSelector(store, cfg.S3.Auth.SecretRef.AccessKeyID); err != nil {
+			return nil, fmt.Errorf("invalid s3.auth.secretRef.accessKeyIDSecretRef: %w", err)
+		}
+		if err := utils.ValidateReferentSecretSelector(store, cfg.S3.Auth.SecretRef.SecretAccessKey); err != nil {
+			return nil, fmt.Errorf("invalid s3.auth.secretRef.secretAccessKeySecretRef: %w", err)
+		}
+		if cfg.S3.Auth.SecretRef.SessionToken != nil {
+			if err := utils.ValidateReferentSecretSelector(store, *cfg.S3.Auth.SecretRef.SessionToken); err != nil {
+				return nil, fmt.Errorf("invalid s3.auth.secretRef.sessionTokenSecretRef: %w", err)
+			}
+		}
+	}
+	if cfg.S3.Auth.JWTAuth != nil && cfg.S3.Auth.JWTAuth.ServiceAccountRef != nil {
+		if err := utils.ValidateReferentServiceAccountSelector(store, *cfg.S3.Auth.JWTAuth.ServiceAccountRef); err != nil {
+			return nil, fmt.Errorf("invalid s3.auth.jwt.serviceAccountRef: %w", err)
+		}
+	}
+	return nil, nil
+}
+
+func (p *Provider) Capabilities() esv1beta1.SecretStoreCapabilities {
+	return esv1beta1.SecretStoreReadOnly
+}
+
+func init() {
+	esv1beta1.Register(&Provider{}, &esv1beta1.SecretStoreProvider{
+		SOPS: &esv1beta1.SOPSProvider{},
+	})
+}
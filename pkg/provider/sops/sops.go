@@ -0,0 +1,190 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sops
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/tidwall/gjson"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/cache"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	errReadOnly         = "the SOPS provider is read only"
+	errFindUnsupported  = "find is not supported by the SOPS provider"
+	errGetObject        = "unable to download s3://%s/%s: %w"
+	errDecryptFile      = "unable to decrypt s3://%s/%s: %w"
+	errPropertyNotFound = "property %q not found in s3://%s/%s"
+)
+
+var log = ctrl.Log.WithName("provider").WithName("sops")
+
+// files caches the decrypted files by store and object key, they are only downloaded
+// and decrypted again when their ETag changed. The store is part of the key so that
+// a store without access to the KMS keys can not read files decrypted by another one.
+var files = cache.Must[cachedFile](1024, nil)
+
+type cachedFile struct {
+	etag string
+	data map[string]any
+}
+
+type client struct {
+	s3        s3iface.S3API
+	decrypter *decrypter
+	bucket    string
+	prefix    string
+
+	// cacheKey and storeVersion identify the store in the files cache.
+	cacheKey     cache.Key
+	storeVersion string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+// GetSecret returns the decrypted file as JSON, or a single value of it if property is set.
+// property is a gjson path, e.g. "database.password".
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	data, err := c.file(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := utils.JSONMarshal(data)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Property == "" {
+		return doc, nil
+	}
+	result := gjson.GetBytes(doc, ref.Property)
+	if !result.Exists() {
+		return nil, fmt.Errorf(errPropertyNotFound, ref.Property, c.bucket, c.key(ref.Key))
+	}
+	if result.Type == gjson.String {
+		return []byte(result.Str), nil
+	}
+	return []byte(result.Raw), nil
+}
+
+// GetSecretMap returns the top level keys of the decrypted file, nested values are returned as JSON.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	data, err := c.file(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	secretData := make(map[string][]byte, len(data))
+	for k, v := range data {
+		if s, ok := v.(string); ok {
+			secretData[k] = []byte(s)
+			continue
+		}
+		b, err := utils.JSONMarshal(v)
+		if err != nil {
+			return nil, err
+		}
+		secretData[k] = b
+	}
+	return secretData, nil
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindUnsupported)
+}
+
+func (c *client) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
+	return false, errors.New(errReadOnly)
+}
+
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	_, err := c.s3.HeadBucket(&s3.HeadBucketInput{Bucket: aws.String(c.bucket)})
+	if err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+func (c *client) key(key string) string {
+	return c.prefix + key
+}
+
+// file returns the decrypted content of the object key, the object is only
+// downloaded and decrypted again when it changed since the last call.
+func (c *client) file(ctx context.Context, key string) (map[string]any, error) {
+	objectKey := c.key(key)
+	cacheKey := c.cacheKey
+	cacheKey.Name += "/" + objectKey
+	cached, hasCached := files.Get(c.storeVersion, cacheKey)
+
+	input := &s3.GetObjectInput{
+		Bucket: aws.String(c.bucket),
+		Key:    aws.String(objectKey),
+	}
+	if hasCached {
+		input.IfNoneMatch = aws.String(cached.etag)
+	}
+	out, err := c.s3.GetObjectWithContext(ctx, input)
+	var reqErr awserr.RequestFailure
+	if hasCached && errors.As(err, &reqErr) && reqErr.StatusCode() == http.StatusNotModified {
+		return cached.data, nil
+	}
+	if errors.As(err, &reqErr) && reqErr.StatusCode() == http.StatusNotFound {
+		return nil, esv1beta1.NoSecretError{}
+	}
+	if err != nil {
+		return nil, fmt.Errorf(errGetObject, c.bucket, objectKey, err)
+	}
+	defer out.Body.Close()
+	content, err := io.ReadAll(out.Body)
+	if err != nil {
+		return nil, fmt.Errorf(errGetObject, c.bucket, objectKey, err)
+	}
+	data, err := c.decrypter.decrypt(ctx, objectKey, content)
+	if err != nil {
+		return nil, fmt.Errorf(errDecryptFile, c.bucket, objectKey, err)
+	}
+	etag := aws.StringValue(out.ETag)
+	if etag != "" {
+		if hasCached {
+			log.V(1).Info("file changed", "bucket", c.bucket, "key", objectKey, "etag", etag)
+		}
+		files.Add(c.storeVersion, cacheKey, cachedFile{etag: etag, data: data})
+	}
+	return data, nil
+}
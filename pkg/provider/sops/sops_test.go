@@ -0,0 +1,156 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sops
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/cache"
+)
+
+type fakeS3 struct {
+	s3iface.S3API
+	objects map[string]string
+	etags   map[string]string
+	gets    int
+}
+
+func (f *fakeS3) GetObjectWithContext(_ aws.Context, in *s3.GetObjectInput, _ ...request.Option) (*s3.GetObjectOutput, error) {
+	f.gets++
+	key := aws.StringValue(in.Key)
+	content, ok := f.objects[key]
+	if !ok {
+		return nil, awserr.NewRequestFailure(awserr.New(s3.ErrCodeNoSuchKey, "not found", nil), http.StatusNotFound, "")
+	}
+	if aws.StringValue(in.IfNoneMatch) == f.etags[key] {
+		return nil, awserr.NewRequestFailure(awserr.New("NotModified", "not modified", nil), http.StatusNotModified, "")
+	}
+	return &s3.GetObjectOutput{
+		Body: io.NopCloser(strings.NewReader(content)),
+		ETag: aws.String(f.etags[key]),
+	}, nil
+}
+
+func newTestClient(t *testing.T) (*client, *fakeS3, *fakeKMS) {
+	t.Helper()
+	s := &fakeS3{
+		objects: map[string]string{"apps/web.yaml": testYAMLFile(t)},
+		etags:   map[string]string{"apps/web.yaml": `"etag-1"`},
+	}
+	k := &fakeKMS{}
+	return &client{
+		s3:        s,
+		decrypter: testDecrypter(k),
+		bucket:    "gitops",
+		prefix:    "apps/",
+		cacheKey:  cache.Key{Name: t.Name(), Namespace: "default", Kind: esv1beta1.SecretStoreKind},
+	}, s, k
+}
+
+func TestGetSecret(t *testing.T) {
+	c, _, _ := newTestClient(t)
+	ctx := context.Background()
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"property": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "web.yaml", Property: "database.password"},
+			want: "s3cr3t",
+		},
+		"number property": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "web.yaml", Property: "database.port"},
+			want: "5432",
+		},
+		"object property": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "web.yaml", Property: "hosts"},
+			want: `["a.example.com","b.example.com"]`,
+		},
+		"missing property": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "web.yaml", Property: "database.host"},
+			wantErr: `property "database.host" not found in s3://gitops/apps/web.yaml`,
+		},
+		"missing file": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "db.yaml"},
+			wantErr: esv1beta1.NoSecretError{}.Error(),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(ctx, tc.ref)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestGetSecretMap(t *testing.T) {
+	c, _, _ := newTestClient(t)
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "web.yaml"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"database":           []byte(`{"password":"s3cr3t","port":5432,"user":"admin"}`),
+		"api_key":            []byte("abc"),
+		"hosts":              []byte(`["a.example.com","b.example.com"]`),
+		"region_unencrypted": []byte("eu-west-1"),
+	}, got)
+}
+
+func TestFileCache(t *testing.T) {
+	c, s, k := newTestClient(t)
+	ctx := context.Background()
+	ref := esv1beta1.ExternalSecretDataRemoteRef{Key: "web.yaml", Property: "api_key"}
+
+	for range 2 {
+		got, err := c.GetSecret(ctx, ref)
+		require.NoError(t, err)
+		assert.Equal(t, "abc", string(got))
+	}
+	// the unchanged file is not decrypted again
+	assert.Equal(t, 2, s.gets)
+	assert.Equal(t, 1, k.calls)
+
+	// a new ETag invalidates the cache
+	s.etags["apps/web.yaml"] = `"etag-2"`
+	_, err := c.GetSecret(ctx, ref)
+	require.NoError(t, err)
+	assert.Equal(t, 2, k.calls)
+
+	// another store does not share the cached file
+	other, _, otherKMS := newTestClient(t)
+	other.s3 = s
+	other.cacheKey.Name = "other"
+	_, err = other.GetSecret(ctx, ref)
+	require.NoError(t, err)
+	assert.Equal(t, 1, otherKMS.calls)
+}
@@ -0,0 +1,234 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package splunkkv
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	errUnexpectedStatus  = "unexpected status code from Splunk: %d: %s"
+	errUnmarshalResponse = "unable to unmarshal Splunk response: %w"
+	errLogin             = "unable to log in to Splunk: %w"
+	errReadOnly          = "the Splunk KV Store provider is read only"
+	errFindUnsupported   = "find is not supported by the Splunk KV Store provider"
+	errInvalidKey        = "key %q must have the format <collection>/<record id>"
+	errFieldNotFound     = "record %q has no field %q"
+)
+
+// client reads records of KV Store collections with the Splunk REST API.
+// https://docs.splunk.com/Documentation/Splunk/latest/RESTREF/RESTkvstore
+type client struct {
+	httpClient *http.Client
+	url        string
+	app        string
+	owner      string
+	username   string
+	password   string
+	// sessionKey is obtained with the first request and renewed once it expires.
+	sessionKey string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+// GetSecret returns the field of the record named by the key,
+// or all of its fields as JSON if no property is given.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	record, err := c.record(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Property == "" {
+		return utils.JSONMarshal(recordFields(record))
+	}
+	v, ok := record[ref.Property]
+	if !ok {
+		return nil, fmt.Errorf(errFieldNotFound, ref.Key, ref.Property)
+	}
+	return utils.GetByteValue(v)
+}
+
+// GetSecretMap returns the fields of the record named by the key.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	record, err := c.record(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	fields := recordFields(record)
+	secretMap := make(map[string][]byte, len(fields))
+	for k, v := range fields {
+		b, err := utils.GetByteValue(v)
+		if err != nil {
+			return nil, err
+		}
+		secretMap[k] = b
+	}
+	return secretMap, nil
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindUnsupported)
+}
+
+func (c *client) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
+	return false, errors.New(errReadOnly)
+}
+
+// Validate opens a session with the credentials of the store.
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	if err := c.login(context.Background()); err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+// parseKey splits a key into the collection and the record ID.
+func parseKey(key string) (string, string, error) {
+	collection, id, ok := strings.Cut(key, "/")
+	if !ok || collection == "" || id == "" {
+		return "", "", fmt.Errorf(errInvalidKey, key)
+	}
+	return collection, id, nil
+}
+
+func (c *client) record(ctx context.Context, key string) (map[string]any, error) {
+	collection, id, err := parseKey(key)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("/servicesNS/%s/%s/storage/collections/data/%s/%s",
+		url.PathEscape(c.owner), url.PathEscape(c.app), url.PathEscape(collection), url.PathEscape(id))
+	var record map[string]any
+	if err := c.get(ctx, path, &record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// get sends an authenticated request, opening a new session once the current one has expired.
+func (c *client) get(ctx context.Context, path string, target any) error {
+	if c.sessionKey == "" {
+		if err := c.login(ctx); err != nil {
+			return err
+		}
+	}
+	resp, err := c.do(ctx, path)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		resp.Body.Close()
+		if err := c.login(ctx); err != nil {
+			return err
+		}
+		if resp, err = c.do(ctx, path); err != nil {
+			return err
+		}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return esv1beta1.NoSecretError{}
+	}
+	return decode(resp, target)
+}
+
+func (c *client) do(ctx context.Context, path string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(c.url, "/")+path+"?output_mode=json", http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Splunk "+c.sessionKey)
+	req.Header.Set("Accept", "application/json")
+	return c.httpClient.Do(req)
+}
+
+// login opens a session and stores its key.
+func (c *client) login(ctx context.Context) error {
+	form := url.Values{
+		"username":    []string{c.username},
+		"password":    []string{c.password},
+		"output_mode": []string{"json"},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(c.url, "/")+"/services/auth/login", strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf(errLogin, err)
+	}
+	defer resp.Body.Close()
+
+	var session struct {
+		SessionKey string `json:"sessionKey"`
+	}
+	if err := decode(resp, &session); err != nil {
+		return fmt.Errorf(errLogin, err)
+	}
+	c.sessionKey = session.SessionKey
+	return nil
+}
+
+func decode(resp *http.Response, target any) error {
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf(errUnexpectedStatus, resp.StatusCode, body)
+	}
+	if err := json.Unmarshal(body, target); err != nil {
+		return fmt.Errorf(errUnmarshalResponse, err)
+	}
+	return nil
+}
+
+// recordFields returns the fields of a record without the _key and _user fields added by Splunk.
+func recordFields(record map[string]any) map[string]any {
+	fields := make(map[string]any, len(record))
+	for k, v := range record {
+		if k == "_key" || k == "_user" {
+			continue
+		}
+		fields[k] = v
+	}
+	return fields
+}
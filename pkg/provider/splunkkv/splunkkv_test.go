@@ -0,0 +1,191 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package splunkkv
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	testUsername = "esoreader"
+	testPassword = "changeme"
+)
+
+type fakeSplunk struct {
+	*httptest.Server
+	sessions int
+	// session is the only valid session key
+	session string
+}
+
+// newFakeSplunk serves the login endpoint and the records of the db_credentials
+// collection shared in the search app.
+func newFakeSplunk(t *testing.T) *fakeSplunk {
+	t.Helper()
+	records := map[string]map[string]any{
+		"payments": {
+			"_key":     "payments",
+			"_user":    "nobody",
+			"host":     "db.example.com",
+			"port":     5432,
+			"username": "payments",
+			"password": "s3cr3t",
+			"options":  map[string]any{"sslmode": "require"},
+		},
+	}
+	f := &fakeSplunk{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /services/auth/login", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("username") != testUsername || r.FormValue("password") != testPassword {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"messages":[{"type":"WARN","code":"incorrect_username_or_password","text":"Login failed"}]}`))
+			return
+		}
+		f.sessions++
+		f.session = fmt.Sprintf("session-%d", f.sessions)
+		_, _ = w.Write([]byte(`{"sessionKey":"` + f.session + `"}`))
+	})
+	mux.HandleFunc("GET /servicesNS/nobody/search/storage/collections/data/{collection}/{id}", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Splunk "+f.session {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"messages":[{"type":"WARN","text":"call not properly authenticated"}]}`))
+			return
+		}
+		record, ok := records[r.PathValue("id")]
+		if r.PathValue("collection") != "db_credentials" || !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"messages":[{"type":"ERROR","text":"Could not find object."}]}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(record)
+	})
+	f.Server = httptest.NewServer(mux)
+	t.Cleanup(f.Close)
+	return f
+}
+
+func newTestClient(f *fakeSplunk) *client {
+	return &client{
+		httpClient: f.Client(),
+		url:        f.URL,
+		app:        defaultApp,
+		owner:      defaultOwner,
+		username:   testUsername,
+		password:   testPassword,
+	}
+}
+
+func TestGetSecret(t *testing.T) {
+	c := newTestClient(newFakeSplunk(t))
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"field": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "db_credentials/payments", Property: "password"},
+			want: "s3cr3t",
+		},
+		"number field": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "db_credentials/payments", Property: "port"},
+			want: "5432",
+		},
+		"object field": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "db_credentials/payments", Property: "options"},
+			want: `{"sslmode":"require"}`,
+		},
+		"whole record": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "db_credentials/payments"},
+			want: `{"host":"db.example.com","options":{"sslmode":"require"},"password":"s3cr3t","port":5432,"username":"payments"}`,
+		},
+		"missing field": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "db_credentials/payments", Property: "token"},
+			wantErr: `record "db_credentials/payments" has no field "token"`,
+		},
+		"missing record": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "db_credentials/billing"},
+			wantErr: esv1beta1.NoSecretError{}.Error(),
+		},
+		"key without record": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "db_credentials"},
+			wantErr: `key "db_credentials" must have the format <collection>/<record id>`,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestGetSecretMap(t *testing.T) {
+	c := newTestClient(newFakeSplunk(t))
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "db_credentials/payments"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"host":     []byte("db.example.com"),
+		"port":     []byte("5432"),
+		"username": []byte("payments"),
+		"password": []byte("s3cr3t"),
+		"options":  []byte(`{"sslmode":"require"}`),
+	}, got)
+}
+
+func TestExpiredSessionIsRenewed(t *testing.T) {
+	f := newFakeSplunk(t)
+	c := newTestClient(f)
+	ref := esv1beta1.ExternalSecretDataRemoteRef{Key: "db_credentials/payments", Property: "username"}
+	_, err := c.GetSecret(context.Background(), ref)
+	require.NoError(t, err)
+	_, err = c.GetSecret(context.Background(), ref)
+	require.NoError(t, err)
+	assert.Equal(t, 1, f.sessions, "the session is reused")
+
+	// the session expires
+	f.session = "expired"
+	got, err := c.GetSecret(context.Background(), ref)
+	require.NoError(t, err)
+	assert.Equal(t, "payments", string(got))
+	assert.Equal(t, 2, f.sessions)
+}
+
+func TestValidate(t *testing.T) {
+	f := newFakeSplunk(t)
+	result, err := newTestClient(f).Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, result)
+
+	c := newTestClient(f)
+	c.password = "wrong"
+	result, err = c.Validate()
+	assert.ErrorContains(t, err, "unable to log in to Splunk: unexpected status code from Splunk: 401")
+	assert.Equal(t, esv1beta1.ValidationResultError, result)
+}
@@ -0,0 +1,175 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package springconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+const (
+	defaultApplication = "application"
+	defaultProfile     = "default"
+
+	errStoreIsNil                  = "store is nil"
+	errNoStoreTypeOrWrongStoreType = "no store type or wrong store type"
+	errURLRequired                 = "url is required"
+	errInvalidURL                  = "invalid url %q: %w"
+	errInvalidTokenURL             = "invalid oauth2.tokenURL %q: %w"
+	errMultipleAuth                = "only one of auth.basic and auth.oauth2 may be set"
+	errInvalidCABundle             = "failed to parse caBundle"
+	errCannotResolveSecretKeyRef   = "cannot resolve secret key ref: %w"
+)
+
+// Provider satisfies the provider interface.
+type Provider struct{}
+
+var _ esv1beta1.Provider = &Provider{}
+
+func (p *Provider) NewClient(ctx context.Context, store esv1beta1.GenericStore, kube kclient.Client, namespace string) (esv1beta1.SecretsClient, error) {
+	cfg, err := getConfig(store)
+	if err != nil {
+		return nil, err
+	}
+	storeKind := store.GetKind()
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(cfg.CABundle) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(cfg.CABundle) {
+			return nil, errors.New(errInvalidCABundle)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	httpClient := &http.Client{
+		Timeout:   30 * time.Second,
+		Transport: &http.Transport{TLSClientConfig: tlsConfig},
+	}
+
+	c := &client{
+		httpClient:  httpClient,
+		url:         cfg.URL,
+		application: cfg.Application,
+		profile:     cfg.Profile,
+		label:       cfg.Label,
+	}
+	if c.application == "" {
+		c.application = defaultApplication
+	}
+	if c.profile == "" {
+		c.profile = defaultProfile
+	}
+	if basic := cfg.Auth.Basic; basic != nil {
+		c.username, err = resolvers.SecretKeyRef(ctx, kube, storeKind, namespace, &basic.Username)
+		if err != nil {
+			return nil, fmt.Errorf(errCannotResolveSecretKeyRef, err)
+		}
+		c.password, err = resolvers.SecretKeyRef(ctx, kube, storeKind, namespace, &basic.Password)
+		if err != nil {
+			return nil, fmt.Errorf(errCannotResolveSecretKeyRef, err)
+		}
+	}
+	if o := cfg.Auth.OAuth2; o != nil {
+		clientID, err := resolvers.SecretKeyRef(ctx, kube, storeKind, namespace, &o.ClientID)
+		if err != nil {
+			return nil, fmt.Errorf(errCannotResolveSecretKeyRef, err)
+		}
+		clientSecret, err := resolvers.SecretKeyRef(ctx, kube, storeKind, namespace, &o.ClientSecret)
+		if err != nil {
+			return nil, fmt.Errorf(errCannotResolveSecretKeyRef, err)
+		}
+		cc := &clientcredentials.Config{
+			ClientID:     clientID,
+			ClientSecret: clientSecret,
+			TokenURL:     o.TokenURL,
+			Scopes:       o.Scopes,
+		}
+		// the token is requested lazily, it must not be bound to the context of this call
+		tokenCtx := context.WithValue(context.Background(), oauth2.HTTPClient, httpClient)
+		c.httpClient = cc.Client(tokenCtx)
+		c.httpClient.Timeout = httpClient.Timeout
+	}
+	return c, nil
+}
+
+func getConfig(store esv1beta1.GenericStore) (*esv1beta1.SpringConfigProvider, error) {
+	if store == nil {
+		return nil, errors.New(errStoreIsNil)
+	}
+	spec := store.GetSpec()
+	if spec == nil || spec.Provider == nil || spec.Provider.SpringConfig == nil {
+		return nil, errors.New(errNoStoreTypeOrWrongStoreType)
+	}
+	cfg := spec.Provider.SpringConfig
+	if cfg.URL == "" {
+		return nil, errors.New(errURLRequired)
+	}
+	if _, err := url.ParseRequestURI(cfg.URL); err != nil {
+		return nil, fmt.Errorf(errInvalidURL, cfg.URL, err)
+	}
+	if cfg.Auth.Basic != nil && cfg.Auth.OAuth2 != nil {
+		return nil, errors.New(errMultipleAuth)
+	}
+	if ref := cfg.Auth.Basic; ref != nil {
+		if err := utils.ValidateReferentSecretSelector(store, ref.Username); err != nil {
+			return nil, err
+		}
+		if err := utils.ValidateReferentSecretSelector(store, ref.Password); err != nil {
+			return nil, err
+		}
+	}
+	if ref := cfg.Auth.OAuth2; ref != nil {
+		if _, err := url.ParseRequestURI(ref.TokenURL); err != nil {
+			return nil, fmt.Errorf(errInvalidTokenURL, ref.TokenURL, err)
+		}
+		if err := utils.ValidateReferentSecretSelector(store, ref.ClientID); err != nil {
+			return nil, err
+		}
+		if err := utils.ValidateReferentSecretSelector(store, ref.ClientSecret); err != nil {
+			return nil, err
+		}
+	}
+	return cfg, nil
+}
+
+func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnings, error) {
+	_, err := getConfig(store)
+	return nil, err
+}
+
+func (p *Provider) Capabilities() esv1beta1.SecretStoreCapabilities {
+	return esv1beta1.SecretStoreReadOnly
+}
+
+func init() {
+	esv1beta1.Register(&Provider{}, &esv1beta1.SecretStoreProvider{
+		SpringConfig: &esv1beta1.SpringConfigProvider{},
+	})
+}
@@ -0,0 +1,106 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package springconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+func TestValidateStore(t *testing.T) {
+	otherNamespace := "other"
+	basic := &esv1beta1.SpringConfigBasicAuth{
+		Username: esmeta.SecretKeySelector{Name: "config", Key: "username"},
+		Password: esmeta.SecretKeySelector{Name: "config", Key: "password"},
+	}
+	oauth2 := &esv1beta1.SpringConfigOAuth2{
+		TokenURL:     "https://auth.example.com/oauth/token",
+		ClientID:     esmeta.SecretKeySelector{Name: "config", Key: "id"},
+		ClientSecret: esmeta.SecretKeySelector{Name: "config", Key: "secret"},
+	}
+	tests := map[string]struct {
+		cfg     esv1beta1.SpringConfigProvider
+		wantErr string
+	}{
+		"valid": {
+			cfg: esv1beta1.SpringConfigProvider{
+				URL:  "https://config.example.com",
+				Auth: esv1beta1.SpringConfigAuth{Basic: basic},
+			},
+		},
+		"valid oauth2": {
+			cfg: esv1beta1.SpringConfigProvider{
+				URL:  "https://config.example.com",
+				Auth: esv1beta1.SpringConfigAuth{OAuth2: oauth2},
+			},
+		},
+		"invalid without url": {
+			cfg:     esv1beta1.SpringConfigProvider{},
+			wantErr: errURLRequired,
+		},
+		"invalid url": {
+			cfg:     esv1beta1.SpringConfigProvider{URL: "config.example.com"},
+			wantErr: "invalid url",
+		},
+		"both auth methods": {
+			cfg: esv1beta1.SpringConfigProvider{
+				URL:  "https://config.example.com",
+				Auth: esv1beta1.SpringConfigAuth{Basic: basic, OAuth2: oauth2},
+			},
+			wantErr: errMultipleAuth,
+		},
+		"invalid token url": {
+			cfg: esv1beta1.SpringConfigProvider{
+				URL: "https://config.example.com",
+				Auth: esv1beta1.SpringConfigAuth{OAuth2: &esv1beta1.SpringConfigOAuth2{
+					TokenURL: "token",
+				}},
+			},
+			wantErr: "invalid oauth2.tokenURL",
+		},
+		"invalid namespace in secret ref": {
+			cfg: esv1beta1.SpringConfigProvider{
+				URL: "https://config.example.com",
+				Auth: esv1beta1.SpringConfigAuth{Basic: &esv1beta1.SpringConfigBasicAuth{
+					Username: esmeta.SecretKeySelector{Name: "config", Key: "username", Namespace: &otherNamespace},
+					Password: esmeta.SecretKeySelector{Name: "config", Key: "password"},
+				}},
+			},
+			wantErr: "namespace should either be empty or match the namespace of the SecretStore",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := esv1beta1.SecretStore{
+				Spec: esv1beta1.SecretStoreSpec{
+					Provider: &esv1beta1.SecretStoreProvider{
+						SpringConfig: &tc.cfg,
+					},
+				},
+			}
+			p := &Provider{}
+			_, err := p.ValidateStore(&s)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,241 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package springconfig
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/find"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	errUnexpectedStatus    = "unexpected status code from the Config Server: %d: %s"
+	errReadOnly            = "the Spring Cloud Config provider is read only"
+	errPropertyUnsupported = "property is not supported, use the property path as key"
+	errFindTagsUnsupported = "find by tags is not supported by the Spring Cloud Config provider"
+	errDecodeEnvironment   = "unable to decode the Config Server response: %w"
+)
+
+// client reads the environment of an application from the Config Server.
+// https://docs.spring.io/spring-cloud-config/reference/server/environment-repository.html
+type client struct {
+	httpClient  *http.Client
+	url         string
+	application string
+	profile     string
+	label       string
+	username    string
+	password    string
+
+	// the environment is fetched once per client, which is created for every reconcile
+	mu          sync.Mutex
+	environment map[string]any
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+type environment struct {
+	Name            string           `json:"name"`
+	Profiles        []string         `json:"profiles"`
+	PropertySources []propertySource `json:"propertySources"`
+}
+
+type propertySource struct {
+	Name   string         `json:"name"`
+	Source map[string]any `json:"source"`
+}
+
+// GetSecret returns the property key, e.g. "spring.datasource.password".
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	if ref.Property != "" {
+		return nil, errors.New(errPropertyUnsupported)
+	}
+	props, err := c.properties(ctx)
+	if err != nil {
+		return nil, err
+	}
+	v, ok := props[ref.Key]
+	if !ok {
+		return nil, esv1beta1.NoSecretError{}
+	}
+	return utils.GetByteValue(v)
+}
+
+// GetSecretMap returns the properties below the path key with the path removed,
+// e.g. "url" and "password" for "spring.datasource". An empty key returns every property.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	props, err := c.properties(ctx)
+	if err != nil {
+		return nil, err
+	}
+	prefix := ""
+	if ref.Key != "" {
+		prefix = strings.TrimSuffix(ref.Key, ".") + "."
+	}
+	data := make(map[string][]byte)
+	for k, v := range props {
+		if !strings.HasPrefix(k, prefix) {
+			continue
+		}
+		b, err := utils.GetByteValue(v)
+		if err != nil {
+			return nil, err
+		}
+		data[strings.TrimPrefix(k, prefix)] = b
+	}
+	if len(data) == 0 {
+		return nil, esv1beta1.NoSecretError{}
+	}
+	return data, nil
+}
+
+// GetAllSecrets returns the properties below path whose name matches.
+func (c *client) GetAllSecrets(ctx context.Context, ref esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	if len(ref.Tags) > 0 {
+		return nil, errors.New(errFindTagsUnsupported)
+	}
+	var matcher *find.Matcher
+	if ref.Name != nil {
+		m, err := find.New(*ref.Name)
+		if err != nil {
+			return nil, err
+		}
+		matcher = m
+	}
+	props, err := c.properties(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data := make(map[string][]byte)
+	for k, v := range props {
+		if ref.Path != nil && !strings.HasPrefix(k, *ref.Path) {
+			continue
+		}
+		if matcher != nil && !matcher.MatchName(k) {
+			continue
+		}
+		b, err := utils.GetByteValue(v)
+		if err != nil {
+			return nil, err
+		}
+		data[k] = b
+	}
+	return utils.ConvertKeys(ref.ConversionStrategy, data)
+}
+
+func (c *client) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
+	return false, errors.New(errReadOnly)
+}
+
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	if _, err := c.fetch(context.Background()); err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+// properties merges the property sources of the environment,
+// the first source containing a property takes precedence like in Spring.
+func (c *client) properties(ctx context.Context) (map[string]any, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.environment != nil {
+		return c.environment, nil
+	}
+	env, err := c.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	props := make(map[string]any)
+	for _, source := range env.PropertySources {
+		for k, v := range source.Source {
+			if _, ok := props[k]; !ok {
+				props[k] = v
+			}
+		}
+	}
+	c.environment = props
+	return props, nil
+}
+
+type statusError struct {
+	code int
+	body []byte
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf(errUnexpectedStatus, e.code, e.body)
+}
+
+// fetch requests /{application}/{profile}[/{label}].
+func (c *client) fetch(ctx context.Context) (*environment, error) {
+	path := "/" + url.PathEscape(c.application) + "/" + url.PathEscape(c.profile)
+	if c.label != "" {
+		// slashes in labels, e.g. git branches, are escaped as "(_)"
+		path += "/" + url.PathEscape(strings.ReplaceAll(c.label, "/", "(_)"))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(c.url, "/")+path, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, &statusError{code: resp.StatusCode, body: body}
+	}
+	env := &environment{}
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(env); err != nil {
+		return nil, fmt.Errorf(errDecodeEnvironment, err)
+	}
+	return env, nil
+}
@@ -0,0 +1,220 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package springconfig
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+const testToken = "config-token"
+
+// fakeConfigServer serves the environment of "billing" for the "prod" profile on the "release/1.2" label.
+type fakeConfigServer struct {
+	username string
+	password string
+	requests int
+}
+
+func (f *fakeConfigServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/oauth/token" {
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") != "client_credentials" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		id, secret, _ := r.BasicAuth()
+		if id != "esc" || secret != "client-secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"access_token": testToken, "token_type": "Bearer", "expires_in": 3600})
+		return
+	}
+	f.requests++
+	user, pass, ok := r.BasicAuth()
+	authorized := (ok && user == f.username && pass == f.password) || r.Header.Get("Authorization") == "Bearer "+testToken
+	if !authorized {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if r.URL.Path != "/billing/prod/release(_)1.2" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	_, _ = w.Write([]byte(`{
+		"name": "billing",
+		"profiles": ["prod"],
+		"label": "release/1.2",
+		"propertySources": [
+			{"name": "git:billing-prod.yml", "source": {"spring.datasource.password": "s3cr3t", "server.port": 8443}},
+			{"name": "git:application.yml", "source": {"spring.datasource.password": "default", "spring.datasource.url": "jdbc:postgresql://db/billing", "feature.enabled": true}}
+		]
+	}`))
+}
+
+func newTestClient(t *testing.T, fake *fakeConfigServer) *client {
+	t.Helper()
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+	return &client{
+		httpClient:  srv.Client(),
+		url:         srv.URL,
+		application: "billing",
+		profile:     "prod",
+		label:       "release/1.2",
+		username:    fake.username,
+		password:    fake.password,
+	}
+}
+
+func TestGetSecret(t *testing.T) {
+	fake := &fakeConfigServer{username: "user", password: "pass"}
+	c := newTestClient(t, fake)
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr error
+	}{
+		"first property source takes precedence": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "spring.datasource.password"},
+			want: "s3cr3t",
+		},
+		"property of a later source": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "spring.datasource.url"},
+			want: "jdbc:postgresql://db/billing",
+		},
+		"number": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "server.port"},
+			want: "8443",
+		},
+		"missing": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "spring.datasource.username"},
+			wantErr: esv1beta1.NoSecretError{},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(ctx, tc.ref)
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+	// the environment is fetched once per client
+	assert.Equal(t, 1, fake.requests)
+
+	_, err := c.GetSecret(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: "spring.datasource", Property: "password"})
+	assert.EqualError(t, err, errPropertyUnsupported)
+}
+
+func TestGetSecretMap(t *testing.T) {
+	c := newTestClient(t, &fakeConfigServer{username: "user", password: "pass"})
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "spring.datasource"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"password": []byte("s3cr3t"),
+		"url":      []byte("jdbc:postgresql://db/billing"),
+	}, got)
+
+	_, err = c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "management"})
+	assert.ErrorIs(t, err, esv1beta1.NoSecretError{})
+}
+
+func TestGetAllSecrets(t *testing.T) {
+	c := newTestClient(t, &fakeConfigServer{username: "user", password: "pass"})
+	got, err := c.GetAllSecrets(context.Background(), esv1beta1.ExternalSecretFind{
+		Name: &esv1beta1.FindName{RegExp: "password$|enabled$"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"spring.datasource.password": []byte("s3cr3t"),
+		"feature.enabled":            []byte("true"),
+	}, got)
+}
+
+func TestUnauthorized(t *testing.T) {
+	fake := &fakeConfigServer{username: "user", password: "pass"}
+	c := newTestClient(t, fake)
+	c.password = "wrong"
+	_, err := c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "server.port"})
+	assert.ErrorContains(t, err, "unexpected status code from the Config Server: 401")
+
+	result, err := c.Validate()
+	assert.Equal(t, esv1beta1.ValidationResultError, result)
+	assert.Error(t, err)
+}
+
+func TestNewClientOAuth2(t *testing.T) {
+	fake := &fakeConfigServer{}
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+
+	kube := clientfake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "config-oauth", Namespace: "billing"},
+		Data: map[string][]byte{
+			"id":     []byte("esc"),
+			"secret": []byte("client-secret"),
+		},
+	}).Build()
+	store := &esv1beta1.SecretStore{
+		ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "billing"},
+		Spec: esv1beta1.SecretStoreSpec{
+			Provider: &esv1beta1.SecretStoreProvider{
+				SpringConfig: &esv1beta1.SpringConfigProvider{
+					URL:         srv.URL,
+					Application: "billing",
+					Profile:     "prod",
+					Label:       "release/1.2",
+					Auth: esv1beta1.SpringConfigAuth{
+						OAuth2: &esv1beta1.SpringConfigOAuth2{
+							TokenURL:     srv.URL + "/oauth/token",
+							ClientID:     esmeta.SecretKeySelector{Name: "config-oauth", Key: "id"},
+							ClientSecret: esmeta.SecretKeySelector{Name: "config-oauth", Key: "secret"},
+						},
+					},
+				},
+			},
+		},
+	}
+	p := &Provider{}
+	c, err := p.NewClient(context.Background(), store, kube, "billing")
+	require.NoError(t, err)
+
+	got, err := c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "spring.datasource.password"})
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t", string(got))
+
+	result, err := c.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, result)
+}
@@ -0,0 +1,165 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supabase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	// propertyAnonKey and propertyServiceRoleKey are the API keys of a project.
+	propertyAnonKey        = "anonKey"
+	propertyServiceRoleKey = "serviceRoleKey"
+
+	errNoAPIKey          = "project %q has no %s key"
+	errUnexpectedStatus  = "unexpected status code from Supabase: %d: %s"
+	errUnmarshalResponse = "unable to unmarshal Supabase response: %w"
+	errReadOnly          = "the Supabase provider is read only"
+	errFindUnsupported   = "find is not supported by the Supabase provider"
+)
+
+// apiKeyNames maps the properties to the names of the API keys in the Management API.
+var apiKeyNames = map[string]string{
+	propertyAnonKey:        "anon",
+	propertyServiceRoleKey: "service_role",
+}
+
+// client reads the API keys of projects with the Supabase Management API.
+// https://supabase.com/docs/reference/api/introduction
+type client struct {
+	httpClient  *http.Client
+	url         string
+	accessToken string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+type apiKey struct {
+	Name   string `json:"name"`
+	APIKey string `json:"api_key"`
+}
+
+// GetSecret returns an API key of the project ref.Key selected by the property,
+// or both API keys as JSON if the property is empty.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	if err := validateProperty(ref.Property); err != nil {
+		return nil, err
+	}
+	data, err := c.GetSecretMap(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Property != "" {
+		value, ok := data[ref.Property]
+		if !ok {
+			return nil, fmt.Errorf(errNoAPIKey, ref.Key, apiKeyNames[ref.Property])
+		}
+		return value, nil
+	}
+	values := make(map[string]string, len(data))
+	for k, v := range data {
+		values[k] = string(v)
+	}
+	return json.Marshal(values)
+}
+
+// GetSecretMap returns the anon and service_role API keys of the project ref.Key.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	if err := validateKey(ref.Key); err != nil {
+		return nil, err
+	}
+	var keys []apiKey
+	if err := c.get(ctx, "/v1/projects/"+url.PathEscape(ref.Key)+"/api-keys", &keys); err != nil {
+		return nil, err
+	}
+	data := make(map[string][]byte, len(apiKeyNames))
+	for property, name := range apiKeyNames {
+		for _, key := range keys {
+			if key.Name == name {
+				data[property] = []byte(key.APIKey)
+				break
+			}
+		}
+	}
+	return data, nil
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindUnsupported)
+}
+
+func (c *client) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
+	return false, errors.New(errReadOnly)
+}
+
+// Validate lists the projects to check the access token.
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	var projects []map[string]any
+	if err := c.get(context.Background(), "/v1/projects", &projects); err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+func (c *client) get(ctx context.Context, path string, target any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+path, http.NoBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.accessToken)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return esv1beta1.NoSecretError{}
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf(errUnexpectedStatus, resp.StatusCode, body)
+	}
+	if err := json.Unmarshal(body, target); err != nil {
+		return fmt.Errorf(errUnmarshalResponse, err)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package supabase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	testToken          = "sbp_0123456789abcdef0123456789abcdef01234567"
+	testProject        = "abcdefghijklmnopqrst"
+	testAnonKey        = "eyJhbGciOiJIUzI1NiJ9.anon.signature"
+	testServiceRoleKey = "eyJhbGciOiJIUzI1NiJ9.service_role.signature"
+)
+
+// newTestClient serves the API keys of testProject, and of the project anononly with only an anon key.
+func newTestClient(t *testing.T) *client {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/projects", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode([]map[string]string{{"id": testProject, "name": "acme"}})
+	})
+	mux.HandleFunc("GET /v1/projects/{ref}/api-keys", func(w http.ResponseWriter, r *http.Request) {
+		switch r.PathValue("ref") {
+		case testProject:
+			_ = json.NewEncoder(w).Encode([]apiKey{
+				{Name: "anon", APIKey: testAnonKey},
+				{Name: "service_role", APIKey: testServiceRoleKey},
+			})
+		case "anononly":
+			_ = json.NewEncoder(w).Encode([]apiKey{{Name: "anon", APIKey: testAnonKey}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Project not found"}`))
+		}
+	})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message":"Unauthorized"}`))
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return &client{
+		httpClient:  srv.Client(),
+		url:         srv.URL,
+		accessToken: testToken,
+	}
+}
+
+func TestGetSecret(t *testing.T) {
+	c := newTestClient(t)
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"anon key": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: testProject, Property: "anonKey"},
+			want: testAnonKey,
+		},
+		"service role key": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: testProject, Property: "serviceRoleKey"},
+			want: testServiceRoleKey,
+		},
+		"all keys": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: testProject},
+			want: `{"anonKey":"` + testAnonKey + `","serviceRoleKey":"` + testServiceRoleKey + `"}`,
+		},
+		"missing key": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "anononly", Property: "serviceRoleKey"},
+			wantErr: `project "anononly" has no service_role key`,
+		},
+		"invalid property": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: testProject, Property: "jwtSecret"},
+			wantErr: `invalid property "jwtSecret"`,
+		},
+		"invalid key": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: testProject + "/api-keys", Property: "anonKey"},
+			wantErr: "must be the reference of a project",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+
+	_, err := c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "missing", Property: "anonKey"})
+	assert.True(t, errors.Is(err, esv1beta1.NoSecretError{}))
+}
+
+func TestGetSecretMap(t *testing.T) {
+	c := newTestClient(t)
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: testProject})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"anonKey":        []byte(testAnonKey),
+		"serviceRoleKey": []byte(testServiceRoleKey),
+	}, got)
+}
+
+func TestValidate(t *testing.T) {
+	c := newTestClient(t)
+	res, err := c.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+
+	c.accessToken = "invalid"
+	res, err = c.Validate()
+	assert.ErrorContains(t, err, "unexpected status code from Supabase: 401")
+	assert.Equal(t, esv1beta1.ValidationResultError, res)
+}
@@ -0,0 +1,87 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package teleport
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	errStoreIsNil                  = "store is nil"
+	errNoStoreTypeOrWrongStoreType = "no store type or wrong store type"
+	errPathRequired                = "path is required"
+	errPathNotAbsolute             = "path must be absolute"
+	errClusterStoreOnly            = "the teleport provider can only be used with a ClusterSecretStore"
+)
+
+// Provider satisfies the provider interface.
+type Provider struct{}
+
+var _ esv1beta1.Provider = &Provider{}
+
+func (p *Provider) NewClient(_ context.Context, store esv1beta1.GenericStore, _ kclient.Client, _ string) (esv1beta1.SecretsClient, error) {
+	cfg, err := getConfig(store)
+	if err != nil {
+		return nil, err
+	}
+	return &client{
+		path:  filepath.Clean(cfg.Path),
+		files: files,
+	}, nil
+}
+
+func getConfig(store esv1beta1.GenericStore) (*esv1beta1.TeleportProvider, error) {
+	if store == nil {
+		return nil, errors.New(errStoreIsNil)
+	}
+	spec := store.GetSpec()
+	if spec == nil || spec.Provider == nil || spec.Provider.Teleport == nil {
+		return nil, errors.New(errNoStoreTypeOrWrongStoreType)
+	}
+	// a namespaced store must not be able to read arbitrary files of the operator pod
+	if store.GetKind() != esv1beta1.ClusterSecretStoreKind {
+		return nil, errors.New(errClusterStoreOnly)
+	}
+	cfg := spec.Provider.Teleport
+	if cfg.Path == "" {
+		return nil, errors.New(errPathRequired)
+	}
+	if !filepath.IsAbs(cfg.Path) {
+		return nil, errors.New(errPathNotAbsolute)
+	}
+	return cfg, nil
+}
+
+func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnings, error) {
+	_, err := getConfig(store)
+	return nil, err
+}
+
+func (p *Provider) Capabilities() esv1beta1.SecretStoreCapabilities {
+	return esv1beta1.SecretStoreReadOnly
+}
+
+func init() {
+	esv1beta1.Register(&Provider{}, &esv1beta1.SecretStoreProvider{
+		Teleport: &esv1beta1.TeleportProvider{},
+	})
+}
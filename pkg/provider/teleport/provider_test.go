@@ -0,0 +1,73 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package teleport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+func TestValidateStore(t *testing.T) {
+	tests := map[string]struct {
+		store   esv1beta1.GenericStore
+		wantErr string
+	}{
+		"valid": {
+			store: clusterStore(esv1beta1.TeleportProvider{Path: "/opt/machine-id"}),
+		},
+		"invalid without path": {
+			store:   clusterStore(esv1beta1.TeleportProvider{}),
+			wantErr: errPathRequired,
+		},
+		"invalid relative path": {
+			store:   clusterStore(esv1beta1.TeleportProvider{Path: "machine-id"}),
+			wantErr: errPathNotAbsolute,
+		},
+		"invalid namespaced store": {
+			store: &esv1beta1.SecretStore{
+				Spec: esv1beta1.SecretStoreSpec{
+					Provider: &esv1beta1.SecretStoreProvider{
+						Teleport: &esv1beta1.TeleportProvider{Path: "/opt/machine-id"},
+					},
+				},
+			},
+			wantErr: errClusterStoreOnly,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := &Provider{}
+			_, err := p.ValidateStore(tc.store)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func clusterStore(cfg esv1beta1.TeleportProvider) *esv1beta1.ClusterSecretStore {
+	return &esv1beta1.ClusterSecretStore{
+		Spec: esv1beta1.SecretStoreSpec{
+			Provider: &esv1beta1.SecretStoreProvider{
+				Teleport: &cfg,
+			},
+		},
+	}
+}
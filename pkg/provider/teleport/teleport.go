@@ -0,0 +1,186 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package teleport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/find"
+)
+
+const (
+	errReadOnly            = "the teleport provider is read only"
+	errInvalidKey          = "invalid key %q: must be a path relative to the destination directory"
+	errPropertyUnsupported = "property is not supported by the teleport provider"
+	errReadFile            = "unable to read %s: %w"
+	errReadDir             = "unable to read directory %s: %w"
+)
+
+var log = ctrl.Log.WithName("provider").WithName("teleport")
+
+// files is shared by every client so that credentials are only read again once tbot renewed them.
+var files = &fileCache{entries: make(map[string]cachedFile)}
+
+type client struct {
+	path  string
+	files *fileCache
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+// GetSecret returns the content of the file key of the destination directory, e.g. "tlscert".
+func (c *client) GetSecret(_ context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	if ref.Property != "" {
+		return nil, errors.New(errPropertyUnsupported)
+	}
+	path, err := c.resolve(ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	return c.files.read(path)
+}
+
+// GetSecretMap returns every file of the directory key, an empty key being the destination directory itself.
+func (c *client) GetSecretMap(_ context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	dir := c.path
+	if ref.Key != "" {
+		var err error
+		dir, err = c.resolve(ref.Key)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return c.readDir(dir, nil)
+}
+
+func (c *client) GetAllSecrets(_ context.Context, ref esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	var matcher *find.Matcher
+	if ref.Name != nil {
+		m, err := find.New(*ref.Name)
+		if err != nil {
+			return nil, err
+		}
+		matcher = m
+	}
+	return c.readDir(c.path, matcher)
+}
+
+func (c *client) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
+	return false, errors.New(errReadOnly)
+}
+
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	info, err := os.Stat(c.path)
+	if err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	if !info.IsDir() {
+		return esv1beta1.ValidationResultError, fmt.Errorf("%s is not a directory", c.path)
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+// resolve returns the path of key, which must not leave the destination directory.
+func (c *client) resolve(key string) (string, error) {
+	if !filepath.IsLocal(key) {
+		return "", fmt.Errorf(errInvalidKey, key)
+	}
+	return filepath.Join(c.path, key), nil
+}
+
+// readDir reads the regular files of dir whose name matches, subdirectories and hidden files are skipped.
+func (c *client) readDir(dir string, matcher *find.Matcher) (map[string][]byte, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf(errReadDir, dir, err)
+	}
+	data := make(map[string][]byte)
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.Type().IsRegular() || name[0] == '.' {
+			continue
+		}
+		if matcher != nil && !matcher.MatchName(name) {
+			continue
+		}
+		value, err := c.files.read(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		data[name] = value
+	}
+	return data, nil
+}
+
+type cachedFile struct {
+	modTime time.Time
+	size    int64
+	value   []byte
+}
+
+// fileCache keeps the content of the credential files and reads a file again
+// only when its modification time or size changed, i.e. when tbot rotated it.
+type fileCache struct {
+	mu      sync.Mutex
+	entries map[string]cachedFile
+}
+
+func (f *fileCache) read(path string) ([]byte, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf(errReadFile, path, err)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	cached, ok := f.entries[path]
+	if ok && cached.modTime.Equal(info.ModTime()) && cached.size == info.Size() {
+		return cached.value, nil
+	}
+	value, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf(errReadFile, path, err)
+	}
+	if ok {
+		log.Info("credential rotated", "path", path, "modTime", info.ModTime())
+	}
+	f.entries[path] = cachedFile{
+		modTime: info.ModTime(),
+		size:    info.Size(),
+		value:   value,
+	}
+	return value, nil
+}
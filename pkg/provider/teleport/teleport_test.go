@@ -0,0 +1,149 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package teleport
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+func newTestClient(t *testing.T) (*client, string) {
+	t.Helper()
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "tlscert"), "cert", time.Now())
+	writeFile(t, filepath.Join(dir, "key"), "key", time.Now())
+	writeFile(t, filepath.Join(dir, ".hidden"), "hidden", time.Now())
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "app"), 0o700))
+	writeFile(t, filepath.Join(dir, "app", "identity"), "identity", time.Now())
+	return &client{
+		path:  dir,
+		files: &fileCache{entries: make(map[string]cachedFile)},
+	}, dir
+}
+
+func writeFile(t *testing.T, path, content string, modTime time.Time) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func TestGetSecret(t *testing.T) {
+	c, _ := newTestClient(t)
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"file": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "tlscert"},
+			want: "cert",
+		},
+		"file in subdirectory": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "app/identity"},
+			want: "identity",
+		},
+		"missing file": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "missing"},
+			wantErr: "unable to read",
+		},
+		"key outside of the directory": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "../etc/passwd"},
+			wantErr: "invalid key",
+		},
+		"absolute key": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "/etc/passwd"},
+			wantErr: "invalid key",
+		},
+		"property": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "tlscert", Property: "foo"},
+			wantErr: errPropertyUnsupported,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestGetSecretMap(t *testing.T) {
+	c, _ := newTestClient(t)
+
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"tlscert": []byte("cert"), "key": []byte("key")}, got)
+
+	got, err = c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "app"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"identity": []byte("identity")}, got)
+}
+
+func TestGetAllSecrets(t *testing.T) {
+	c, _ := newTestClient(t)
+
+	got, err := c.GetAllSecrets(context.Background(), esv1beta1.ExternalSecretFind{
+		Name: &esv1beta1.FindName{RegExp: "^tls"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"tlscert": []byte("cert")}, got)
+}
+
+func TestRotation(t *testing.T) {
+	c, dir := newTestClient(t)
+	path := filepath.Join(dir, "tlscert")
+	issued := time.Now().Add(-time.Hour)
+	writeFile(t, path, "cert-1", issued)
+
+	got, err := c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "tlscert"})
+	require.NoError(t, err)
+	assert.Equal(t, "cert-1", string(got))
+
+	// the cached value is used as long as the modification time does not change
+	writeFile(t, path, "cert-2", issued)
+	got, err = c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "tlscert"})
+	require.NoError(t, err)
+	assert.Equal(t, "cert-1", string(got))
+
+	writeFile(t, path, "cert-2", issued.Add(time.Minute))
+	got, err = c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "tlscert"})
+	require.NoError(t, err)
+	assert.Equal(t, "cert-2", string(got))
+}
+
+func TestValidate(t *testing.T) {
+	c, dir := newTestClient(t)
+	res, err := c.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+
+	c.path = filepath.Join(dir, "missing")
+	res, err = c.Validate()
+	assert.Error(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultError, res)
+}
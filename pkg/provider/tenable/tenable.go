@@ -0,0 +1,189 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tenable
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	propertyUsername = "username"
+	propertyPassword = "password"
+	propertyDomain   = "domain"
+
+	errUnexpectedStatus  = "unexpected status code from Tenable.io: %d: %s"
+	errUnmarshalResponse = "unable to unmarshal Tenable.io response: %w"
+	errReadOnly          = "the Tenable.io provider is read only"
+	errFindUnsupported   = "find is not supported by the Tenable.io provider"
+	errInvalidProperty   = "property must be one of username, password or domain"
+	errMaskedPassword    = "the password of credential %q is masked by Tenable.io and can not be read"
+)
+
+// client reads managed credentials with the Tenable.io API.
+// https://developer.tenable.com/reference/credentials-details
+type client struct {
+	httpClient *http.Client
+	url        string
+	accessKey  string
+	secretKey  string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+type credential struct {
+	Name     string         `json:"name"`
+	Settings map[string]any `json:"settings"`
+}
+
+// GetSecret returns the property of the credential with the UUID key,
+// or all of its properties as JSON if no property is given.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	cred, err := c.credential(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	data := credentialData(cred)
+	if ref.Property == "" {
+		return utils.JSONMarshal(data)
+	}
+	switch ref.Property {
+	case propertyUsername, propertyDomain:
+	case propertyPassword:
+		if _, ok := data[propertyPassword]; !ok {
+			return nil, fmt.Errorf(errMaskedPassword, ref.Key)
+		}
+	default:
+		return nil, errors.New(errInvalidProperty)
+	}
+	return []byte(data[ref.Property]), nil
+}
+
+// GetSecretMap returns the username, password and domain of the credential with the UUID key.
+// A masked password is left out.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	cred, err := c.credential(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	data := credentialData(cred)
+	secretMap := make(map[string][]byte, len(data))
+	for k, v := range data {
+		secretMap[k] = []byte(v)
+	}
+	return secretMap, nil
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindUnsupported)
+}
+
+func (c *client) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
+	return false, errors.New(errReadOnly)
+}
+
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	err := c.get(context.Background(), "/session", nil)
+	if err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+func (c *client) credential(ctx context.Context, id string) (*credential, error) {
+	var cred credential
+	if err := c.get(ctx, "/credentials/"+url.PathEscape(id), &cred); err != nil {
+		return nil, err
+	}
+	return &cred, nil
+}
+
+func (c *client) get(ctx context.Context, path string, target any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(c.url, "/")+path, http.NoBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("X-ApiKeys", fmt.Sprintf("accessKey=%s;secretKey=%s", c.accessKey, c.secretKey))
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return esv1beta1.NoSecretError{}
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf(errUnexpectedStatus, resp.StatusCode, body)
+	}
+	if target == nil {
+		return nil
+	}
+	if err := json.Unmarshal(body, target); err != nil {
+		return fmt.Errorf(errUnmarshalResponse, err)
+	}
+	return nil
+}
+
+// credentialData returns the username, password and domain settings of a credential.
+// Credential types without a domain have an empty one.
+func credentialData(cred *credential) map[string]string {
+	data := map[string]string{
+		propertyUsername: setting(cred, propertyUsername),
+		propertyDomain:   setting(cred, propertyDomain),
+	}
+	if password := setting(cred, propertyPassword); !isMasked(password) {
+		data[propertyPassword] = password
+	}
+	return data
+}
+
+func setting(cred *credential, name string) string {
+	v, _ := cred.Settings[name].(string)
+	return v
+}
+
+// isMasked reports whether Tenable.io replaced a value with asterisks.
+func isMasked(v string) bool {
+	return v != "" && strings.Trim(v, "*") == ""
+}
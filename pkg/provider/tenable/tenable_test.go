@@ -0,0 +1,191 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tenable
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	testAccessKey = "access-key"
+	testSecretKey = "secret-key"
+
+	windowsCredential = "5c5ca2d6-3f2e-4d6b-a2c5-9a4b3d3c1e01"
+	sshCredential     = "8f1d8f0e-1f7a-4b0a-9a1c-2b7e9c6d4f02"
+	maskedCredential  = "0b9e4c3a-6d1e-4f6b-8c2d-7e5f4a3b2c03"
+)
+
+// fakeTenable serves the managed credentials of Tenable.io.
+func fakeTenable(t *testing.T) *httptest.Server {
+	t.Helper()
+	credentials := map[string]map[string]any{
+		windowsCredential: {
+			"name":     "windows-admin",
+			"category": map[string]any{"id": "Host", "name": "Host"},
+			"type":     map[string]any{"id": "Windows", "name": "Windows"},
+			"settings": map[string]any{
+				"auth_method": "Password",
+				"domain":      "CORP",
+				"username":    "administrator",
+				"password":    "s3cr3t",
+			},
+		},
+		sshCredential: {
+			"name":     "ssh-scanner",
+			"category": map[string]any{"id": "Host", "name": "Host"},
+			"type":     map[string]any{"id": "SSH", "name": "SSH"},
+			"settings": map[string]any{
+				"auth_method":             "password",
+				"username":                "scanner",
+				"password":                "hunter2",
+				"elevate_privileges_with": "Nothing",
+			},
+		},
+		maskedCredential: {
+			"name": "masked",
+			"settings": map[string]any{
+				"domain":   "CORP",
+				"username": "auditor",
+				"password": "********",
+			},
+		},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/session", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"id":1,"username":"scanner@example.com"}`))
+	})
+	mux.HandleFunc("/credentials/{uuid}", func(w http.ResponseWriter, r *http.Request) {
+		cred, ok := credentials[r.PathValue("uuid")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":"Credential not found"}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(cred)
+	})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-ApiKeys") != "accessKey="+testAccessKey+";secretKey="+testSecretKey {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"Invalid Credentials"}`))
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newTestClient(t *testing.T) *client {
+	t.Helper()
+	srv := fakeTenable(t)
+	return &client{
+		httpClient: srv.Client(),
+		url:        srv.URL,
+		accessKey:  testAccessKey,
+		secretKey:  testSecretKey,
+	}
+}
+
+func TestGetSecret(t *testing.T) {
+	c := newTestClient(t)
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"username": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: windowsCredential, Property: "username"},
+			want: "administrator",
+		},
+		"password": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: windowsCredential, Property: "password"},
+			want: "s3cr3t",
+		},
+		"domain": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: windowsCredential, Property: "domain"},
+			want: "CORP",
+		},
+		"domain of a credential without domain": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: sshCredential, Property: "domain"},
+			want: "",
+		},
+		"all properties": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: sshCredential},
+			want: `{"domain":"","password":"hunter2","username":"scanner"}`,
+		},
+		"masked password": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: maskedCredential, Property: "password"},
+			wantErr: `the password of credential "` + maskedCredential + `" is masked`,
+		},
+		"invalid property": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: windowsCredential, Property: "auth_method"},
+			wantErr: errInvalidProperty,
+		},
+		"missing credential": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "00000000-0000-0000-0000-000000000000", Property: "username"},
+			wantErr: esv1beta1.NoSecretError{}.Error(),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestGetSecretMap(t *testing.T) {
+	c := newTestClient(t)
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: windowsCredential})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"username": []byte("administrator"),
+		"password": []byte("s3cr3t"),
+		"domain":   []byte("CORP"),
+	}, got)
+
+	got, err = c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: maskedCredential})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"username": []byte("auditor"),
+		"domain":   []byte("CORP"),
+	}, got)
+}
+
+func TestValidate(t *testing.T) {
+	c := newTestClient(t)
+	res, err := c.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+
+	c.secretKey = "invalid"
+	res, err = c.Validate()
+	assert.ErrorContains(t, err, "unexpected status code from Tenable.io: 401")
+	assert.Equal(t, esv1beta1.ValidationResultError, res)
+}
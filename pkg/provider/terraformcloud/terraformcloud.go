@@ -0,0 +1,208 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraformcloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	categoryTerraform = "terraform"
+	categoryEnv       = "env"
+
+	errUnexpectedStatus  = "unexpected status code from Terraform Cloud: %d: %s"
+	errUnmarshalResponse = "unable to unmarshal Terraform Cloud response: %w"
+	errReadOnly          = "the Terraform Cloud provider is read only"
+	errFindUnsupported   = "find is not supported by the Terraform Cloud provider"
+	errPropertyRequired  = "property is required, it is the key of the workspace variable"
+	errSensitiveVariable = "variable %q of workspace %q is sensitive, its value can not be read back from Terraform Cloud"
+)
+
+// client reads workspace variables with the Terraform Cloud API.
+// https://developer.hashicorp.com/terraform/cloud-docs/api-docs/workspace-variables
+type client struct {
+	httpClient   *http.Client
+	url          string
+	organization string
+	token        string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+type workspaceResponse struct {
+	Data struct {
+		ID string `json:"id"`
+	} `json:"data"`
+}
+
+type variablesResponse struct {
+	Data []struct {
+		Attributes variable `json:"attributes"`
+	} `json:"data"`
+}
+
+type variable struct {
+	Key       string  `json:"key"`
+	Value     *string `json:"value"`
+	Category  string  `json:"category"`
+	Sensitive bool    `json:"sensitive"`
+}
+
+// GetSecret returns the value of the variable property of the workspace key.
+// Terraform variables take precedence over environment variables with the same key.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	if ref.Property == "" {
+		return nil, errors.New(errPropertyRequired)
+	}
+	vars, err := c.variables(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	var found *variable
+	for i := range vars {
+		v := &vars[i]
+		if v.Key != ref.Property {
+			continue
+		}
+		if found == nil || v.Category == categoryTerraform {
+			found = v
+		}
+	}
+	if found == nil {
+		return nil, esv1beta1.NoSecretError{}
+	}
+	if found.Sensitive {
+		return nil, fmt.Errorf(errSensitiveVariable, found.Key, ref.Key)
+	}
+	return []byte(valueOf(found)), nil
+}
+
+// GetSecretMap returns the variables of the workspace key, sensitive variables are skipped.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	vars, err := c.variables(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	data := make(map[string][]byte, len(vars))
+	for i := range vars {
+		v := &vars[i]
+		if v.Sensitive {
+			continue
+		}
+		if _, ok := data[v.Key]; ok && v.Category == categoryEnv {
+			continue
+		}
+		data[v.Key] = []byte(valueOf(v))
+	}
+	return data, nil
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindUnsupported)
+}
+
+func (c *client) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
+	return false, errors.New(errReadOnly)
+}
+
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	err := c.get(context.Background(), "/organizations/"+url.PathEscape(c.organization), nil)
+	if err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+// variables returns the variables of the workspace with the given name.
+func (c *client) variables(ctx context.Context, workspace string) ([]variable, error) {
+	var ws workspaceResponse
+	path := "/organizations/" + url.PathEscape(c.organization) + "/workspaces/" + url.PathEscape(workspace)
+	if err := c.get(ctx, path, &ws); err != nil {
+		return nil, err
+	}
+	var resp variablesResponse
+	if err := c.get(ctx, "/workspaces/"+url.PathEscape(ws.Data.ID)+"/vars", &resp); err != nil {
+		return nil, err
+	}
+	vars := make([]variable, 0, len(resp.Data))
+	for _, d := range resp.Data {
+		vars = append(vars, d.Attributes)
+	}
+	return vars, nil
+}
+
+func (c *client) get(ctx context.Context, path string, target any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(c.url, "/")+"/api/v2"+path, http.NoBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Content-Type", "application/vnd.api+json")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// Terraform Cloud answers 404 as well when the token may not read the workspace
+	if resp.StatusCode == http.StatusNotFound {
+		return esv1beta1.NoSecretError{}
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf(errUnexpectedStatus, resp.StatusCode, body)
+	}
+	if target == nil {
+		return nil
+	}
+	if err := json.Unmarshal(body, target); err != nil {
+		return fmt.Errorf(errUnmarshalResponse, err)
+	}
+	return nil
+}
+
+// valueOf returns the value of a variable, which is null for empty variables.
+func valueOf(v *variable) string {
+	if v.Value == nil {
+		return ""
+	}
+	return *v.Value
+}
@@ -0,0 +1,156 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terraformcloud
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const testToken = "team-token"
+
+// fakeTerraformCloud serves the workspaces of the organization "example" and their variables.
+func fakeTerraformCloud(t *testing.T) *httptest.Server {
+	t.Helper()
+	workspaces := map[string]string{"production": "ws-1"}
+	vars := map[string][]map[string]any{
+		"ws-1": {
+			{"key": "region", "value": "eu-west-1", "category": "terraform", "sensitive": false},
+			{"key": "region", "value": "us-east-1", "category": "env", "sensitive": false},
+			{"key": "AWS_PROFILE", "value": "prod", "category": "env", "sensitive": false},
+			{"key": "db_password", "value": nil, "category": "terraform", "sensitive": true},
+			{"key": "empty", "value": nil, "category": "terraform", "sensitive": false},
+		},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v2/organizations/example", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"id":"example","type":"organizations"}}`))
+	})
+	mux.HandleFunc("/api/v2/organizations/example/workspaces/{name}", func(w http.ResponseWriter, r *http.Request) {
+		id, ok := workspaces[r.PathValue("name")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"id": id, "type": "workspaces"}})
+	})
+	mux.HandleFunc("/api/v2/workspaces/{id}/vars", func(w http.ResponseWriter, r *http.Request) {
+		var data []map[string]any
+		for _, v := range vars[r.PathValue("id")] {
+			data = append(data, map[string]any{"type": "vars", "attributes": v})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+	})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newTestClient(t *testing.T) *client {
+	t.Helper()
+	srv := fakeTerraformCloud(t)
+	return &client{
+		httpClient:   srv.Client(),
+		url:          srv.URL,
+		organization: "example",
+		token:        testToken,
+	}
+}
+
+func TestGetSecret(t *testing.T) {
+	c := newTestClient(t)
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"terraform variable": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "production", Property: "region"},
+			want: "eu-west-1",
+		},
+		"environment variable": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "production", Property: "AWS_PROFILE"},
+			want: "prod",
+		},
+		"empty variable": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "production", Property: "empty"},
+			want: "",
+		},
+		"sensitive variable": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "production", Property: "db_password"},
+			wantErr: `variable "db_password" of workspace "production" is sensitive`,
+		},
+		"missing variable": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "production", Property: "missing"},
+			wantErr: esv1beta1.NoSecretError{}.Error(),
+		},
+		"missing workspace": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "staging", Property: "region"},
+			wantErr: esv1beta1.NoSecretError{}.Error(),
+		},
+		"missing property": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "production"},
+			wantErr: errPropertyRequired,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestGetSecretMap(t *testing.T) {
+	c := newTestClient(t)
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "production"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"region":      []byte("eu-west-1"),
+		"AWS_PROFILE": []byte("prod"),
+		"empty":       []byte(""),
+	}, got)
+}
+
+func TestValidate(t *testing.T) {
+	c := newTestClient(t)
+	res, err := c.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+
+	c.token = "invalid"
+	res, err = c.Validate()
+	assert.ErrorContains(t, err, "unexpected status code from Terraform Cloud: 401")
+	assert.Equal(t, esv1beta1.ValidationResultError, res)
+}
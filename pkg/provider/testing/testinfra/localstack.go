@@ -0,0 +1,70 @@
+//go:build integration
+
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testinfra
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/docker/go-connections/nat"
+	"github.com/testcontainers/testcontainers-go"
+)
+
+const (
+	localStackImage  = "localstack/localstack:3.5"
+	localStackPort   = nat.Port("4566/tcp")
+	localStackRegion = "eu-west-1"
+)
+
+// LocalStack emulates the AWS APIs, the credentials are not checked.
+type LocalStack struct {
+	*container
+}
+
+// StartLocalStack starts LocalStack with the given services, e.g. "secretsmanager" and "ssm".
+func StartLocalStack(ctx context.Context, services ...string) (*LocalStack, error) {
+	c, err := start(ctx, testcontainers.ContainerRequest{
+		Image:        localStackImage,
+		ExposedPorts: []string{string(localStackPort)},
+		Env: map[string]string{
+			"SERVICES":       strings.Join(services, ","),
+			"DEFAULT_REGION": localStackRegion,
+		},
+		WaitingFor: waitForHTTP("/_localstack/health", localStackPort),
+	}, localStackPort, "http")
+	if err != nil {
+		return nil, err
+	}
+	return &LocalStack{container: c}, nil
+}
+
+// Endpoint returns the endpoint of every AWS API, e.g. http://localhost:32768.
+func (l *LocalStack) Endpoint() string {
+	return l.endpoint
+}
+
+// Session returns an AWS session sending requests to LocalStack.
+func (l *LocalStack) Session() (*session.Session, error) {
+	return session.NewSession(aws.NewConfig().
+		WithEndpoint(l.endpoint).
+		WithRegion(localStackRegion).
+		WithS3ForcePathStyle(true).
+		WithCredentials(credentials.NewStaticCredentials("test", "test", "")))
+}
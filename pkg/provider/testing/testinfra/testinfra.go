@@ -0,0 +1,58 @@
+//go:build integration
+
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package testinfra starts the backends of the providers in containers
+// for the integration tests, which are only built with the integration build tag.
+// A Docker daemon is required, see the developer guide.
+package testinfra
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/testcontainers/testcontainers-go"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+// container is a started container exposing a single port.
+type container struct {
+	testcontainers.Container
+	endpoint string
+}
+
+func start(ctx context.Context, req testcontainers.ContainerRequest, port nat.Port, scheme string) (*container, error) {
+	c, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
+		ContainerRequest: req,
+		Started:          true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to start %s: %w", req.Image, err)
+	}
+	endpoint, err := c.PortEndpoint(ctx, port, scheme)
+	if err != nil {
+		_ = c.Terminate(ctx)
+		return nil, fmt.Errorf("unable to get the endpoint of %s: %w", req.Image, err)
+	}
+	return &container{Container: c, endpoint: endpoint}, nil
+}
+
+// waitForHTTP waits until path returns a 200 on port.
+func waitForHTTP(path string, port nat.Port) wait.Strategy {
+	return wait.ForHTTP(path).WithPort(port).WithStatusCodeMatcher(func(status int) bool {
+		return status == 200
+	})
+}
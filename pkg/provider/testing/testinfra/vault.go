@@ -0,0 +1,64 @@
+//go:build integration
+
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testinfra
+
+import (
+	"context"
+
+	"github.com/docker/go-connections/nat"
+	"github.com/testcontainers/testcontainers-go"
+)
+
+const (
+	vaultImage     = "hashicorp/vault:1.17.1"
+	vaultPort      = nat.Port("8200/tcp")
+	vaultRootToken = "root"
+)
+
+// Vault is a Vault server running in dev mode, the KV secrets engine
+// version 2 is mounted at secret/.
+type Vault struct {
+	*container
+}
+
+// StartVault starts a Vault server in dev mode.
+func StartVault(ctx context.Context) (*Vault, error) {
+	c, err := start(ctx, testcontainers.ContainerRequest{
+		Image:        vaultImage,
+		ExposedPorts: []string{string(vaultPort)},
+		Env: map[string]string{
+			"VAULT_DEV_ROOT_TOKEN_ID":  vaultRootToken,
+			"VAULT_DEV_LISTEN_ADDRESS": "0.0.0.0:8200",
+		},
+		Cmd:        []string{"server", "-dev"},
+		WaitingFor: waitForHTTP("/v1/sys/health", vaultPort),
+	}, vaultPort, "http")
+	if err != nil {
+		return nil, err
+	}
+	return &Vault{container: c}, nil
+}
+
+// Address returns the address of the Vault server, e.g. http://localhost:32768.
+func (v *Vault) Address() string {
+	return v.endpoint
+}
+
+// RootToken returns the root token of the Vault server.
+func (v *Vault) RootToken() string {
+	return vaultRootToken
+}
@@ -0,0 +1,141 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package twilio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+const (
+	defaultURL = "https://api.twilio.com"
+
+	errStoreIsNil                  = "store is nil"
+	errNoStoreTypeOrWrongStoreType = "no store type or wrong store type"
+	errInvalidURL                  = "invalid url %q: %w"
+	errInvalidAccountSID           = "invalid account sid %q"
+	errInvalidAuth                 = "exactly one of authToken or apiKey must be set"
+	errMissingAPIKeySID            = "missing sid of the api key"
+	errCannotResolveAuthToken      = "cannot resolve auth token: %w"
+	errCannotResolveAPIKeySecret   = "cannot resolve api key secret: %w"
+	errEmptyKey                    = "key must be accountSid, authToken or the name or SID of an API key"
+)
+
+// Provider satisfies the provider interface.
+type Provider struct{}
+
+var _ esv1beta1.Provider = &Provider{}
+
+func (p *Provider) NewClient(ctx context.Context, store esv1beta1.GenericStore, kube kclient.Client, namespace string) (esv1beta1.SecretsClient, error) {
+	cfg, err := getConfig(store)
+	if err != nil {
+		return nil, err
+	}
+	// the account SID and auth token, or the SID and secret of an API key, are the basic auth credentials
+	username, password := cfg.AccountSID, ""
+	if cfg.Auth.AuthToken != nil {
+		password, err = resolvers.SecretKeyRef(ctx, kube, store.GetKind(), namespace, cfg.Auth.AuthToken)
+		if err != nil {
+			return nil, fmt.Errorf(errCannotResolveAuthToken, err)
+		}
+	} else {
+		username = cfg.Auth.APIKey.SID
+		password, err = resolvers.SecretKeyRef(ctx, kube, store.GetKind(), namespace, &cfg.Auth.APIKey.Secret)
+		if err != nil {
+			return nil, fmt.Errorf(errCannotResolveAPIKeySecret, err)
+		}
+	}
+	apiURL := cfg.URL
+	if apiURL == "" {
+		apiURL = defaultURL
+	}
+	return &client{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		url:        strings.TrimSuffix(apiURL, "/"),
+		accountSID: cfg.AccountSID,
+		username:   username,
+		password:   password,
+	}, nil
+}
+
+func getConfig(store esv1beta1.GenericStore) (*esv1beta1.TwilioProvider, error) {
+	if store == nil {
+		return nil, errors.New(errStoreIsNil)
+	}
+	spec := store.GetSpec()
+	if spec == nil || spec.Provider == nil || spec.Provider.Twilio == nil {
+		return nil, errors.New(errNoStoreTypeOrWrongStoreType)
+	}
+	cfg := spec.Provider.Twilio
+	if cfg.URL != "" {
+		if _, err := url.ParseRequestURI(cfg.URL); err != nil {
+			return nil, fmt.Errorf(errInvalidURL, cfg.URL, err)
+		}
+	}
+	if !strings.HasPrefix(cfg.AccountSID, "AC") || strings.Contains(cfg.AccountSID, "/") {
+		return nil, fmt.Errorf(errInvalidAccountSID, cfg.AccountSID)
+	}
+	if (cfg.Auth.AuthToken == nil) == (cfg.Auth.APIKey == nil) {
+		return nil, errors.New(errInvalidAuth)
+	}
+	if cfg.Auth.AuthToken != nil {
+		if err := utils.ValidateReferentSecretSelector(store, *cfg.Auth.AuthToken); err != nil {
+			return nil, err
+		}
+	} else {
+		if cfg.Auth.APIKey.SID == "" {
+			return nil, errors.New(errMissingAPIKeySID)
+		}
+		if err := utils.ValidateReferentSecretSelector(store, cfg.Auth.APIKey.Secret); err != nil {
+			return nil, err
+		}
+	}
+	return cfg, nil
+}
+
+func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnings, error) {
+	_, err := getConfig(store)
+	return nil, err
+}
+
+// ValidateRemoteRef checks that the key is set.
+func (p *Provider) ValidateRemoteRef(_ esv1beta1.GenericStore, ref esv1beta1.ExternalSecretDataRemoteRef) error {
+	if ref.Key == "" {
+		return errors.New(errEmptyKey)
+	}
+	return nil
+}
+
+func (p *Provider) Capabilities() esv1beta1.SecretStoreCapabilities {
+	return esv1beta1.SecretStoreReadOnly
+}
+
+func init() {
+	esv1beta1.Register(&Provider{}, &esv1beta1.SecretStoreProvider{
+		Twilio: &esv1beta1.TwilioProvider{},
+	})
+}
@@ -0,0 +1,240 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package twilio
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	// keyAccountSID and keyAuthToken are the remote keys of the credentials of the account.
+	keyAccountSID = "accountSid"
+	keyAuthToken  = "authToken"
+
+	// propertySID and propertySecret are the properties of an API key.
+	propertySID    = "sid"
+	propertySecret = "secret"
+
+	apiVersion = "/2010-04-01"
+	pageSize   = "1000"
+
+	errKeyNotFound        = "api key %q not found"
+	errAmbiguousKey       = "%d api keys are named %q, use the SID of the key instead"
+	errKeySecret          = "the secret of api key %q can not be read, Twilio returns it only when the key is created"
+	errInvalidProperty    = "invalid property %q of api key %q, must be sid"
+	errAccountProperty    = "key %q does not support a property"
+	errUnexpectedStatus   = "unexpected status code from Twilio: %d: %s"
+	errUnmarshalResponse  = "unable to unmarshal Twilio response: %w"
+	errReadOnly           = "the Twilio provider is read only"
+	errFindUnsupported    = "find is not supported by the Twilio provider"
+	errUnexpectedNextPage = "unexpected next page uri %q"
+)
+
+// client reads the credentials of an account with the Twilio REST API.
+// https://www.twilio.com/docs/usage/api
+type client struct {
+	httpClient *http.Client
+	url        string
+	accountSID string
+	username   string
+	password   string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+type account struct {
+	SID       string `json:"sid"`
+	AuthToken string `json:"auth_token"`
+}
+
+type apiKey struct {
+	SID          string `json:"sid"`
+	FriendlyName string `json:"friendly_name"`
+}
+
+type keysResponse struct {
+	Keys        []apiKey `json:"keys"`
+	NextPageURI string   `json:"next_page_uri"`
+}
+
+// GetSecret returns the SID or the auth token of the account with the keys accountSid and authToken.
+// Any other key is the name or the SID of an API key, whose SID is returned.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	switch ref.Key {
+	case keyAccountSID, keyAuthToken:
+		if ref.Property != "" {
+			return nil, fmt.Errorf(errAccountProperty, ref.Key)
+		}
+		data, err := c.accountCredentials(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return data[ref.Key], nil
+	}
+	switch ref.Property {
+	case "", propertySID:
+	case propertySecret:
+		return nil, fmt.Errorf(errKeySecret, ref.Key)
+	default:
+		return nil, fmt.Errorf(errInvalidProperty, ref.Property, ref.Key)
+	}
+	key, err := c.findKey(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(key.SID), nil
+}
+
+// GetSecretMap returns the SID and the auth token of the account together with the keys accountSid
+// and authToken. For an API key only its SID is returned, as its secret can not be read.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	switch ref.Key {
+	case keyAccountSID, keyAuthToken:
+		return c.accountCredentials(ctx)
+	}
+	key, err := c.findKey(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	return map[string][]byte{
+		propertySID: []byte(key.SID),
+	}, nil
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindUnsupported)
+}
+
+func (c *client) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
+	return false, errors.New(errReadOnly)
+}
+
+// Validate reads the account to check the credentials.
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	if _, err := c.account(context.Background()); err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+func (c *client) accountCredentials(ctx context.Context) (map[string][]byte, error) {
+	acc, err := c.account(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return map[string][]byte{
+		keyAccountSID: []byte(acc.SID),
+		keyAuthToken:  []byte(acc.AuthToken),
+	}, nil
+}
+
+func (c *client) account(ctx context.Context) (*account, error) {
+	var acc account
+	if err := c.get(ctx, c.accountPath()+".json", &acc); err != nil {
+		return nil, err
+	}
+	return &acc, nil
+}
+
+// findKey returns the API key with the SID or the name nameOrSID.
+func (c *client) findKey(ctx context.Context, nameOrSID string) (*apiKey, error) {
+	var matches []apiKey
+	path := c.accountPath() + "/Keys.json?" + url.Values{"PageSize": {pageSize}}.Encode()
+	for path != "" {
+		var resp keysResponse
+		if err := c.get(ctx, path, &resp); err != nil {
+			return nil, err
+		}
+		for _, k := range resp.Keys {
+			if k.SID == nameOrSID {
+				return &k, nil
+			}
+			if k.FriendlyName == nameOrSID {
+				matches = append(matches, k)
+			}
+		}
+		path = ""
+		if resp.NextPageURI != "" {
+			// the next page uri is relative to the host and includes the version of the API
+			if !strings.HasPrefix(resp.NextPageURI, apiVersion+"/") {
+				return nil, fmt.Errorf(errUnexpectedNextPage, resp.NextPageURI)
+			}
+			path = strings.TrimPrefix(resp.NextPageURI, apiVersion)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf(errKeyNotFound, nameOrSID)
+	case 1:
+		return &matches[0], nil
+	}
+	return nil, fmt.Errorf(errAmbiguousKey, len(matches), nameOrSID)
+}
+
+func (c *client) accountPath() string {
+	return "/Accounts/" + url.PathEscape(c.accountSID)
+}
+
+func (c *client) get(ctx context.Context, path string, target any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+apiVersion+path, http.NoBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(c.username, c.password)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return esv1beta1.NoSecretError{}
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf(errUnexpectedStatus, resp.StatusCode, body)
+	}
+	if err := json.Unmarshal(body, target); err != nil {
+		return fmt.Errorf(errUnmarshalResponse, err)
+	}
+	return nil
+}
@@ -38,6 +38,7 @@ const (
 	errVaultToken            = "cannot parse Vault authentication token: %w"
 	errGetKubeSATokenRequest = "cannot request Kubernetes service account token for service account %q: %w"
 	errVaultRevokeToken      = "error while revoking token: %w"
+	errVaultRenewToken       = "error while renewing token: %w"
 )
 
 // setAuth gets a new token using the configured mechanism.
@@ -57,6 +58,17 @@ func (c *client) setAuth(ctx context.Context, cfg *vault.Config) error {
 		return err
 	}
 
+	if c.client.Token() != "" && err == nil {
+		renewed, renewErr := renewToken(ctx, c.token)
+		if renewErr != nil {
+			c.log.V(1).Info("Unable to renew existing token, falling back to login", "error", renewErr)
+		}
+		if renewed {
+			c.log.V(1).Info("Renewed existing token")
+			return nil
+		}
+	}
+
 	tokenExists, err = setSecretKeyToken(ctx, c)
 	if tokenExists {
 		c.log.V(1).Info("Set token from secret")
@@ -182,6 +194,28 @@ func checkToken(ctx context.Context, token util.Token) (bool, error) {
 	return true, nil
 }
 
+// renewToken calls auth/token/renew-self if the current token is renewable,
+// e.g. a periodic token. It returns false if the token can not be renewed,
+// in which case the caller is expected to fall back to a new login.
+func renewToken(ctx context.Context, token util.Token) (bool, error) {
+	// https://developer.hashicorp.com/vault/api-docs/auth/token#renew-a-token-self
+	resp, err := token.LookupSelfWithContext(ctx)
+	metrics.ObserveAPICall(constants.ProviderHCVault, constants.CallHCVaultLookupSelf, err)
+	if err != nil || resp == nil {
+		return false, nil
+	}
+	renewable, err := resp.TokenIsRenewable()
+	if err != nil || !renewable {
+		return false, nil
+	}
+	_, err = token.RenewSelfWithContext(ctx, 0)
+	metrics.ObserveAPICall(constants.ProviderHCVault, constants.CallHCVaultRenewSelf, err)
+	if err != nil {
+		return false, fmt.Errorf(errVaultRenewToken, err)
+	}
+	return true, nil
+}
+
 func revokeTokenIfValid(ctx context.Context, client util.Client) error {
 	valid, err := checkToken(ctx, client.AuthToken())
 	if err != nil {
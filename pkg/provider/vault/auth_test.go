@@ -18,6 +18,10 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -137,7 +141,7 @@ func TestSetAuthNamespace(t *testing.T) {
 				t.Errorf(err.Error())
 			}
 
-			client, err := getVaultClient(prov, tc.args.store, cfg)
+			client, err := getVaultClient(context.Background(), prov, tc.args.store, cfg)
 			if err != nil {
 				t.Errorf("vault.useAuthNamespace: failed to create client: %s", err.Error())
 			}
@@ -169,6 +173,163 @@ func TestSetAuthNamespace(t *testing.T) {
 	}
 }
 
+// Test that the namespace header is sent on every request to Vault.
+func TestNamespaceHeader(t *testing.T) {
+	adminNS := "admin"
+	teamNS := "admin/team-a"
+
+	kube := clientfake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "vault-secret",
+			Namespace: "default",
+		},
+		Data: map[string][]byte{
+			"token":     []byte("token"),
+			"secret-id": []byte("secret-id"),
+			"jwt":       []byte("jwt"),
+		},
+	}).Build()
+
+	cases := map[string]struct {
+		auth          esv1beta1.VaultAuth
+		authNamespace *string
+		wantAuthNS    string
+		wantPaths     []string
+	}{
+		"TokenAuth": {
+			auth: esv1beta1.VaultAuth{
+				TokenSecretRef: &esmeta.SecretKeySelector{Name: "vault-secret", Key: "token"},
+			},
+			wantAuthNS: teamNS,
+			wantPaths:  []string{"/v1/auth/token/lookup-self"},
+		},
+		"AppRoleAuth": {
+			auth: esv1beta1.VaultAuth{
+				AppRole: &esv1beta1.VaultAppRole{
+					Path:      "approle",
+					RoleID:    "role-id",
+					SecretRef: esmeta.SecretKeySelector{Name: "vault-secret", Key: "secret-id"},
+				},
+			},
+			wantAuthNS: teamNS,
+			wantPaths:  []string{"/v1/auth/approle/login", "/v1/auth/token/lookup-self"},
+		},
+		"KubernetesAuth": {
+			auth: esv1beta1.VaultAuth{
+				Kubernetes: &esv1beta1.VaultKubernetesAuth{
+					Path:      "kubernetes",
+					Role:      "kubernetes-auth-role",
+					SecretRef: &esmeta.SecretKeySelector{Name: "vault-secret", Key: "jwt"},
+				},
+			},
+			wantAuthNS: teamNS,
+			wantPaths:  []string{"/v1/auth/kubernetes/login", "/v1/auth/token/lookup-self"},
+		},
+		"KubernetesAuthInAuthNamespace": {
+			auth: esv1beta1.VaultAuth{
+				Kubernetes: &esv1beta1.VaultKubernetesAuth{
+					Path:      "kubernetes",
+					Role:      "kubernetes-auth-role",
+					SecretRef: &esmeta.SecretKeySelector{Name: "vault-secret", Key: "jwt"},
+				},
+			},
+			authNamespace: &adminNS,
+			wantAuthNS:    adminNS,
+			wantPaths:     []string{"/v1/auth/kubernetes/login", "/v1/auth/token/lookup-self"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var (
+				mu         sync.Mutex
+				namespaces = map[string]string{}
+			)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				namespaces[r.URL.Path] = r.Header.Get(vault.NamespaceHeaderName)
+				mu.Unlock()
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case strings.HasSuffix(r.URL.Path, "/login"):
+					_, _ = w.Write([]byte(`{"auth":{"client_token":"token","lease_duration":3600}}`))
+				case r.URL.Path == "/v1/auth/token/lookup-self":
+					_, _ = w.Write([]byte(`{"data":{"type":"service","ttl":3600,"expire_time":"2100-01-01T00:00:00Z"}}`))
+				default:
+					_, _ = w.Write([]byte(`{"data":{"data":{"foo":"bar"}}}`))
+				}
+			}))
+			defer srv.Close()
+
+			store := makeValidSecretStore()
+			store.Spec.Provider.Vault.Server = srv.URL
+			store.Spec.Provider.Vault.Namespace = ptr.To(teamNS)
+			store.Spec.Provider.Vault.Auth = tc.auth
+			store.Spec.Provider.Vault.Auth.Namespace = tc.authNamespace
+
+			prov := &Provider{NewVaultClient: NewVaultClient}
+			sc, err := prov.newClient(context.Background(), store, kube, nil, "default")
+			if err != nil {
+				t.Fatalf("newClient() error = %v", err)
+			}
+			c := sc.(*client)
+			// a second authentication looks up the token that is already set
+			if err := c.setAuth(context.Background(), nil); err != nil {
+				t.Fatalf("setAuth() error = %v", err)
+			}
+			if _, err := c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "foo", Property: "foo"}); err != nil {
+				t.Fatalf("GetSecret() error = %v", err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			for _, path := range tc.wantPaths {
+				got, ok := namespaces[path]
+				if !ok {
+					t.Errorf("no request to %s", path)
+					continue
+				}
+				if got != tc.wantAuthNS {
+					t.Errorf("%s header on %s = %q, want %q", vault.NamespaceHeaderName, path, got, tc.wantAuthNS)
+				}
+			}
+			if got := namespaces["/v1/secret/data/foo"]; got != teamNS {
+				t.Errorf("%s header on secret read = %q, want %q", vault.NamespaceHeaderName, got, teamNS)
+			}
+		})
+	}
+}
+
+// Test that a cached client does not keep the namespace of an earlier version of the store.
+func TestInitClientClearsNamespace(t *testing.T) {
+	store := makeValidSecretStore()
+	store.Spec.Provider.Vault.Auth = esv1beta1.VaultAuth{
+		TokenSecretRef: &esmeta.SecretKeySelector{Name: "vault-secret", Key: "token"},
+	}
+	kube := clientfake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "vault-secret", Namespace: "default"},
+		Data:       map[string][]byte{"token": []byte("token")},
+	}).Build()
+
+	prov := &Provider{NewVaultClient: NewVaultClient}
+	c, cfg, err := prov.prepareConfig(context.Background(), kube, nil, store.Spec.Provider.Vault, nil, "default", esv1beta1.SecretStoreKind)
+	if err != nil {
+		t.Fatalf("prepareConfig() error = %v", err)
+	}
+	vc, err := NewVaultClient(cfg)
+	if err != nil {
+		t.Fatalf("NewVaultClient() error = %v", err)
+	}
+	vc.SetNamespace("admin/team-a")
+
+	if _, err := prov.initClient(context.Background(), c, vc, cfg, store.Spec.Provider.Vault); err != nil {
+		t.Fatalf("initClient() error = %v", err)
+	}
+	if got := vc.Namespace(); got != "" {
+		t.Errorf("namespace = %q, want it to be cleared", got)
+	}
+}
+
 func TestCheckTokenErrors(t *testing.T) {
 	cases := map[string]struct {
 		message string
@@ -277,3 +438,67 @@ func TestCheckTokenTtl(t *testing.T) {
 		})
 	}
 }
+
+func TestRenewToken(t *testing.T) {
+	cases := map[string]struct {
+		message  string
+		secret   *vault.Secret
+		renewErr error
+		renewed  bool
+		wantErr  bool
+	}{
+		"Renewable": {
+			message: "should renew a renewable token",
+			secret: &vault.Secret{
+				Data: map[string]interface{}{
+					"renewable": true,
+					"period":    json.Number("3600"),
+				},
+			},
+			renewed: true,
+		},
+		"NotRenewable": {
+			message: "should fall back to login if token is not renewable",
+			secret: &vault.Secret{
+				Data: map[string]interface{}{
+					"renewable": false,
+				},
+			},
+			renewed: false,
+		},
+		"RenewFailed": {
+			message: "should fall back to login if renew-self failed",
+			secret: &vault.Secret{
+				Data: map[string]interface{}{
+					"renewable": true,
+				},
+			},
+			renewErr: errors.New("permission denied"),
+			renewed:  false,
+			wantErr:  true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			renewCalled := false
+			token := fake.Token{
+				LookupSelfWithContextFn: func(ctx context.Context) (*vault.Secret, error) {
+					return tc.secret, nil
+				},
+				RenewSelfWithContextFn: func(ctx context.Context, increment int) (*vault.Secret, error) {
+					renewCalled = true
+					return &vault.Secret{}, tc.renewErr
+				},
+			}
+
+			renewed, err := renewToken(context.Background(), token)
+			if renewed != tc.renewed || (err != nil) != tc.wantErr {
+				t.Errorf("%v: renewed = %v, err = %v", tc.message, renewed, err)
+			}
+			if tc.renewed && !renewCalled {
+				t.Errorf("%v: renew-self was not called", tc.message)
+			}
+		})
+	}
+}
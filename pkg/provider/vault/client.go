@@ -48,6 +48,13 @@ type client struct {
 	token     util.Token
 	namespace string
 	storeKind string
+
+	// dynamic credentials read by the client, by role
+	dynamicCredentials map[string]map[string]any
+	// leases issued since the last call to IssuedLeases
+	leases []string
+	// leased is set once the client issued a lease
+	leased bool
 }
 
 func (c *client) newConfig(ctx context.Context) (*vault.Config, error) {
@@ -184,8 +191,9 @@ func getCertFromConfigMap(v *client) ([]byte, error) {
 
 func (c *client) Close(ctx context.Context) error {
 	// Revoke the token if we have one set, it wasn't sourced from a TokenSecretRef,
-	// and token caching isn't enabled
-	if !enableCache && c.client.Token() != "" && c.store.Auth.TokenSecretRef == nil {
+	// and token caching isn't enabled. Leases are revoked along with the token that
+	// issued them, so it is kept once dynamic credentials have been read.
+	if !enableCache && !c.leased && c.client.Token() != "" && c.store.Auth.TokenSecretRef == nil {
 		err := revokeTokenIfValid(ctx, c.client)
 		if err != nil {
 			return err
@@ -0,0 +1,131 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/constants"
+	"github.com/external-secrets/external-secrets/pkg/metrics"
+)
+
+const (
+	errDynamicSecretsEngineUnsupported = "the operation is not supported for dynamic secrets engines"
+	errRevokeLease                     = "cannot revoke lease: %w"
+	errRenewLease                      = "cannot renew lease: %w"
+	errRenewLeaseEmpty                 = "cannot renew lease: empty response"
+
+	defaultLDAPSecretsEnginePath     = "ldap"
+	defaultDatabaseSecretsEnginePath = "database"
+)
+
+var _ esv1beta1.LeaseClient = &client{}
+
+// dynamicSecretsEnginePath returns the mount path of the LDAP or database secrets engine
+// of the store, or false if the store reads a KV secrets engine.
+func (c *client) dynamicSecretsEnginePath() (string, bool) {
+	switch {
+	case c.store.LDAPSecretsEngine != nil:
+		if c.store.LDAPSecretsEngine.Path != "" {
+			return c.store.LDAPSecretsEngine.Path, true
+		}
+		return defaultLDAPSecretsEnginePath, true
+	case c.store.DatabaseSecretsEngine != nil:
+		if c.store.DatabaseSecretsEngine.Path != "" {
+			return c.store.DatabaseSecretsEngine.Path, true
+		}
+		return defaultDatabaseSecretsEnginePath, true
+	}
+	return "", false
+}
+
+// usesDynamicSecretsEngine returns true if the store serves dynamic credentials instead of KV secrets.
+func (c *client) usesDynamicSecretsEngine() bool {
+	_, ok := c.dynamicSecretsEnginePath()
+	return ok
+}
+
+// readDynamicCredentials returns dynamic credentials of a role of the LDAP or database
+// secrets engine. Credentials are only issued once per role and client, so that the
+// username and password of separate remoteRefs belong together.
+func (c *client) readDynamicCredentials(ctx context.Context, role string) (map[string]any, error) {
+	if creds, ok := c.dynamicCredentials[role]; ok {
+		return creds, nil
+	}
+
+	path, _ := c.dynamicSecretsEnginePath()
+	secret, err := c.logical.ReadWithDataWithContext(ctx, fmt.Sprintf("%s/creds/%s", path, role), nil)
+	metrics.ObserveAPICall(constants.ProviderHCVault, constants.CallHCVaultReadSecretData, err)
+	if err != nil {
+		return nil, fmt.Errorf(errReadSecret, err)
+	}
+	if secret == nil || secret.Data == nil {
+		return nil, esv1beta1.NoSecretError{}
+	}
+
+	creds := map[string]any{
+		"username": secret.Data["username"],
+		"password": secret.Data["password"],
+	}
+	if c.dynamicCredentials == nil {
+		c.dynamicCredentials = make(map[string]map[string]any)
+	}
+	c.dynamicCredentials[role] = creds
+	if secret.LeaseID != "" {
+		c.leases = append(c.leases, secret.LeaseID)
+		c.leased = true
+	}
+	return creds, nil
+}
+
+// IssuedLeases returns the leases of the credentials read since the last call.
+func (c *client) IssuedLeases() []string {
+	leases := c.leases
+	c.leases = nil
+	return leases
+}
+
+// RenewLease extends a lease of dynamic credentials by increment. Vault caps the
+// returned TTL at the max TTL of the lease.
+func (c *client) RenewLease(ctx context.Context, leaseID string, increment time.Duration) (time.Duration, error) {
+	secret, err := c.logical.WriteWithContext(ctx, "sys/leases/renew", map[string]any{
+		"lease_id":  leaseID,
+		"increment": int(increment.Seconds()),
+	})
+	metrics.ObserveAPICall(constants.ProviderHCVault, constants.CallHCVaultRenewLease, err)
+	if err != nil {
+		return 0, fmt.Errorf(errRenewLease, err)
+	}
+	if secret == nil {
+		return 0, errors.New(errRenewLeaseEmpty)
+	}
+	return time.Duration(secret.LeaseDuration) * time.Second, nil
+}
+
+// RevokeLease revokes a lease of dynamic credentials.
+func (c *client) RevokeLease(ctx context.Context, leaseID string) error {
+	_, err := c.logical.WriteWithContext(ctx, "sys/leases/revoke", map[string]any{
+		"lease_id": leaseID,
+	})
+	metrics.ObserveAPICall(constants.ProviderHCVault, constants.CallHCVaultRevokeLease, err)
+	if err != nil {
+		return fmt.Errorf(errRevokeLease, err)
+	}
+	return nil
+}
@@ -0,0 +1,406 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	vault "github.com/hashicorp/vault/api"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+	testingfake "github.com/external-secrets/external-secrets/pkg/provider/testing/fake"
+	"github.com/external-secrets/external-secrets/pkg/provider/vault/fake"
+	"github.com/external-secrets/external-secrets/pkg/provider/vault/util"
+)
+
+const ldapLeaseID = "ldap/creds/dev/7a8b"
+
+func makeLDAPSecretStore(path string) *esv1beta1.VaultProvider {
+	store := makeValidSecretStore().Spec.Provider.Vault
+	store.LDAPSecretsEngine = &esv1beta1.VaultLDAPSecretsEngine{
+		Path: path,
+	}
+	return store
+}
+
+func newLDAPCredsReadFn(paths *[]string) fake.ReadWithDataWithContextFn {
+	return func(ctx context.Context, path string, data map[string][]string) (*vault.Secret, error) {
+		*paths = append(*paths, path)
+		return &vault.Secret{
+			LeaseID: ldapLeaseID,
+			Data: map[string]any{
+				"username":            "v_dev_1234",
+				"password":            "s3cr3t",
+				"distinguished_names": []any{"cn=v_dev_1234,ou=users,dc=example,dc=com"},
+			},
+		}, nil
+	}
+}
+
+func TestGetSecretLDAPCredentials(t *testing.T) {
+	var paths []string
+	c := &client{
+		store: makeLDAPSecretStore(""),
+		logical: fake.Logical{
+			ReadWithDataWithContextFn: newLDAPCredsReadFn(&paths),
+		},
+	}
+
+	username, err := c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "dev", Property: "username"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	password, err := c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "dev", Property: "password"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(username) != "v_dev_1234" || string(password) != "s3cr3t" {
+		t.Errorf("unexpected credentials %q/%q", username, password)
+	}
+	if diff := cmp.Diff([]string{"ldap/creds/dev"}, paths); diff != "" {
+		t.Errorf("credentials must be read once per role: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{ldapLeaseID}, c.IssuedLeases()); diff != "" {
+		t.Errorf("IssuedLeases(): -want, +got:\n%s", diff)
+	}
+	if leases := c.IssuedLeases(); leases != nil {
+		t.Errorf("IssuedLeases() must drain the leases, got %v", leases)
+	}
+}
+
+func TestGetSecretMapLDAPCredentials(t *testing.T) {
+	var paths []string
+	c := &client{
+		store: makeLDAPSecretStore("ad"),
+		logical: fake.Logical{
+			ReadWithDataWithContextFn: newLDAPCredsReadFn(&paths),
+		},
+	}
+
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "dev"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string][]byte{
+		"username": []byte("v_dev_1234"),
+		"password": []byte("s3cr3t"),
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetSecretMap(): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"ad/creds/dev"}, paths); diff != "" {
+		t.Errorf("unexpected read paths: -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetSecretLDAPRoleNotFound(t *testing.T) {
+	c := &client{
+		store:   makeLDAPSecretStore(""),
+		logical: fake.NewVaultLogical(),
+	}
+	_, err := c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "dev"})
+	if !errors.Is(err, esv1beta1.NoSecretError{}) {
+		t.Errorf("expected NoSecretError, got %v", err)
+	}
+	if leases := c.IssuedLeases(); leases != nil {
+		t.Errorf("expected no leases, got %v", leases)
+	}
+}
+
+func TestRenewLease(t *testing.T) {
+	tests := []struct {
+		name    string
+		secret  *vault.Secret
+		err     error
+		wantTTL time.Duration
+		wantErr bool
+	}{
+		{
+			name:    "lease is extended by the increment",
+			secret:  &vault.Secret{LeaseID: ldapLeaseID, LeaseDuration: 7200, Renewable: true},
+			wantTTL: 2 * time.Hour,
+		},
+		{
+			name:    "lease is capped at its max TTL",
+			secret:  &vault.Secret{LeaseID: ldapLeaseID, LeaseDuration: 600, Renewable: true},
+			wantTTL: 10 * time.Minute,
+		},
+		{
+			name:    "lease has expired",
+			err:     errors.New("lease not found"),
+			wantErr: true,
+		},
+		{
+			name:    "empty response",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotPath string
+			var gotData map[string]any
+			c := &client{
+				store: makeLDAPSecretStore(""),
+				logical: fake.Logical{
+					WriteWithContextFn: func(ctx context.Context, path string, data map[string]any) (*vault.Secret, error) {
+						gotPath = path
+						gotData = data
+						return tt.secret, tt.err
+					},
+				},
+			}
+			ttl, err := c.RenewLease(context.Background(), ldapLeaseID, 2*time.Hour)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RenewLease() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if ttl != tt.wantTTL {
+				t.Errorf("RenewLease() = %v, want %v", ttl, tt.wantTTL)
+			}
+			if gotPath != "sys/leases/renew" {
+				t.Errorf("unexpected path %q", gotPath)
+			}
+			if diff := cmp.Diff(map[string]any{"lease_id": ldapLeaseID, "increment": 7200}, gotData); diff != "" {
+				t.Errorf("unexpected data: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRevokeLease(t *testing.T) {
+	var gotPath string
+	var gotData map[string]any
+	c := &client{
+		store: makeLDAPSecretStore(""),
+		logical: fake.Logical{
+			WriteWithContextFn: func(ctx context.Context, path string, data map[string]any) (*vault.Secret, error) {
+				gotPath = path
+				gotData = data
+				return nil, nil
+			},
+		},
+	}
+	if err := c.RevokeLease(context.Background(), ldapLeaseID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if gotPath != "sys/leases/revoke" {
+		t.Errorf("unexpected path %q", gotPath)
+	}
+	if diff := cmp.Diff(map[string]any{"lease_id": ldapLeaseID}, gotData); diff != "" {
+		t.Errorf("unexpected data: -want, +got:\n%s", diff)
+	}
+
+	c.logical = fake.Logical{
+		WriteWithContextFn: fake.NewWriteWithContextFn(nil, errors.New("boom")),
+	}
+	if err := c.RevokeLease(context.Background(), ldapLeaseID); err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func TestCloseKeepsTokenOfLeases(t *testing.T) {
+	var paths []string
+	revoked := false
+	logical := fake.Logical{
+		ReadWithDataWithContextFn: newLDAPCredsReadFn(&paths),
+	}
+	c := &client{
+		store: makeLDAPSecretStore(""),
+		client: &util.VaultClient{
+			LogicalField: logical,
+			AuthTokenField: fake.Token{
+				LookupSelfWithContextFn: func(ctx context.Context) (*vault.Secret, error) {
+					return &vault.Secret{}, nil
+				},
+				RevokeSelfWithContextFn: func(ctx context.Context, token string) error {
+					revoked = true
+					return nil
+				},
+			},
+			TokenFunc:      fake.NewTokenFn("token"),
+			ClearTokenFunc: fake.NewClearTokenFn(),
+		},
+		logical: logical,
+	}
+	if _, err := c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "dev"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := c.Close(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if revoked {
+		t.Errorf("the token that issued a lease must not be revoked")
+	}
+}
+
+func TestLDAPSecretsEngineUnsupported(t *testing.T) {
+	c := &client{
+		store:   makeLDAPSecretStore(""),
+		logical: fake.NewVaultLogical(),
+	}
+	ctx := context.Background()
+	ref := testingfake.PushSecretData{RemoteKey: "dev"}
+
+	if _, err := c.GetAllSecrets(ctx, esv1beta1.ExternalSecretFind{}); err == nil || err.Error() != errDynamicSecretsEngineUnsupported {
+		t.Errorf("GetAllSecrets(): unexpected error %v", err)
+	}
+	if err := c.PushSecret(ctx, &corev1.Secret{}, ref); err == nil || err.Error() != errDynamicSecretsEngineUnsupported {
+		t.Errorf("PushSecret(): unexpected error %v", err)
+	}
+	if err := c.DeleteSecret(ctx, ref); err == nil || err.Error() != errDynamicSecretsEngineUnsupported {
+		t.Errorf("DeleteSecret(): unexpected error %v", err)
+	}
+	if _, err := c.SecretExists(ctx, ref); err == nil || err.Error() != errDynamicSecretsEngineUnsupported {
+		t.Errorf("SecretExists(): unexpected error %v", err)
+	}
+}
+
+const databaseLeaseID = "database/creds/readonly/9c1d"
+
+// newDatabaseVaultServer fakes the HTTP API of a database secrets engine and records the requests.
+func newDatabaseVaultServer(t *testing.T, requests *[]string) *httptest.Server {
+	t.Helper()
+	var mu sync.Mutex
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		*requests = append(*requests, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/database/creds/readonly", "/v1/postgres/creds/readonly":
+			_, _ = w.Write([]byte(`{"lease_id":"` + databaseLeaseID + `","lease_duration":3600,"renewable":true,` +
+				`"data":{"username":"v-token-readonly-x7k2","password":"A1a-9dkq"}}`))
+		case "/v1/sys/leases/renew":
+			var body map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["lease_id"] != databaseLeaseID {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"errors":["lease not found"]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"lease_id":"` + databaseLeaseID + `","lease_duration":1800,"renewable":true}`))
+		case "/v1/sys/leases/revoke":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newDatabaseClient(t *testing.T, server, path string) *client {
+	t.Helper()
+	store := makeValidSecretStore()
+	store.Spec.Provider.Vault.Server = server
+	store.Spec.Provider.Vault.Auth = esv1beta1.VaultAuth{
+		TokenSecretRef: &esmeta.SecretKeySelector{Name: "vault-secret", Key: "token"},
+	}
+	store.Spec.Provider.Vault.DatabaseSecretsEngine = &esv1beta1.VaultDatabaseSecretsEngine{Path: path}
+	kube := clientfake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "vault-secret", Namespace: "default"},
+		Data:       map[string][]byte{"token": []byte("token")},
+	}).Build()
+
+	prov := &Provider{NewVaultClient: NewVaultClient}
+	sc, err := prov.newClient(context.Background(), store, kube, nil, "default")
+	if err != nil {
+		t.Fatalf("newClient() error = %v", err)
+	}
+	return sc.(*client)
+}
+
+func TestDatabaseCredentials(t *testing.T) {
+	var requests []string
+	srv := newDatabaseVaultServer(t, &requests)
+	c := newDatabaseClient(t, srv.URL, "")
+	ctx := context.Background()
+
+	username, err := c.GetSecret(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: "readonly", Property: "username"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	password, err := c.GetSecret(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: "readonly", Property: "password"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(username) != "v-token-readonly-x7k2" || string(password) != "A1a-9dkq" {
+		t.Errorf("unexpected credentials %q/%q", username, password)
+	}
+	if diff := cmp.Diff([]string{databaseLeaseID}, c.IssuedLeases()); diff != "" {
+		t.Errorf("IssuedLeases(): -want, +got:\n%s", diff)
+	}
+
+	ttl, err := c.RenewLease(ctx, databaseLeaseID, time.Hour)
+	if err != nil {
+		t.Fatalf("RenewLease() error = %v", err)
+	}
+	if ttl != 30*time.Minute {
+		t.Errorf("RenewLease() = %v, want %v", ttl, 30*time.Minute)
+	}
+	if _, err := c.RenewLease(ctx, "database/creds/readonly/expired", time.Hour); err == nil {
+		t.Errorf("RenewLease() of an unknown lease must fail")
+	}
+	if err := c.RevokeLease(ctx, databaseLeaseID); err != nil {
+		t.Fatalf("RevokeLease() error = %v", err)
+	}
+
+	want := []string{
+		"GET /v1/database/creds/readonly",
+		"PUT /v1/sys/leases/renew",
+		"PUT /v1/sys/leases/renew",
+		"PUT /v1/sys/leases/revoke",
+	}
+	if diff := cmp.Diff(want, requests); diff != "" {
+		t.Errorf("unexpected requests: -want, +got:\n%s", diff)
+	}
+}
+
+func TestGetSecretMapDatabaseCredentials(t *testing.T) {
+	var requests []string
+	srv := newDatabaseVaultServer(t, &requests)
+	c := newDatabaseClient(t, srv.URL, "postgres")
+
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "readonly"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string][]byte{
+		"username": []byte("v-token-readonly-x7k2"),
+		"password": []byte("A1a-9dkq"),
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("GetSecretMap(): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff("GET /v1/postgres/creds/readonly", requests[len(requests)-1]); diff != "" {
+		t.Errorf("unexpected request: -want, +got:\n%s", diff)
+	}
+
+	_, err = c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "missing"})
+	if !errors.Is(err, esv1beta1.NoSecretError{}) {
+		t.Errorf("expected NoSecretError, got %v", err)
+	}
+}
@@ -47,6 +47,13 @@ const (
 func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
 	var data map[string]any
 	var err error
+	if c.usesDynamicSecretsEngine() {
+		data, err = c.readDynamicCredentials(ctx, ref.Key)
+		if err != nil {
+			return nil, err
+		}
+		return getSecretValue(data, ref.Property)
+	}
 	if ref.MetadataPolicy == esv1beta1.ExternalSecretMetadataPolicyFetch {
 		if c.store.Version == esv1beta1.VaultKVStoreV1 {
 			return nil, errors.New(errUnsupportedMetadataKvVersion)
@@ -99,6 +106,9 @@ func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretD
 }
 
 func (c *client) SecretExists(ctx context.Context, ref esv1beta1.PushSecretRemoteRef) (bool, error) {
+	if c.usesDynamicSecretsEngine() {
+		return false, errors.New(errDynamicSecretsEngineUnsupported)
+	}
 	path := c.buildPath(ref.GetRemoteKey())
 	data, err := c.readSecret(ctx, path, "")
 	if err != nil {
@@ -215,7 +225,11 @@ func (c *client) readSecretMetadata(ctx context.Context, path string) (map[strin
 func (c *client) buildMetadataPath(path string) (string, error) {
 	var url string
 	if c.store.Version == esv1beta1.VaultKVStoreV1 {
-		url = fmt.Sprintf("%s/%s", *c.store.Path, path)
+		// KV v1 lists secrets on the path of the secrets, it has no metadata path
+		url = path
+		if c.store.Path != nil {
+			url = fmt.Sprintf("%s/%s", *c.store.Path, path)
+		}
 	} else { // KV v2 is used
 		if c.store.Path == nil && !strings.Contains(path, "data") {
 			return "", fmt.Errorf(errPathInvalid)
@@ -27,19 +27,23 @@ import (
 )
 
 const (
-	errUnsupportedKvVersion = "cannot perform find operations with kv version v1"
+	errUnsupportedKvVersion = "cannot find secrets by tags with kv version v1"
 )
 
 // GetAllSecrets gets multiple secrets from the provider and loads into a kubernetes secret.
 // First load all secrets from secretStore path configuration
 // Then, gets secrets from a matching name or matching custom_metadata.
 func (c *client) GetAllSecrets(ctx context.Context, ref esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
-	if c.store.Version == esv1beta1.VaultKVStoreV1 {
+	if c.usesDynamicSecretsEngine() {
+		return nil, errors.New(errDynamicSecretsEngineUnsupported)
+	}
+	// KV v1 can list secrets but has no custom metadata to match tags against
+	if c.store.Version == esv1beta1.VaultKVStoreV1 && ref.Name == nil {
 		return nil, errors.New(errUnsupportedKvVersion)
 	}
 	searchPath := ""
 	if ref.Path != nil {
-		searchPath = *ref.Path + "/"
+		searchPath = strings.TrimSuffix(*ref.Path, "/") + "/"
 	}
 	potentialSecrets, err := c.listSecrets(ctx, searchPath)
 	if err != nil {
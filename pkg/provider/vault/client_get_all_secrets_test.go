@@ -33,8 +33,10 @@ func TestGetAllSecrets(t *testing.T) {
 	secret2Bytes := []byte("{\"access_key\":\"access_key2\",\"access_secret\":\"access_secret2\"}")
 	path1Bytes := []byte("{\"access_key\":\"path1\",\"access_secret\":\"path1\"}")
 	path2Bytes := []byte("{\"access_key\":\"path2\",\"access_secret\":\"path2\"}")
+	nestedBytes := []byte("{\"access_key\":\"nested\",\"access_secret\":\"nested\"}")
 	tagBytes := []byte("{\"access_key\":\"unfetched\",\"access_secret\":\"unfetched\"}")
 	path := "path"
+	pathWithSlash := "path/"
 	secret := map[string]any{
 		"secret1": map[string]any{
 			"metadata": map[string]any{
@@ -99,6 +101,17 @@ func TestGetAllSecrets(t *testing.T) {
 				"access_secret": "path2",
 			},
 		},
+		"path/nested/deep/3": map[string]any{
+			"metadata": map[string]any{
+				"custom_metadata": map[string]any{
+					"foo": "nested",
+				},
+			},
+			"data": map[string]any{
+				"access_key":    "nested",
+				"access_secret": "nested",
+			},
+		},
 		"default": map[string]any{
 			"data": map[string]any{
 				"empty": "true",
@@ -112,7 +125,17 @@ func TestGetAllSecrets(t *testing.T) {
 				"empty": "true",
 			},
 			"metadata": map[string]any{
-				"keys": []any{"1", "2"},
+				"keys": []any{"1", "2", "nested/"},
+			},
+		},
+		"path/nested/": map[string]any{
+			"metadata": map[string]any{
+				"keys": []any{"deep/"},
+			},
+		},
+		"path/nested/deep/": map[string]any{
+			"metadata": map[string]any{
+				"keys": []any{"3"},
 			},
 		},
 	}
@@ -201,8 +224,78 @@ func TestGetAllSecrets(t *testing.T) {
 				},
 			},
 		},
-		"FailIfKv1": {
-			reason: "should not work if using kv1 store",
+		"FindByNameRecursive": {
+			reason: "should list secrets of nested paths",
+			args: args{
+				store: makeValidSecretStoreWithVersion(esv1beta1.VaultKVStoreV2).Spec.Provider.Vault,
+				vLogical: &fake.Logical{
+					ListWithContextFn:         newListWithContextFn(secret),
+					ReadWithDataWithContextFn: newReadtWithContextFn(secret),
+				},
+				data: esv1beta1.ExternalSecretFind{
+					Path: &pathWithSlash,
+					Name: &esv1beta1.FindName{
+						RegExp: ".*",
+					},
+				},
+			},
+			want: want{
+				err: nil,
+				val: map[string][]byte{
+					"path/1":             path1Bytes,
+					"path/2":             path2Bytes,
+					"path/nested/deep/3": nestedBytes,
+				},
+			},
+		},
+		"FindByNameKv1": {
+			reason: "should map multiple secrets matching name of a kv1 store",
+			args: args{
+				store: makeValidSecretStoreWithVersion(esv1beta1.VaultKVStoreV1).Spec.Provider.Vault,
+				vLogical: &fake.Logical{
+					ListWithContextFn:         newListWithContextFn(secret),
+					ReadWithDataWithContextFn: newReadV1WithContextFn(secret),
+				},
+				data: esv1beta1.ExternalSecretFind{
+					Name: &esv1beta1.FindName{
+						RegExp: "secret.*",
+					},
+				},
+			},
+			want: want{
+				err: nil,
+				val: map[string][]byte{
+					"secret1": secret1Bytes,
+					"secret2": secret2Bytes,
+				},
+			},
+		},
+		"FindByNameRecursiveKv1": {
+			reason: "should list secrets of nested paths of a kv1 store",
+			args: args{
+				store: makeValidSecretStoreWithVersion(esv1beta1.VaultKVStoreV1).Spec.Provider.Vault,
+				vLogical: &fake.Logical{
+					ListWithContextFn:         newListWithContextFn(secret),
+					ReadWithDataWithContextFn: newReadV1WithContextFn(secret),
+				},
+				data: esv1beta1.ExternalSecretFind{
+					Path: &pathWithSlash,
+					Name: &esv1beta1.FindName{
+						RegExp: ".*",
+					},
+				},
+			},
+			want: want{
+				err: nil,
+				val: map[string][]byte{
+					"path/1":             path1Bytes,
+					"path/2":             path2Bytes,
+					"path/nested/deep/3": nestedBytes,
+				},
+			},
+		},
+		"FailIfKv1Tags": {
+			reason: "should not find secrets by tags if using kv1 store",
 			args: args{
 				store: makeValidSecretStoreWithVersion(esv1beta1.VaultKVStoreV1).Spec.Provider.Vault,
 				vLogical: &fake.Logical{
@@ -262,7 +355,9 @@ func TestGetAllSecrets(t *testing.T) {
 
 func newListWithContextFn(secrets map[string]any) func(ctx context.Context, path string) (*vault.Secret, error) {
 	return func(ctx context.Context, path string) (*vault.Secret, error) {
+		// kv2 lists secrets on the metadata path, kv1 on the path of the secrets
 		path = strings.TrimPrefix(path, "secret/metadata/")
+		path = strings.TrimPrefix(path, "secret/")
 		if path == "" {
 			path = "default"
 		}
@@ -304,3 +399,16 @@ func newReadtWithContextFn(secrets map[string]any) func(ctx context.Context, pat
 		return secret, nil
 	}
 }
+
+// newReadV1WithContextFn reads secrets of a kv1 store, which returns the data of a secret at the top level.
+func newReadV1WithContextFn(secrets map[string]any) func(ctx context.Context, path string, data map[string][]string) (*vault.Secret, error) {
+	return func(ctx context.Context, path string, d map[string][]string) (*vault.Secret, error) {
+		path = strings.TrimPrefix(path, "secret/")
+		data, ok := secrets[path]
+		if !ok {
+			return nil, errors.New("Secret not found")
+		}
+		content, _ := data.(map[string]any)["data"].(map[string]any)
+		return &vault.Secret{Data: content}, nil
+	}
+}
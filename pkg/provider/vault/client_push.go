@@ -20,7 +20,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 
+	vault "github.com/hashicorp/vault/api"
 	corev1 "k8s.io/api/core/v1"
 
 	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
@@ -29,11 +31,54 @@ import (
 	"github.com/external-secrets/external-secrets/pkg/utils"
 )
 
+const (
+	// PushSecretMergePolicy is the metadata key of a PushSecret data entry that selects
+	// whether the pushed keys replace the secret in Vault or are merged into it.
+	PushSecretMergePolicy = "mergePolicy"
+	MergePolicyReplace    = "Replace"
+	MergePolicyMerge      = "Merge"
+
+	errInvalidMergePolicy = "invalid %s %q, must be %s or %s"
+)
+
+// PermissionDeniedError is returned by PushSecret when the token
+// lacks the capabilities to write the secret to its path.
+type PermissionDeniedError struct {
+	Path string
+	Err  error
+}
+
+func (e *PermissionDeniedError) Error() string {
+	return fmt.Sprintf("permission denied to update %s, the token needs the create and update capabilities: %v", e.Path, e.Err)
+}
+
+func (e *PermissionDeniedError) Unwrap() error {
+	return e.Err
+}
+
+// writeSecret writes data to path, returning a PermissionDeniedError if Vault refuses it.
+func (c *client) writeSecret(ctx context.Context, path string, data map[string]any) error {
+	_, err := c.logical.WriteWithContext(ctx, path, data)
+	metrics.ObserveAPICall(constants.ProviderHCVault, constants.CallHCVaultWriteSecretData, err)
+	var respErr *vault.ResponseError
+	if errors.As(err, &respErr) && respErr.StatusCode == http.StatusForbidden {
+		return &PermissionDeniedError{Path: path, Err: err}
+	}
+	return err
+}
+
 func (c *client) PushSecret(ctx context.Context, secret *corev1.Secret, data esv1beta1.PushSecretData) error {
-	var (
-		value []byte
-		err   error
-	)
+	if c.usesDynamicSecretsEngine() {
+		return errors.New(errDynamicSecretsEngineUnsupported)
+	}
+	mergePolicy, err := utils.FetchValueFromMetadata(PushSecretMergePolicy, data.GetMetadata(), MergePolicyReplace)
+	if err != nil {
+		return err
+	}
+	if mergePolicy != MergePolicyReplace && mergePolicy != MergePolicyMerge {
+		return fmt.Errorf(errInvalidMergePolicy, PushSecretMergePolicy, mergePolicy, MergePolicyReplace, MergePolicyMerge)
+	}
+	var value []byte
 	key := data.GetSecretKey()
 	if key == "" {
 		// Must convert secret values to string, otherwise data will be sent as base64 to Vault
@@ -117,6 +162,23 @@ func (c *client) PushSecret(ctx context.Context, secret *corev1.Secret, data esv
 		if err != nil {
 			return fmt.Errorf("error unmarshalling vault secret: %w", err)
 		}
+		// With the Merge policy the keys that are not pushed are kept
+		if mergePolicy == MergePolicyMerge && len(vaultSecret) > 0 {
+			for k, v := range vaultSecret {
+				if _, ok := secretVal[k]; !ok {
+					secretVal[k] = v
+				}
+			}
+			merged := &bytes.Buffer{}
+			enc := json.NewEncoder(merged)
+			enc.SetEscapeHTML(false)
+			if err := enc.Encode(secretVal); err != nil {
+				return fmt.Errorf("error encoding vault secret: %w", err)
+			}
+			if bytes.Equal(bytes.TrimSpace(merged.Bytes()), vaultSecretValue) {
+				return nil
+			}
+		}
 	}
 	secretToPush := secretVal
 	// Adding custom_metadata to the secret for KV v1
@@ -133,19 +195,18 @@ func (c *client) PushSecret(ctx context.Context, secret *corev1.Secret, data esv
 	}
 	// Secret metadata should be pushed separately only for KV2
 	if c.store.Version == esv1beta1.VaultKVStoreV2 {
-		_, err = c.logical.WriteWithContext(ctx, metaPath, label)
-		metrics.ObserveAPICall(constants.ProviderHCVault, constants.CallHCVaultWriteSecretData, err)
-		if err != nil {
+		if err := c.writeSecret(ctx, metaPath, label); err != nil {
 			return err
 		}
 	}
 	// Otherwise, create or update the version.
-	_, err = c.logical.WriteWithContext(ctx, path, secretToPush)
-	metrics.ObserveAPICall(constants.ProviderHCVault, constants.CallHCVaultWriteSecretData, err)
-	return err
+	return c.writeSecret(ctx, path, secretToPush)
 }
 
 func (c *client) DeleteSecret(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) error {
+	if c.usesDynamicSecretsEngine() {
+		return errors.New(errDynamicSecretsEngineUnsupported)
+	}
 	path := c.buildPath(remoteRef.GetRemoteKey())
 	metaPath, err := c.buildMetadataPath(remoteRef.GetRemoteKey())
 	if err != nil {
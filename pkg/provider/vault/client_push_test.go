@@ -18,10 +18,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 	"testing"
 
+	vault "github.com/hashicorp/vault/api"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 
 	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
 	testingfake "github.com/external-secrets/external-secrets/pkg/provider/testing/fake"
@@ -416,6 +419,104 @@ func TestPushSecret(t *testing.T) {
 				err: noPermission,
 			},
 		},
+		"SetSecretPermissionDenied": {
+			reason: "secret cannot be pushed if the token lacks the update capability",
+			args: args{
+				store: makeValidSecretStoreWithVersion(esv1beta1.VaultKVStoreV2).Spec.Provider.Vault,
+				vLogical: &fake.Logical{
+					ReadWithDataWithContextFn: fake.NewReadWithContextFn(nil, nil),
+					WriteWithContextFn:        fake.NewWriteWithContextFn(nil, &vault.ResponseError{StatusCode: http.StatusForbidden, Errors: []string{"permission denied"}}),
+				},
+			},
+			want: want{
+				err: errors.New("permission denied to update"),
+			},
+		},
+		"MergeSecretKV1": {
+			reason: "with the Merge policy keys of the vault secret that are not pushed are kept",
+			value:  []byte(`{"foo":"new-value"}`),
+			data:   &testingfake.PushSecretData{SecretKey: secretKey, RemoteKey: "secret", Metadata: &apiextensionsv1.JSON{Raw: []byte(`{"mergePolicy":"Merge"}`)}},
+			args: args{
+				store: makeValidSecretStoreWithVersion(esv1beta1.VaultKVStoreV1).Spec.Provider.Vault,
+				vLogical: &fake.Logical{
+					ReadWithDataWithContextFn: fake.NewReadWithContextFn(map[string]any{
+						fakeKey: fakeValue,
+						"foo":   fakeValue,
+						"custom_metadata": map[string]any{
+							managedBy: managedByESO,
+						},
+					}, nil),
+					WriteWithContextFn: fake.ExpectWriteWithContextValue(map[string]any{
+						fakeKey: fakeValue,
+						"foo":   "new-value",
+						"custom_metadata": map[string]string{
+							managedBy: managedByESO,
+						},
+					}),
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		"MergeSecretKV2": {
+			reason: "with the Merge policy keys of the vault secret that are not pushed are kept",
+			value:  []byte(`{"foo":"new-value"}`),
+			data:   &testingfake.PushSecretData{SecretKey: secretKey, RemoteKey: "secret", Metadata: &apiextensionsv1.JSON{Raw: []byte(`{"mergePolicy":"Merge"}`)}},
+			args: args{
+				store: makeValidSecretStoreWithVersion(esv1beta1.VaultKVStoreV2).Spec.Provider.Vault,
+				vLogical: &fake.Logical{
+					ReadWithDataWithContextFn: fake.NewReadWithContextFn(map[string]any{
+						"data": map[string]any{
+							fakeKey: fakeValue,
+						},
+						"custom_metadata": map[string]any{
+							managedBy: managedByESO,
+						},
+					}, nil),
+					WriteWithContextFn: fake.ExpectWriteWithContextValue(map[string]any{"data": map[string]any{fakeKey: fakeValue, "foo": "new-value"}}),
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		"MergeSecretUnchangedKV2": {
+			reason: "with the Merge policy the secret is not written if the pushed keys are up to date",
+			value:  []byte(`{"foo":"bar"}`),
+			data:   &testingfake.PushSecretData{SecretKey: secretKey, RemoteKey: "secret", Metadata: &apiextensionsv1.JSON{Raw: []byte(`{"mergePolicy":"Merge"}`)}},
+			args: args{
+				store: makeValidSecretStoreWithVersion(esv1beta1.VaultKVStoreV2).Spec.Provider.Vault,
+				vLogical: &fake.Logical{
+					ReadWithDataWithContextFn: fake.NewReadWithContextFn(map[string]any{
+						"data": map[string]any{
+							fakeKey: fakeValue,
+							"foo":   "bar",
+						},
+						"custom_metadata": map[string]any{
+							managedBy: managedByESO,
+						},
+					}, nil),
+					WriteWithContextFn: fake.ExpectWriteWithContextNoCall(),
+				},
+			},
+			want: want{
+				err: nil,
+			},
+		},
+		"InvalidMergePolicy": {
+			reason: "an unknown merge policy is rejected",
+			data:   &testingfake.PushSecretData{SecretKey: secretKey, RemoteKey: "secret", Metadata: &apiextensionsv1.JSON{Raw: []byte(`{"mergePolicy":"Append"}`)}},
+			args: args{
+				store: makeValidSecretStoreWithVersion(esv1beta1.VaultKVStoreV2).Spec.Provider.Vault,
+				vLogical: &fake.Logical{
+					WriteWithContextFn: fake.ExpectWriteWithContextNoCall(),
+				},
+			},
+			want: want{
+				err: errors.New(`invalid mergePolicy "Append"`),
+			},
+		},
 		"SetSecretEqualsPushSecretV1": {
 			reason: "vault secret kv equals secret to push kv",
 			args: args{
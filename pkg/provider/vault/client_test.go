@@ -0,0 +1,99 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	vault "github.com/hashicorp/vault/api"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+func TestReadYourWritesForwardsIndexHeader(t *testing.T) {
+	// replication state as returned by Vault: base64("v1:<cluster-id>:<local index>:<replicated index>:<hmac>")
+	index := base64.StdEncoding.EncodeToString([]byte("v1:cluster-id:42:0:"))
+
+	cases := map[string]struct {
+		readYourWrites bool
+		wantIndex      string
+	}{
+		"ReadYourWritesEnabled": {
+			readYourWrites: true,
+			wantIndex:      index,
+		},
+		"ReadYourWritesDisabled": {
+			readYourWrites: false,
+			wantIndex:      "",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var (
+				mu       sync.Mutex
+				gotIndex string
+			)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				switch r.Method {
+				case http.MethodPut, http.MethodPost:
+					w.Header().Set(vault.HeaderIndex, index)
+				case http.MethodGet:
+					gotIndex = r.Header.Get(vault.HeaderIndex)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"data":{"data":{"foo":"bar"}}}`))
+			}))
+			defer srv.Close()
+
+			c := &client{
+				store: &esv1beta1.VaultProvider{
+					Server:         srv.URL,
+					ReadYourWrites: tc.readYourWrites,
+				},
+			}
+			cfg, err := c.newConfig(context.Background())
+			if err != nil {
+				t.Fatalf("newConfig() error = %v", err)
+			}
+			vc, err := vault.NewClient(cfg)
+			if err != nil {
+				t.Fatalf("vault.NewClient() error = %v", err)
+			}
+			vc.SetToken("token")
+
+			ctx := context.Background()
+			if _, err := vc.Logical().WriteWithContext(ctx, "secret/data/foo", map[string]any{"data": map[string]any{"foo": "bar"}}); err != nil {
+				t.Fatalf("write error = %v", err)
+			}
+			if _, err := vc.Logical().ReadWithContext(ctx, "secret/data/foo"); err != nil {
+				t.Fatalf("read error = %v", err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if gotIndex != tc.wantIndex {
+				t.Errorf("%s header on read = %q, want %q", vault.HeaderIndex, gotIndex, tc.wantIndex)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	vault "github.com/hashicorp/vault/api"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/provider/vault/util"
+)
+
+const (
+	healthPath = "sys/health"
+
+	// statusDRSecondary and statusPerformanceStandby are answered by sys/health
+	// on the nodes of replicated clusters which can not serve the requests of the store.
+	statusDRSecondary        = 472
+	statusPerformanceStandby = 473
+
+	errNoClusterReachable = "unable to reach a healthy Vault cluster: %w"
+)
+
+// healthParams makes standby nodes answer sys/health with 200, they forward requests to the active node.
+var healthParams = map[string][]string{"standbyok": {"true"}}
+
+// clusterClient is the client of a store with clusters, it keeps the address of the cluster it connects to.
+type clusterClient struct {
+	util.Client
+	address string
+}
+
+// newVaultClient returns a client for the server of the store, or for the first healthy of the server
+// and the clusters when the store lists clusters.
+func (p *Provider) newVaultClient(ctx context.Context, cfg *vault.Config, vaultSpec *esv1beta1.VaultProvider) (util.Client, error) {
+	if len(vaultSpec.Clusters) == 0 {
+		return p.NewVaultClient(cfg)
+	}
+	return p.failover(ctx, cfg, vaultSpec)
+}
+
+// failover returns a client for the first of the server and the clusters of the store
+// which is healthy, cfg.Address is set to its address.
+func (p *Provider) failover(ctx context.Context, cfg *vault.Config, vaultSpec *esv1beta1.VaultProvider) (util.Client, error) {
+	addresses := make([]string, 0, len(vaultSpec.Clusters)+1)
+	addresses = append(addresses, vaultSpec.Server)
+	for _, cluster := range vaultSpec.Clusters {
+		addresses = append(addresses, cluster.Server)
+	}
+	var errs error
+	for _, address := range addresses {
+		cfg.Address = address
+		client, err := p.NewVaultClient(cfg)
+		if err != nil {
+			return nil, err
+		}
+		err = checkHealth(ctx, client)
+		if err == nil {
+			return &clusterClient{Client: client, address: address}, nil
+		}
+		logger.V(1).Info("Vault cluster is not available, failing over", "address", address, "error", err.Error())
+		errs = errors.Join(errs, err)
+	}
+	return nil, fmt.Errorf(errNoClusterReachable, errs)
+}
+
+// checkHealth returns an error when the cluster of the client is not available.
+func checkHealth(ctx context.Context, client util.Client) error {
+	// sys/health does not require a token
+	_, err := client.Logical().ReadWithDataWithContext(ctx, healthPath, healthParams)
+	if isUnavailable(err) {
+		return err
+	}
+	return nil
+}
+
+// isUnavailable returns whether the sys/health request failed because the cluster can not
+// be reached, or answered that it can not serve requests: 5xx when it is sealed or not
+// initialized, 472 for a disaster recovery secondary and 473 for a performance standby.
+func isUnavailable(err error) bool {
+	var respErr *vault.ResponseError
+	if errors.As(err, &respErr) {
+		return respErr.StatusCode >= http.StatusInternalServerError ||
+			respErr.StatusCode == statusDRSecondary ||
+			respErr.StatusCode == statusPerformanceStandby
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// ActiveCluster returns the address of the cluster in use, it is empty for stores without clusters.
+func (c *client) ActiveCluster() string {
+	if cc, ok := c.client.(*clusterClient); ok {
+		return cc.address
+	}
+	return ""
+}
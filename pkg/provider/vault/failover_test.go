@@ -0,0 +1,308 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+	"github.com/external-secrets/external-secrets/pkg/cache"
+	"github.com/external-secrets/external-secrets/pkg/provider/vault/util"
+)
+
+// mockVault serves the health endpoint and a KV v2 secret, it counts the requests it receives.
+type mockVault struct {
+	*httptest.Server
+
+	mu           sync.Mutex
+	healthStatus int
+	requests     int
+}
+
+func newMockVault(t *testing.T, healthStatus int, password string) *mockVault {
+	t.Helper()
+	m := &mockVault{healthStatus: healthStatus}
+	m.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.mu.Lock()
+		m.requests++
+		m.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/v1/sys/health":
+			m.mu.Lock()
+			status := m.healthStatus
+			m.mu.Unlock()
+			if status == http.StatusTooManyRequests && r.URL.Query().Get("standbyok") == "true" {
+				status = http.StatusOK
+			}
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`{"initialized":true,"sealed":false,"standby":false}`))
+		case "/v1/secret/data/app":
+			_, _ = w.Write([]byte(`{"data":{"data":{"password":"` + password + `"}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+		}
+	}))
+	t.Cleanup(m.Close)
+	return m
+}
+
+func (m *mockVault) setHealthStatus(status int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.healthStatus = status
+}
+
+func (m *mockVault) requestCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.requests
+}
+
+// unreachableAddress returns the address of a server which has been shut down.
+func unreachableAddress(t *testing.T) string {
+	t.Helper()
+	srv := httptest.NewServer(http.NotFoundHandler())
+	srv.Close()
+	return srv.URL
+}
+
+// newFailoverStore returns a store reading "secret/app" with a static token, and a
+// Kubernetes client holding the store and the token.
+func newFailoverStore(t *testing.T, server string, clusters ...string) (*esv1beta1.SecretStore, kclient.Client) {
+	t.Helper()
+	vaultClusters := make([]esv1beta1.VaultClusterConfig, 0, len(clusters))
+	for _, address := range clusters {
+		vaultClusters = append(vaultClusters, esv1beta1.VaultClusterConfig{Server: address})
+	}
+	path := "secret"
+	maxRetries := int32(0)
+	store := &esv1beta1.SecretStore{
+		ObjectMeta: metav1.ObjectMeta{Name: "vault", Namespace: "default"},
+		Spec: esv1beta1.SecretStoreSpec{
+			RetrySettings: &esv1beta1.SecretStoreRetrySettings{MaxRetries: &maxRetries},
+			Provider: &esv1beta1.SecretStoreProvider{
+				Vault: &esv1beta1.VaultProvider{
+					Server:   server,
+					Clusters: vaultClusters,
+					Path:     &path,
+					Version:  esv1beta1.VaultKVStoreV2,
+					Auth: esv1beta1.VaultAuth{
+						TokenSecretRef: &esmeta.SecretKeySelector{Name: "vault-token", Key: "token"},
+					},
+				},
+			},
+		},
+	}
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("unable to build scheme: %v", err)
+	}
+	if err := esv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("unable to build scheme: %v", err)
+	}
+	kube := clientfake.NewClientBuilder().WithScheme(scheme).WithObjects(store.DeepCopy(), &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "vault-token", Namespace: "default"},
+		Data:       map[string][]byte{"token": []byte("root")},
+	}).Build()
+	return store, kube
+}
+
+func TestFailover(t *testing.T) {
+	primary := newMockVault(t, http.StatusOK, "primary")
+	secondary := newMockVault(t, http.StatusOK, "secondary")
+	sealed := newMockVault(t, http.StatusServiceUnavailable, "sealed")
+	drSecondary := newMockVault(t, statusDRSecondary, "dr-secondary")
+	perfStandby := newMockVault(t, statusPerformanceStandby, "performance-standby")
+	standby := newMockVault(t, http.StatusTooManyRequests, "standby")
+	down := unreachableAddress(t)
+
+	cases := map[string]struct {
+		server       string
+		clusters     []string
+		wantPassword string
+		wantActive   string
+		wantErr      string
+		unused       *mockVault
+	}{
+		"ServerReachable": {
+			server:       primary.URL,
+			clusters:     []string{secondary.URL},
+			wantPassword: "primary",
+			wantActive:   primary.URL,
+			unused:       secondary,
+		},
+		"FailoverToCluster": {
+			server:       down,
+			clusters:     []string{secondary.URL},
+			wantPassword: "secondary",
+			wantActive:   secondary.URL,
+		},
+		"FailoverInOrder": {
+			server:       down,
+			clusters:     []string{down, primary.URL, secondary.URL},
+			wantPassword: "primary",
+			wantActive:   primary.URL,
+			unused:       secondary,
+		},
+		"SealedClusterFailsOver": {
+			server:       sealed.URL,
+			clusters:     []string{secondary.URL},
+			wantPassword: "secondary",
+			wantActive:   secondary.URL,
+		},
+		"ReplicationSecondariesFailOver": {
+			server:       drSecondary.URL,
+			clusters:     []string{perfStandby.URL, secondary.URL},
+			wantPassword: "secondary",
+			wantActive:   secondary.URL,
+		},
+		"StandbyDoesNotFailOver": {
+			server:       standby.URL,
+			clusters:     []string{secondary.URL},
+			wantPassword: "standby",
+			wantActive:   standby.URL,
+			unused:       secondary,
+		},
+		"NoClusterReachable": {
+			server:   down,
+			clusters: []string{down},
+			wantErr:  "unable to reach a healthy Vault cluster",
+		},
+		"NoClusterHealthy": {
+			server:   sealed.URL,
+			clusters: []string{down, drSecondary.URL},
+			wantErr:  "unable to reach a healthy Vault cluster",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var unusedBefore int
+			if tc.unused != nil {
+				unusedBefore = tc.unused.requestCount()
+			}
+			store, kube := newFailoverStore(t, tc.server, tc.clusters...)
+
+			p := &Provider{NewVaultClient: NewVaultClient}
+			ctx := context.Background()
+			sc, err := p.newClient(ctx, store, kube, nil, "default")
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("newClient() error = %v, want %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("newClient() error = %v", err)
+			}
+
+			got, err := sc.GetSecret(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: "app", Property: "password"})
+			if err != nil {
+				t.Fatalf("GetSecret() error = %v", err)
+			}
+			if string(got) != tc.wantPassword {
+				t.Errorf("GetSecret() = %q, want %q", got, tc.wantPassword)
+			}
+
+			if active := sc.(*client).ActiveCluster(); active != tc.wantActive {
+				t.Errorf("ActiveCluster() = %q, want %q", active, tc.wantActive)
+			}
+			if tc.unused != nil && tc.unused.requestCount() != unusedBefore {
+				t.Errorf("cluster %s received requests, want none", tc.unused.URL)
+			}
+		})
+	}
+}
+
+func TestNoActiveClusterWithoutClusters(t *testing.T) {
+	primary := newMockVault(t, http.StatusOK, "primary")
+	store, kube := newFailoverStore(t, primary.URL)
+
+	p := &Provider{NewVaultClient: NewVaultClient}
+	ctx := context.Background()
+	sc, err := p.newClient(ctx, store, kube, nil, "default")
+	if err != nil {
+		t.Fatalf("newClient() error = %v", err)
+	}
+	if primary.requestCount() != 0 {
+		t.Errorf("server received %d requests, want none before reading a secret", primary.requestCount())
+	}
+	if active := sc.(*client).ActiveCluster(); active != "" {
+		t.Errorf("ActiveCluster() = %q for a store without clusters, want none", active)
+	}
+}
+
+func TestFailoverCachedClient(t *testing.T) {
+	defer func(enabled bool, c *cache.Cache[util.Client]) {
+		enableCache, clientCache = enabled, c
+	}(enableCache, clientCache)
+	enableCache = true
+	clientCache = cache.Must[util.Client](10, nil)
+
+	primary := newMockVault(t, http.StatusOK, "primary")
+	secondary := newMockVault(t, http.StatusOK, "secondary")
+	store, kube := newFailoverStore(t, primary.URL, secondary.URL)
+	// clients of stores with a static token are not cached
+	store.Spec.Provider.Vault.Auth = esv1beta1.VaultAuth{
+		AppRole: &esv1beta1.VaultAppRole{Path: "approle", RoleID: "role"},
+	}
+
+	p := &Provider{NewVaultClient: NewVaultClient}
+	ctx := context.Background()
+	getClient := func() *clusterClient {
+		t.Helper()
+		_, cfg, err := p.prepareConfig(ctx, kube, nil, store.Spec.Provider.Vault, store.Spec.RetrySettings, "default", esv1beta1.SecretStoreKind)
+		if err != nil {
+			t.Fatalf("prepareConfig() error = %v", err)
+		}
+		c, err := getVaultClient(ctx, p, store, cfg)
+		if err != nil {
+			t.Fatalf("getVaultClient() error = %v", err)
+		}
+		return c.(*clusterClient)
+	}
+
+	first := getClient()
+	if first.address != primary.URL {
+		t.Fatalf("client address = %q, want %q", first.address, primary.URL)
+	}
+	if cached := getClient(); cached != first {
+		t.Errorf("the cached client of a healthy cluster is not reused")
+	}
+
+	primary.setHealthStatus(http.StatusServiceUnavailable)
+	failedOver := getClient()
+	if failedOver.address != secondary.URL {
+		t.Errorf("client address = %q after the cluster became unhealthy, want %q", failedOver.address, secondary.URL)
+	}
+	if cached := getClient(); cached != failedOver {
+		t.Errorf("the client of the cluster failed over to is not cached")
+	}
+}
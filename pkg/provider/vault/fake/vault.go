@@ -137,10 +137,12 @@ func (f Logical) WriteWithContext(ctx context.Context, path string, data map[str
 
 type RevokeSelfWithContextFn func(ctx context.Context, token string) error
 type LookupSelfWithContextFn func(ctx context.Context) (*vault.Secret, error)
+type RenewSelfWithContextFn func(ctx context.Context, increment int) (*vault.Secret, error)
 
 type Token struct {
 	RevokeSelfWithContextFn RevokeSelfWithContextFn
 	LookupSelfWithContextFn LookupSelfWithContextFn
+	RenewSelfWithContextFn  RenewSelfWithContextFn
 }
 
 func (f Token) RevokeSelfWithContext(ctx context.Context, token string) error {
@@ -149,6 +151,9 @@ func (f Token) RevokeSelfWithContext(ctx context.Context, token string) error {
 func (f Token) LookupSelfWithContext(ctx context.Context) (*vault.Secret, error) {
 	return f.LookupSelfWithContextFn(ctx)
 }
+func (f Token) RenewSelfWithContext(ctx context.Context, increment int) (*vault.Secret, error) {
+	return f.RenewSelfWithContextFn(ctx, increment)
+}
 
 type MockSetTokenFn func(v string)
 
@@ -170,7 +175,7 @@ type VaultListResponse struct {
 func NewAuthTokenFn() Token {
 	return Token{nil, func(ctx context.Context) (*vault.Secret, error) {
 		return &(vault.Secret{}), nil
-	}}
+	}, nil}
 }
 
 func NewSetTokenFn(ofn ...func(v string)) MockSetTokenFn {
@@ -0,0 +1,179 @@
+//go:build integration
+
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	vault "github.com/hashicorp/vault/api"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+	testingfake "github.com/external-secrets/external-secrets/pkg/provider/testing/fake"
+	"github.com/external-secrets/external-secrets/pkg/provider/testing/testinfra"
+)
+
+var integrationVault *testinfra.Vault
+
+func TestMain(m *testing.M) {
+	ctx := context.Background()
+	v, err := testinfra.StartVault(ctx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	integrationVault = v
+	code := m.Run()
+	_ = v.Terminate(ctx)
+	os.Exit(code)
+}
+
+// newIntegrationClient returns a client of the KV v2 engine of the Vault container
+// authenticating with the root token.
+func newIntegrationClient(t *testing.T) esv1beta1.SecretsClient {
+	t.Helper()
+	return newIntegrationClientWithToken(t, integrationVault.RootToken())
+}
+
+// newIntegrationClientWithToken returns a client of the KV v2 engine of the Vault container
+// authenticating with token.
+func newIntegrationClientWithToken(t *testing.T, token string) esv1beta1.SecretsClient {
+	t.Helper()
+	path := "secret"
+	store := &esv1beta1.SecretStore{
+		TypeMeta:   metav1.TypeMeta{Kind: esv1beta1.SecretStoreKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "vault", Namespace: "default"},
+		Spec: esv1beta1.SecretStoreSpec{
+			Provider: &esv1beta1.SecretStoreProvider{
+				Vault: &esv1beta1.VaultProvider{
+					Server:  integrationVault.Address(),
+					Path:    &path,
+					Version: esv1beta1.VaultKVStoreV2,
+					Auth: esv1beta1.VaultAuth{
+						TokenSecretRef: &esmeta.SecretKeySelector{Name: "vault-token", Key: "token"},
+					},
+				},
+			},
+		},
+	}
+	kube := clientfake.NewClientBuilder().WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "vault-token", Namespace: "default"},
+		Data:       map[string][]byte{"token": []byte(token)},
+	}).Build()
+	p := &Provider{NewVaultClient: NewVaultClient}
+	c, err := p.newClient(context.Background(), store, kube, nil, "default")
+	require.NoError(t, err)
+	return c
+}
+
+func TestIntegrationPushAndGetSecret(t *testing.T) {
+	ctx := context.Background()
+	c := newIntegrationClient(t)
+	secret := &corev1.Secret{Data: map[string][]byte{"password": []byte("s3cr3t")}}
+	ref := testingfake.PushSecretData{SecretKey: "password", RemoteKey: "integration/push", Property: "password"}
+	require.NoError(t, c.PushSecret(ctx, secret, ref))
+
+	got, err := c.GetSecret(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: "integration/push", Property: "password"})
+	require.NoError(t, err)
+	assert.Equal(t, "s3cr3t", string(got))
+
+	gotMap, err := c.GetSecretMap(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: "integration/push"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"password": []byte("s3cr3t")}, gotMap)
+}
+
+func TestIntegrationPushSecretMerge(t *testing.T) {
+	ctx := context.Background()
+	c := newIntegrationClient(t)
+	secret := &corev1.Secret{Data: map[string][]byte{"username": []byte("admin"), "password": []byte("s3cr3t")}}
+	require.NoError(t, c.PushSecret(ctx, secret, testingfake.PushSecretData{RemoteKey: "integration/merge"}))
+
+	secret = &corev1.Secret{Data: map[string][]byte{"password": []byte("n3w")}}
+	ref := testingfake.PushSecretData{
+		RemoteKey: "integration/merge",
+		Metadata:  &apiextensionsv1.JSON{Raw: []byte(`{"mergePolicy":"Merge"}`)},
+	}
+	require.NoError(t, c.PushSecret(ctx, secret, ref))
+
+	got, err := c.GetSecretMap(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: "integration/merge"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"username": []byte("admin"), "password": []byte("n3w")}, got)
+}
+
+func TestIntegrationPushSecretPermissionDenied(t *testing.T) {
+	ctx := context.Background()
+	root, err := vault.NewClient(&vault.Config{Address: integrationVault.Address()})
+	require.NoError(t, err)
+	root.SetToken(integrationVault.RootToken())
+	require.NoError(t, root.Sys().PutPolicyWithContext(ctx, "read-only", `path "secret/*" { capabilities = ["read"] }`))
+	token, err := root.Auth().Token().CreateWithContext(ctx, &vault.TokenCreateRequest{Policies: []string{"read-only"}})
+	require.NoError(t, err)
+
+	c := newIntegrationClientWithToken(t, token.Auth.ClientToken)
+	secret := &corev1.Secret{Data: map[string][]byte{"password": []byte("s3cr3t")}}
+	err = c.PushSecret(ctx, secret, testingfake.PushSecretData{SecretKey: "password", RemoteKey: "integration/denied", Property: "password"})
+	var denied *PermissionDeniedError
+	assert.ErrorAs(t, err, &denied)
+}
+
+func TestIntegrationDeleteSecret(t *testing.T) {
+	ctx := context.Background()
+	c := newIntegrationClient(t)
+	secret := &corev1.Secret{Data: map[string][]byte{"token": []byte("abc")}}
+	ref := testingfake.PushSecretData{SecretKey: "token", RemoteKey: "integration/delete", Property: "token"}
+	require.NoError(t, c.PushSecret(ctx, secret, ref))
+
+	exists, err := c.SecretExists(ctx, ref)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	require.NoError(t, c.DeleteSecret(ctx, ref))
+	exists, err = c.SecretExists(ctx, ref)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	_, err = c.GetSecret(ctx, esv1beta1.ExternalSecretDataRemoteRef{Key: "integration/delete"})
+	assert.ErrorIs(t, err, esv1beta1.NoSecretError{})
+}
+
+func TestIntegrationGetAllSecrets(t *testing.T) {
+	ctx := context.Background()
+	c := newIntegrationClient(t)
+	for _, key := range []string{"integration/find/a", "integration/find/nested/b", "integration/other/c"} {
+		secret := &corev1.Secret{Data: map[string][]byte{"value": []byte(key)}}
+		require.NoError(t, c.PushSecret(ctx, secret, testingfake.PushSecretData{SecretKey: "value", RemoteKey: key, Property: "value"}))
+	}
+	path := "integration/find"
+	got, err := c.GetAllSecrets(ctx, esv1beta1.ExternalSecretFind{
+		Path: &path,
+		Name: &esv1beta1.FindName{RegExp: ".*"},
+	})
+	require.NoError(t, err)
+	assert.Len(t, got, 2)
+	for _, v := range got {
+		assert.Contains(t, string(v), "integration/find/")
+	}
+}
@@ -56,6 +56,10 @@ type Provider struct {
 	// NewVaultClient is a function that returns a new Vault client.
 	// This is used for testing to inject a fake client.
 	NewVaultClient func(config *vault.Config) (util.Client, error)
+
+	// OpenBao makes the provider read the openbao field of the store instead of
+	// the vault one and follow the conventions of OpenBao where they diverge from Vault.
+	OpenBao bool
 }
 
 // NewVaultClient returns a new Vault client.
@@ -104,7 +108,7 @@ func (p *Provider) NewGeneratorClient(ctx context.Context, kube kclient.Client,
 		return nil, err
 	}
 
-	client, err := p.NewVaultClient(cfg)
+	client, err := p.newVaultClient(ctx, cfg, vaultSpec)
 	if err != nil {
 		return nil, err
 	}
@@ -119,10 +123,10 @@ func (p *Provider) NewGeneratorClient(ctx context.Context, kube kclient.Client,
 
 func (p *Provider) newClient(ctx context.Context, store esv1beta1.GenericStore, kube kclient.Client, corev1 typedcorev1.CoreV1Interface, namespace string) (esv1beta1.SecretsClient, error) {
 	storeSpec := store.GetSpec()
-	if storeSpec == nil || storeSpec.Provider == nil || storeSpec.Provider.Vault == nil {
+	vaultSpec := p.vaultSpec(storeSpec)
+	if vaultSpec == nil {
 		return nil, errors.New(errVaultStore)
 	}
-	vaultSpec := storeSpec.Provider.Vault
 
 	vStore, cfg, err := p.prepareConfig(
 		ctx,
@@ -136,7 +140,7 @@ func (p *Provider) newClient(ctx context.Context, store esv1beta1.GenericStore,
 		return nil, err
 	}
 
-	client, err := getVaultClient(p, store, cfg)
+	client, err := getVaultClient(ctx, p, store, cfg)
 	if err != nil {
 		return nil, fmt.Errorf(errVaultClient, err)
 	}
@@ -147,6 +151,9 @@ func (p *Provider) newClient(ctx context.Context, store esv1beta1.GenericStore,
 func (p *Provider) initClient(ctx context.Context, c *client, client util.Client, cfg *vault.Config, vaultSpec *esv1beta1.VaultProvider) (esv1beta1.SecretsClient, error) {
 	if vaultSpec.Namespace != nil {
 		client.SetNamespace(*vaultSpec.Namespace)
+	} else if client.Namespace() != "" {
+		// a cached client keeps the namespace of an earlier version of the store
+		client.SetNamespace("")
 	}
 
 	if vaultSpec.ReadYourWrites && vaultSpec.ForwardInconsistent {
@@ -206,8 +213,9 @@ func (p *Provider) prepareConfig(ctx context.Context, kube kclient.Client, corev
 	return c, cfg, nil
 }
 
-func getVaultClient(p *Provider, store esv1beta1.GenericStore, cfg *vault.Config) (util.Client, error) {
-	isStaticToken := store.GetSpec().Provider.Vault.Auth.TokenSecretRef != nil
+func getVaultClient(ctx context.Context, p *Provider, store esv1beta1.GenericStore, cfg *vault.Config) (util.Client, error) {
+	vaultSpec := p.vaultSpec(store.GetSpec())
+	isStaticToken := vaultSpec.Auth.TokenSecretRef != nil
 	useCache := enableCache && !isStaticToken
 
 	key := cache.Key{
@@ -218,11 +226,21 @@ func getVaultClient(p *Provider, store esv1beta1.GenericStore, cfg *vault.Config
 	if useCache {
 		client, ok := clientCache.Get(store.GetObjectMeta().ResourceVersion, key)
 		if ok {
-			return client, nil
+			// the client of a store with clusters is only reused while its cluster is healthy
+			cc, isClusterClient := client.(*clusterClient)
+			if !isClusterClient {
+				return client, nil
+			}
+			err := checkHealth(ctx, cc)
+			if err == nil {
+				return client, nil
+			}
+			logger.V(1).Info("Vault cluster is not available, failing over", "address", cc.address, "error", err.Error())
+			clientCache.Remove(key)
 		}
 	}
 
-	client, err := p.NewVaultClient(cfg)
+	client, err := p.newVaultClient(ctx, cfg, vaultSpec)
 	if err != nil {
 		return nil, fmt.Errorf(errVaultClient, err)
 	}
@@ -233,6 +251,20 @@ func getVaultClient(p *Provider, store esv1beta1.GenericStore, cfg *vault.Config
 	return client, nil
 }
 
+// vaultSpec returns the configuration of the provider, OpenBao accepts the configuration of Vault.
+func (p *Provider) vaultSpec(spec *esv1beta1.SecretStoreSpec) *esv1beta1.VaultProvider {
+	if spec == nil || spec.Provider == nil {
+		return nil
+	}
+	if p.OpenBao {
+		if spec.Provider.OpenBao == nil {
+			return nil
+		}
+		return &spec.Provider.OpenBao.VaultProvider
+	}
+	return spec.Provider.Vault
+}
+
 func isReferentSpec(prov *esv1beta1.VaultProvider) bool {
 	if prov.Auth.TokenSecretRef != nil && prov.Auth.TokenSecretRef.Namespace == nil {
 		return true
@@ -18,6 +18,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
@@ -45,6 +46,11 @@ const (
 	errInvalidClientTLSCert   = "invalid ClientTLS.ClientCert: %w"
 	errInvalidClientTLSSecret = "invalid ClientTLS.SecretRef: %w"
 	errInvalidClientTLS       = "when provided, both ClientTLS.ClientCert and ClientTLS.SecretRef should be provided"
+	errMultipleSecretsEngines = "only one of LDAPSecretsEngine and DatabaseSecretsEngine can be set"
+	errEmptyRemoteKey         = "key must not be empty"
+	errEmptyPathSegment       = "key %q contains an empty path segment"
+
+	warnOpenBaoConsistency = "readYourWrites and forwardInconsistent are features of Vault Enterprise and may not be supported by OpenBao"
 )
 
 func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnings, error) {
@@ -58,10 +64,13 @@ func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnin
 	if spc.Provider == nil {
 		return nil, fmt.Errorf(errInvalidStoreProv)
 	}
-	vaultProvider := spc.Provider.Vault
+	vaultProvider := p.vaultSpec(spc)
 	if vaultProvider == nil {
 		return nil, fmt.Errorf(errInvalidVaultProv)
 	}
+	if vaultProvider.LDAPSecretsEngine != nil && vaultProvider.DatabaseSecretsEngine != nil {
+		return nil, fmt.Errorf(errMultipleSecretsEngines)
+	}
 	if vaultProvider.Auth.AppRole != nil {
 		// check SecretRef for valid configuration
 		if err := utils.ValidateReferentSecretSelector(store, vaultProvider.Auth.AppRole.SecretRef); err != nil {
@@ -160,9 +169,32 @@ func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnin
 	} else if vaultProvider.ClientTLS.CertSecretRef != nil || vaultProvider.ClientTLS.KeySecretRef != nil {
 		return nil, errors.New(errInvalidClientTLS)
 	}
+	if p.OpenBao && (vaultProvider.ReadYourWrites || vaultProvider.ForwardInconsistent) {
+		return admission.Warnings{warnOpenBaoConsistency}, nil
+	}
 	return nil, nil
 }
 
+var _ esv1beta1.RemoteRefValidator = &Provider{}
+
+// ValidateRemoteRef rejects keys which are no valid Vault paths, and metadata
+// fetches from KV v1 stores.
+func (p *Provider) ValidateRemoteRef(store esv1beta1.GenericStore, ref esv1beta1.ExternalSecretDataRemoteRef) error {
+	key := strings.Trim(ref.Key, "/")
+	if key == "" {
+		return errors.New(errEmptyRemoteKey)
+	}
+	if strings.Contains(key, "//") {
+		return fmt.Errorf(errEmptyPathSegment, ref.Key)
+	}
+	vaultProvider := p.vaultSpec(store.GetSpec())
+	if vaultProvider != nil && vaultProvider.Version == esv1beta1.VaultKVStoreV1 &&
+		ref.MetadataPolicy == esv1beta1.ExternalSecretMetadataPolicyFetch {
+		return errors.New(errUnsupportedMetadataKvVersion)
+	}
+	return nil
+}
+
 func (c *client) Validate() (esv1beta1.ValidationResult, error) {
 	// when using referent namespace we can not validate the token
 	// because the namespace is not known yet when Validate() is called
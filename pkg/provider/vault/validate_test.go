@@ -28,6 +28,8 @@ func TestValidateStore(t *testing.T) {
 	type args struct {
 		auth      esv1beta1.VaultAuth
 		clientTLS esv1beta1.VaultClientTLS
+		ldap      *esv1beta1.VaultLDAPSecretsEngine
+		database  *esv1beta1.VaultDatabaseSecretsEngine
 	}
 
 	tests := []struct {
@@ -248,6 +250,20 @@ func TestValidateStore(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "database secrets engine",
+			args: args{
+				database: &esv1beta1.VaultDatabaseSecretsEngine{},
+			},
+		},
+		{
+			name: "invalid ldap and database secrets engines",
+			args: args{
+				ldap:     &esv1beta1.VaultLDAPSecretsEngine{},
+				database: &esv1beta1.VaultDatabaseSecretsEngine{},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -258,8 +274,10 @@ func TestValidateStore(t *testing.T) {
 				Spec: esv1beta1.SecretStoreSpec{
 					Provider: &esv1beta1.SecretStoreProvider{
 						Vault: &esv1beta1.VaultProvider{
-							Auth:      tt.args.auth,
-							ClientTLS: tt.args.clientTLS,
+							Auth:                  tt.args.auth,
+							ClientTLS:             tt.args.clientTLS,
+							LDAPSecretsEngine:     tt.args.ldap,
+							DatabaseSecretsEngine: tt.args.database,
 						},
 					},
 				},
@@ -270,3 +288,55 @@ func TestValidateStore(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateRemoteRef(t *testing.T) {
+	tests := []struct {
+		name    string
+		version esv1beta1.VaultKVStoreVersion
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		wantErr bool
+	}{
+		{
+			name: "valid key",
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "/app/db"},
+		},
+		{
+			name:    "empty key",
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "/"},
+			wantErr: true,
+		},
+		{
+			name:    "empty path segment",
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "app//db"},
+			wantErr: true,
+		},
+		{
+			name:    "metadata of kv v2",
+			version: esv1beta1.VaultKVStoreV2,
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "app/db", MetadataPolicy: esv1beta1.ExternalSecretMetadataPolicyFetch},
+		},
+		{
+			name:    "metadata of kv v1",
+			version: esv1beta1.VaultKVStoreV1,
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "app/db", MetadataPolicy: esv1beta1.ExternalSecretMetadataPolicyFetch},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Provider{}
+			store := &esv1beta1.SecretStore{
+				Spec: esv1beta1.SecretStoreSpec{
+					Provider: &esv1beta1.SecretStoreProvider{
+						Vault: &esv1beta1.VaultProvider{
+							Version: tt.version,
+						},
+					},
+				},
+			}
+			if err := c.ValidateRemoteRef(store, tt.ref); (err != nil) != tt.wantErr {
+				t.Errorf("connector.ValidateRemoteRef() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
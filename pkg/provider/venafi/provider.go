@@ -0,0 +1,147 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package venafi
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+const (
+	errStoreIsNil                  = "store is nil"
+	errNoStoreTypeOrWrongStoreType = "no store type or wrong store type"
+	errInvalidURL                  = "invalid url %q: %w"
+	errPolicyDNRequired            = "policyDN is required"
+	errInvalidPolicyDN             = `policyDN must start with \VED\Policy`
+	errAuthRequired                = "exactly one of auth.apiKeyRef or auth.secretRef is required"
+	errInvalidCABundle             = "failed to parse caBundle"
+	errCannotResolveSecretKeyRef   = "cannot resolve secret key ref: %w"
+
+	policyRoot = `\VED\Policy`
+)
+
+// Provider satisfies the provider interface.
+type Provider struct{}
+
+var _ esv1beta1.Provider = &Provider{}
+
+func (p *Provider) NewClient(ctx context.Context, store esv1beta1.GenericStore, kube kclient.Client, namespace string) (esv1beta1.SecretsClient, error) {
+	cfg, err := getConfig(store)
+	if err != nil {
+		return nil, err
+	}
+	storeKind := store.GetKind()
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(cfg.CABundle) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(cfg.CABundle) {
+			return nil, errors.New(errInvalidCABundle)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	c := &client{
+		httpClient: &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+		baseURL:  strings.TrimSuffix(cfg.URL, "/"),
+		policyDN: strings.TrimSuffix(cfg.PolicyDN, `\`),
+	}
+	if cfg.Auth.APIKeyRef != nil {
+		c.apiKey, err = resolvers.SecretKeyRef(ctx, kube, storeKind, namespace, cfg.Auth.APIKeyRef)
+		if err != nil {
+			return nil, fmt.Errorf(errCannotResolveSecretKeyRef, err)
+		}
+	}
+	if cfg.Auth.SecretRef != nil {
+		c.username, err = resolvers.SecretKeyRef(ctx, kube, storeKind, namespace, &cfg.Auth.SecretRef.Username)
+		if err != nil {
+			return nil, fmt.Errorf(errCannotResolveSecretKeyRef, err)
+		}
+		c.password, err = resolvers.SecretKeyRef(ctx, kube, storeKind, namespace, &cfg.Auth.SecretRef.Password)
+		if err != nil {
+			return nil, fmt.Errorf(errCannotResolveSecretKeyRef, err)
+		}
+	}
+	return c, nil
+}
+
+func getConfig(store esv1beta1.GenericStore) (*esv1beta1.VenafiProvider, error) {
+	if store == nil {
+		return nil, errors.New(errStoreIsNil)
+	}
+	spec := store.GetSpec()
+	if spec == nil || spec.Provider == nil || spec.Provider.Venafi == nil {
+		return nil, errors.New(errNoStoreTypeOrWrongStoreType)
+	}
+	cfg := spec.Provider.Venafi
+	if _, err := url.ParseRequestURI(cfg.URL); err != nil {
+		return nil, fmt.Errorf(errInvalidURL, cfg.URL, err)
+	}
+	if cfg.PolicyDN == "" {
+		return nil, errors.New(errPolicyDNRequired)
+	}
+	if !strings.HasPrefix(cfg.PolicyDN, policyRoot) {
+		return nil, errors.New(errInvalidPolicyDN)
+	}
+	if (cfg.Auth.APIKeyRef == nil) == (cfg.Auth.SecretRef == nil) {
+		return nil, errors.New(errAuthRequired)
+	}
+	if ref := cfg.Auth.APIKeyRef; ref != nil {
+		if err := utils.ValidateReferentSecretSelector(store, *ref); err != nil {
+			return nil, err
+		}
+	}
+	if ref := cfg.Auth.SecretRef; ref != nil {
+		if err := utils.ValidateReferentSecretSelector(store, ref.Username); err != nil {
+			return nil, err
+		}
+		if err := utils.ValidateReferentSecretSelector(store, ref.Password); err != nil {
+			return nil, err
+		}
+	}
+	return cfg, nil
+}
+
+func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnings, error) {
+	_, err := getConfig(store)
+	return nil, err
+}
+
+func (p *Provider) Capabilities() esv1beta1.SecretStoreCapabilities {
+	return esv1beta1.SecretStoreReadOnly
+}
+
+func init() {
+	esv1beta1.Register(&Provider{}, &esv1beta1.SecretStoreProvider{
+		Venafi: &esv1beta1.VenafiProvider{},
+	})
+}
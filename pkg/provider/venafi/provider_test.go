@@ -0,0 +1,111 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package venafi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+func TestValidateStore(t *testing.T) {
+	otherNamespace := "other"
+	apiKeyRef := &esmeta.SecretKeySelector{Name: "venafi", Key: "apiKey"}
+	tests := map[string]struct {
+		cfg     esv1beta1.VenafiProvider
+		wantErr string
+	}{
+		"valid with api key": {
+			cfg: esv1beta1.VenafiProvider{
+				URL:      "https://tpp.example.com",
+				PolicyDN: `\VED\Policy\Certificates`,
+				Auth:     esv1beta1.VenafiAuth{APIKeyRef: apiKeyRef},
+			},
+		},
+		"valid with username and password": {
+			cfg: esv1beta1.VenafiProvider{
+				URL:      "https://tpp.example.com",
+				PolicyDN: `\VED\Policy\Certificates`,
+				Auth: esv1beta1.VenafiAuth{
+					SecretRef: &esv1beta1.VenafiAuthSecretRef{
+						Username: esmeta.SecretKeySelector{Name: "venafi", Key: "username"},
+						Password: esmeta.SecretKeySelector{Name: "venafi", Key: "password"},
+					},
+				},
+			},
+		},
+		"invalid url": {
+			cfg: esv1beta1.VenafiProvider{
+				URL:      "tpp",
+				PolicyDN: `\VED\Policy\Certificates`,
+				Auth:     esv1beta1.VenafiAuth{APIKeyRef: apiKeyRef},
+			},
+			wantErr: "invalid url",
+		},
+		"invalid without policyDN": {
+			cfg: esv1beta1.VenafiProvider{
+				URL:  "https://tpp.example.com",
+				Auth: esv1beta1.VenafiAuth{APIKeyRef: apiKeyRef},
+			},
+			wantErr: errPolicyDNRequired,
+		},
+		"invalid policyDN": {
+			cfg: esv1beta1.VenafiProvider{
+				URL:      "https://tpp.example.com",
+				PolicyDN: "Certificates",
+				Auth:     esv1beta1.VenafiAuth{APIKeyRef: apiKeyRef},
+			},
+			wantErr: errInvalidPolicyDN,
+		},
+		"invalid without auth": {
+			cfg: esv1beta1.VenafiProvider{
+				URL:      "https://tpp.example.com",
+				PolicyDN: `\VED\Policy\Certificates`,
+			},
+			wantErr: errAuthRequired,
+		},
+		"invalid namespace in api key ref": {
+			cfg: esv1beta1.VenafiProvider{
+				URL:      "https://tpp.example.com",
+				PolicyDN: `\VED\Policy\Certificates`,
+				Auth: esv1beta1.VenafiAuth{
+					APIKeyRef: &esmeta.SecretKeySelector{Name: "venafi", Key: "apiKey", Namespace: &otherNamespace},
+				},
+			},
+			wantErr: "namespace should either be empty or match the namespace of the SecretStore",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := esv1beta1.SecretStore{
+				Spec: esv1beta1.SecretStoreSpec{
+					Provider: &esv1beta1.SecretStoreProvider{
+						Venafi: &tc.cfg,
+					},
+				},
+			}
+			p := &Provider{}
+			_, err := p.ValidateStore(&s)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
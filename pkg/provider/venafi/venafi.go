@@ -0,0 +1,344 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package venafi
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/youmark/pkcs8"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	// PropertyCertificate selects the PEM encoded certificate.
+	PropertyCertificate = "certificate"
+	// PropertyPrivateKey selects the PEM encoded PKCS#8 private key.
+	PropertyPrivateKey = "privateKey"
+	// PropertyChain selects the PEM encoded issuer chain, leaf first.
+	PropertyChain = "chain"
+	// PropertySerialNumber selects the hex encoded serial number of the certificate.
+	PropertySerialNumber = "serialNumber"
+	// PropertyNotAfter selects the expiration date of the certificate in RFC 3339 format.
+	PropertyNotAfter = "notAfter"
+
+	// certificates expiring within this window are logged as due for renewal.
+	renewalWindow = 30 * 24 * time.Hour
+
+	errUnexpectedStatus  = "unexpected status code from venafi: %d: %s"
+	errAuthenticate      = "unable to authenticate with venafi: %w"
+	errUnknownProperty   = "unknown property %q, must be one of certificate, privateKey, chain, serialNumber or notAfter"
+	errInvalidObjectName = "invalid object name %q"
+	errNoCertificate     = "no certificate found for %s"
+	errDecodeCertificate = "unable to decode certificate of %s: %w"
+	errDecryptKey        = "unable to decrypt private key of %s: %w"
+	errNotSupported      = "not supported by the venafi provider"
+)
+
+var log = ctrl.Log.WithName("provider").WithName("venafi")
+
+// client retrieves certificates with the WebSDK of Venafi TLS Protect Datacenter.
+// https://docs.venafi.com/Docs/current/TopNav/Content/SDK/WebSDK/r-SDK-POST-Certificates-Retrieve.php
+type client struct {
+	httpClient *http.Client
+	baseURL    string
+	policyDN   string
+	username   string
+	password   string
+
+	mu     sync.Mutex
+	apiKey string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+type retrieveRequest struct {
+	CertificateDN     string
+	Format            string
+	IncludeChain      bool
+	IncludePrivateKey bool
+	Password          string `json:",omitempty"`
+	RootFirstOrder    bool
+}
+
+type retrieveResponse struct {
+	CertificateData string
+}
+
+// certificate is a retrieved certificate, its chain and private key, PEM encoded.
+type certificate struct {
+	leaf        *x509.Certificate
+	certificate []byte
+	chain       []byte
+	privateKey  []byte
+}
+
+// GetSecret returns one part of the certificate object ref.Key, the certificate if no property is set.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	property := ref.Property
+	if property == "" {
+		property = PropertyCertificate
+	}
+	cert, err := c.retrieve(ctx, ref.Key, property == PropertyPrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	switch property {
+	case PropertyCertificate:
+		return cert.certificate, nil
+	case PropertyPrivateKey:
+		return cert.privateKey, nil
+	case PropertyChain:
+		return cert.chain, nil
+	case PropertySerialNumber:
+		return []byte(fmt.Sprintf("%x", cert.leaf.SerialNumber)), nil
+	case PropertyNotAfter:
+		return []byte(cert.leaf.NotAfter.UTC().Format(time.RFC3339)), nil
+	}
+	return nil, fmt.Errorf(errUnknownProperty, ref.Property)
+}
+
+// GetSecretMap returns the certificate, privateKey and chain of the certificate object ref.Key.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	cert, err := c.retrieve(ctx, ref.Key, true)
+	if err != nil {
+		return nil, err
+	}
+	return map[string][]byte{
+		PropertyCertificate: cert.certificate,
+		PropertyPrivateKey:  cert.privateKey,
+		PropertyChain:       cert.chain,
+	}, nil
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errNotSupported)
+}
+
+func (c *client) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
+	return errors.New(errNotSupported)
+}
+
+func (c *client) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
+	return errors.New(errNotSupported)
+}
+
+func (c *client) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
+	return false, errors.New(errNotSupported)
+}
+
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	if err := c.call(ctx, http.MethodGet, "/vedsdk/authorize/checkvalid", nil, nil); err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+func (c *client) retrieve(ctx context.Context, objectName string, includePrivateKey bool) (*certificate, error) {
+	if objectName == "" || strings.Contains(objectName, `\`) {
+		return nil, fmt.Errorf(errInvalidObjectName, objectName)
+	}
+	dn := c.policyDN + `\` + objectName
+	req := retrieveRequest{
+		CertificateDN:  dn,
+		Format:         "Base64",
+		IncludeChain:   true,
+		RootFirstOrder: false,
+	}
+	var password string
+	if includePrivateKey {
+		// the private key is only returned encrypted, use a password unique to this request
+		var err error
+		password, err = randomPassword()
+		if err != nil {
+			return nil, err
+		}
+		req.Format = "Base64 (PKCS #8)"
+		req.IncludePrivateKey = true
+		req.Password = password
+	}
+	var resp retrieveResponse
+	err := c.call(ctx, http.MethodPost, "/vedsdk/certificates/retrieve", req, &resp)
+	var statusErr *statusError
+	if errors.As(err, &statusErr) && statusErr.code == http.StatusNotFound {
+		return nil, esv1beta1.NoSecretError{}
+	}
+	if err != nil {
+		return nil, err
+	}
+	data, err := base64.StdEncoding.DecodeString(resp.CertificateData)
+	if err != nil {
+		return nil, fmt.Errorf(errDecodeCertificate, dn, err)
+	}
+	cert, err := parseBundle(dn, data, password)
+	if err != nil {
+		return nil, err
+	}
+	if time.Until(cert.leaf.NotAfter) < renewalWindow {
+		log.Info("certificate is due for renewal", "dn", dn, "notAfter", cert.leaf.NotAfter)
+	}
+	return cert, nil
+}
+
+// parseBundle splits the PEM bundle returned by the WebSDK,
+// the first certificate is the leaf and the following ones its chain.
+func parseBundle(dn string, data []byte, password string) (*certificate, error) {
+	cert := &certificate{}
+	var chain bytes.Buffer
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		switch block.Type {
+		case "CERTIFICATE":
+			if cert.leaf != nil {
+				if err := pem.Encode(&chain, block); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			leaf, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf(errDecodeCertificate, dn, err)
+			}
+			cert.leaf = leaf
+			cert.certificate = pem.EncodeToMemory(block)
+		case "ENCRYPTED PRIVATE KEY":
+			key, err := pkcs8.ParsePKCS8PrivateKey(block.Bytes, []byte(password))
+			if err != nil {
+				return nil, fmt.Errorf(errDecryptKey, dn, err)
+			}
+			der, err := x509.MarshalPKCS8PrivateKey(key)
+			if err != nil {
+				return nil, fmt.Errorf(errDecryptKey, dn, err)
+			}
+			cert.privateKey = pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+		}
+	}
+	if cert.leaf == nil {
+		return nil, fmt.Errorf(errNoCertificate, dn)
+	}
+	cert.chain = chain.Bytes()
+	return cert, nil
+}
+
+func randomPassword() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// authorize returns the API key of the store, or requests one with the username and password.
+func (c *client) authorize(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.apiKey != "" {
+		return c.apiKey, nil
+	}
+	var resp struct {
+		APIKey string
+	}
+	body := map[string]string{"Username": c.username, "Password": c.password}
+	if err := c.do(ctx, http.MethodPost, "/vedsdk/authorize/", "", body, &resp); err != nil {
+		return "", fmt.Errorf(errAuthenticate, err)
+	}
+	c.apiKey = resp.APIKey
+	return c.apiKey, nil
+}
+
+func (c *client) call(ctx context.Context, method, path string, body, target any) error {
+	apiKey, err := c.authorize(ctx)
+	if err != nil {
+		return err
+	}
+	err = c.do(ctx, method, path, apiKey, body, target)
+	var statusErr *statusError
+	if errors.As(err, &statusErr) && statusErr.code == http.StatusUnauthorized && c.username != "" {
+		// the API key expired, request a new one on the next call
+		c.mu.Lock()
+		c.apiKey = ""
+		c.mu.Unlock()
+	}
+	return err
+}
+
+type statusError struct {
+	code int
+	body []byte
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf(errUnexpectedStatus, e.code, e.body)
+}
+
+func (c *client) do(ctx context.Context, method, path, apiKey string, body, target any) error {
+	var payload io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		payload = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, payload)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if apiKey != "" {
+		req.Header.Set("X-Venafi-Api-Key", apiKey)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return &statusError{code: resp.StatusCode, body: respBody}
+	}
+	if target == nil {
+		return nil
+	}
+	return json.Unmarshal(respBody, target)
+}
@@ -0,0 +1,255 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package venafi
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/youmark/pkcs8"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const testPolicyDN = `\VED\Policy\Certificates`
+
+type fakeTPP struct {
+	t        *testing.T
+	mu       sync.Mutex
+	apiKey   string
+	leaf     []byte
+	issuer   []byte
+	key      *ecdsa.PrivateKey
+	requests []retrieveRequest
+}
+
+func newFakeTPP(t *testing.T) *fakeTPP {
+	t.Helper()
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "issuer"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTmpl, caTmpl, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	leafTmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(0xabc),
+		Subject:      pkix.Name{CommonName: "app.example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leafTmpl, caTmpl, &key.PublicKey, caKey)
+	require.NoError(t, err)
+
+	return &fakeTPP{
+		t:      t,
+		apiKey: "api-key",
+		leaf:   pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leafDER}),
+		issuer: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}),
+		key:    key,
+	}
+}
+
+func (f *fakeTPP) setAPIKey(apiKey string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.apiKey = apiKey
+}
+
+func (f *fakeTPP) retrieveRequests() []retrieveRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]retrieveRequest{}, f.requests...)
+}
+
+func (f *fakeTPP) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch r.URL.Path {
+	case "/vedsdk/authorize/":
+		var body map[string]string
+		require.NoError(f.t, json.NewDecoder(r.Body).Decode(&body))
+		if body["Username"] != "user" || body["Password"] != "pass" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"APIKey": f.apiKey})
+		return
+	}
+	if r.Header.Get("X-Venafi-Api-Key") != f.apiKey {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	switch r.URL.Path {
+	case "/vedsdk/authorize/checkvalid":
+		w.WriteHeader(http.StatusOK)
+	case "/vedsdk/certificates/retrieve":
+		var req retrieveRequest
+		require.NoError(f.t, json.NewDecoder(r.Body).Decode(&req))
+		f.requests = append(f.requests, req)
+		if req.CertificateDN != testPolicyDN+`\app` {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		bundle := append(append([]byte{}, f.leaf...), f.issuer...)
+		if req.IncludePrivateKey {
+			der, err := pkcs8.MarshalPrivateKey(f.key, []byte(req.Password), nil)
+			require.NoError(f.t, err)
+			bundle = append(bundle, pem.EncodeToMemory(&pem.Block{Type: "ENCRYPTED PRIVATE KEY", Bytes: der})...)
+		}
+		_ = json.NewEncoder(w).Encode(retrieveResponse{CertificateData: base64.StdEncoding.EncodeToString(bundle)})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newTestClient(t *testing.T, tpp *fakeTPP) *client {
+	t.Helper()
+	srv := httptest.NewServer(tpp)
+	t.Cleanup(srv.Close)
+	return &client{
+		httpClient: srv.Client(),
+		baseURL:    srv.URL,
+		policyDN:   testPolicyDN,
+		username:   "user",
+		password:   "pass",
+	}
+}
+
+func TestGetSecret(t *testing.T) {
+	tpp := newFakeTPP(t)
+	c := newTestClient(t, tpp)
+
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"certificate by default": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "app"},
+			want: string(tpp.leaf),
+		},
+		"chain": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "app", Property: PropertyChain},
+			want: string(tpp.issuer),
+		},
+		"serial number": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "app", Property: PropertySerialNumber},
+			want: "abc",
+		},
+		"expiration": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "app", Property: PropertyNotAfter},
+			want: "2030-01-01T00:00:00Z",
+		},
+		"unknown property": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "app", Property: "foo"},
+			wantErr: "unknown property",
+		},
+		"invalid object name": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: `..\app`},
+			wantErr: "invalid object name",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+	for _, req := range tpp.retrieveRequests() {
+		assert.False(t, req.IncludePrivateKey, "the private key must only be requested when needed")
+	}
+}
+
+func TestGetSecretNotFound(t *testing.T) {
+	c := newTestClient(t, newFakeTPP(t))
+	_, err := c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "missing"})
+	assert.True(t, errors.Is(err, esv1beta1.NoSecretError{}))
+}
+
+func TestGetSecretMap(t *testing.T) {
+	tpp := newFakeTPP(t)
+	c := newTestClient(t, tpp)
+
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "app"})
+	require.NoError(t, err)
+	assert.Equal(t, string(tpp.leaf), string(got[PropertyCertificate]))
+	assert.Equal(t, string(tpp.issuer), string(got[PropertyChain]))
+
+	block, _ := pem.Decode(got[PropertyPrivateKey])
+	require.NotNil(t, block)
+	assert.Equal(t, "PRIVATE KEY", block.Type)
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	require.NoError(t, err)
+	assert.True(t, tpp.key.Equal(key))
+
+	requests := tpp.retrieveRequests()
+	require.Len(t, requests, 1)
+	assert.True(t, requests[0].IncludePrivateKey)
+	assert.NotEmpty(t, requests[0].Password)
+}
+
+func TestAuthorize(t *testing.T) {
+	tpp := newFakeTPP(t)
+	c := newTestClient(t, tpp)
+
+	res, err := c.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+	assert.Equal(t, "api-key", c.apiKey)
+
+	// an expired API key is requested again on the next call
+	tpp.setAPIKey("rotated")
+	_, err = c.Validate()
+	assert.Error(t, err)
+	assert.Empty(t, c.apiKey)
+	res, err = c.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+
+	c.password = "wrong"
+	c.apiKey = ""
+	_, err = c.Validate()
+	assert.ErrorContains(t, err, "unable to authenticate")
+}
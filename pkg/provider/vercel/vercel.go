@@ -0,0 +1,286 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vercel
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	// targetProduction is the environment of variables without a version and of pushed variables.
+	targetProduction = "production"
+
+	errInvalidKey         = "key %q must have the format <project>/<variable>"
+	errInvalidTarget      = "version %q must be one of the environments %v"
+	errUnexpectedStatus   = "unexpected status code from Vercel: %d: %s"
+	errUnmarshalResponse  = "unable to unmarshal Vercel response: %w"
+	errUnreadableVariable = "variable %q is of type %s, Vercel does not return its value"
+	errPropertyNotAllowed = "property is not supported, Vercel variables hold a single value"
+	errFindUnsupported    = "find is not supported by the Vercel provider"
+)
+
+var targets = []string{"production", "preview", "development"}
+
+// client reads and writes environment variables of projects with the Vercel API.
+// https://vercel.com/docs/rest-api/endpoints/projects
+type client struct {
+	httpClient *http.Client
+	url        string
+	teamID     string
+	token      string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+type envVar struct {
+	ID        string   `json:"id,omitempty"`
+	Key       string   `json:"key,omitempty"`
+	Value     string   `json:"value,omitempty"`
+	Type      string   `json:"type,omitempty"`
+	Target    []string `json:"target,omitempty"`
+	GitBranch string   `json:"gitBranch,omitempty"`
+}
+
+type envVars struct {
+	Envs []envVar `json:"envs"`
+}
+
+// parseKey returns the project id or name and the key of the variable.
+func parseKey(key string) (string, string, error) {
+	project, name, ok := strings.Cut(key, "/")
+	if !ok || project == "" || name == "" || strings.Contains(name, "/") {
+		return "", "", fmt.Errorf(errInvalidKey, key)
+	}
+	return project, name, nil
+}
+
+// parseTarget returns the environment of the version, production if it is empty.
+func parseTarget(version string) (string, error) {
+	if version == "" {
+		return targetProduction, nil
+	}
+	if !slices.Contains(targets, version) {
+		return "", fmt.Errorf(errInvalidTarget, version, targets)
+	}
+	return version, nil
+}
+
+// GetSecret returns the value of the variable in the environment given by the version.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	if ref.Property != "" {
+		return nil, errors.New(errPropertyNotAllowed)
+	}
+	project, name, err := parseKey(ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	target, err := parseTarget(ref.Version)
+	if err != nil {
+		return nil, err
+	}
+	v, err := c.envVar(ctx, project, name, target)
+	if err != nil {
+		return nil, err
+	}
+	if v.Type != "plain" && v.Type != "encrypted" {
+		return nil, fmt.Errorf(errUnreadableVariable, ref.Key, v.Type)
+	}
+	// the list of variables does not contain the decrypted values
+	var decrypted envVar
+	if err := c.do(ctx, http.MethodGet, "/v1/projects/"+url.PathEscape(project)+"/env/"+url.PathEscape(v.ID), nil, &decrypted); err != nil {
+		return nil, err
+	}
+	return []byte(decrypted.Value), nil
+}
+
+// GetSecretMap decodes the value of the variable as a JSON object.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	data, err := c.GetSecret(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	kv := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &kv); err != nil {
+		return nil, fmt.Errorf(errUnmarshalResponse, err)
+	}
+	secretData := make(map[string][]byte, len(kv))
+	for k, v := range kv {
+		var s string
+		if err := json.Unmarshal(v, &s); err == nil {
+			secretData[k] = []byte(s)
+			continue
+		}
+		secretData[k] = v
+	}
+	return secretData, nil
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindUnsupported)
+}
+
+// PushSecret creates or updates the encrypted production variable.
+// A variable shared with other environments is updated for all of them.
+func (c *client) PushSecret(ctx context.Context, secret *corev1.Secret, data esv1beta1.PushSecretData) error {
+	if data.GetProperty() != "" {
+		return errors.New(errPropertyNotAllowed)
+	}
+	project, name, err := parseKey(data.GetRemoteKey())
+	if err != nil {
+		return err
+	}
+	value, err := utils.PushSecretValue(secret, data.GetSecretKey())
+	if err != nil {
+		return err
+	}
+	existing, err := c.envVar(ctx, project, name, targetProduction)
+	if errors.Is(err, esv1beta1.NoSecretError{}) {
+		body := envVar{Key: name, Value: string(value), Type: "encrypted", Target: []string{targetProduction}}
+		return c.do(ctx, http.MethodPost, "/v10/projects/"+url.PathEscape(project)+"/env", body, nil)
+	}
+	if err != nil {
+		return err
+	}
+	return c.do(ctx, http.MethodPatch, "/v9/projects/"+url.PathEscape(project)+"/env/"+url.PathEscape(existing.ID), envVar{Value: string(value)}, nil)
+}
+
+// DeleteSecret removes the production variable, a variable shared with other
+// environments is kept for them. A missing variable is not an error.
+func (c *client) DeleteSecret(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) error {
+	project, name, err := parseKey(remoteRef.GetRemoteKey())
+	if err != nil {
+		return err
+	}
+	v, err := c.envVar(ctx, project, name, targetProduction)
+	if errors.Is(err, esv1beta1.NoSecretError{}) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	path := "/v9/projects/" + url.PathEscape(project) + "/env/" + url.PathEscape(v.ID)
+	remaining := slices.DeleteFunc(v.Target, func(t string) bool { return t == targetProduction })
+	if len(remaining) > 0 {
+		return c.do(ctx, http.MethodPatch, path, envVar{Target: remaining}, nil)
+	}
+	return c.do(ctx, http.MethodDelete, path, nil, nil)
+}
+
+// SecretExists checks if the production variable exists.
+func (c *client) SecretExists(ctx context.Context, remoteRef esv1beta1.PushSecretRemoteRef) (bool, error) {
+	project, name, err := parseKey(remoteRef.GetRemoteKey())
+	if err != nil {
+		return false, err
+	}
+	_, err = c.envVar(ctx, project, name, targetProduction)
+	if errors.Is(err, esv1beta1.NoSecretError{}) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Validate lists a project to check the token and its access to the team.
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	if err := c.do(context.Background(), http.MethodGet, "/v9/projects?limit=1", nil, nil); err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+// envVar looks up the variable name of the target environment in the project.
+// Preview variables of a git branch are ignored.
+func (c *client) envVar(ctx context.Context, project, name, target string) (*envVar, error) {
+	var list envVars
+	if err := c.do(ctx, http.MethodGet, "/v9/projects/"+url.PathEscape(project)+"/env", nil, &list); err != nil {
+		return nil, err
+	}
+	for i, v := range list.Envs {
+		if v.Key == name && v.GitBranch == "" && slices.Contains(v.Target, target) {
+			return &list.Envs[i], nil
+		}
+	}
+	return nil, esv1beta1.NoSecretError{}
+}
+
+func (c *client) do(ctx context.Context, method, path string, body, target any) error {
+	reqURL, err := url.Parse(c.url + path)
+	if err != nil {
+		return err
+	}
+	if c.teamID != "" {
+		q := reqURL.Query()
+		q.Set("teamId", c.teamID)
+		reqURL.RawQuery = q.Encode()
+	}
+	var reqBody io.Reader = http.NoBody
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, reqURL.String(), reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// a missing project or variable
+	if resp.StatusCode == http.StatusNotFound {
+		return esv1beta1.NoSecretError{}
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf(errUnexpectedStatus, resp.StatusCode, respBody)
+	}
+	if target == nil {
+		return nil
+	}
+	if err := json.Unmarshal(respBody, target); err != nil {
+		return fmt.Errorf(errUnmarshalResponse, err)
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1alpha1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1alpha1"
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	testToken  = "token"
+	testTeamID = "team_1"
+)
+
+// fakeVercel serves the variables of the project shop of testTeamID,
+// the list of variables does not contain values like the Vercel API.
+type fakeVercel struct {
+	mu     sync.Mutex
+	envs   []envVar
+	lastID int
+}
+
+func newTestClient(t *testing.T) (*client, *fakeVercel) {
+	f := &fakeVercel{envs: []envVar{
+		{ID: "1", Key: "DB_HOST", Value: "db.example.com", Type: "plain", Target: []string{"production", "preview"}},
+		{ID: "2", Key: "DB_HOST", Value: "localhost", Type: "plain", Target: []string{"development"}},
+		{ID: "3", Key: "DB_HOST", Value: "db.feature.example.com", Type: "plain", Target: []string{"preview"}, GitBranch: "feature"},
+		{ID: "4", Key: "DB_CONFIG", Value: `{"user":"admin","port":5432}`, Type: "encrypted", Target: []string{"production"}},
+		{ID: "5", Key: "DB_PASSWORD", Value: "s3cr3t", Type: "sensitive", Target: []string{"production"}},
+	}, lastID: 5}
+	srv := httptest.NewServer(f.handler())
+	t.Cleanup(srv.Close)
+	return &client{httpClient: srv.Client(), url: srv.URL, teamID: testTeamID, token: testToken}, f
+}
+
+func (f *fakeVercel) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v9/projects", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"projects":[{"id":"prj_1","name":"shop"}]}`))
+	})
+	mux.HandleFunc("GET /v9/projects/shop/env", func(w http.ResponseWriter, _ *http.Request) {
+		list := envVars{}
+		for _, v := range f.envs {
+			v.Value = ""
+			list.Envs = append(list.Envs, v)
+		}
+		_ = json.NewEncoder(w).Encode(list)
+	})
+	mux.HandleFunc("GET /v1/projects/shop/env/{id}", func(w http.ResponseWriter, r *http.Request) {
+		v := f.find(r.PathValue("id"))
+		if v == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(v)
+	})
+	mux.HandleFunc("POST /v10/projects/shop/env", func(w http.ResponseWriter, r *http.Request) {
+		var v envVar
+		if err := json.NewDecoder(r.Body).Decode(&v); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.lastID++
+		v.ID = fmt.Sprint(f.lastID)
+		f.envs = append(f.envs, v)
+		_ = json.NewEncoder(w).Encode(map[string]any{"created": v})
+	})
+	mux.HandleFunc("PATCH /v9/projects/shop/env/{id}", func(w http.ResponseWriter, r *http.Request) {
+		v := f.find(r.PathValue("id"))
+		var patch envVar
+		if v == nil || json.NewDecoder(r.Body).Decode(&patch) != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if patch.Value != "" {
+			v.Value = patch.Value
+		}
+		if patch.Target != nil {
+			v.Target = patch.Target
+		}
+		_ = json.NewEncoder(w).Encode(v)
+	})
+	mux.HandleFunc("DELETE /v9/projects/shop/env/{id}", func(w http.ResponseWriter, r *http.Request) {
+		f.envs = slices.DeleteFunc(f.envs, func(v envVar) bool { return v.ID == r.PathValue("id") })
+		_, _ = w.Write([]byte(`{}`))
+	})
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testToken || r.URL.Query().Get("teamId") != testTeamID {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error":{"code":"forbidden","message":"Not authorized"}}`))
+			return
+		}
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		mux.ServeHTTP(w, r)
+	})
+}
+
+func (f *fakeVercel) find(id string) *envVar {
+	for i := range f.envs {
+		if f.envs[i].ID == id {
+			return &f.envs[i]
+		}
+	}
+	return nil
+}
+
+func TestGetSecret(t *testing.T) {
+	c, _ := newTestClient(t)
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"production by default": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "shop/DB_HOST"},
+			want: "db.example.com",
+		},
+		"preview without git branch": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "shop/DB_HOST", Version: "preview"},
+			want: "db.example.com",
+		},
+		"development": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "shop/DB_HOST", Version: "development"},
+			want: "localhost",
+		},
+		"encrypted": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: "shop/DB_CONFIG"},
+			want: `{"user":"admin","port":5432}`,
+		},
+		"sensitive": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "shop/DB_PASSWORD"},
+			wantErr: `variable "shop/DB_PASSWORD" is of type sensitive, Vercel does not return its value`,
+		},
+		"missing variable": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "shop/DB_CONFIG", Version: "preview"},
+			wantErr: "Secret does not exist",
+		},
+		"missing project": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "blog/DB_HOST"},
+			wantErr: "Secret does not exist",
+		},
+		"invalid environment": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "shop/DB_HOST", Version: "staging"},
+			wantErr: `version "staging" must be one of the environments`,
+		},
+		"invalid key": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "DB_HOST"},
+			wantErr: `key "DB_HOST" must have the format <project>/<variable>`,
+		},
+		"property": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "shop/DB_HOST", Property: "host"},
+			wantErr: errPropertyNotAllowed,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestGetSecretMap(t *testing.T) {
+	c, _ := newTestClient(t)
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "shop/DB_CONFIG"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"user": []byte("admin"), "port": []byte("5432")}, got)
+}
+
+func pushData(secretKey, remoteKey string) esv1alpha1.PushSecretData {
+	return esv1alpha1.PushSecretData{
+		Match: esv1alpha1.PushSecretMatch{
+			SecretKey: secretKey,
+			RemoteRef: esv1alpha1.PushSecretRemoteRef{RemoteKey: remoteKey},
+		},
+	}
+}
+
+func TestPushSecret(t *testing.T) {
+	c, fake := newTestClient(t)
+	secret := &corev1.Secret{Data: map[string][]byte{"token": []byte("abc"), "user": []byte("deploy")}}
+
+	require.NoError(t, c.PushSecret(context.Background(), secret, pushData("token", "shop/DEPLOY_TOKEN")))
+	created := fake.envs[len(fake.envs)-1]
+	assert.Equal(t, envVar{ID: "6", Key: "DEPLOY_TOKEN", Value: "abc", Type: "encrypted", Target: []string{"production"}}, created)
+	got, err := c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: "shop/DEPLOY_TOKEN"})
+	require.NoError(t, err)
+	assert.Equal(t, "abc", string(got))
+
+	// the existing production variable is updated
+	require.NoError(t, c.PushSecret(context.Background(), secret, pushData("", "shop/DB_CONFIG")))
+	assert.JSONEq(t, `{"token":"abc","user":"deploy"}`, fake.find("4").Value)
+	assert.Len(t, fake.envs, 6)
+
+	assert.EqualError(t, c.PushSecret(context.Background(), secret, pushData("token", "DEPLOY_TOKEN")),
+		`key "DEPLOY_TOKEN" must have the format <project>/<variable>`)
+}
+
+func TestSecretExistsAndDelete(t *testing.T) {
+	c, fake := newTestClient(t)
+	ctx := context.Background()
+
+	exists, err := c.SecretExists(ctx, esv1alpha1.PushSecretRemoteRef{RemoteKey: "shop/DB_CONFIG"})
+	require.NoError(t, err)
+	assert.True(t, exists)
+	require.NoError(t, c.DeleteSecret(ctx, esv1alpha1.PushSecretRemoteRef{RemoteKey: "shop/DB_CONFIG"}))
+	assert.Nil(t, fake.find("4"))
+	exists, err = c.SecretExists(ctx, esv1alpha1.PushSecretRemoteRef{RemoteKey: "shop/DB_CONFIG"})
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// the variable is kept for the other environments
+	require.NoError(t, c.DeleteSecret(ctx, esv1alpha1.PushSecretRemoteRef{RemoteKey: "shop/DB_HOST"}))
+	assert.Equal(t, []string{"preview"}, fake.find("1").Target)
+
+	// deleting a missing variable or project succeeds
+	require.NoError(t, c.DeleteSecret(ctx, esv1alpha1.PushSecretRemoteRef{RemoteKey: "shop/DB_CONFIG"}))
+	require.NoError(t, c.DeleteSecret(ctx, esv1alpha1.PushSecretRemoteRef{RemoteKey: "blog/DB_CONFIG"}))
+}
+
+func TestValidate(t *testing.T) {
+	c, _ := newTestClient(t)
+	res, err := c.Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, res)
+
+	c.teamID = "team_2"
+	res, err = c.Validate()
+	assert.ErrorContains(t, err, "unexpected status code from Vercel: 403")
+	assert.Equal(t, esv1beta1.ValidationResultError, res)
+}
@@ -0,0 +1,148 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wiz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	kclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+	"github.com/external-secrets/external-secrets/pkg/utils/resolvers"
+)
+
+const (
+	defaultTokenURL = "https://auth.app.wiz.io/oauth/token"
+	tokenAudience   = "wiz-api"
+
+	// Wiz throttles the API of an organization to 60 requests per minute, a rate
+	// limited request is retried until the limit resets after at most a minute.
+	retryWaitMin = time.Second
+	retryWaitMax = time.Minute
+	retryMax     = 5
+
+	errStoreIsNil                  = "store is nil"
+	errNoStoreTypeOrWrongStoreType = "no store type or wrong store type"
+	errURLRequired                 = "url is required"
+	errInvalidURL                  = "invalid url %q: %w"
+	errCannotResolveClientID       = "cannot resolve client id: %w"
+	errCannotResolveClientSecret   = "cannot resolve client secret: %w"
+)
+
+// Provider satisfies the provider interface.
+type Provider struct{}
+
+var _ esv1beta1.Provider = &Provider{}
+
+func (p *Provider) NewClient(ctx context.Context, store esv1beta1.GenericStore, kube kclient.Client, namespace string) (esv1beta1.SecretsClient, error) {
+	cfg, err := getConfig(store)
+	if err != nil {
+		return nil, err
+	}
+	clientID, err := resolvers.SecretKeyRef(ctx, kube, store.GetKind(), namespace, &cfg.Auth.ClientID)
+	if err != nil {
+		return nil, fmt.Errorf(errCannotResolveClientID, err)
+	}
+	clientSecret, err := resolvers.SecretKeyRef(ctx, kube, store.GetKind(), namespace, &cfg.Auth.ClientSecret)
+	if err != nil {
+		return nil, fmt.Errorf(errCannotResolveClientSecret, err)
+	}
+	tokenURL := cfg.TokenURL
+	if tokenURL == "" {
+		tokenURL = defaultTokenURL
+	}
+	return newClient(cfg.URL, tokenURL, clientID, clientSecret, retryWaitMin), nil
+}
+
+// newClient returns a client whose requests, including the token requests,
+// are retried when they are rate limited.
+func newClient(apiURL, tokenURL, clientID, clientSecret string, waitMin time.Duration) *client {
+	retryClient := retryablehttp.NewClient()
+	retryClient.Logger = nil
+	retryClient.RetryWaitMin = waitMin
+	retryClient.RetryWaitMax = retryWaitMax
+	retryClient.RetryMax = retryMax
+	retryClient.HTTPClient = &http.Client{Timeout: 30 * time.Second}
+
+	cc := &clientcredentials.Config{
+		ClientID:       clientID,
+		ClientSecret:   clientSecret,
+		TokenURL:       tokenURL,
+		EndpointParams: url.Values{"audience": []string{tokenAudience}},
+		AuthStyle:      oauth2.AuthStyleInParams,
+	}
+	// the token is requested lazily, it must not be bound to the context of this call
+	tokenCtx := context.WithValue(context.Background(), oauth2.HTTPClient, retryClient.StandardClient())
+	tokenSource := cc.TokenSource(tokenCtx)
+	return &client{
+		httpClient:  oauth2.NewClient(tokenCtx, tokenSource),
+		tokenSource: tokenSource,
+		url:         apiURL,
+	}
+}
+
+func getConfig(store esv1beta1.GenericStore) (*esv1beta1.WizProvider, error) {
+	if store == nil {
+		return nil, errors.New(errStoreIsNil)
+	}
+	spec := store.GetSpec()
+	if spec == nil || spec.Provider == nil || spec.Provider.Wiz == nil {
+		return nil, errors.New(errNoStoreTypeOrWrongStoreType)
+	}
+	cfg := spec.Provider.Wiz
+	if cfg.URL == "" {
+		return nil, errors.New(errURLRequired)
+	}
+	if _, err := url.ParseRequestURI(cfg.URL); err != nil {
+		return nil, fmt.Errorf(errInvalidURL, cfg.URL, err)
+	}
+	if cfg.TokenURL != "" {
+		if _, err := url.ParseRequestURI(cfg.TokenURL); err != nil {
+			return nil, fmt.Errorf(errInvalidURL, cfg.TokenURL, err)
+		}
+	}
+	if err := utils.ValidateReferentSecretSelector(store, cfg.Auth.ClientID); err != nil {
+		return nil, err
+	}
+	if err := utils.ValidateReferentSecretSelector(store, cfg.Auth.ClientSecret); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (p *Provider) ValidateStore(store esv1beta1.GenericStore) (admission.Warnings, error) {
+	_, err := getConfig(store)
+	return nil, err
+}
+
+func (p *Provider) Capabilities() esv1beta1.SecretStoreCapabilities {
+	return esv1beta1.SecretStoreReadOnly
+}
+
+func init() {
+	esv1beta1.Register(&Provider{}, &esv1beta1.SecretStoreProvider{
+		Wiz: &esv1beta1.WizProvider{},
+	})
+}
@@ -0,0 +1,80 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wiz
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	esmeta "github.com/external-secrets/external-secrets/apis/meta/v1"
+)
+
+func TestValidateStore(t *testing.T) {
+	namespace := "other"
+	validAuth := esv1beta1.WizAuth{
+		ClientID:     esmeta.SecretKeySelector{Name: "wiz", Key: "client-id"},
+		ClientSecret: esmeta.SecretKeySelector{Name: "wiz", Key: "client-secret"},
+	}
+	tests := map[string]struct {
+		cfg     esv1beta1.WizProvider
+		wantErr string
+	}{
+		"valid": {
+			cfg: esv1beta1.WizProvider{URL: "https://api.us17.app.wiz.io/graphql", Auth: validAuth},
+		},
+		"missing url": {
+			cfg:     esv1beta1.WizProvider{Auth: validAuth},
+			wantErr: "url is required",
+		},
+		"invalid token url": {
+			cfg: esv1beta1.WizProvider{
+				URL:      "https://api.us17.app.wiz.io/graphql",
+				TokenURL: "auth.app.wiz.io/oauth/token",
+				Auth:     validAuth,
+			},
+			wantErr: `invalid url "auth.app.wiz.io/oauth/token"`,
+		},
+		"client secret in other namespace": {
+			cfg: esv1beta1.WizProvider{
+				URL: "https://api.us17.app.wiz.io/graphql",
+				Auth: esv1beta1.WizAuth{
+					ClientID:     validAuth.ClientID,
+					ClientSecret: esmeta.SecretKeySelector{Name: "wiz", Key: "client-secret", Namespace: &namespace},
+				},
+			},
+			wantErr: "namespace should either be empty or match the namespace of the SecretStore",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := esv1beta1.SecretStore{
+				Spec: esv1beta1.SecretStoreSpec{
+					Provider: &esv1beta1.SecretStoreProvider{
+						Wiz: &tc.cfg,
+					},
+				},
+			}
+			p := &Provider{}
+			_, err := p.ValidateStore(&s)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,190 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wiz
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"golang.org/x/oauth2"
+	corev1 "k8s.io/api/core/v1"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+	"github.com/external-secrets/external-secrets/pkg/utils"
+)
+
+const (
+	// connectorQuery reads the connection parameters of a connector.
+	connectorQuery = `query Connector($id: ID!) {
+  connector(id: $id) {
+    id
+    name
+    authParams
+  }
+}`
+
+	errUnexpectedStatus  = "unexpected status code from Wiz: %d: %s"
+	errUnmarshalResponse = "unable to unmarshal Wiz response: %w"
+	errGraphQL           = "Wiz API error: %s"
+	errReadOnly          = "the Wiz provider is read only"
+	errFindUnsupported   = "find is not supported by the Wiz provider"
+	errParamNotFound     = "connector %q has no connection parameter %q"
+)
+
+// client reads connection parameters of connectors with the GraphQL API of Wiz.
+type client struct {
+	httpClient  *http.Client
+	tokenSource oauth2.TokenSource
+	url         string
+}
+
+var _ esv1beta1.SecretsClient = &client{}
+
+type graphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+type graphQLError struct {
+	Message    string `json:"message"`
+	Extensions struct {
+		Code string `json:"code"`
+	} `json:"extensions"`
+}
+
+type connectorResponse struct {
+	Data struct {
+		Connector *struct {
+			ID         string         `json:"id"`
+			Name       string         `json:"name"`
+			AuthParams map[string]any `json:"authParams"`
+		} `json:"connector"`
+	} `json:"data"`
+	Errors []graphQLError `json:"errors"`
+}
+
+// GetSecret returns the connection parameter of the connector with the ID key,
+// or all of its connection parameters as JSON if no property is given.
+func (c *client) GetSecret(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) ([]byte, error) {
+	params, err := c.authParams(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	if ref.Property == "" {
+		return utils.JSONMarshal(params)
+	}
+	v, ok := params[ref.Property]
+	if !ok {
+		return nil, fmt.Errorf(errParamNotFound, ref.Key, ref.Property)
+	}
+	return utils.GetByteValue(v)
+}
+
+// GetSecretMap returns the connection parameters of the connector with the ID key.
+func (c *client) GetSecretMap(ctx context.Context, ref esv1beta1.ExternalSecretDataRemoteRef) (map[string][]byte, error) {
+	params, err := c.authParams(ctx, ref.Key)
+	if err != nil {
+		return nil, err
+	}
+	secretMap := make(map[string][]byte, len(params))
+	for k, v := range params {
+		b, err := utils.GetByteValue(v)
+		if err != nil {
+			return nil, err
+		}
+		secretMap[k] = b
+	}
+	return secretMap, nil
+}
+
+func (c *client) GetAllSecrets(_ context.Context, _ esv1beta1.ExternalSecretFind) (map[string][]byte, error) {
+	return nil, errors.New(errFindUnsupported)
+}
+
+func (c *client) PushSecret(_ context.Context, _ *corev1.Secret, _ esv1beta1.PushSecretData) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) DeleteSecret(_ context.Context, _ esv1beta1.PushSecretRemoteRef) error {
+	return errors.New(errReadOnly)
+}
+
+func (c *client) SecretExists(_ context.Context, _ esv1beta1.PushSecretRemoteRef) (bool, error) {
+	return false, errors.New(errReadOnly)
+}
+
+// Validate requests an access token with the client credentials.
+func (c *client) Validate() (esv1beta1.ValidationResult, error) {
+	if _, err := c.tokenSource.Token(); err != nil {
+		return esv1beta1.ValidationResultError, err
+	}
+	return esv1beta1.ValidationResultReady, nil
+}
+
+func (c *client) Close(_ context.Context) error {
+	return nil
+}
+
+// authParams returns the connection parameters of a connector. Parameters which
+// Wiz does not return, e.g. the secrets of some connector types, are missing.
+func (c *client) authParams(ctx context.Context, id string) (map[string]any, error) {
+	body, err := json.Marshal(graphQLRequest{
+		Query:     connectorQuery,
+		Variables: map[string]any{"id": id},
+	})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf(errUnexpectedStatus, resp.StatusCode, respBody)
+	}
+	var out connectorResponse
+	if err := json.Unmarshal(respBody, &out); err != nil {
+		return nil, fmt.Errorf(errUnmarshalResponse, err)
+	}
+	for _, e := range out.Errors {
+		if e.Extensions.Code == "NOT_FOUND" {
+			return nil, esv1beta1.NoSecretError{}
+		}
+	}
+	if len(out.Errors) > 0 {
+		return nil, fmt.Errorf(errGraphQL, out.Errors[0].Message)
+	}
+	if out.Data.Connector == nil {
+		return nil, esv1beta1.NoSecretError{}
+	}
+	return out.Data.Connector.AuthParams, nil
+}
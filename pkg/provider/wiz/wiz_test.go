@@ -0,0 +1,196 @@
+/*
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wiz
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	esv1beta1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1beta1"
+)
+
+const (
+	testClientID     = "client-id"
+	testClientSecret = "client-secret"
+	testToken        = "access-token"
+
+	awsConnector   = "1a2b3c4d-0000-4000-8000-000000000001"
+	azureConnector = "1a2b3c4d-0000-4000-8000-000000000002"
+)
+
+type fakeWiz struct {
+	*httptest.Server
+	// throttled is the number of requests answered with 429 before a request is served.
+	throttled atomic.Int32
+	requests  atomic.Int32
+}
+
+// newFakeWiz serves the token endpoint and the connector query of the GraphQL API.
+func newFakeWiz(t *testing.T) *fakeWiz {
+	t.Helper()
+	connectors := map[string]map[string]any{
+		awsConnector: {
+			"id":   awsConnector,
+			"name": "aws-prod",
+			"authParams": map[string]any{
+				"customerRoleARN": "arn:aws:iam::123456789012:role/WizAccess-Role",
+				"externalId":      "6f1c2d3e-4b5a-4c6d-8e7f-9a0b1c2d3e4f",
+				"diskAnalyzer":    map[string]any{"enabled": true},
+			},
+		},
+		azureConnector: {
+			"id":   azureConnector,
+			"name": "azure-prod",
+			"authParams": map[string]any{
+				"tenantId":     "72f988bf-86f1-41af-91ab-2d7cd011db47",
+				"clientId":     "0d4e9c11-7a2b-4f5e-9b3c-1e2d3c4b5a69",
+				"clientSecret": "azure-s3cr3t",
+			},
+		},
+	}
+	f := &fakeWiz{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /oauth/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("grant_type") != "client_credentials" || r.FormValue("audience") != "wiz-api" ||
+			r.FormValue("client_id") != testClientID || r.FormValue("client_secret") != testClientSecret {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"access_denied","error_description":"Unauthorized"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"` + testToken + `","token_type":"Bearer","expires_in":86400}`))
+	})
+	mux.HandleFunc("POST /graphql", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var req graphQLRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Query != connectorQuery {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		connector, ok := connectors[req.Variables["id"].(string)]
+		if !ok {
+			_, _ = w.Write([]byte(`{"data":{"connector":null},"errors":[{"message":"Resource not found","extensions":{"code":"NOT_FOUND"}}]}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"connector": connector}})
+	})
+	f.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.requests.Add(1)
+		if f.throttled.Add(-1) >= 0 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			_, _ = w.Write([]byte(`{"errors":[{"message":"Rate limit exceeded","extensions":{"code":"RATE_LIMIT_EXCEEDED"}}]}`))
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	t.Cleanup(f.Close)
+	return f
+}
+
+func newTestClient(f *fakeWiz) *client {
+	return newClient(f.URL+"/graphql", f.URL+"/oauth/token", testClientID, testClientSecret, time.Millisecond)
+}
+
+func TestGetSecret(t *testing.T) {
+	c := newTestClient(newFakeWiz(t))
+	tests := map[string]struct {
+		ref     esv1beta1.ExternalSecretDataRemoteRef
+		want    string
+		wantErr string
+	}{
+		"connection parameter": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: azureConnector, Property: "clientSecret"},
+			want: "azure-s3cr3t",
+		},
+		"nested connection parameter": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: awsConnector, Property: "diskAnalyzer"},
+			want: `{"enabled":true}`,
+		},
+		"all connection parameters": {
+			ref:  esv1beta1.ExternalSecretDataRemoteRef{Key: azureConnector},
+			want: `{"clientId":"0d4e9c11-7a2b-4f5e-9b3c-1e2d3c4b5a69","clientSecret":"azure-s3cr3t","tenantId":"72f988bf-86f1-41af-91ab-2d7cd011db47"}`,
+		},
+		"missing connection parameter": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: awsConnector, Property: "clientSecret"},
+			wantErr: `connector "` + awsConnector + `" has no connection parameter "clientSecret"`,
+		},
+		"missing connector": {
+			ref:     esv1beta1.ExternalSecretDataRemoteRef{Key: "1a2b3c4d-0000-4000-8000-000000000009"},
+			wantErr: esv1beta1.NoSecretError{}.Error(),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := c.GetSecret(context.Background(), tc.ref)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestGetSecretMap(t *testing.T) {
+	c := newTestClient(newFakeWiz(t))
+	got, err := c.GetSecretMap(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: awsConnector})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"customerRoleARN": []byte("arn:aws:iam::123456789012:role/WizAccess-Role"),
+		"externalId":      []byte("6f1c2d3e-4b5a-4c6d-8e7f-9a0b1c2d3e4f"),
+		"diskAnalyzer":    []byte(`{"enabled":true}`),
+	}, got)
+}
+
+func TestRateLimitedRequestsAreRetried(t *testing.T) {
+	f := newFakeWiz(t)
+	c := newTestClient(f)
+	// the token request and the first query are throttled
+	f.throttled.Store(2)
+	got, err := c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: azureConnector, Property: "tenantId"})
+	require.NoError(t, err)
+	assert.Equal(t, "72f988bf-86f1-41af-91ab-2d7cd011db47", string(got))
+	assert.Equal(t, int32(4), f.requests.Load())
+
+	// retries stop once the limit keeps being hit
+	f.throttled.Store(retryMax + 1)
+	_, err = c.GetSecret(context.Background(), esv1beta1.ExternalSecretDataRemoteRef{Key: azureConnector, Property: "tenantId"})
+	assert.ErrorContains(t, err, "giving up after 6 attempt(s)")
+}
+
+func TestValidate(t *testing.T) {
+	f := newFakeWiz(t)
+	result, err := newTestClient(f).Validate()
+	require.NoError(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultReady, result)
+
+	c := newClient(f.URL+"/graphql", f.URL+"/oauth/token", testClientID, "wrong", time.Millisecond)
+	result, err = c.Validate()
+	assert.Error(t, err)
+	assert.Equal(t, esv1beta1.ValidationResultError, result)
+}
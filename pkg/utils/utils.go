@@ -31,6 +31,7 @@ import (
 	"time"
 	"unicode"
 
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 
 	esv1alpha1 "github.com/external-secrets/external-secrets/apis/externalsecrets/v1alpha1"
@@ -515,3 +516,21 @@ func CompareStringAndByteSlices(valueString *string, valueByte []byte) bool {
 
 	return bytes.Equal(valueByte, []byte(*valueString))
 }
+
+// PushSecretValue returns the value of the key of a secret that is pushed, or the whole
+// secret as JSON if no key is given. It fails if the secret has no such key, so that a
+// remote value is not overwritten with an empty one.
+func PushSecretValue(secret *corev1.Secret, key string) ([]byte, error) {
+	if key != "" {
+		value, ok := secret.Data[key]
+		if !ok {
+			return nil, fmt.Errorf("key %q not found in secret %s/%s", key, secret.Namespace, secret.Name)
+		}
+		return value, nil
+	}
+	values := make(map[string]string, len(secret.Data))
+	for k, v := range secret.Data {
+		values[k] = string(v)
+	}
+	return JSONMarshal(values)
+}
@@ -908,6 +908,53 @@ func TestCompareStringAndByteSlices(t *testing.T) {
 	}
 }
 
+func TestPushSecretValue(t *testing.T) {
+	secret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+		Data: map[string][]byte{
+			"token": []byte("secret"),
+			"empty": {},
+		},
+	}
+	tests := []struct {
+		name    string
+		key     string
+		want    string
+		wantErr string
+	}{
+		{
+			name: "value of a key",
+			key:  "token",
+			want: "secret",
+		},
+		{
+			name: "empty value",
+			key:  "empty",
+			want: "",
+		},
+		{
+			name: "whole secret",
+			want: `{"empty":"","token":"secret"}`,
+		},
+		{
+			name:    "missing key",
+			key:     "password",
+			wantErr: `key "password" not found in secret default/foo`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PushSecretValue(secret, tt.key)
+			if !ErrorContains(err, tt.wantErr) {
+				t.Fatalf("PushSecretValue() error = %v, want %q", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("PushSecretValue() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestValidateSecretSelector(t *testing.T) {
 	tests := []struct {
 		desc     string